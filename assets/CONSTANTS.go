@@ -740,1373 +740,2342 @@ UTUHjkRmfVLrlZ4/4L7bN1+WVjMgoXs14YU+QoHFR4HTaYpTLkI0tyCYGQgxKckO8SSl2gf3ee9giXbt
 `,
 	},
 
-	"/resources/SpartaHelmet128.png": {
-		name:    "SpartaHelmet128.png",
-		local:   "resources/SpartaHelmet128.png",
-		size:    20356,
-		modtime: 1497199806,
+	"/resources/fonts/Go-Bold.ttf": {
+		name:    "Go-Bold.ttf",
+		local:   "resources/fonts/Go-Bold.ttf",
+		size:    151748,
+		modtime: 1792239993,
 		compressed: `
-H4sIAAAAAAAC/2zyA3BlAbM2Cu84O57Y2bFtc2Lbtm07E9uaOJnYtm1MJpyJbe789d5z7v99de7pql5V
-q/vBWtVPjLKiFDICPgIAAECWkZZQBQAAwf9peFgAADAsZDINAED42IiLK8uIi4OUXZ0sbewtAACa5IAk
-BXoJE8zxIMvosd+hMGXbZIjI2SoY/PJ3KRGw/xzcjnNwOJnb+bUmJHU25ky5JiJHqI3JXyFlaRkoLJxE
-N6XXfZBmi/L45u/95tzm+t1QOnq6bKhku0HZTbULC8Fcguw16cLKYuzEttYr8XGbwkJCkRvPn8uG9ZR6
-F9XiNp+fX+t0OGR4ULJ04e9Iy00RURF6GQ5qjUs17aW0+x6jjQLHmFH3/g/Z7HgICIUIdpL6Rmh9GE/4
-LvRAprJdfKapGCwXtkZz/8cSqqofpeVU6n3eGHhiSKzBY/Bh0MC9Eq05ANHLaRqOp/mo5uhgS6ct49hB
-2xpzbrQ1OW3Jcs1rtz+Est9nb70YA+P9g0AIv150BOoeBSLyc4T2OdOK48BtdpXem9UE49UPyf1XtGqr
-oit0Abds63ZhYZVVmjRBvjk5/rTrgffyw55L3rn3PszdIR+m/hJmxicnY1fkMSZjO4ItiW5PMBREhZWK
-qkt/BAAAADpL67gBACgj/2mIdHoUAgAA0tlGXdtdW0Gez8zJgcnE3MnUgsnbwRnwnxLw5vN2cHawcDcB
-eTvYO7rxeQuS/T8IPkc3vv+MmclA/w/E3U6QTFtBGSTu5GoB4mTiYGIhE0IAgUACruaWfKoSkv9NdzW3
-FCSzdnd35mNm9vLyYvJiZ3JytWJm5eXlZWZhY2ZjY3Q1t2R083F0N/FmdHQj/y+R/1dHwsLNzNXG2d3G
-yRH0n3cTUycPd0Eysv/G/Ff9l5G7jeX/cXJ0++/fMnNyYP7PhpmViYX5f6N5Ozj/7yxvE+f/Ign9H5bA
-f6T4dFQt3JzsPf7zUUK8XALM/5/h/ySIOzk4u1q4uf1nx/nf+P979j/x2v+bgfb/buDt4Mwn7mph4u7k
-qu7kZC8kae9j42gFUrAwcQeJmjm5OoI4mVgFmP8n7H8oKDiZ21j6SJi4WwixsbByM7JwMbKyqrPw8nGy
-8LFw/Rf9/8L8vydi/h83+q8AMP93AoQQBJj//2kSQlCKjyQFAEQAMhKi6t4Qq/lwWQYanQ1K2dnmbKcR
-SWLSUJSYyXScpA+W0MAlTLRSxOhQw8+I/TONRXrQ9xxpEQjKaJB+TtrCN4hOeBoIyHBFUHgS3+XHdfec
-YVTA3Iw51aR8SneBVWHrc/Xu2xudX6H90Zdxx34kwZ3ZeMJswN0kdUS+51ZVVS2pcnl5W5untIHBn60I
-MRMxObnprhE7q+ZNkzSSsYBoH8e/vrHHXh07s/9cnVK/iJ+Zj+9fcWqqq3d2TaKIhIrki5tIpsytZrKS
-C3ndLJ/yvXZs6urrnaoYmsuFrdbdAeT+IG5ul4OR7VKZVF2QJN7P6/4vMBgZGRmDphsCmlBBIIHtXOxh
-f2RY+hyiUUyNFQUVSzitVnrSX2zo90oyreG/HAv5zmPjhGyWXfQCOdExQjZzaWzcXNrlHyayMOtFuZrk
-hmHG09IPQAwq8wrFfF1AMeXPXY91Aw8Bp4FWypvAEDv2ultpfyX4VFJB8uLcnJwhGPJwk7KYwj7uZT7W
-ET9cajUDg3xRxPCnmajFHqGjJyN9JdUnlzd/27k8XmYi772Q2QzWCaQx5p4bsRCXNGNuBwWEwgRf5FA6
-Mub4hd9O/b1SCgqzbWiOQXVEIh8vxIK+KzIgIIMJqEMVh0NCbI/BJaGpzMVXTveUFyXXPUUPWBuO6IB+
-3PErEy5hALet1f10Sd7M7FB/yWjvtZ5lljGVWglsVCAHgayxmnFEh6Udz12L1ohvAlQtQVD4/eh31WmF
-Ad+DGp0PGdI2UH5M0bGEfACjVZUqnUCJXlUKI31+hoZH9gBcvFeR21j8+T0WG11wxANj3aD7quvc/gCN
-qfui6YxzVkubHBe0NSftTVFu5ebWDP1Fl5ULv+hHny+XwTCZVBce6y8eszc6bvGlO++pSiqUdiOSzcUp
-Ua34z2EFvfyYBWezQNAPwcry9mD0emknn+Wi5Y/n78sQ7dtJ6sJffZdtv9fgKxo5buC3q+Nab/k8k29i
-HAoRlhJEq9u+JYNUbBLb0n+JjHLtM1nC5LxhxJwn9bQnZFKRWsOb4ZEZ/sUjE7XA/oGJN5SF9vumrxbt
-vTVegIpuOj1bOCgQw+GPLEFm8berH1gYyw26RGMs1fAL1T++/1qukH6JIgnsQA0XigP3hwR89tBhadCZ
-4HfegRRlnJijH6Q5xsOUMp4rLn7mg9JzUMvYx9A1rebvWJ92jHmZZxVUK5rqEziS4n6L+a32+j5OMWg3
-AFgQvFwMQiVfYgOcC/OFAnyZLq+z+qqH6KwAqwFb7H3PO34VYnEkZJcEcgDKAtB+DSiBr5tRPwu3VSwd
-6ZBynwJzxgwcpV+9lxlttZTg9ql0YgYBbSDyAfxkKkJp7xTafi62d5p6OuojeS7pNCq0Y3gW73iTkwgL
-OAlaUASi3N237FIJyaAm0AZoVK2iq5XbVO4GPP/5ssytmVaxxzCGOEvYA5WslFxxQ5JambJX8M3Kwl//
-TFBdLRiBiyHrz6E1AnUtdTrvD5Wh7RnpJwZfvE8WTnISeJy7M0J/Ijj6sutVlu3qRhsiktqRioW8f99m
-5jrymTyv7wdPNo4TEwjI0x9Go1Dif/9NsDxuflDrbYi6S2GNbAWagV0RG6p4s8YAT8C3qFgYcAyhXzED
-CoyCM1ErcXBJDyaOr1BqwiFwvVE4MzVmRaN30C7VYZ8uhQLeHuCA1aJA5oOtUGrpbAdjJTfZJHipH4Zw
-Ps9JgSSXgl3HBX59kgExnPCmgbCldVI73iliSC5wbrvDsQ9oapJV8eXlIU8ZN9sieKtJiCLMateUZnXZ
-aD5Hfe9X3dHvDJ/2Eq9ExlkGNOzAUJQ93iymvcW6c6u/DHJ66aKWNiG3NebmmhxGpReJnpsWmXaMWdZh
-GgcELTD6lA30j/qkj/yhv+5F1iyrleuKzk6LGHl+GszX+Banz634n+3CLNXDTbZft64bypEEfdz2/eeB
-XXotwf/bjbYszL2PAxENxOOPMvQ+pS2GZ0p4baZTaMFTh720U769rI8KGA5r+Ypb+sGlh/jyRP4F+nG9
-0O6023MOFaPZoMYppmemboZpHEo3ixCwHY7qTpdwS8A3wRTq0e60gK08sMJJqvDRD36sl/1UIg1UKhVS
-/PdjR3MpXwIQI3rQibq0T360j/dDX3FLQXrSr33bVY4kiSviOKyjbQ+/2jIYKCvRJpNsGxAjukDoXdJ2
-qyvfG/FXLo+PSUECS4sz0/Kn4rJ5riGrMnULonyCCVfcDeC1H67OjwGK3T/jWLxTsMnreofcWP29Ijd0
-pO1TM8STg3s1TYugRzaX+oBg22SQA0VP4yI03NEEAKt3Z68zSBBamoc6iPINT386xReIcWEW2tEfNmN0
-0XM/t0xEb+uFsyUU/rs36aY4mYVVswb0nWmkZ+rREn2erXyGoKUKVgfaBEd0ABa3FBgznaIJs8SJ6IHW
-lyEEK5bmETOOlkhumNmjYsii27o1IZJfxLuC1lK764LhADZJFHx6Mcr7swUBBZsnsRXD0vuOztYkb2wd
-exumo0ygPPd6rMOymsiwnS+/8A8J0ISJA6csctZ+DHa1/4R1bjOLUUx5LhPJ36Pk975Kz4oS2Qji1zjg
-leMfX9iJYVkaYxqeIkTP53CoW7pny9Q9AC6BI4NurgOeNo9LVOEMnO/3wlBRSgys6PSgUhWLyi0NZlNz
-xkBme0nVg1dl250sV0Rps8Md46mebOh9BVvwL5m00C0YlogtHgQzvgKWRwrZxqrc9mwetFA8o7Fc51fd
-10K40J+a6fMiafadfU39RVdELP9abpur7gN4vnQzFTbvBIHQBQCzOGpBkG4rA7roW2yLF3+cKwH6C8Oc
-zs1PGXqY7PFHWFyW8zVWAq6nTBe3yZeL5nmuqTyPNR28/JWbgcTpxbVsTjtNxMOYPukZFNbqQKJoBnL5
-/S2Y+L91ZsaKAYkS9AJiO50zko6QGYRxzlTzu2WXBQjQNny+FlvB/uLNDc/n68xwiu5iqBK4Bd/6B4hn
-rRr/yoIzYHtTLnlwcexDaUCN1C5BxUsIjQ/VdnS/K3qgJkVe9Mk4+99OShL7UIdw1L5y/+ZDqErpKgHV
-upJEuA1a4oOogmDjDvT7MdNSpDugVY5gTDMRagfiR5wbYV9cKz2yyarvFvA/E8Y+LlDk31NZgOwq5DNs
-NVs/BIjGSQDX+WlESls+aZtRnqPzTeElwA/1sMJn62cQro2k4FxxPvp0Fj1RVzjE0oiVyTEDrGH9rFkR
-Sh3jeAXQBqhIHOYXx0bN1hEEIv29sOYj/bGmCWMjuhpGgWqtr9Q5Tftc/1BJ2TVPX0TcDGLl3389bhbn
-2mbhv79sb86lRhZOPwOVB7C5WYYI6a7g+R7bAjllKdm3vXYDMEjBQMzfmS4rfCicYUgQ5B8QQ4Vthwn8
-fWGRebJvnWjepfThaVqgoRaO1d72eA61eKO9g9V1FvogKsaBHSz5hiWkJM136SuYjREnsio+6droeUCx
-WTGQQj0Wt85IZIEd9NMOqp/iC7fCqVrJDPYT22bd2WBywCgmulHpOlOKKHSfocDccaJUZhOuUk/YLFK1
-R2xrsM1SPidtXj5HAmOUxWoonM0yKXuSN+OWGABxPhIDhkS6tv87HvfeP6glfFUfAXxSdC532mMoZWAB
-S7bYcIYqA+p3jSOdKWG6woGaPyCWRqJKmC3Afhdseb3Gci7hd2nZw4CEtcmSHUOkG4SlqLA8Ee+kYoce
-pNZrhE07U6iRyrfw9mYuFj0lnwRgBCuxtrtIlSEx+K258NEui70iaPnn90/X2dmAb6vECvHxnA22WBhB
-wDsZDaH+ymRK8w7HucDl8ll6J1IBi2sl3J/zDSabjKo10WibUPTC4cF+i5pZGVPbtkIc/0gTEtmEjXuE
-JVnM3zRDzVDZIP7VOECZ8MLCdkEiJw0HAVG8gk1hAjWz/pMHwNx1PzUEcLDx2wJuh5NogEVyMIMFscgQ
-6pbfde9uxIuwifJXcYAW+Qm7yEeUSqYVW3rX7tuTmo8kqO23pr6Rvnuyjm4Hc3Nq/x/XaY+zt2eS9Jpa
-A2q9/F34cVPBliuRoxDxjQkD6tz5p7kjt1kdwJSFGydwUF/eWuzuaBhz6+BZZIte+S+JMXgz8uzsGLXn
-424phf01ezqBt9x3gKm7I7rkCwPSTBCSrCCGCxcqmX0E3U8ogDAKpK8ElkAAo4hqwxG23iFW2vGHAPOu
-7gSwCg8kb5sFclgAqW86pLBJ9z8/Q14qhheFtRzYcVdtJ2uwrJej71mi7XbXBzqjqO2ggigcqLMl56Ju
-yBqJIzsmVjlNVvrG/QozsRyLdnHQ0t4OyJRofDePcrWsdl6NzdwHFCd2rtB8zbgu4AZiBbUrYWKco/1l
-f+Uz/b2f3cw5g5kZrL7z6L37NcNg4taPOifblmNhjQ0Y2kPYcqsrm52VszHH9n0lfcq6Pkuen984nKL3
-UBfvKO7rs7xoMImo/MaCKD44nr13CJ1d8L63LbYQS3okE+fFEuqMk3BhaS3jgtdqaM08aIXxUjdEyHDg
-g/B4pUMsgEX6/uDViQcoqhXf3cSO/vKA9yyDErXV+1boqpBWI3vx6oP21cJ/3eNGD/uFEy2MeYATvYqg
-lOK5/XcyNezrXzCVh+sc/X6UOZWF19QMvPzhApOB3wo0V9JzSMP7XQcvv3BrObfo5ZGYnIj/ghKk4k3U
-b+d5FYwxuG7zJgXeFtFyOqPKK4P2UtjrfsuFAXuN/r5Vnx7/sVVyJT/X7hFzDZly72+MLhjdcv1fSxpa
-g4kt4Q0CnPbagZq2TwOYdyDcKy3rkdbtTvejIfdrlfLE7kNtJmIe570/1qGTLKIrxmxitAte5Dy1ktZC
-gAvBRZBSHQ0GAzfUSMKeZQVrO8AdBYWpWQ9A0zC4jMP7l7/9ixapmfuU++eZX/WYMfE9ExxwN5vNYooa
-uN9667JXJqq1s0JOtPVs/TuI1d3aoNB/Vkj6Z+rHJFpAsHID/Hnp1lOPbDQFc80tRe0sGttfIBOFwK+3
-YkH8O4/W+NXPd+4n/DbPcsuFojN7jT4rObNUu4XNAkc59h2lDKb86fvVzepqI6XOu6nMyz3w21v1zEhM
-duViCuWhnHGoDLwIIvR8SBTOYml3w/gtFZUJX5yLCJt4Eq9cAzWAW7U9h6/etMBzjNWfc4lq8lp9hQly
-q5qIH9DxuLcHp/LHzmxcQIskD+M8Wg23BG+om6nIkgflwAh2mFDxiUOj9rSSD2dCKdQvyEwwUcahcRS7
-jFF7eQvhESv863LrMIpDnZMQ0rNPdjr3ufQSNjryuVwy5QTBhvJO+sw8SW0PS5a/Ie6sP/jvcXUNfvX7
-s8GS3UPXrGBUnUbu7CR8/JNOZUizRfh3ZOQ6AYNFZbV7t0/oq+Zlpw5SnIAc88bzY/iRqpyFEcw+qMAn
-gNV8aDM96G6RTRrS4R8PvktPzSLx89I/YjatLe7zGXj+WUCjSTbg4A/Q6yruB0IuLhYRTG79ltT3/Mgt
-YdRJ4XTRgoqV8xPWjUTcv8Xylxl2w4fgmdLqyjNmAv42I0ubw/XB0xhB7fr1cnaZ0IOI8p8eXys4VcFO
-ESSiGXqxa/M/0X/MJE1zPeIKeJsUPo5hVcOhXOQ62Ey2NoyDMofhX21xA/eXyvjiKljDskz+EZQrzqLy
-7ofbr8CivSFBHxJCk9mOKnXsIm4LIl2BAXX9SxlKImps5VvkieUveP++wny/A7Jdf2tJFashJTliGgfB
-agVmooRZkdynJITmKBD2FZL92ZXSI4wa9wPOoFV4O4XmVgHevQ8Gq2PIo9qBTgERK0Z8pivrRcN4F18O
-8HhDt6SSBPnlsrQ2zbPpCtCWEzDZqwgzxk2rGnJnY3GG6tyO8g591V1t2RyugohZ5xHZ+tsbJ7j9X2U2
-y3ON175jHLfgd7lj0sFzlbRewtHsZXsyCsUqT4ASU9IpxgUCRlq27u/iBKm5oSxWGKPyDwUVtdxdlRNM
-qBHY/THm4SpuNm+Njja+eHveuiJGcQuDSAT+uTuAF3kwzOS42yd3ta0wz9du2yaeVH1BugQTXEGn+0jW
-vIk6GAP1IrbyfQY93k0FIbxEzawxnwl1D2pyXuRBbL51PWfXqQTY6Z9YEABKEJmkUdDgjYPdMpZRWx7N
-CiehUBtnEFPmQ/W+7n2+gqsLRE3wowQv+u3Z25XPNA/+20X7xTjoQE9lLMBU1xn7gvKM8a0nk2yWD98u
-J9ghIx55vyWueKBefX/Do/d9qmu2ki4mqHlE6o5aFYxKNsiH9Ffj7JL+tvW5WrisclYxVUIjMEDsY1qy
-zg+d73mwS/8qdutlFGbFfrHN7lCL5PZUIIknk/5mTNrzlP/6Mfd5pjn+5kjsM38A+kWagYLBwoR2GDiC
-4cfFYW/MKS8K6lu2bSWlWrTYETeG9K6SiefF3P4Ddfs+hNYd8lvH2mhe9sxrUwJJw3qD6iPEhELf3O9g
-ncss5OlAliwjPRY5e8L47NQ4nOhWu62PWQQy8ocvcNUjSZHMJsgkcoBMd2MkW/wTSnvbx4c3r9f4OkjQ
-diV4OrLejTH3EWftb1MATlvLiZfxSZMFC/zhGRJal3Syf1/ZeBIprxWcqGgI/F1Odv5D0m+TUKdAl62W
-8c5ZxXwhwSyhm+0DUR1gJEI/jD7RpTZHrvSnt+MXguhxciCLYT9Km2I7FTORvolHp0/7ZfsuuMN9rOkq
-cxJQsNnORtjBnR+E3M0spbUGBABxLVDus7DNwBie/fVyUDyBBV288FeBug5LMkr91cST/d5/J25fj2YM
-8pZ4Rk4JC3pnjnV98N7ve96g0HUWJokEblK7Gs18i34fjCkiDhz5ajaybyzdLdNqGXgkD4i5W3SUQpBj
-BNcwdOTAXvWVHK2sizU7ApED5d4w1AX/uIcuGVSjzo3GCEX5fIm+qjiqx7hhbCFekqk6tkCoC+tyP4Py
-OvAkUAyDlmIctnLleohn4ImFl4KwAge+VdITmeAd0Ttzzno4TjYJNb5mIhk933fa78/3unZ68FYTrAZQ
-3gOHbVFRUk/hzhEiysLatv1dZvcGP7PRQ7OND3R911f+4nBwyA9DCKga58MQ8gT4lkFHuQQzp31fpB17
-5Yf7HQjb1hvBE4UniH/vS3m6XDwOwRLloF79SoUr6XAxd9rIJZt7JxWjIyY1qdQNbxHAqx78UiHrz5sc
-KXv/WRrIPuKp8C7Jx486kACpHGOUREtzXbeODFn0ww41Gl655TUzAfeWNzoIMZYX3ag8NfZ3BOeaUQAr
-HGnDZu8aXmel781eVygzG20SBfVIv5u5wizr0HGost8It9GBVFXExi5Mu1fIdN459N6tmLOAyUaDSZRY
-YuZg64gWz5Ko8MyxGU13DTc7D6bZtqY1RhUkCMTw21H6w4HfKcx7lofWdYvQlF+PBMC84AOxx4C/XydF
-7POXgf+RJYKujhFgmjPilRC0edNY0pT9ES8N5pPT1d2WV/lX3yl6O76isbrKZIqvDbP5LYVlRdLAh+IL
-LwE+yCN2mtnJPdW64u1NNrQVPxclry8Fjyd8XHyB+7EM1HGMVf/HeRh5dFomR/vkp3Opy/l0s0IMoOBS
-b7VNqpfL5g9dcug+FJynYkUF6BOq4TC9mqoysILOOprkDiY+kSYQaOEHkaSXP/eolHRS56lBnNNw6d4R
-UkcB1iiRak5ad8fisupJUaIe3CibrrN53lLO6xW7nNhHft3x6NXHAHE0omv3RnMniWyzvhsGfcKhf2ln
-FTXfnNPH8Gd6D/6TWSgqUose+4P5DibnODcoNYjtap/kgHrJkwLkf7cPIw+sBGYaBfrk6oq8TIfmv2ki
-5dvLj4nzEH4uANKRFuBBq5XFUQBzqaN6qYVNDUgaqIGQfMOk4IkVGj72zHjCx/su+yt3gU/LJdejdIj2
-ckF4XtWs802zxSKHNtu6K431qGZokwCJisQ/Q05nD5RcRmS0PPkoCCURgzYQu2eEpW9IH4DbmpJe4PP9
-4DvigjXg2dVwa18NUZ00TmkAGmMRUu6nUSZskTzUBukIKsPM5yJaJ+MBXL3wLVBxWQa6qHAgfn8E8bk/
-jNafW+h+AApV3dWy69r5mpbIiYlIyFZrwe3toKENg6XVEoMkC0b59JrT9EVVsfXJ1lIpjvE4Y4cpjJvz
-k9X3Q417W3mIGeeWlgS+/vWBePnNSPrxHk1NgJ1+Lu86G+HkodDWNBS94Io86msPf0Zz5fGXKc+FbR6K
-BVISDyLMJS+hZ3RbYZIjx8ojC9S+azsp7bMcAF/y11kQSfdDoeWZlDVkjxPziri/1/1JsE3A/vtGgEe2
-bZOCx6ohfw15/m0XXMHk+pO+Pr9tfrW9HEQW7xUs5wayPxDqKpZl8PTwYo3ttoHh5VA94fTR/01AjYSe
-9KLAOiLsW9v94Pvygkiguto4yOEP15qPABdTXxWMKcQhOhTNZ2XMxsB6I2OYSbBs6xISXNdtcIzja4wl
-ZFFfse4QTkOJm/4FqSclXVv6Vf1FoKml6snXj+TzK6P0WQXZUuHasampehNUHa4AhNbzOO2pbjsPJqiw
-sGpKZGRfPeprQYetbt+PfJc3PnytlVJbTeiLRAQQj6b4x0TXiHAU8ZgFCqnFHUtU/cTlRIzlvGQLAiWs
-tLoCxCSRzOgw+qmTBoTSSoudi+IOCEycl6nGOAid+O1rS34LYWvx/UlMcKbi0EvYE5HRF+H8vRo/e6zL
-zh2w+P30WqO7w/PvhG3G24iZGzOOddOmXLl8rif1/KbM4yMRgqgVbDWVRokuttgXrtpNMRKAcawYZtvx
-ixy83hxjCRs/CsjryzurMsU7slZbpWnR+ul3SlVVUruZikAOqrStoYjn2Pv9i4nbVYjNKwEOf+KriU7G
-EqIB0Z1DnZPI5/OJ0/5+NozO1+eFz++4OZsoA9vhC1/J34Qa9LkRWGr0e3dpREY1ozf7rndzYQnd812A
-YspYdoRGU+/WiJOOY4LUe35x6hq9u/7o8ZH9NqiuUYIa4VPVcOiYEy8tN3wM3GMhsOZoD48kUvygV/mu
-ttHD1v19mBFIbTCd8pde/lv/bEti7cveo7CQ9UKRsI7N/Sz3ddJ5CJPfhZoSjBVGps98wfffTr0LjZe/
-qkJuqZYSL0l4t6/HWfWAzKOVXXO8Nzl2lueP1xyHy+S2BhwZkEK6A6gs+hAqx10owantpOGf+HEunDn7
-MC2hI4yLCU284S14uqcdASraB2SFz8WDHaojcsiFJUNPW+6ds5AyzvOb30lQGfKu50CvcNQpzOsGZz0a
-nKMBVffanWyKAl+Q92DwX4dxCALxTBk569r3wCb/Crplt1rS+NcuoVYGyNaV4rdMDi6hFvfB0Onu+t/y
-PIrlb5L//HZuYFuDhxDmlNqMDmINWFSL6PelseP7Xk5KEtdkS6xhlyQ04/2/0T5Txjd459mW6pxSC3Bk
-O+0tnq5nk3VJFU16IziGl2fPUOzksV7RAAIAzjbi0xlp+QNUiRpTxqeV8asp1z0kauk1VbYnrHkxqM1Y
-wU8NUXsfcZQ7kdQ6NzprqtdTQ0TcJ6CHsr2APircXTu3cFctaFc+lDcBgSCX7LWRTYnKP/Obhrim/HxA
-l+sNi7EisonMJEomMsENuwNh3Ww0e+E8inaF+xo8Rea/v0yf2IIcuNMOj+7lP3l8CdYU1/1h0ZxLCzGG
-5HyRCJWXIe8E4RHed2Yl5y8T3tr2h5ejuavkoMSwt7TQbDbaoywAZV/K5T/cpLvpXVbkWkCotRHlB9ZC
-Dwh2kC6WyX5nVRWQLrw/emDShbVOP9UGOYOEZwQKE8Aj+IOKzruwpd1Gn0KIIvv6RlZSVb/WqZEAKyU+
-HGnBjEJ12f3e7z++s7f1R4gX2b9iNxHDUT+ik3puRdlerjWKTDs7ggYXCkszdYMfBHWerxEN6uSsTwxD
-T1CoBn1zIIbMrXmkebC2tn/pNEMOqH9A2gr1NEsVp3DoLo5Cm6JS9sjtmAhRG54IOv+hbztzLHSR2r9I
-s4ToYZV5VVy9rLx0nNX6TfqpEfDOcMVrLo181KQce+lk6+jT4LI/pHf5HqIxM4HmDjvy1V6OW2WECPGJ
-HuoPF/pqa9o03ZIcJ4aLWGtwNH/zFlDnThx/XOsBgs+Y8hV3NuiesjCk+/xGXc99a4gklKdJ+oWNOCmT
-/+8DKPNbCwUsT+KLt8OYts533NOygJXpQ4/2axT7u9xlQytBs6DtB6Z1gk+2VGpgyez6/TUDimbRexNd
-H4IlZKyjGyn4S4QcZqVU3H2sePEyJrgcbromkUH/9hvKlKOFSYSwNF/4I/9bLhXdUA8uwhYOz5TZVN9y
-O8a83bRL2idOHMtwaIFbIhYfgVVNjl9o3w2eV5Zx53nmIwZ8AdoWEgXn4c7EAaV+bOwtuhDqXyxhvjN3
-XhY4A68F/o4YRGE5PxSj7rFnSF/1xs3ljRe6Co4rpjALLAF4VI5YRPH2gw3WBrS+YN/nZV4vupY0tZ8l
-MkOmEc+c7k8BJpwMCDCwA+Lb0TONWygHSdg5h1KwIIFxgfuADlnY6O2nLBtLRVlCjOtMavfg54TclBtD
-dH3T93xLQVfPf81QZ2ybyXZWTSWS2Icm4mybxVDsm7pjyxLZo7Fs9oYDNBde0T83Mly+J4nqPM0+EPL+
-kuJEFMb9gz33oXf8RLtu9FUwWrHjLC7h/v3yyifOiF+09iuM2FCZplhBOLqbc+gVWqxm3vLYQlaTNIpV
-aFwHFTUJW+sNdKHpSNUxLGVpDOiMG/sITpG/TYrw2Rmzwm9P7s+monYliYudz0eO9eP6V4PPyy0VsUZz
-XZQuTRFTa6YWm8jm703F7qtOxe5qXr6Q+S+CL+OX3bl13n6L8lhMpMUm9XwgT+Z19AHLPGng+6X8oHZ8
-MTCtYYzPf/A0dVBE12Fe/vLGhOHNXI9nU6/VeDIFVoKOIbDxrF2M58Q4bXomwXJAgSwjrDBSstBKRTrK
-me0cOsjE5aSQZcAT3iMGAtMxgtA3nlRJeC+/SHJiQ3UopXgZDTeYg5vbNOJ9454EAkoetKkoRHUuQ2q+
-bN15atVLrKBAyDbBSVaDJmNma0sN6QxLu35PtMCduglfb1qCzJ5dbGeF4NEba2uw1dlFsejALUZziPQT
-XMk6muAfYgUez764WGfPzjScMzfMzaf1B7AHl8pznr9p2CrnBEP4IuHlVF2JB8lX8OwW6qmmf1W67BVp
-zRR2gt82Z+LwduqaALWheAOsz96fu6ALFb5jBBvIEfHgTgMxyUmZxgY63F3x7TRXL639gUcqM0ws+pDE
-pyCmeGHRw+4hE0V2hmf0uZH+ntOZpfp/dMO5Tn28QS/RKm6Pq7Ka1U3Kb7yyvejbOGJOMXi/7ZvlTWhS
-nAqIHaHwRV8g40o3PWuSDuBbxBewc+++LSpwzZofz86aW/VOPY8VTGIa+iA7kJIXSSb/KrDOsu9DsBjK
-jQ7kcH3oEj+u43/jyBFeTRUChkK56npsKgBVXhb2gqRoDABnqIzUDTQtKLcBA6+CWQOtxDEEDaK+K3fn
-QM0Em2FdTFxJqP3NQtwHiqqbH4X6JTOa1VnhKgU7nr8dcKjDHEd5GwP1WGuCIWQek5DK3fd/HhatwI2j
-LLNDdPR7Spc3PPd9P2i2JyLsBQgrTCNlZDb3OHkk4yyPxFpClw+VhOHBWiLoOeZPEvpaU/ndrMh7ps4P
-yfl3hN7jtH6+WWgrTjvvMQ10l0rNy/dVn6XJMffxMPwaCSMgQi0/ly0Nv95teUthLNnyE7+fLfqN+OKJ
-WErqLutVTXfj0yJsTM6eZncZDiGWgKcpWx5RbEIHkyCDu1eYkd0Yt1x+1I41J4s7IMBFvys9lfbmoCFl
-SrdZGXNRl6odwcOghgtFwxfwQkYZGiXauitf46kQHWdMoukQnu2MhOij+SwU5cn7sif/VANnccfvmGmD
-z0GzvFHDtRwqKcJ5lLGywgEDsS+egWPksbp1FcxH5NhceCWHlOaBk8FkmMKVAzH0lkqtFJjrncAR7mmD
-MiFrd8J/sKbnRPTLFzfMvFvdehQdthaaWgftEHcId2n7zUPPtY0WbKDLWnPfr07jDb+Cn8ceNNjJYePg
-lC+L2/Ngb6BA5TeQJm/SLgjpEgrHfDtzPYVWEXkwhBfaXBnPNcuwafk+sz3vz8XuVRLPRuLYBLyNzC7f
-6a3B98Yr1zL4o20/tN6NHjfXRhmvKs+N2BVeXZrInhi1jk6qyI2HGxy+n3Xb6g80yavOB8FBh1Kl389R
-Apk73aV/VSWRfmyf2Vm+zAoc/kPOCllAfTuG2XmxwYQvYnSiR+9H3fkIMZrDbBtq1wlVwC6vo/L/ByBz
-m4S3xaOElbGtO03eL7UoL3bqlx/chHzZcyCxI/Fc1dB6NCLSHVjtgf7QicF+ZrW2W+BjNwlugXX2077c
-QS0mMbHoHJUtE0PHJ4siojjA8SH+DkbTQLIQhARnYC29TwkxO+25n8rzBZDLf1f9hIMseWr2qGyGeprG
-pLJPmWQQIYNp7I4pJA73pj2gQiPgxPMHZKwMsX9vjp/BbegmADFcEvfIc2+uPl8sQneJVqyT/ioXISUq
-sww2HPRPVcfkccJFfR0cjAKOvaCLghhsysSwk9wm9OR/5T+RTyYvW87n83sR25Qp1fr2C+z/+tk2mfey
-ZXfNhuwf6iBRg/P+bBillN9w7JgNSddRmcCoAuni06V32gRizBJ/M0JQr0eXMrcRyzUGvG+Mk1RYPFaR
-a5V7WEUjij3c8q2PD5h5hb3OMCyXzDDIt83nbCeJ9tGHLvagplWCv8vuIcnhHwTTjmcPRXhLZou6KrrO
-xkj1fUgaVDbkCScl9z9mYcIEwS5D6KFN/MTT/tHe/0fze6I+qsFQPdUBYpY0hpR0NvGacGdQ4lcVUAdc
-qSrz++Agltlw3EoMM+NAjRssyXgBJN2D1oHYMdx+htnxmZwywoNO7hvp5egHGynimneljVAxmKJuei1m
-e2eKnZQ+tIRyo5pbYtodITzf9H29rz6Np2nk5v4x9qdqvmeyhsSF2tCkvlUbtpPxp8QxLPCAFUky+ZTO
-6C45L2LMFq8rABR/0mgVId+8YrHnVcxA2kwHUCqrQD1kqL23qdDMYX3W9cUSBtiP0I9a1OrWXljozD/G
-LPtcEDj1QM7v7K+zh44LJ6229T7NYPoOusVX1mvY3GdCsJih8ph8QHUSy1oq2DLOBltRraDpbUFTDWQv
-ye5b4RWlWYwyF7AfSyVjf/ATBKCflmJrJy0BKB0utVMkIaGgkUXE8AaQJEOZfQ9iqFcAIRD2eUXclqDO
-X7Ksrff9ORDfJjJswag98AhUuffZDv0DSB7qLgX9kWjCAxMbRMJmxGIfAm2rwgXSTT+iRZO0M1GKASaa
-CLwy/PkJG8keU9jW5JcLkr3+sJrCWxprKDaDpd+eaW0AsmmyKqSu72Qdm+tDrdQuWM6i1a0TMlWlc7um
-sKGzJB2zGc8W2IyBPz23rcv99G41gFqUWtWnc1CLrynfbN7E76ueXA8+YKCpdd0/vuMY/E3rvDjxOO7W
-Bqcsoi6/lj6JxVzC9K5cgOmztYx31M9NGEqf0yFCuVOn4vIcZ/ypwyfOhEZaTOdkP9bMxFNxqAm8K/4d
-lOmViPo9358nz4lr/fx3VYUgJMGkF4fncMlgJQvNyzlTO3n/awQeQ0uNZhH2QOuwmca4lkMEoY2u8SOG
-UEw6Vv/Bd1jF+E4QDT0z8rvIekSVmrheZyH4pqAcmcs3LEgXkJ/Pp7/lweLeROkiooyr3l7/JgI/oQaV
-tItau1Cf1WMbWFJV7GRT9QcVEkmgcC+va2hdbYNOzBmyrCZdf7NmIorgB/LjnYfL1WfmbJFuZalDjB2A
-snmxOuSfvHXl1fiOPVMlS3V5tguv3lnfwUH4QDuJ2xz0EhIaNOxBd+mcSbgGyVXHIQqnD9pcuOccoFTj
-pRrxJgOCctYCXVD7S3F1hmPJgIGldv1mfxgKKhyyVIXWPeUFlqpePTpKhuB41uxbiYWhbG2devcfFZ28
-4IkGCcWLqJxLKuOM4wPTb7EsVGMuPv10kN73yrJoiuuUHUbQBn/OmCsEBpIwrXwQ9eGi8UGzgMX981GE
-IkIS+WCoYv+T0ho4j7tJauayQlSyHtars8Cuol6GFMN4UHgmm7XSaQiOc21shyH9qhT934q7rr/Y1Cwt
-UFcf4603EdjGdqGkH0YjOwiYpxm0g2SVackZiNBCtsELScLY0nSvWtTV2L07G2gYj3WIi7Gt398eiqra
-50oebfXwmNkzvzE4CeaTXhP54Jnd96iK9jhvgseZACEvG4bGgCR8+eDDJnuvqL8K9ih0nHIfe82Lx6rw
-1/QfAeH1yDyQUMqOxVRF6bBFpASYTzszHvlrPf+MU1l+YFAPIknAUVj+kpY3KmNUMPaRDd4sl7h9qYrY
-K5UU81wRIHDX+MCTdsDaYbbiFOjAmiOYfLUZcza+ZRM0DNEJr+hNCI9vwN1+WZT8+k5Btv50UCDiDDm6
-Xw1/K8F1+NgKbjAU77/XHvAjwZXs8JXpMpOHwuQcoDY9feQKRj6G0v5DRn+uJfwVY3DrxyHb/5V6Tpqi
-c7oe52N4KtI93/R8QwGw83wxlJiGht/i9qlXGCqFUzlDfUkTeu7c3c9RiCQgnVu3E42jyz168ZQTY9lC
-DWfnYiz/nWAD/U1zDP5vVfoHAV5FcEGS7m9XNnwfzz0pIpmDa3P4NvGu9j/4B40sYyz4uG+UAX2HJipt
-t+vL0GiESuoaB1Ne0NvD3sv98gG8yY67l1BXDeG0f5mcjlghzsNEvs4DUV7Zgte79JvJnl36VUkdmXof
-1/SYEwh5Xb3ebMzHF5nNUaJeKlgbDk+EVSuwNEM6KUMesZ6YniLfA+ZdWNZ1kdx51lkASDiXSGWfpAkR
-/pzZzJ1rbJJAS5pRlB9s+z/jBQLCmb2Igz5u71/ONFG9pC0UhzBa/Khvawkb/dagt2jNQMtd5COXxm8E
-+dLdfySo9I3EMJZ4lnVmm1JO+8v6y2G9v8PukZJtIERaL491T42wHnJLE8CNr6t6pcGQPfvsYg1mw1zO
-KLQ+oj+Ps2P9q/fzJUZkh80CyfqKFHdAUQZAgQVMboDUeSyxEvPnwiRsasJr+VwOOcylDybbYUmhIXE2
-ALMlv/UQt9rd4fTPmHf0aQrHyFdmatuMoGg63D3yvc7gd73dwPdLuBDBi+6Ufjrx09RAdz5s7dKSz1ju
-YW/cZu/nU+aKrepVQN/Rc18Q+IbLdigT/i00vEg2ten2ZOFNKAyp0xZ9QtXjl9XWr7+9YtfddMETldVs
-0mqtzVA83cTm18hx5KShDEQPutXzAPp/sKyRlzKFo8D8givzMTbFaXOWGKkcOOqeo5GPJ9P9/RWFWl0Y
-k8UqBLKgGdZxAfF8NVPBaRt0wfrCh2/NXYyRVJQK4oWHwYz2CnL00JpGFAWPSCwThH9P6V8/uORv+oJO
-HgrkRUyNkui70+CTpNO/CvK7n3f8CtNz7cMbIoSrHe+kz/g8qJPt+YFy3y+SpYhfmdC9UJGcoVnGetx/
-sRNcsJq5yD3lkCfwDDmdlq024DMB6u/xP3FmtQV+v8zxX2v3fUcVWlPYc0kdPYeqU0XjEvqdU1Gw+0dm
-2IBuOZ97sli/9Z5m7AnP+OhiRgxvQWt8FT6sjJQ0LpctJDCDfGZMSfuskMsf3sUieD5FO5IlWWF/0fuT
-oISS+ICoxXIHdEe/QdB0x+E7L/jszwhpEvvnG1mx9bvQHm+o9inOHAvCAS7ZveNtrNHHT5QOHLE0/LrK
-nLBXaYSKcbzzTNOzjTakwx9WeM3ekNRvDMBFLd598qJFaHGC3XP9cfexT7qc105WszHCYSmBB50p59Es
-zIzKRZ9YFNr91Z/Hlfybb1wp0FaQYKjo+rjLiXPJ/shlw7IP77pv0uRWrWz130GmZVc/IN5UcPeg3ojk
-9bkq4ZmXSD4A6l/SS4ZpLyvTPwWr0xwpfscnW6AL29CnFf9KzKclqV4s3JVo8684D/9B/4Kxi2i8bSTd
-h2Etp0Rp5JteAIvHkSO/P2VGZdAPD1ZhgaUQSuD2Y4Py5JwUE4WMLunNl9+JYJlKLY23ss0Ypaq2dUoQ
-cd5lJGWqgtzN5QllX1RnE0l7ci/cN0dZWogawXAmZB6g9kbsaH0cdBTJWxJOfouIa6lW4m7peUY3VMDi
-hHocNWpngzB3fcPre4RibV8A11zFXB+LVOaK6NGgYTlzhGHs2r8lPVTMIjEk/PhiwCIZI8GF/lmLhRum
-+MGVpDbIvYGBO89xeS5gBJnSysmuzNk96VAgooC849nNAIA6ZJBlTPFHzgNrvGUU1bY1mlUMRfWzJZ77
-nAkNbqwQK1E3h2kzjCR7C0lINf4SgUNdqT9AyHpiAHwyCt6OYBignWhS3ZTpW1nNPH7KvoftWdBQbY1W
-zUOJKznfeJlmkyE0kt9en3wG3oj787jiWK+hwc9S7xia7HwKLLWasdW3NpAiHmzDGkZ5vdq/GGaZ0Kac
-7E8wjscTVLC/aGvVwm2YWDijJQOjh38zp9HyVqf/C/CcyfoBjrPiJw4Qf9KL2xdsCXXiEJTkT1Q9woN/
-ZBqhb95ng7boSatrEliSFc3yJ0KigD1YfmId6GKW4MkRQ0T78TDOLfvYdQa7yzjURh/Kz+GyYPQ3/fOY
-ZbViGM1kNhQMWRc82967iv8GDMp8SRC9mn3VAK6879L2kMcUJwWrLoShMH5oKSe8PoL9CV66OcA8pxin
-RSjsDaijxFBZTIXIG0HoAob/QOpVzakhkVZwaIgaIx9+cmbNM3LOAoGz7jRI+whHTCyNZrnxkj4/nogp
-P1mXvjweFqXmFaKJk+0+NQcDgUPL/qNYWnHF8SQH9EQx9Q9/e3zu+fJ022ydeTHQ8WEe5z8zV7xhr5wU
-c7a4Y4ikVo6IH1FJkmB3xmfXuatd8Yd7vyBeOqIb0Za+7YKwVPwJBr8Epq6k4HbNxpyJbiUIQDtwkS+8
-PiR2sNIIbij3XWwj2YDP3gp3DDqcWrruVNahAUrMkCBnmzRaMYMxOvG40BvFwqewZA8UK7LRN57Ehagv
-iw/mmdzpPouQO0zGHIopEhS2oB+ShJJ+Ge9aK/iLXrQ1299RXaCihaO2CL3bMKhzHPe6UNz6vv2xp2YE
-2kAA74OmZiGOgrI6wW/nOK7gl4FgEqmtXXeQS3A5xoHV+PqheEq36hUV2tO3eG1HDAga7HOwEewbKQtT
-ZKAn9t5VpRZQEo9+oojJq/ub6gADhx07XuzMNds17MZ4/QGFAYLZC9aDE5WDXn/4hPlWzcPL98fYKnb5
-saFyodn7zjWVGklJ6a2TKZqPzwRktoSAxREmZZlYqc9gime4qsbB1PKqSvyUHQyqgCVU39zSoyeCENEA
-jH4Z8iCLG5lbVYo7/H1XfoE016vNXQfe5Q7hmRS+EGCTHFb3/hH5ln6VODkwDl39L482d4yucThVvhzu
-8Neu2y8To8I7zFcOFj941NIoL0rainkX9pesv2fG3BjCL+Js6w7tf7gAIuWyVngAb3CSSGdQ8lPh0Ex+
-2AS1RVi2dN8F+lxyZx3RatmSQxhZBYcrfmIusZ/KosedjWsvzrPREIO9hZcKX891eZFXb7QQkTOwj+lE
-DoMaZeVwP1/6ANWe2+e6X8Mozavr1EprdDBeishjhRpH31kUNcas9wphj6dSmGOV1JoR5zovOSB6qhCF
-iCq1L4SDorRMuGdFLNvLeI1/Jq4+LWJsb9tY2+YEgueNF79N3hekCPPZEu12M6zC6UVGG0i887xoZ/MA
-TV+pGi17ttRShySdTY5IBShtJ5uaekpvwcszDgf9etVqixFGf4v4gg2+0De1C6sfkK/W8y+CDwvMUBMO
-XygxHM8qqHy0e9E9h1u4graBs6/+4iAaaieW6c7VGSJYi93FRUASMRssyWOz+CHV7zg6p1fOEE0Iz9he
-KI8nWHPvr7j0ZIXdWGXxou9PT6C6tiArcMPnawx404nR3KqfyppBsiLtQDVdMbQxPmG+E+Uz6nJyMRn1
-gujKo13+bKbFOolAIw5nsTclFuMvfHf7XOBONBqrZ+VNu48sWj/7QvALShVmS2m561Nn+Wez+5MxHPg3
-tAV7AY9h7yGurNksG11RCvFj2JQBxQed0hqiPK3v5woj5lBzRjMn1B9s3CiJ1s/gyR8JQ46FNml4Jxu0
-9ZIISMoqbXIklDnLHn1n+4v1ZqS+L7r0HX2GX8jefcdbwpBV2D9CGZH2xXc3vnAqn4OBtxGq13JIaBWM
-lfDCX54ViMDwFtnUygcMxYU8W6p9/6AHGHgjcUR5iqfup1qNaEpNFomNlnoksgKlCxQqNV1IKJY5ENuD
-wZkUsmigioJ5MxSj3BZZuSZfxR88854FZjqwd13DTGyLX9LTsfzop+c9RAwv3SBpT/hNlfDB+es0nhGJ
-lwDp8Aw6VLQ+G9Q+7keZNUS+aP5PWcaikaBIxX7FsPWF0+UY7BPR1+YHpla1ya7gAHcbQJtx7vdAL5js
-PNbWJQCWFQiOzRDPmGkJa0pS8tK2Y6CKrhsG4t57B28o6suEW+sgcRYy8rqJ9ngTBYaL0R9c2/N1d0d1
-uq4tul8av4ZkTEVqLrLBv0ARpIGo3KGKWHnA+A71RQxr3FcGm/S+N5PfIbw244hk493VlVsEYb3C+VgE
-8VIX6BcADRsMFDrZ7Q/S9rCp35sqDTv/BWlBsHAQlbXH8inxtgs7xv5ycKxZGsTZ+FlWR8FGmplVVVN8
-ah8ukMlzVuZKiWyMkD5UiKGTPS1MwVKrivhi9DjrvK2NLRwYOumNJhJI38wzX/qBmpXw67wreKtoRQ3n
-z0KgiCQO53o13pYX0tO3Uj5i7Ebjh1RtiF/l8l8SUD3jIekDgCumNltnSowDTKLTL7JPGLFz/6Wv58FP
-saW50afujGIiJhnhEdQgnniqoEQ2LWBkELpoLxvuNO6AmLO08ivaMzZ+ALyHk+sW0gshuDvhRO9C0iE0
-5xWFyMd6ZbTYxDrxnoH8eCpix8vkxBMWcGSZtHbFEHz7uuqOmm4LueNqP2LgmIoJ8tJLvX4Do2dx9B+A
-DdW/VrP2D0nBnhei1n1yQ1TJEdTZZIbHQQK3Fz+N6LdbyVDSl7+e/VgL+rYtD22oJsKgv4TWUL/lZ354
-3aBeoF3uUU8+Q3Vcx+Cu18KMvTMhfLBq4acx2bZuyKehpOmodSMF63p8mwjSWFXKcdD4hBJrTGd6iXcL
-zP3ZH6v8uR9MXb2z3EMt/LAgXgddULtGCe9V5XZzSXtKGHdLasHcCmjrx6pVcGJfZHe0HLIIGin/ipgL
-YBjmy1dfDAnCENZRHBnTD8HOUtzsSHkiX1mby4EEp5eO5HO19BSLtT7JW9KgbCEnSbf8c2ibvUNPtgPO
-ECamtNKNb/ilbjBnf0LDSs+IZw78HJCoJyEMxAnZIhkaF77igerqCS4yCAeguGDAa8tWGxnXFmbFuNHy
-px5zle02ObAFs4da91f5Y4cbioShs14GT7p8OCjuDXw91R/xjvrRyKMJ5QVnnrk0y+ENOB/X88V5dpCJ
-/uSpLL2a/dXR+6OqKnJacNC8ydkDeJfa93K+Wz4AmBRdLD03lShkGwAQGV3tMk1fhMJdCjm3GBt6UYIb
-P2m0R5ZJT+rROpxYnsRBsb3p0cwX+raWZV+0LQ/1DGheqL31SA5swRE3MEPC8k1790iJ/aXDU11C7+W/
-KuR9i8vrMhcAzvDVrBejw50FJD1rWpMzsfKrmuJEpadpssZf22+Wb9vo5v6IV1/czhadEqFIZNyeMOQn
-KxGjyAeaENpv1rGkfX5fJvNFGsVY8guDK1duJ2v5azU6N7ca3KhePTP2h8rY+1ylp4gTzXB4wgiRNx2K
-qvkz8HMrMpjHtiaI7VhbFoBvxL2GdAVR5sKxZ1iL6Lx/MqTicrKw7JGswNgojzRLNtU2gNvCaioT6noX
-hJ8YTejyxoUhQEW+01JU0aVX55c2lqFJlqF7MmBtUQY6T0y7WFxSnAPQAQYZgCJ7KdGK1IyqPIq+8++M
-DVZZTtbfSmUhgC9OUF6Mvc+yAFU1GvfeY28lU32qJzCENDwIdliqC3+w9w/0vBB+O86WBYB+pIrw6dMM
-v1Ypj/gYnB7/Vm+KvietDm5kGYRxvoCpJsuGCn4+vhn617YJI4/2VUIzjtefmMB/1YEoutC2dyhxYGUO
-Xbpsk4Pe2CMk9tceDNX1EvHdRFEMW4p5m+1DFG3kfRPtwsxJ0/MbfEW9JKSZLNwSOB5+4S1OKv3KY+Pv
-ZGrhEIX+qOYj1UFao0aOOk7Ah5tSRcAXrTxC7tH2+9MQG96AbA6kGc/Z/MZobJo94AfdJkOhQ5TwAso5
-AAfqhDhNGEakPgy4gjYL8MLE/8khqA9UQJv7x8/SiqCF5YQ6ayV1vy59lLBwSSCfWiAm1jxQnQhS4XfA
-l+ApNodcCo+fo3F4cyw+DFwciszS8tftM1oVGloDBL6xwsb0R2xh5HW2ubJBPsLdzR28wiccbXpPXr0D
-BIhtq8T4XypTeeQ+2jgvm0X43CcASE0Pb1wp/rApn7iNzFFpFwjPbachYVSXrPPcQ9B6P3lRyj4MWEKC
-jC/KR6pKvySHgNtTPJGe0vxsotqengNSjdSJEmBYCoXwbwkDAgLF1ZpsuFLm4Ray4aPBQTHVG0Km0mO4
-xLQYuMEt2VQ8FuS15nSm+QmmZ1Yls3Dg5LR+DMJL6NvPeiPu3UBoFObwF+qQdV9OSI9wYWcb+90+LPmW
-ve9p/WMPNB2N8n4uUUjGo9YIH6nw1v6E6II4wSsh1RvuvzUAmyOgM8ADV7QgdvCTCKMgejZAJHHLsfuy
-XGpcmQrt26lkxrPhizkNcN/j7bSCOTxYS/wjZsAPN9qfcHKJYwI+QeeAKfbbCoC0lJIfPdubMUvOdKZJ
-2Xe4Urvm8P8HOQ7G8V/q5jxsIqOBpwnAeTe4+QKweu4iD44vRIueSd5XgP1CpRvT4NnFEs24t6OwHkP5
-R4HpuyYsD0MGQ2aljoIIBmg5/Vk48lvcX829KlZNkFZJLhU3wsFrAdTdDGA7VNL21LmTge2ORgg+AXkD
-8RcOxe69LcC0o1lZFlE6AjEwqoVszxjGkJDXImU3gDx3y45HVe4A81aDaAkoI0TH0Sqqda9Wi0Au9JAd
-pJy21mIcrha+Hiot54/aij7C/Bn87ED169BSI5H8ArH+1DkQ/3vgbHdg/D39KN0KW5z2Bjip1+hYKP8R
-ecf5F76dVf0R5BI3xYRlxSAhIEA1g6RfgOcvEkuIiXVJq4bAEnoc948wyKAJQJoerAtI/hX9JIB+gDxf
-UJCzS2sPsZ0VjW3PS1PDFZZTv/GNb1zPa9yTkaQDIf59IhWJ01kUfokRmA947TbsAzi2YrZJxZBIlyZg
-LgzlLKDTwfZU+dE2M/YTSLsTh3KkPqd+C3j9dkL8/cn7i+spEp9OSsS3Q/JkQB1yVyO/CU3+KWyx7c4C
-5eCXK9iJyDXmhM4fYYIvw4FqAZmprD2Q1CiSIfgcgNwbTfB3Bn0+xD9FCdQ+M3B33pyz/lRAbNfYPoy7
-IsgIHn+Pdt0+WEzah7ZPRL36YkXYzQSpHn6thrAXUfabCQPJEb5aLjO1+McQwPxvicMMJBzL4l5GCZzT
-Hrz2yTTSeXpBT59+z6Pfr0HccLBDBqCdr0H834GjYynn6eSAH9qs1NQKTB5Yhfv74PWnwmDfHcFRCV9l
-w5X5rZ4lTFQz2PHDIe4AVPtk4sCl6QoApSaQCYZA8PsZ2AEAeyr1ZsEQHqHOE1cxkJ+g7rbCDn7LN45t
-wz50ZJZ3QNatDBKOtDBrcEcskZJbgO8vrLrtBWwnkb+HxNdXIKRPHZURFglV+m+HadP2tl1mpQ9tfem9
-QXCgyr8IIdiEft03yaL636bcIRD/AfDwA6T+x9p8hYOylTQK72yAUxeHzqVOEBzKAa9RWvcAAAspSURB
-VNbym9DKxmmn4yACVWWuNEHMgyHyHTDBZgDk4kk4qZBqRYnSVtWBvNuw+ycC9AUg4jGenSaOp76270uU
-25r0/4IIlyVEyuok4ieIiDJCpNrv9G1khKhlQJiE+x8vGGF34DkJ5O9DeugrtRCWSeqK5feB9fTDDz/8
-I9Laff3LPP5tTZ8pzCYeeeSRtSD4dyG0fdRwr1Mq8e+k/4PAyccQ/2LSTgAOHb4ym58MUrh8Y9s3taZR
-59umO55kLEmxzkdlKrvz1YolE+LoxY5lYHcD2DiYwAFXLlJYwX/ZMridKfBHKuVI/e/h5q+oCmkjqEMY
-YQblz8auGQc/g92sfFdpBNs0SCSJnDY3MPaXQOYC1hXuAR5uy1S7+ArMYV2uVk6r6SktE/oBP4fT1v/D
-/xkPwZ2WBpND5ploxB+58gfBrwd/24A/6wQVLz5Swb4K/BNI/uOPP76F7fovp7Vxqtxy3a4UA9hTyhxs
-hDq/OWECJVZdWtm+EpfXecRveI5BH4wWeQ4EHcVgf+LMISIIJllK2u8gwK9hhH9QL4QovdGexvSViekn
-fEMg+gi2ZVoF8WMXWYjsVSK+RI/aIs1MjGsPKp2MRtsT3IQT1BIfjef+io7bw5T5CveXMvb+EF/8iLMy
-5xxYVPtZ8Oa3Gm7DfzmYfhZE3FN+hUMlgVaooQgIg1ETTIcJNAcOppVjSJqIc826CmI73foOUvBr2uiP
-3fsF4zxa51AbaAwjeFT9Jq5LYBYPrIbAc1atkP5iR8xb0TglvcJeJn6Vbdq/83jfjE7MVSgig+Ic62B6
-SnoXCB6+W4DWj2P5Mdrv59TPga8LST+BMWvvw5TUdmNfpvMctIE2H3z9HuIfBfEbIs5j2RWNS52taAOx
-XgQIn2AtuP06uHVn7FTwehmI8/xYNMZ+WJG/3ql2YPeTfxqIeQY7uD0FzgNxW1hQRtAxwyz43fubaft3
-pD2C9IQ1eMtQN8eZAw8/FrraTNh+DDKIswnfp6zsh+PaI7DfuwDLEcA5SYKj4QITJzb9RtJP5XTOW+zo
-7c8YzkFQ9H+Evy1nz279Gxi/4ySOLgA/p5gYce39yoYuYwABiT6BZ9cB+mo2Lg5AEyxrgEFLgIgsxHM9
-+3wQ/B8bbrhhA87iV0UYiNxMv8FpkswiMkGYH6z6H+Lb+LbB391ESSNCKfQlChlCDeF6fsWKnsXTHBnw
-QHvpZpTwbPyIVFsfgoJZ+1FBhp3CtTuwjmIMgWllAJ1bwh1c56C9Hkw05Lkw9xTGpkZoU+UnQDTSRvCl
-8I+Cr2B6VxLf9rqUAWzQ2YG2lEGr4n7JIE51sBCuPb9AY+vHjauRIH2DV6n7E7j9N7YHkr9M9C2Iv51I
-xWl04UNvXKlYRFm3nx/j+huIeiZOI63bTgg2HIYoy2aRK4OEl+x6WWbyIPIZx2fo3//m/QLJ2xBvinqO
-bxMFJtX8kX4T138j8U/8+c9/7o/JO53nU9CMdYzRFtuTes/36em7HKyJPAVtd4UVSF5hb9/6bYUuZwA7
-SXMpTHAYgJ8PAgYxIDle4rVlEkIe0uHagMSdSblfgcBLiT0TvxtVj+R+N8rElzKCeVDVIk3i7S3y/fjR
-k1xu1PhBideJ33fKStypQDtZ4O6L5vGA62gqjeKaQLpmSQYYDAzc4szQN30GqYc5XiPP9YUrIdo/NYfk
-fZvrKAjq53dkYIXD6XdbuA9TP3DlHP8Z2j4u+j1qtbSDGTrvgp+2gOiCZotz0wgwiHCGcClI2A7CirT2
-por2HSRDbSAjwDSvkuae/zT/xRzCDKL+fiD1ENK3hRA99BFikCAgOLytBPFc0lXc/GrobOrMI09OMd0j
-1QtI87Npfbh85cp/3PCVKL/1sy73g7jvi/apsQ/hIZ2kYlAbAddcnu6h399jju7UHDGtG8ezi0tfR+LD
-t44xYWoXbcynwPJge/RjepMq32favArmOw3ncp6E14R1pJ2ov0Lh09GsUPVlVwLo0pwYreAplnMY2MlK
-T9QGtFCGkKRFESLCcpTN6gNQ3rdf74AI1z388MN3ixCWVMeTtzP4m0hZpXMs6jhoEO4DciNDRMLF2HQY
-JJSRuAkhrBYYyDgSvEgfKATB8UVVy//mckZyP/3fB6E+SPwBfYGDuSbj/4TFHsYcNBtpbc2ISC4yPIyi
-+ZtPX9/F/F1qRqJJrS8+uiV0KwNEiNMmAURNYZBnM+DPqRJBkBIvcsrmvkndwAiU91vBOb1hCKCEvAzB
-bqbMn6j/D794qaQMHjx4I54nkrcJ1zjy1+EaApGU4tCkhI6XBJa4XjKEIT4LG/e+9Pk+5V/j3j6f4vkh
-pq2zLMu4+tPftqR/hfw9Ud1DZCbMkcziuMRvWwxu22GGhKbziL14uBUYzoT4rhFE4ttGt4ZVwgCOIG3D
-br/99r6ou1NAwncwC32RbhGyLEawCSVBpAVnUURrTkDeG6T7eZb7QOCDfKTpn9H0yHikjaDtUcQjKefn
-1FX3vs0cY9v1mI1LcDGeS/5smOCNvffeu7TjhymrI30LrkmUVetsAlMO0jl1lkJ5GTZum7fF0GSHP8PQ
-WQybOUj9y6SdDeF/b2Z3q3z7SIdVxgB2CuLD4k1cOEEbbEza2SBjP6VbyYFQHTGCouqVhQnySjYSbvOa
-CaMgrcQv0fbLSPlMpOslyvkfP25RN0CoxgiDFQxOYT/88MMamKoaM1JDeR3Az5C1HgT3pQy/CTSO9KGa
-MJ6DSZDwtmsb5OvctofToMqB1c0vnbwllP019z/bcccdw5fF0prS9lZFaA/Ybu27ksuRrAMg1Elcfn4u
-SDYI1lt22tOeJIn4oEYTYH0RMrwQoXbwMqjKlUyCzuBC2tMBTF+275svwQEk33fh/AJHnYRSuqkb/AW1
-ekJwGTDaZXHYLozkBcLDOIHwSPwS0m7hOp+ZwuPEQeo9cUyftrtKw2phgGSEWTi+dIBBh3Gbbbb5IoRz
-j31PTEO0pSLQ0BGiJUi8LO+9dWiyyBAguOTYaf99NijNEjkde69tJgTpJo64Mo6X+WWBNmW2EMjwnYXg
-u0B4TcwNXFdEwlMofmwyjrGsrVXxEAe1Kvpqsw+R4Jsw6Y0YTMPuFD6OvL1wFvkvyfAdPAkSJN46ELCz
-7xwGpqCOBPfeUBlHPITY9hPmaJfQxWZKv1QJlQLTwXDOXgJTYdY0PTdwXREdPGsl6j5oh1Irq+EmDnw1
-dF3eJQjKwQilUzvmsvijw+Wy6b48fk7zIDOohpHQ6AtYVH2/qsdS1j9Ez2ou1Cz4In7E4jFA/DMm/39Y
-zHpFIA2ulLLV7IJPZMJixmr6XdVI63CYMsIM3mRNO2l63yByRxDqAtBuIHldJYz7sOCTcsSCBNKJ4wpf
-+6K9lR2jbYaL/lymdQyAkMtLcOAKpgPnUe3kFrd7FLcg7U/wHApbQYn3LR3XL3xeU8LKIqfbxqFP4B57
-5SoYWmE40rY1muALEGFLEL4RhBgiQxjUEHj9ISZPAkT7GsdqnL63WiCwxE0RzTTLhYUlCY0jFyTccsnU
-9XXy/5frCdIeR0M9wRtQYaGftODcGXfl2QXb68oQEdGVbXZ5WzKDp2wmVZwRVKpggjF455tC7G3oeBOu
-4VzDSO+vhEo0g4xBmbJLQhooW3bBBGEWYb7M5EyC8D7P71B2NrHrDn40+5+o97fMjMEZjjuIlbDG/DUt
-/j/BACmkhZkDjmGWT6W2+T897hVAIL+jMxwCjaXuelzrcvUjzQ879CXdONwT+4nb+aQpuS4EGXt9yDWL
-vFfIexUGehcGet//4yO9LEh0t59nzZrVEhehygqswQ//H3WngtIFQIjXAAAAAElFTkSuQmCCAQAA//9g
-Zo/jhE8AAA==
+H4sIAAAAAAAC/9T9B3xUVfowjj/POefeOyVl7kwmk2TSZiaVUDMJnTAUGyRUWxSkEwJWEERRxBJ7F3tv
+WGMHxdXFhsZV15VdFQXBtuuuu6y6dsnc/D/n3DJ3JjMJ7vf7vv/3px+UzH1u5jzlPOc5TwUEgBzYABTm
+T589pP611aP+DgD/AoD5i05YcPLvrxk0EwCbAaSmRWtOLT/8meKjgUwYCgCPLz257YSFQ+jZQCZ8ApCT
+1bZg1ckA4AEyoQYAHG3Hn7501jEzFgLIlwH+cueyJQsWF0qzfgECAwFg+LJlSxbkzHGMAwKLAaBi2Qmn
+rl2zS74LCFwE4C49/qRFC2ase/c1IO4HAKRrTliw9mT5YM/9QNSxAFB+4oITlnQeXf4lEHUOQLDw5JNW
+ndr9rOcDIAPXAuQ9fvLKJSdf8ac71wCZzvF5Hyj7jBwNEoB0sxQFwKD+f/ouLCXgIMStUAkAgN0J5LEZ
+sHY/GP+Ul5eXQzlAT1yu01bjxcqV+Hg5wB17PwBgU6QuDiIAqfGnWNAVaANQ8bdiYLQAAF6GDSDDRADI
+hvV4Nl6Du7GHBMnr5A3yMV1PL6GX0bvoH9k8dnVpR+l35f7y0vJweVX50PJo+ejyseVN5ZPLTy6/ovym
+8nvL7y9/JCSFfKH8UDhUFRocOi50XeiBMAnL4dywN+wPF4XLwnXhQ8MLwksiJOKJhCqhklRmVXoq8yoL
+KosrKyoHVjZUjq08vnJD5fmVF1VeVnlt5V2Vj1Q+Wflc5fOVr1a+WflO5YeVf6saWxWrmlg1v2pR1dKq
+FT/DftIT7+kR2GZDOdyJ1+Db+CspIK+RN8hOup6eTy+jV9B7GLCrSjeU/qfcVx4oLxc41JePsnA4tfym
+8nt64XBs6CoLBzXsDxeGS8O14UPD88OLBQ7lGXCYYeFwVeWdlQ9ZOPyh8p3KnZV/qxpt4bCkavnPsB8F
+DtjzQ8/nPS/3PN6zpqe1Z4J2g3a9dlH87/HP45/FP41/En8sfvbnV39e+Zn8mfQZ+ww//fnTnz79z6ff
+fvrNp//89MVPX/j0qU8f+vSBT+/9tPnT8Z+O+7T+0yGf1nzS/cmbn2z9ZP4ncz854pPDP5n1yfRPmj+Z
+9En1J55Pcvau3Ltk75Efr/34tI9P/HjFx8s+XvLxwo+P/fjwj1s+Pvjj6MflH5d8XPRx4OO8jx0fw+79
+u7/f/d3uf+3+cvcXu3fv/tPus3afsfv03XN2H7O7dfeRrpeVl0mXLmP/H/9HJi7+P4Re2CAQ42+kn9+B
+xt5jIIEMCjjACS5wQxZkQw7kggdU8IIP8sAP+RCAAiiEIghCMZRAKZRBOYQgDBGogEqogmqogVoYAHUw
+EAbBYBgCQ2EY1EMUGqARhsMIGAmjYDSMgbEwDppgPMRgAkyESTAZDoKD4RA4FA6DKTAVmqEFpsF0mAEz
+YRbMhsPhCDgSjoKjoRWOgWNhDsyF42AezIcFAHABXAgXw6VwLdwIt8M9cDfcC5vgPrgfHoSH4SF4BDrh
+MXgUHocn4CnYDE/DFngWnoHn4XfwAvyeVMBKWAxLoJ1UwVq4C06GFeRwWAPLyclwEdxMlsMqspKsgjY4
+jSwkS8hivJOcCMfDmeQIeACeg3NgEZxI2nEiOYksghPgLFINFM6FDrgB89BPCkkRCZEwKSGlsJU8AS/h
+aDKEzCB1ZCB5hbxKKuF0UkYipAbOh8vgPLgcLoEr4Sq4Gq6A6+B6ANgIt8JtcAt8h3NxMZyC83EBLoQz
+cAkuwuMAgCAASCABUFBgQKwaACgB2gYEkRwFhOA8hkhwOoAiSwwoUFWSA3UhNaRWhtQQAoPuGN3QvUGC
+/RBjGwAIvAlAFKkLKDiheerjdTOOjgUkpJTMA0JGtgCA+K04CqcFY34gElIgtM36fCxOa415GQNgTuZU
+ZPGtsjNQF1VDKlI1pNI3cazWMwxrhiFqPfg4+QZrtJ1xjy67K+mztFWuEzhVxEISZRQR5hEEGI3TxO9V
+mCJL1u/10ZD4955hWNOE8jCsGUefJavil/M/+u8sBGDHSF0QhDJ4cOrjgRlHx2pynASzXATcCG0yMjay
+JddBCKHzFIlQOqbFk03c7lHuaUGdBlX8Yw6BlCzr/a4FHauSkVHWkQlcAI51T2ttjZUClJWWFEMQgkWF
+BYF8f55Ptf5xltVFfZER+p8oFX+UiPgT8UWoL+qLnLX8jyXvH/730q+X37TivRU3Ln+n9P3ZX5Z8veLG
+5e8tv/K92e/hQxuxZSM+qB3F/2zUntqoHYkPaUeSJmzRNcCinvn0B7kdqqAOhsKpsdI8pKQQGR00sK66
+pMgpO5BJCMiaKxFwqk6JEiAySkRaBIzRBUDpyBZAFHtyFEwLxoJAgSFl7Rac8XQsTGuN5QBAFVT51BK/
+qjiDdVKjXFVd3ZgfUKsH08aGJjKiMerPD/irImqA+fNyiOKnwxsbqqkq+/Pwk4M2LRryzGuzjj55fMHg
+o8868c6bL3tx6Qhsapx71tKyBx9cdO+d92J5Sc5HS1z4ReXQuXPbDmo5+7gxrrvvdox441hteOnTf5ty
+yQlTXPfgkoor1xyDc/DEkovOA7GNDu/ZJb8h7QMKuRCACNShZ+rjOTOOjg3MQuZGojDSDgo4HYpzOUgS
+LKAI0NSSgw4HLsiWXRRxPHJpCc44OtaQ5h2AkS0gSQbJ+HvygmyU5VEtLmM76fRtAKDQ4UKU8YK0X937
+N8jTgrF622tAQWJUau/9euKlsfK01tbW2IDKisIC1SMxgNrqirrKurKSgkhhxO/zBNSA28lypVyx03Kd
+gboARtEXrmpsGB6tz/fnydX1jQ2RsD8P03z2Bbusa/9qctdzv7v33uef33T+ueew6N1bX7jb+KFS9v7y
+b+p8/eHO7dsfeXT7xr0f79nTfVHXYw++uv2Rx14VPwKFQT27pHXSv6EYqmAwXBVTfU5CpYqgnzJKEBht
+1lkUAkkiC4CQphagFBYgAoxqAcaMv4JJ27JkwHlgwOEC0NnHpRskSqR28/lY6/lE5LvWWV1TWBP2yM7i
+ulCoWo6EodFTaeEv5QeGNzZG85R8lP15+dH6EVXVvuE6SaSV2r0TH/3zN9ofcOWKf3Vu+lDbuuGRmb/s
+XNitPfNMx6pF911zTuf5508/FbP+iPU3PrfmwmefuPi9G//1p2suLny7ewM5edaDl7968I6fsGbOORec
+cxQAwmz6LG4UurIwlk+Q60kQalLsbqpSQz1e3YQTpuhKEfh7h2tvkRPkEeAG+SkZsL4O/aB60F8FqgfI
+CdqP6PpO+whD8oht2veaXwto3207jzhxANYRAIRx2lvkSPv7vkb+fmM+f38cOrWfvsMqbY/21jbMxi9x
+H2ZtOy/+i/YX7f14D4AER2GAlbMnQQY3eCAApVAfG+JDgoDNpUV5XjU3J9vldEiU5OBhAIhwFABwJgDO
+LAkWFhBnfh1W+qThjQ2KpNDhCq2uzK+u9EmyP5+GaFUkrJDLcXSrdi1xovfzjZPnbPwc1VCtdv2ROKYG
+vRhoDpWVl9Kml7SfhuS6GhZ/twmn5+ZoT9z7/ZhZg9H14sCoC3drN3s8gl7LYBGbxJrADdWxCorIECSh
++iVJHItjJE5xN7iF6uZqrVINNYbUqBryh1T8XjsVL3sFL9NOXWT8/xXtVACEM7W78QWcAE44Uj+V/GDw
+cBSXTyGp44gpvF7gJy1ZYsFwbZv6IVeyrVtUr+phzqK6aEQN+T2hxvrGn9bhBG3bOpzwi3bMSe9wnPBr
+Npk8CRTKYsUEEQCPMkUIYbpNgtAfamSTuyvIk6+8otuPZwKIdVMYqi8tSxz/xDQLEj8Ka8Bl+2XcCjgT
+J/DF6L8LejrIKGk9UAjE8vgHHfy9NbZX/BhFPKVLu3Kd9MuvCv98Rs8uViHtAzd4oRCOizkLAqqHEYqG
+wVIgI6Vizza1SEgILGAIMJ7TKx9QRkqQticeTOQHUyDPl52F4CvMK8zyZnsVCdzoVpz5dVKYS3W0nv+3
+ul5tqEavL6zmBZCQ0Xu1HzBrz8eYrX2/ZwfTTh5w4w6KVw2oxEfwOJyPD2it2h3abVrrVjJzxkHadO1N
+dmf8iRkH4RMYBUDYAsAGS/vACWO2yBKjDKboCGQjApB5FAkZRaYF7T+PJdNaY24AcILTrzJnQV20MaRK
+jZVqyL+FG1JkBtZ0sslTntz464udOo1bAOh/pH1QBEfEcrMRMAsJkGZEYh7q+cb+wqYW4EYQQ0pH0WnB
+WB7wb0Zotz4eS6e1Cg4XQVFBpFZyFtZFG4ePJ8OFmlMah0frmT9PVhRZCflbZq5ZuubMqbuv/PCzZz5H
+etbdi17MIzO/uvL09rVnX7r8yxM/PHzvqxveunT2Pdd+BQgX9+yicWkflEFbLN+LlKlIaCEiQQQElEBq
+tmwQQCDItTRbAIw1tQCAtAAkabwkbBAGlDDabsEZTydK01pjzppIRI2EuQof0ZgfrW9sqIpEGusT68/3
+eySm5rFI+OI9y3/u/CD6yaMf3HH5Pa99+LW2FddoH317n3vPEd/ef8ETJ1+14/A/THzuQa37+CO1117e
+BwTKANhAqQtckA1Hxtycc9iMiBalJUSZEUKRLNNtAoPS+UL47U8NWucCZLnBBS5VVb2Ks6jO14ghNeqP
+6GZ8GVmDuRddpMW14/FmvHQPndd91x5tNV66h0wGhKt6dtGfpS4IwbExl0lHYtGRAQITdEwY+HY6EqCM
+m/cmXDIdQ6paEzHomB+tbyKNDYNJJCLUXrS+lHADLhKuumrqldPHzp9cMWzxzcvPoEPj95St333PvDk3
+bW+feJf8fkXFwKkLR8xadViYfP+V9vDQE544Z/VTpzfl6bZ7Q88uVintg3woh3mxHECgiAgKMqQWFghM
+QsZXJ4yjphbznB9PDIsUkEK7BWc8ncj3krMiXFFRIaQB/VWRMPEH9LOcRMTOD9VTTySsn+b04xePu+tp
+XHf/87t/+Gu39lc8CiNnF7y4eO3qxa9I23ZO037eV3D1z3/u0f6F52Erno4v7N+LIx649JqHAADhFAAW
+FLIx/mlHwqJWhboExJEt1o7PNfZdW9Ke50KQJ/b8cK/PF2oM+YE+nnfXR1/FD6UPxb/PvxPn4AJc9803
+8VLNpdv4R/bsYsMN+tXBpM1upMTUk37gKhC4HQuUJowgHwAQCqTd/JSbPltqamor+aGCVZa5o+Tn+/k2
+qWhsGF5pmDmBfEX250X5dqqqxvZV0/7+5u8+/vT+s7TPP/9Yi5+OD66754FbvEdf8dfj1k48m5bMu/jE
+x/+w5ea/3Hr8o2e9+tPOref//s/H3fT8PSetyfm8o/PnU46eOWeBkIPpPbtYLCEHCiLTpZkC7SXNCSvP
+kIjx1JBmiXHbLiHN4ulEmiwHQit4GxvlCLdsvbruD4V9Hh2rSPiGl+be/dSZ9z+/+8cvujGoPajt2VDw
+4uLT1yx+xfnhNFS4EPylB33aWdoD2oXaRBbWuh689OqHAQi8D0ACbJG43y6f+ngtv18z03Qbo2tfydC+
+JpeAIRLAtqSH6T7n+sK8fafckvntWw2p7/OTFyfQMP9/9x7zHCbwvna3sa5suE+3Rspl27oMBTGKby2h
+csexaUF9/SUgAZPYknTglvUSBJARKV+tDm0HyfyUiz5HietAl9NyYygGStQycN7HCa/pNk4CN8vWMew3
+GeSnCLdXKxH96Ec2qbuS7orvIpWLcB/++yNtH9H+DQSWYTabRM8VPBoSG2hzcpim6DwhfNPTEbox5MfG
+kH8Z3cV/OWb/5S+oG078EF3EJhvrkBm3m7ERGxHbSWV8F4fHcegl6PtI82o+IHAZAJsn7qTFsDjmUpEy
+DxJqau9SIBQogQ1JpyDOk0w7rNgCMI9D87GwyzwAed7cbIlZi+cahYYaG4aPGDG8sSEkTsNyVRzmsh/n
+41iXtvPIlYvXa7/sfPCV3aRh4bWzZzb89BM+7j39jiOmLL3shdmfDdfua99wdP3Q1efpcrWtZ5f8o7QP
+otCEXTFXI1JXFJ2E4+CacXSsHqhLcVFlgxsVhsSpkOXgcJimiMslLeBW9oQWcDqFphov7nL80jfUfFNG
+CVxUcrWn/RVJ7/n/628M/5ffWDHj6FgjUJfbRd2J98CtEHcr9PcyZ/JYkCWXJLs2mL8EfsvviI3u9TqC
+Q0JHe6ZfY7zMbWLxT8w7bszI4fVDKyrCkbCvoiKU5Syrq5Qj5UInloOaB5FwY8OIiCzz/0O0PpAfjVJx
+BHgbGyAiQKL1I+gIcbjyk4E98Nij2ufa1aQBV2IhrtXe+fSekf7pexc9hZOeDwwYc+gFJ7maT3tZe+cT
+7VWtACs/v+VmpWpG0znPHTL8nUGz8FjMwlPJCO0W7ftu7YETBj97/Qtf/gtvkh3xHQ2DJqP/4fcxogW0
+l9/X9h1KNj5Xqf395XV7AQhkAUjPSV2ggAtaNruQQeIkZECQGSaZRInl4AQGHeZD8bnYOjkATgcooKiq
+KnMLGEPInZC+EM0iTdhAxmrbb4jfesPn+NbfpK5fR+Kl2moyg0zTz+ROAGm91AVuyIMSWLBZRsk6k0uN
+Q6rNdECMFA6IeXJiU1tuXgPQfKy7XgFKggF/njcnC9zgCin6patcFSatHMGQfoaFVPMvneQt7NJuwzu/
++0KrwdJzb1r3sLYPAy9fu/167WupS3tG++GkxdrbT7xA559zwpIp+9+bfcJK7p9Gfg+T1kv7wActsVw3
+EswCwkkElNosDFMsKU2IpQ8QCEXSbn7K5W1LOFIRFhaGXw4Z8hXwV0TKVU+oXlr/gfaidp62FG/HK5F+
+q33dFe8hpf/E0s/n47W4BtfjpaO+nRd/Ld4FAETQ+DSpCxygwsHmJSdBUsM5rpM0zyBpu/WxyWQ11+0E
+ByghySCjWFV1tN6rNpBIpJNQPBpP0s7U7n76hO++/ubPl0ld2rXaA9o12oodmI3eHzAAKNZSJPh9ZILJ
+ul2NmGCyNI9rnlGSwWTjaDcAzcdjJX1lSe4G3dGg/+lkTd0/klviS+goqetNbdFr2sgd+hrYZqkLnGBY
+nYW91yAhY6PYtGAskPr1/MlYNq01lq3fPFUh90W2L+5kjvhAsiR+C//SprfiVxvycZ+0D0pgecybiwQ9
+KJEipAwRKDMFnmtFRiXWlkFWigGBSEjaE4A2oRG0KIESb0WkJsxpgSo3SKurVM+I4RVRIUCGHT9CjTRK
+92lf/v0X7cPju353PNb8B8Pfan/a+6vWjQFkh6z9lbwTP/zQ41b+Bdvw6HdPXzLq23naLu1v2r+1j3Jw
+6puQxMtDTalCYBRZG0FOQ1kihn2W9IDOEw/GUmPFKdyz/u3EBiJhg/ZWfL/UFb+ftP46ktwTn6vf4xcD
+0LHiuw0/QQFFROPixhDAJkH5QCgiEGxLPEgvO41RNdIY8kfUqH/xjh1k544dO1jFjh37d+8AQABtJ71L
+6oJcmGLeH6l1f/TqbpolYK6C76YC49LdBpxPrYaRDXRm65aI6hMb3M8N7OGNwknmYaHwcw/esErrJiO1
+77UPH+3+eOTqm1nTjv2q9t33YNL8Wf3e9IxTppRYejsHBdEZJYYhmaN7SsQHY4nhqXCBy+tVhaeCm8FR
+xBCqnVhDlvXE/0ge1c6O3y51xevJH+PLuv9EdsZrjP3yrtQFEoxJ3NJEqIPMt25pqrizW59Z9zQJJFX3
+zahiS2ID+Ubq2u/aARY+mtQFWTDjGbeDUmrhkw8UCFCyQcdLYiZeSQ/IPPFgLDE2ZBZkeVWvuSERuSBh
+hKN4I1bgEFyqfaF98LO2R+ra/zVTfx3J5nbLzLl/o12endC0xUHJbyUt1wUWaVEV/+nEXNL2k7YvfpvU
+1Z1Nv/t1JP2uOxuI0Af/EH67ALQYsUXI5KnzA0I6R53uplOzAtkB3UkncyedZY54oLLeq3pIJExUj5d6
+tWe1C/AsnIHT8SztXO2R37/99rNb336rEu/CZXgC3qot1q7XrtCWvoV1mI/ZOEj7s/a19p32JyAWbdzg
+M9dbCBSQUOQK2dKaBoECAMAosDYTRjJlIhfAp4oD2V0ZknW5qM8P5Ps9oYiqRstV7oHqJMe88NyEY+M3
+vH8NrrzmvrelriEHL3hKk+LP01z0nrFQt6dnaDvlP0r7QIUSmPg0Z9hh5lFrUdK81ltHraCj+ak4aiuq
+Khr5TqxkklJRbTPnPOjxRk0Ksg0YwkFd2spfZ9Vor2oXmYTc/+ibv9v61tvPPvvH8OLy19rvkHATLjKo
+eZ1WjP/U/qG9i4PQgx4crP3FPI+fE7T0wlGbZSTMFLQiw7xqA0kySCpbBlgBAEgMpDYTSLZsMA+A16MT
+Vc0LKc5C087BEBpUDUU6SQe+on2KX2ibtPnf3o3Ttfdek7q0q7Vd58RbyNz4PbQGc18DhEU9H0lOaR9E
+uC3jQYIqEiB294LdlrG7FYQtA9yWsbkTtgyM1ApVN6KRRcsbG4aQiqpGT6ieRzaFM6mMCN9JKFy9FIu/
+xsPPufgebf/jZI7213/v3XzZ5AUrH/rpBe1+7fJLH83uXrR9w4iGpkVPb8Jbo1/Nal7YdHDFwPFzXt30
+9w+DAIBQA8CeF3btWEMvE7RcSpYr1wNIEChCW5IbVzdi+Q4OcX3MAxY7tRlsozaDfffee/uzAeHOnl1S
+ldQFeTDc0IX6WQeICTLwz3RlmCBCpTci4hC+kJqXHy1X1RC/TMqRcHVVVeOd2HD5bViMWdPaLt/XfcuK
+I6Su7tXvv4hn07u7V739wfQTTp2x61WBXyGA1CR1gQyjN1NMqKhsXUUluckJdCSpYhlkr1DFIQwhRpEE
+4xoehufjqV9oqtS1/zE289eR+ncoq4VOnviMW7br5FyCxFDGBiXFJxQ6xCdjaUILq15dC4vvCvkwiiGK
+eJ12PkawEAsQe3DQLm2J9uE32s9S1/7tbMz+l1nTryPpT91OQBgGINWKPTIh5nQpDNlvVcducHu9XqGO
+UceX/3cYfqSNwTYchAf/oo3G97WbtW3aA+RD8rWWg/+JH9z9H6LGv9bPpmIAaYywm8c+ozCkv43aDnB4
+vYLaqv71KlE0Px6BC/HU7zUgx9M58SvJKd2b4tcAwnEA7AJx9rQ8IyMgEmKPR+jymz4e0dY7HuEEp1fV
+cW9ELskY8h9HXo6303i8iby8g9W9+eb+97gtfIe2k5wo14EC4VgZEI4lXQRJ4dPErvCpum10B76zc6e2
+U5nd+avSaY9d8VyZwUYcjCACXsB1GFe9rkQ8KzkKJomQlnZlF56yTp70ywuAMFbbSdxiTcWxQkD+5iKB
+I1A6mkxTVT2ihyHdXCNubdjOnfiOtrNT+qUTEJaRbWyy2CPlT8iLJ8f8DAlMEaK7xtgG/JufkGGyDzGC
+EVyG/8Z/v6N9RrTPyDbq7P4JX9XGGnjNZqvEcqvE7wraHF8CQ3t4kP9Gf2PIz1Z1dhp0mSpRcoQ8mcel
+xfs5gIAdALBG9RBngXgFIxgiR7yhbcNCieIEACBwUM+XbAxbBMVQCTdMfXzQjKNjeXlImQ8JLQEiyRIS
+kFhzMBawPkZFBpQU8URqbtXfKgMCskRkKz40oUVBnrSBuhURjNVyCCUJAhTFAGgBG2xrzB2J+CLhSCTs
+cJbWVQa4+ooI17AnFDVjRuWqmhetr2zkHvD8aD1rUI475JmG7BUPXvMUcU1/5JYnNr+ON87yaOce/NPs
+2NIHNxVfeeUTV+GaxQ8vfKtZe1ArOufkvU+Onw0ENvZ8yXhMPxvy4YKYm6FE8nMIk0iz7r4KAuJoK74x
+Qc9yoClZDikgRpaDqZeD6SDG2iF4nkM4XBFWjTwHNaQOj9Z7/R6IhKvzo/WqJxJW8HltJ9bc9vwjf9a+
+/m7meSeP+PGptSvlunj7O9p7P2hfaC++4cOzcMFjukxM6fmSlbNF4IPBsToXEnQnfAXmwTqhxXazi0Qi
+4gCt9OvWiX52lquCvOV/uUfbo71CVj/60LsrtSdeemjtX98ehoPQS8bVvT9Gu+TJPwhbqedLNl2ugxwI
+wN06+XJdyDCQSyQGCBJtDiZ9QiXa3KoDBoGQ0Vbux4QWK+dnoo3OKSCUjtLhwJCyNBBj7RA6nVVvRYjT
+uVKOhEkjjzUHxF2wKhJWPdxzVn3r84/8GdXvZp7LA7wncUKfsuUdrPsBizFWiJ/+8h692aedp93xmG4j
+clorbBF4wA/R2FBAndCkGRgQqltavQkeUkNePf5hkJw787xqyBuqx5AnEmbKM89q+7Tt6PviXx/F15Xh
+gMu1alxyxwM//KkJx6BXw2Hau9oW7TX8FAsFzxsAyCfyZPBBR8zlREpciMJ7rUcNjAyH0S0SmtmDY1oU
+I6HJjBoQoEhoezqQzE9FchO3usEHPtUbCauqw1lcxzVn1LLC/H4eQ920ciVecPj8WRPO3rqVXr11Oj5c
+9GLoy2O2dp9k2ttz2HS2CBwQhttjPgcQBCcSLEGZFKMkM2Nflgvrjx9IE8QlcYEeLJFlES+dJJkYVQBj
+YywDMhNsRrCxdrDW1pgrUqlWRMIhxVlSh6bA+BOCpBtcoXC1fuOm1br03Pr8Q+9h3jdcoMa2btS+f+nj
+D2+75xouRVdojzze9Q7W/Au9OK6w+7n9e6adM/2joy67DRA2ArA5ch144IiYiyLFXFs0IsDPTiuOOMF2
+d037xLq8esAT8YbNy6s6XDcTeZRNUTdizYNvYZ22Mzp57cW6armv+2eybcWMR+MNOn8uAcDXjHzRaan5
+qqNbRDTKcvSlfWLLWE0O5USNmNlGrNH+iGPZlP1bJC8Wal8CkJ6Ttbnie7PAA42xekRAEFkVEjKmn9qc
+aYKHZCEQMolMy87O9mR7vGFfmGNLTSeI6gmFeTDrqvbotNFYo51x1MM4Vut4rXHxBWzK/je0KfJm/VvB
+4MFy8b2HPONSKCWINmeM8Z0JKzH1Q8tS5D4Dn+UTieqWYoTj6sPWH7QX8XLtVe0un1zXXUZAi8Sv5TEq
+QLiw50syXXx/JFYOSBARCGnWDxRCYKGhvb1h6izUNYkw+/Oj9WT6pGbtdawZMDG4ZUshLmO79089s0XH
+Sb5OnPtnx7IUShgEfVxPGVsrX0Z+yBEknGUORaL28GiRYCQHwQUEuXwlIDI+1AOlHlFrUhzxRrzhsOp0
+lgh/5vBofSBJCm3SeMGbuyac8QEGtZ21Y8+er+0cMObsBWzKlnd27NAF88SWR+MNxv8S/JojdPEpYs+A
+uWc4Zj4rXXFMYr8kttOYjNtpTLrtlG4/+TPvJ75u+3bSz42b2CLhnxkUG2D7CrsLJq3/JSlJSg+S81su
+WfGj9h4O3L+f+1XiJ28454QTNmwoxDFIUcaRWpfWrf2kvbH13S1P7Njx1BN/4faPtpptZIvEmb0wlstQ
+4oczk3KQinxP/WyWJJ2fYxJWC99o48AwbsYkW0hJEPzMjZi2DYZ0jhu2TWOfxo22Wnpvi2ndFD5umDf8
+jFgtzghusx0Xc+fnGOZFwmZLyJ91+nMOjqOGkTAm1dKwQeg2gtrLRvgNJsKvdVKlZSAInx+Ji/VeYlhF
+FAlkOQglpFlBmBKM5SoIaH1qWUVe4UTSBZYlZSnkm+kLBhbm07QP9O2XJYqisiNhUw2JracKSe3Emtn3
+BIIjq06bzeVU1n4O3BwfDAjrer5kWWwRlHI7Uo8pWH6axJfYXDG1kRrDESNSvaq568EyAfICASOFZd3f
+bvv4scVvr8N3bu/avfSdu47cfZ227+9vVeycs/awQ2pmn3EGNle/OerkyeNCsy8/89atgo4Der4kt0qT
+II/nrwJKiILpbhchU5jwzFMUvE1YfBHVp3oTRpYqLJJKYYkIFXnTymbt9bVrsWbHjgETa57bWoTLyMSt
+H4R/2Rr/q9CVF/V8yWawKeCB83WWuLItJRw0f2CEmBzzUX2bcNUsMWK3XwP6IxH1sevuoP3JWPuThIrh
+KlN4OhptCn4Ez8ioefBt7X2sqT/otEuwZss7eCx1xCesmPEoeWv/Ft3PwlrZlPS+nDHJ3gXj54y+HLxH
+W4Mj0Y+B3dpKNqV7P5XEdzQBSN1sSkZfzphUX475SQZfjs8X8vm4L4fiodqP6N76EsJDXa9rn2l/vfd3
+bEp3D0X9u8m38VxAGArAJrIpmf04Y1L9OGNa+vLj8C/n3z4Ul2oP4cwP3/lG+x0epz32+T9INYlo9+Kc
++BfxHbhCu04/cwq11YLGCgR5iIiIDERjF44j01Svys/mkOGh0qmo4eDd8Q3aDjYlPpq89msdAMJJAPQ2
+NgWcMD2Tf2ZMin8m3YM+PTQn4QfaQVTWavHz7eStrVvjDdsFDou0neQuuQ5qeAZOHkqiqhYkCjw1Hxhl
+S8zdPppMq6nilTRqoJzH4n3GNm/0hPz6DueJav48ReSl+cvVBpHdEoksarrEF8jHLb935bq3b3fnun6v
+TckP+C5pIl+cd9Bhc46ejUM6N5SMO3LyYW+tOvWtww46YlzJhk4cMvvoOYcdfC4AwhPaTry8v/x7Nao+
+sZv7jX7u5O+s03aSrQm8QMJmkACIBCJZleAS0yMxmibjhYa+0vOdBIYNI6oaDWway1UDwyjZKhDTpvze
+lZO1fXtWjuv3uEVHrExHTHvXROzUVRyxpuINndq7BmIIM/ASNocGwA/Dhf+mLstFKPiRECScCxR5rJz/
+2GrcS5DMjFTo93XhYYpwDRvgCrc66gmFq6u5sn3pFKxcs2Lmscdchadou8TfrsTn8dhpYyZd5dQ2tYyZ
+dJVu0z+jnYt72RRRgzYwVguJy51lv48j09LUmHFr3cdNaR/u1Xb+NEzbOYxN6QEEbW6c8gOdxPnv3whA
+e6QuyIcgnBUrVJBgQRahJODNpoz6EJjflmwcAUaBMtigi996IybeLryTVry1D6iU4Gs+5Ks+NV8EX0ON
+vObCaxY9VavDR/hlxU9VWfWQ8/6u/aJ9u/G0U9YvX71q5ZqNf348B+sRkWBV/k23OMp3jlbiDseQN6qd
+T+OF5IuJ2vva+wCAsBSA/iTyvU+I5WYhJdni3o0omymn5QxRQpAR2sxreBO/WSrzHKgoo5RpwVipebm2
+gZoAYxU9/sJzwPX7dZ6wnhuHNzb2umLn8UTwpdvvuQdfOv2a005+4ZVXZl1Azvxq8L82b30I3w28Wfv+
+v7Zuf/yYr3TeE+hkJ0tBKIFaODg2qShAKUFfrkth3IFRWECQ4RSv6nQwOAyQoZFFAkBbDV8GhZllpZWR
+0tqy2kEDJWdBHY4IiP0fUKqHcefdMKVa7JvqETzTVzFye7nRSoe8fsbrzc2vnfHa2vVnr33tjNemTuV/
+P3v9mcedd96cY887lx3x2hmvnbZhw5rX9Wevr9lwzhoOt+fcuceee+6cY88z6mvZKiFfK3i+rRXpCslI
+ubBRssyB6ERwIYjUipEtbnS5RrmmBWMhSdQPpoPkMGNd01pFvko+5PvzeEa4COTzpKyQsCUijVH9cIz6
+I8KswE0//fTTT9oxeBSWYukn2rH8Rxy8ceNGskfLxu/i4Y0bN2o7AIhNnylQG6sCYIiAixKXZpq2AFON
+qlRoud27tZ3ki3gxO4n/V+fn5dqT9G/Sv6EKhsGsmDcPCQ4K+bNRSg6uWZkEZoyNiOCfFVlLzSQYGBkk
+tI3PZtUJbTg8aoieYdopXF+GUWQjV8v+vMD1n1/36b2X3HvJhZd/33X/s09u3HPl6VuvOfdqLftaPL1h
+yoqXG57XvtrVetkR0ydOf/CMW65bcuu9b9S+PfaiwyeMOPzh86+/bdkj2q1k+/RJE2Y1Ljhs0po5AATy
+JEqulz4VdKsXGrOaoHCZ63E4UcSaPl1UaMvGqD/aGPXn7dm+V6J79ojatywA6S8iFhSAQTBf/NYjwihL
+gyJEkQuQKbQZEKjEI7sSyIok8yo/RhTW7kQCQFqd4tbmQCAwk1/aBg6oqgiVBQvtlzeXM1+sIWQPoAvf
+XyQM/jyo1HOgTaoaRW3kS+09bQY+ifVYj09qzVoXSni8dp3Wo12Py8jQK9Zt/+bvr2645pLVa2+474KO
+43AzDsEoPqFN197R3tBmrsNlSDRcrl3f061du2/AP7dt+yxy9shHL734wXogMIyOIH+Q9kExVMDk2IQc
+JDQbkZQECJMLEBhrBgp8c7QDgsxQbleQk7ZVEY4CCYHBzHCkgnvtQw53aR3qWNjc9X41z3CUVVcLp320
+Hh9tXnDsajx66JqT29ZuwKoad/b4oafVksEXTx1Bz285+ai/DTlu7OV172h7xtD1uS2nnTK3BQhMgHbW
+SD8FCdzg2Ox2SpTU1zmJgighKoisUfsFlUu1eXiX9rL2K8qXavPxTrpW69Q62/F5fP547XHjb3qtGdaw
+SXQtSLz6GZDwaBIhIvgirhNAptsTTHyNITWiLqO7Oslbj8Qfx3uFr52UkflkJ1Ao7Tt2w5OVp5B8UrZ1
+KwCDHADpfUPmIjAA2oXUHVeKsgObsxQiyUSWyAZAoIyLHQOngznbwQGy5JDb3LrYuYXYuYTYFRRwwRtQ
+U1VRECmIhEPlKeKX3Y/4Vauhen+e4otQHtHntaUR8o++ZC8P535742e/e+G8o7fcs6JvsdtFw6/fcbB2
+CR6ibcUt167Qbedp0j2sUp584LEvTsNp9APpnrvu0uvXvycvy5MFDUeJ3zDYlsjC6WM5U4xtmepL4b80
+uaQ24U/BG9Z2PnrGGY8+eub4o44aN+6oo+j3j51+xqOPnnHmIxuPbBonPtP17jIANondKeqZlxrxCgmp
+jEShpA2YExVgil637zD9o31CCD9pkdBhuSw3J5sn5DtMXebi/lJu0dsKH5fRXfHntXa8oRNv0No7O7EG
+l3biEu2WTu1mQetRZDP5QZ4ERdAQG5ZSl2YWpB2ZuEccQaclys7chXW+lLKzfKvsbNS41ubWuSPvW3HP
+g5c89PJxp0+5KhefJ5svO/LQ8Ye2zI5dMvHc6AVrmtfNrl949GXcXiJ3kLg8CcpgfGxs+rqz1IKzI61C
+qaOkaUY9mbu4zpehnixP4TVxkfDS+6c/d87dVQ+cd/dpy9dtvOepH7Bg9+Yzye86olcvnrl80pHr60+v
+OXnJrRManj/1Cl0ej5AomSfksUxIU/oaSSGIvhAe8S0WatskatZ1oOFPmwJ5cG7MrSCCL5dQJM16oXoB
+0e+FiHrsYpQwaMdZ0Ypi4zl/AogC9YkWjO3x2N6PW3VzNw/yIj5vWJi73DTP0/0EeYFQFS+fruZOyYtP
+XY01TdqL+Z4Zjxw8lLvZ4se8tY081a29eFHzeZ+Vae363tR20kdFjsaUzYho5Ux7GSVCUxKiJxAJN6n5
+YUJ9AteelvtbVSv5VRTVCrVheJSr0YiKH2qrX/wYX7lro7aTZTXi4idv+sUjt/9yg/j+ddSFz7MpKfe9
+MSn3vZC6DidQF8a0F/k72gwyFABUGCn4N4ggBRcS6kQktDmReYxIWg3rh+DMcKTSY96mQlFuvsqGe5Lr
+QMy6qPnvV7XPWn7IkNNfyTul8LyDjis9Nnfs4dw/RDbTsDwJnDy3wSgqTVSPHkGmWaWjbn56RFWlsVKN
++i/aswef30M2t5GrBq1o7f6wDYBADR1B/izy9XwwrF89puZm+bJ9hh5z59elawuAp9+yZcsddzzzzJ2n
+rz2NjvjdnXdv3XrXPVs3fPzRrt263mqCdlafep6OQByBWCn+24Sy9uuleKc2H8ehov1yKd6lzduBs3F2
+uzZZm3w8zjD+BgDAoLJnl8xrhSVwQh4E4AE9O4IjwjCAwEhzNko4lSFO0QtL6gAZdjgRmMRA4rUzhB3l
+QElGSiS6DBgj89yKixJGpgdjQ5KhXUgUcgEwUMx3FP0dZR4vFlGmt8ZCLpcsA+T7XXmuPJ9X9eTmZLll
+p+x0KCCBlGUeAYIziFGsbAw1YpRXfPoxqiLdg89rr7HZN+5/hL6uzcHb/jBnjhbTSBt5Y0THSd1nxE/l
+zRUqP9uE32ieTZ/hRTgUqKDDMoMOEbhXUKFQp0I+UuYXgXh3MiVqBW4KApWoTgkKjC4HiRDpKODF707Z
+QYnE6TAoGdaBRCYXJN6QZfGGPM+JsiRPb42V6FRwRVyRcKgwkOdNUMCVhgK8IimdptepcZegBvloVF3z
+RTNuWtxx3ZUbt7ScOmmNFy9PJcuDsZqDzlx12BlN1w++8KSWVfNGLBj5IACDRT275CkiVz0EA2EI7BAU
+KjZrrgL6aeAcAoQfB5Xowqk6kcaCEwGdsCE3K4cqDuUCGW3Fx06j+NgFUns2uhwO11HZ6HI55rvR4XLM
+CMYmWq9jlkNxZCkbclBRzv8tv6Q1Vh4Ol5YOHhQeGB5YN6C2prqqIlIaKg15qyPhUDjscZYYloqhPhqj
+9SYpy3XfmBwJ+3pJ2qILF9/dM3bQd5ccf/LVZ2Ds7b+/3Lk+yA6/YX8nvUqbg7f+Ye5cLdZDll7fsOGB
+s685IXph3Qd3NjW+sLYjfk1vCQQgcL62mh0l9GcxjImN9CAlKjKel0CAAqEbrPo0caawhXqZYa8yNd6R
+wBfSZcCfFxJ2fLnaoMsIPU971YU1vEwNFV6mFn9LlKmxKT/9pM3wLrAK1fDYRKGaft96TrlS1AblwJKk
+6qAykCW5g4tmR1KdkGKlqdoh7BVDipWt6gXgJpNVNeRIrhryRTDUq3Jon54eZasfwsdFqlSv9a5IWm85
+SLLU4UAZ5EwLTgJJv+J8txvAnePOyc6yVu1MXjX1hbDXqq/WbZCUVWvbeq/bC6fGXB6UJb54M48gJFbm
+VEiv1Tus1SfD2JfvsJZfIJbvdXtz7Qi4khFAX8gXqY4ovZDQnv4W1W+f2k6390Zk+/YUPEKwIYn+1Zyu
+vOquHRQnSqBIy5MQcVuIDACQmARsQ+Y33BZGRQChsiLeKcqXaxelrBSmRDzR+jLC93rUw++3Vdz72gvD
+2zf+IdZUVRYLTtr4RqypOuAYVzI5Fde6ovxBF2nb6oo88iBgBs4xQ+a8sDYJ65CCktvhojJIclsSvk4L
+3woAmcnANqSDdVqYlubmcu7lenO9qidFBFOwRcN50puD+7g7xY7Qq8K1YuNdPhRCJazdXIiyhUUFIDgU
+XiApA6MyW+ZCRRndoic8O63inmqCoMgKyBvSwzutap+CoM63ymBlQUA4f9X8KtXNK28wWlWdqKWXlail
+DWxXvizSdNjgykkXLVxw8UGVQw62ozik8dBDo9FDD5G61KqD5i+8+OIFiyZXqjaMLz20IcpBDtNtqywA
+5WVRy+OFVZu9mMgdqQYC1MGLwRwgM4ecWpjmVIjh5660l4YlvWHBCU+3F/hdPhdyjYZmLu4fxkSZWkgN
+UQypWXQDU+KtZGS8i7zfTbSKL/FYXCYK197Smnbgeu1c0kRm63eZGdoMUW9YCU/EiktQYqVAZCkPCcgM
+KWQhoW5EIjdPfdzLe7bpNTMrzYp14eHWCyOsePQkq+9WtVlh0x94rNKCZCDJTGo34Sba4XhyVjhSHY5U
+ekVyll+WUs34EaJMLbnK8c/a69GEab9Y++jht3tXPB5Hmi2b/4bgA39ILX80a1eUK8ENuXCijmENl9gO
+EDozff2hw4xmVNlAdX4vS6pEdGCCzVy/WhVlzpRqRIxgyFaRqC0xjjSjMFGehhN6r/dUfb21ICtyhxMV
+UPpdcLUdts8V52dlAWTlZuXmZFurdqWu2hdC26rjqnGk2VatbUtdtw/O3qyiIpt7aoBYkstB+li/01x/
+TRJwOgScJgIFAgFfls9jR8HdGwVxstnQ6D44caYlYbJ9e6IuuUiJCR74YL2BhgPlLKebKiArbRnQcFlo
+JAGnq2F1mWiUejwcEY/P4/OqKfzITkXGUPJ2ZNr2fLg3gcdCXbcTvUZSuVL4vebrGISN9HMGHRJSyuYB
+j7jISIgt3FWeAAImIbe9lyVA9IAXY9zzpYqoi4cHvLh0o61+klwpxFt7Ta+j5NT9+ZRENWXq+o43A4yA
+0OFAfoHqtUCnucCQDSplhU5zhX1557hM29eKulBrlyTWqm375cnEagkcCUAPEzaOD9ZslhBtpyRfjJMQ
+lLBDRs5YkKSRLQpS6pjvQodjlGNaMBa2g4EkoyjfTQCNdUxrjQUdDgCHz6FfhcXC9aPezf3bhhhT28qP
+NMX4PvvSt2+3LZ4adI6JmIwP1hjLJghu6qIoA7YpyO+jIMsjWxzImHNeFjqdo5x82XYwkBWUmCy1JYDG
+Oqe1xsIOB2OpC7dFd7KFf12XWzvdN+z5cG98mrFyIba/3G6tmwAYdetZUGjKb7mMqCAwhDYwOs6JfUfn
+Ocy0C9E1AInUbgM1AYQVkgdQaFiOkAXukGHK85Bhr6p2fv/r7Oxd275jB7l6K1mfWuCutWy16UEnlMOJ
+yZWk5QpK4GSSsx14R1GQ2XK9lMhlJcFkACHzXFZaTAFAeWkhT+bw5mS5XUblqTup8tSX1vRN1KNekNbm
+TdSoytNSbF6q16wK/R6AICyx7iiM3wWRdSSqLhXhD5PN+tUyG4zuOFuegNBbznGvGe/9ao8AOPqoZuXq
+JmNFK54ndE//ha3S/eYdMhm3Faa2ZBLrcKCEUmbkyu1A6bEL6rGfgmBBsCgp5uPsA0N+ocyIoUcorAND
+UNuWgl8ZrNnMTUZMZHVIrMMpk2Q8HQIJxcQzlARlIJqAEYiWGIiWFZQVp6Dq6htVXbNlQvdFU80dKMbb
+t6fgPBjOMiyp5O2FMlueQNmK1+koV6eBNRy/CUiBeBlHe/DA6sqKSLi8OEWOs/pCPe02zUgIb5pde2A0
+Sbm/6rSJGfJeBqsNUZCRcYeuhExqS9DFCp8bopAEZVAkASMoEiku1oWhuKy4rLSkt+z3SRXjsMhIh6v3
+fLj3APD+nW4KiQLC49ihbJLo0+qkaPrRA4gK4iJcq110Bq7F08/QLhT/0S7aqf94Ea4VP57BQUyZkvaB
+ArkQgbkxtQCBBNVchSGEbY34CgCALKAokokQ2QJZIoyN5x1F8ikSACTQnngwkTcUyfF4PBFPpNI7oCok
+2nfwLkFqnkge8/JumyOMK9KIqJIv/latsKoFDz779tDmhfdvfXvo/j1PPoln4WxU7pv3+yef1M7VHtV+
+3TSPbTpT+7P2zX1XXbEOB2Lufdfsf3nvZ3gonoi3nHba3r3aZu0abcnatQBA9Ppm5UrIgwKzzj9oaG9g
+HWlqnZOfplQ9x5yqnxc+8yTftIXPXI33Kn6m+cbNqHcNNPPghF7rPNwI0AkFJSP3PKZZaMrj1JXm+P3+
+An9BPl+uiM+lXa4vhL2WizcaV6L0y9W2Ja+3GI7bXGTTviViXYpMMqw79XnqwnP9fn+xv1gVK3dkIrRp
+PPZa/Z7ERSjT+s07kY5DTNC8GBYYy5eRORUHlYBJbWmWX04QJJQAN9gBkzDILyz0+wuLC4uDRRYLXBlZ
+YKiG3mzIFregNEhUCC1A9Hpu5UpwQBYsTKnoDnKDXCQt6vaYaLpntbMosT8lFMh667kwyHxOJ4Azy8mN
+MVH3rdjrvrnUJNV+a58JibGXgKfeoyulLsgGv9klqRgQJIZSm1W2yBcpmzZvusd0nmxavB4Av8+TwysM
+KkOq0R4iXdsNHIwVf+KNN87/4yXYcc2z26Uu7a9DD1r4lCZpO/F7VNctABR1uTfJk6EOHjfy7vORUD+i
+nnev/2DLuw+YlZWjdaeQxIjoo2n0TAwmHlv592arzaDZasSIDfcGSH421v6s1cjRr4O6wZHBYb0MyBOq
+NxOgRTp0wgk1hFQ1Nowneu9OdSP+/pXPJ4w7eNERnX9+Yv5ZZxx82RELp42rHjKs7tZb2Qr88PK1C449
+cuGAgacteef6GzeXv1SzfuySU49tWXTIITOPmnLwJe/tP0TsGVHHLU8Wddy18Pl/W8nN6TQQHE7mdLAN
+VkW30ylqCdOWdevfVAtOh7MDHMzR0W8deDQZ9sAqwltjObU1qlkV7nKWHWBVOA/y9FMZfpM4BTLVh7PZ
+OKEXfb/6n9B3kAudDuZw2gjscPRH4AHgcDo6XOhkzv4p3JACfKAk9lZVVdVW1XpNMrsPmMz8EtEPmf8m
+dFFfZDbjVXZaD4KfdAIU1KHT0ZveUnMwFkx6ZKe5bNJ8sNtFfjvR6wQd+asHQvXGVOgDJXteVVXVoKpB
+AxKEzzpgwps+x36Ir803j9++GbB9u0X/aYL+YzH238p6iejDaVItO9u5wI1ck2Shw+FaAC7XyD7Iz7Vx
+1I1OyHY4s9vN33JAr/LVDku86spCB3M5lvfLvzHp3zlALrbG8seObozWDxs6eEBCUeUc+A5Ke0Hrh63/
+SXNNy8jguSmXMwBgOq+lfxp6bRB8/z/h9iCTxtnuLOp0OuZxh2N/rKpzoUPAM4ezrf9Nlgp9oJusaMCA
+qqoBgwYMGliXpOMOnEOmRdjfVrtpz/a9mZkgbESD7vJOQfdhMA7e/J/0XhkGTnC7nG6eqaJLbnv/0p7+
+nQMjZywYra+uGjWiflx03KC6qmHVwxLkPNB+LTRDvm0/5G2cfyGPy168yAjEZtZnl+jh2YtOSkRkhW6T
+H2eLIAQDYAicE8vz5RJCcxBJuITIUimCaC8xjPsoXIgin0wSXhiz04QTZdlyRfEoEu/t2+pCBA7alhG0
+NeYdMmhg3YCacCTkDUfCVltfToeKBJl4JbzRA0Qk2dDGSLkuf7wjCK249dsbn8NazD/8D09u/tNLj993
+87NffxY/pBwn3LTt/fe1t36eqv2lddIKvAxvemHbix8+esXml5dxypx+uvaW9jvttYP+1ISD9z41fhZ+
+isVGnxhthugTUwnnxYqLUWIlGWO8njQx3gktaYOxwd7h3QyQvKVGpMqM2lb6ZaVX1LaKR22T29H88c4x
+iaDtCW+eeXtSZ5oh5GgrXHtv0eobktrUANX7tciTRb+WQlgTcyU6tugWAw8loiLjBuuqw3MM0nZx4Zjq
+sVuUOyzwdJCtMbeq6k1fRGOjtE1fkJusmRq/4AnCWk3X/wVbcUIa3M7qjVudg2MmKzbkZDkjcnqc1IEK
+Kv1h58nPzy/ML/TqKDqdJRlQFOZiJhSLhaGYCUNtWy8cS+D8WE5QaMZEK2Rxk3E6yG9A1Ah+O8gBYKrm
+5+eX5JeoBqqujNw0fc4Z0X3LtMwyYywcI0zHWfqnwdcSuCCZs/wErjOX7Xa6qKLI8xzIg22ZEHagLACR
+h477RrigqCg/v6ikqKQ4aGNxVmYWm6dlJrw37tm+Ny3Ki8y4MgAQlzzZPreh0NZ/P6XTjNXNlVBufZOO
+pKdpH4xFI2M+MavR6EHDr40bsWaa2GyiC41omEbgYAAyw1jTKfqaiiSkhK9rQ+/2N2ZjEUIJjzMDTVlV
+2if6sjK2x/GFkLfHuURsE3Nthk+n51EAcpdYnxvO2uw0Jo8I94fMSK91SknrLBCr4XDmcsznGR7pK80T
+K3UztxX8VhJrFcK/EWvi/zHlPLFkIde050sA0i79U9DUDet0SS5ySArlzi+Gxowc3lQmabUMiQAyhmEm
+nmd4pK+2yAgiux3JwWOnuWZDcDmN6/Zs3yuWm23KpOjXIt8CQaiCVbF8t4MABglSCoUoUdrschKcohO8
+3KynGW0NIdT74piDcHgM2RgbmAC1A7TGckuKw+XFVSVVFbVqRAwiqh4hKl0CIwIK86q8gwroIwCqqnkK
+mzE3p/vI3190Sui7v+w+6hEkPdrbP/+gvYuRPdPnzHzinFMv+vHUiy9kBy+9q+K5qb9ru+Ya7VDtF+2f
+2sM47Md9OLzo+uX7L/7Tk3jUaw/c9xoYtdtsjjwNPFANX/fuqFMLLpe8gCua0S1OlCRlASjKyBZbnx13
+os+Ofk1I/0Jyjx13cnOeOitd8wBeidVa0CK5E3lyZxpYY9RJIUB1ZXlpSXGw0O/zqmY/n6x0/XzS39OS
+uvxkpbmVpXT+kbJ7XcaoIVuTjViZofEqgEkoMeRGlL2jV1LoN5guPD6h5YCD3xlaCYmGkenaCeFwoRgz
+dxVii3BCGpxO1nGqdHCMmMSPZdYHUuni4hNaflPQOwNm3ABJi9kcoVX7Rsz0U9lxK4N1Vqybo1jFA9hp
+cUwKZQfTB8UntPzGgHcmBhr6Ny2q2vemRu4P3e3bbfhOM+Lcz5ib2emUFvDL5WiR0yMvEOk9to1mj2Cb
+m9kKeB/AK32F0lNgWw8kQp5JJNJu7LSUOy7NBu+Dhif02uu6LXeT9E8rLn66Ts1KEe6W2Dx9xnECzaSo
+dzB9/HxCy38VG89ED+McTEuBP+/ZvrcvjI14OIVlUMkmsXXG2f6ZPoGoHJgDJcKkNjllDJdiNbjKETM7
+QEZkgG3WCylQ/gP8bcED+G2xSDoASslRJhih01tb+zEhuPlARf5ZyI8f/fTTT/Eb6C7a8eOP3Xf+9BO+
+p/frpVbfNx8EIQJTY4fmZhGJhENFhYxJEiF80i7Pn9Vj+1zpidG6st5NqqTYn4dQHCmJ5AX9wZxst5NR
+8KFPV3nVSmREld6wjEfw/Tpfh1djvi8s++UAVpEVT5w98bGLL8aB+7ecM/GxSy7R/hw/8wos0pZPXHL6
+FRjE6w8qnDnzoVt+4eydNeuhm382+LtktuaeQl07nlp6BP44BYjeG0vcw/Lg/QPqjsXLYFDmepGf/7ZW
+WUqiVZZ+8PWGU3r1zTKTw/RaIJQ6MgGmh0lptdUaUwHyDBOA99typOu3JXLCknpuabvEeZjSesu8M9hp
+tOeAaBRycMwl2Tj1MxOpMh1gJiqFzQIklDOTKT1QLzr5VRVAzVPzfF6TVs60tOLZZUm0is8VR2waWplx
+6QS9CuBfOkFy8lGWTJrxFsK2D1iiV16Y1yelJ5wjmXBVaSEdGSgXsYqfLKr0Bs0E1Yt2AUG7ArXAb6Oe
+KwP19IS1JAp2320e3GmJKGI4gobCV8CzKP5+YFInyoNkaR4/zUfaiedMEE8/pdIAOjPRzqw7QkluywSa
+CaoX7Ur8QvJ4/kYgP1n+stJS0MzwSqbg63v27U0lnnXHE73c5MnixJptdcwzrvpmyynuf7B3eON3ecpo
+h4Q8Rcn+pDWW7XDws8IcGGN2gePdA5I7wf3VuNPr/eDkKxJ7QvQUMPpqj46NYCgRW4/O3g3Kk9pvJvfe
+VA+896ayJdFa3Gq+Kc4vQSPpn4JGuTDHopJ+dZcVylOhJeRT85OpVCAhFc+BsrZkMvmzshwOvSzBJJfT
+Ti6Dlckka93z4V6LYDdadodeb1Zo1M0tT6qaKwXJgUllcCmVmiGzZK4X4G8q1eQmQO8auS3ki6QSuT92
+dpoxV+nV/2n+hl4EY8R+XMhjcNB/CK4GHC60Imp9ho3qk0D/zycWcBr2F3CbSr7IGAVq6+y0yYNeO5oP
+pybJA9c9ssRLrxwgO9oz1FFWGVA8cYWLRgp0opIyn2dBqZ6ketHUGtgoV09RPr+xqreEvLax9r57Bmz0
+vHj11S/aRYV8e8UV1z7a2StHYhgO+J/kowxJyItIiXC7nQvA6ewlNAuTo/NDXMjzHcyXD+S1QXr2i3jN
+CW7mdGeKU1ovxUb0hu8tdQvTR+S9QwcPrLNF439DWoWNQf2J3/E2dmWSQ2mjyTogkKVdIeYy8d4Y18Z8
+Ae6qQoJu5F1/VX1uea1eAw/8tmuoJ4lLorjYLDD6GJs5eyYYAUroEhu0MbxSho4UGEUypFUk7eX7ecuY
+vEiE18gHhaBGxDRREYBsbBiRNGbztufuPqL+qJO1Dz+1j9s88rh5uTNL7lgz1z53k8BB2hVivshAaDSz
+poq9SKiKSAYFCXMUUwKsCmWoRkWWm4OxMusxOh1BZM4kCMWU2lpAcDBeBGwQZEKL02xFxkVBloWPcJxi
+Cl6V9YLMo3VL0r7HJW4oB3SmAxSTWo3fb3uFxxAaoxWRSAMXs0qenBaKVmWSsRFCAO3k9Vkh8Rx5amkv
+OStQjjskJ/tig+inCKELH54iZWxTMU6dqbMAV3KRM2Yp8HmhypXggwCsyDAxtNzMWOYeqPQDIUvtIPoc
+0eXJIyHz8vICeQEvNzVETnmaeaL8LpBppqhWJkyfNKNFpdvN3GYxz0TMtgjAybHclIkmulhE5GTXYrox
+J8G0GE9oSYsQn4nCEUo3E4UjlGYuinahwCV1PAo7WiBi50kQVsZ8hSixJL4wqy2DmZGdmTFlSTDpOJOb
+l5cXzAuqgjUOZ3Em1oiLRib2xI8yrxwZObR9ezKPgnCajlsSn5jBpwolxT+anlFpSTChJT2CETEvqDgT
+qwSCadgV32bilp5j27dbPNsoZK8twz4qBcZFSvcrpR+ragGkmcV7YHtIDakZ91AT1qRjz/O8P73Fm/Gm
+Lui1f+r1wkfufuyLJ73R/G+2jhpS022dTVjTiwvHG+vvtW+YlH7fCNkyoyEZ9k0SzH+9b3w0RKOB6IhM
+LGn6Vvs607aR92rbcMJrr0HafcOk9PvGDAfx9UuZ9006Evw3+8ZEMA2vnjRxS2WYdJOJmF7ffpqohVCh
+EE7aHOBb3uBT2GEuEkTUyjYoer5ihnLLk4BsQ6MFiN52xusF8BZ6C/P9eT5rfLQz7fhoNPDpXWpLBpj4
+9B4oTa6xWCVqs76UXjLu4iH06llRnlIXSR5zZQwDcKEDS5E5rEfcJE/9lEqsubVV/01hkGUyT0FrjpUD
+00zD8guTxgbpcIzpe3bWgD6ge43R6hs4ZaJWaywrT/XqU7WczjL7xIykqVoYUUN+j9RY35h2eAZxrkSq
+xVemn7NFO7Dmr9pD/qX3wf/zNdu3daar2e405qN9LtdBPpRBJ+8U7iBlAeJ0EASnOWulUkamiGYDbXrQ
+1+EwHTtOp37BSUyeq80MLGbQOZ2JEpY+YcfaYblJW1ocFn0XKsIeYdKGGhNsbQzxf3mza2sCFpuu7bSY
+qsWRYs3Gjb3nolz0SGfnI1qXfXaaXsdeKDxJJ5udYWQn9tX+wupCUpmATNf74rf1IGlMmuXefTT5ItHu
+4njhp9FzsF41crDWJmdg6R1fjDwq3sPC6HWQIf2qioczDyT36oDT6hpDmfOttpMv0qZbndDZmdRTJQBn
+6JgMdCDPhwMFnKA42/vrQzIgBTxdQxWrE0k+QH6eV/XY+9j0aqdiu4PbubIkyT1isEe5MuEbsecGhmBL
+7/zHQQa9l+k5KOB0mvkkaRmlk2OQgZ/xan8vxeoMeAWcyOlhvpeWxa2xnPJSfR69yCYsy8Rhu18iE6ef
+thEoHcvJzSatTL5v/H9g7/FJ/Lb+PwOwxrb3hAWY4O349PuvPu3+G/1/bf/xRrQZuVKINen3n4EbQqd2
+hZh9Xgj3x1xelCUfKrIps7UZmOF08DZhKf6OOmEcmC8Ybox07+lzD1OZZ7xggY0VnYVVACiEQu4l0hlY
+msTAZI+RjZV3Wf4ii6GWs0i3fbUrxOzMUojA2pgXUGKIRHIiEBcimKZvNcjAJJnxTGzTIWOEPESgQ3Bu
+nN7yzoQEQMAlaV4Q8404Pyt9kSojF1tJwoA3Cus1jnPBgrsNZB7UfrnptjRzOUO5M48WyLXNlm68vfeI
+zjT9q2TF6l/Fu1H10zAs0b8qCfh/oX8VNW3jBO/+nLCJLUVrmMJpc7BlpVcOdkoe/TKeX505k77W6SCp
+ufTL/qc52KbRn3FvkmoTz/T60kDZuIPep1wJJVAJV8XyI6jI+kQ0iRQhZRxzqVczMlQ6nCiB6MSU4T5a
+YwcGBCIhWZ54KSkTu7QUoLSytJL39ocSKPFWRGrCnJmoskhYNLgbMbwiKm6pIrknVD9CjTRaPp77tC//
+/ov24fFdvzsea/6D4W+1P+39VevGALJD1v6qnW+6Q8g78cMPPW7lX7ANj3739CWjvp2n7dL+pv1b+ygH
+p75Jz7bX7IuZrfJkMbN1AGbHAjXoUHpNbpUMC3eIy0kcClUcdINeVtH/IFcuS9HU1w5krqvOjKGgOJQO
+/j51dBzQewf0Ssps2NYYv0wPiAxQ84whsTzSdQBDYq1Af5/DYuN/NlnT59hY9kjCH5eQ11q4IuZNlVXW
+3MvW48aKqx9pHZAMbsqrK6285gPUVPFsvLLS3yKtNkunP4m92mbw9C207EUrDpMss4Mxt49Jw0OdosMo
+uFyOBXo89cAEtqHXewcusfxdh9LxG9+N1RuvOcBFHa72A5Vbz6C62pqQ7zdKrY1H/Uju5zYW9Sm80j6D
+Q6bsbhT9wc/PLLu6vcrNOEc/YltpQRoS2+5IL7FCw4ZK7frV1a/EUu6a7VtSL8aafpTqbcIaNGVzvJDN
+KujuQzbrnOhwKPMOVI+K0K39lQOTyPoDe21ssjAOAsWJDqo42g5UDtVIJFIVqTLVJz/TD0QQ+RTMPgWQ
+p7f0rTRfNOxwInre3if60g+E22NFqXJXhQ5nJSoO2ZC/oQm1J4PTITvb04gf97UIA30Sbyw52JS/3q+a
+cmh/QejQgXW11RXh36RDabm9jLK6H+F8DldcvnjWyomDNtzXt5B2/+nhyfOzF8oTRjsSelRZJmR1IAb6
+kNV6cIJLcbraISFIByayw9O8eeCSe+Bvpwhwg/WiAr9Jjj18KID6G/UpjaohfYRm38K8ciUX55V969G/
+azv/hiva7u195zglqWduOKkNLgKjyJal9t7OCETn2dpu93XLsP41CyI7sYFI2KC9Fd9/WqI3VPx+0vrr
+SHJPfK69T65Rg6S8I3JC1+nZn+Y0fNqcmqSp34eSii6syqB+oUSjpV4pmrweyJVuXj5NlLglKoDeNBFK
+HaAvX2EhRaAQQOKzdSgEYJnBDEUi1EEIAsU2AHA5wdWm1wJkuYnZKzUTkHOeABK9UvMZYwEWECER1eif
+m83FMNQYQjG2MOQ3XLiCLfgpkeL7tbc2btT5snEj5ww9adf+LWzKxq+++uqrjfu3cL4Y825FTVgdFMAJ
+MZcbFSmAsmLNE6CIDEFCaNNLDRVF7I0JLU4HkeVR8rRgfzBj5Wm6x6EACjjtEx4HY+n+FD5sXLcOa7gK
+T3CBfbWxs3Oj9pCdAYl+wPdAPoTNLrUDKCJIgFI7EIbgILCcm25G9pwLZdk9Lwvd7lFuflfrDQwOJyqy
+Q2lLgI51cy4EAoFwgF/USlXVWxlSK0I6F9K3Y7T3CmZpikzuNHvYJleU/JJva3hMeroAyIXyNKBQBJfr
+KZlh4QKRkGetykiIyJ0y6uccSVWV5RJSUAhV2jPBxUotEGAyEmBkuQWg5xTlAxQV+MSYHYdZWmvVV2Yq
+oNPuTFs3xzNzk9EFIHAcAJ2oFIqezsbeCQE49PKNzB2dyywYs59z22/o58znztn7Ch9HvoiHDZYc39n5
+y8akvtM9WwDIzdKrovbF2BkBa7DnyORC4ZgfCCerWa9q1gFnLgNuDPES1fiv5AtBo0Bnp73XNffjLzcc
+gWYzP6Ay0vZePZhdZg/mimRAswvzsgSY0CzerKysQFaA70hfWA15+OHms98EbBQ60Wb2B3VKGZ76X6Qk
+meXOlYtF3XIurNMvT8VMjz4atbNpa4A5DCUdGWBiRcZjChLoVbb2SmA+HybL5bQk1KpatiHD5dJlQ4LT
+Wp5sXiIRFmtX0LHCZ3tdb59thIqJ9ILbDAH68NaWG07XDG9IevCSIgJB00FrA8jsobUxI9lDu1hnx+eW
+d9bkh805S2CcdgWpk+tEHcFVsVwZgSiIQJoBiZmnmJQzwoucdSvM5pE1O1Dq7th04KL7YebnolA3lqOq
+POXfq/oiETHKOQmhiBqq5Nx73sKIV+b8EcdaCLEpr2Cp9llin1zxf1d/oF6QkNDxw7Eh/qupQDCs7bGp
+EOQ2jzH7bqi+wiwhwMSU7sSPQlO4bCPxuBhvxBourUYPfG2nXCvmkATh+JgLEZyA1DYBSJ8FLPJCyTxZ
+1NynqIcyGQl/zgi2GeCpmsHj8QQ9QWFtRFSfSPNG3bTlOKseFgnb8acnXDx51rIdRNG0T6d0L9MJof20
+s2r1zaxpx35V++57rLHrVAYbtbn0R2NeMq9sOT/mciJDB8rMxEVUp1BqZi9IosbAiSJ5ljF5IcjyJG6B
+ZIQba4drjZX7/YyZJSd5Xk9ulsumj7NMpcFNWwPXkOoJiWAQ1x47sca3PNoyGmu1M456GmuFtvZiofbl
+L6tea1x8AZuy/w1tiryZf6LzCrSdLEfkqgdgVSxH4sxCyhwo21LNuKZWjC7/FGQqLwFzJADTgzo2AP2S
+uQyoLIuZ3bzilsozW2M+gICf99LPcumZ6g4+vNswaU3WhQTrQmEMmmatVv/cgzes0rrJSO177cNHTVP9
+5y0fj0ywTuj1k7W55EZ5MmRBHrTFcrzIKCIgIAEzQlAmS4RRpLzGndsnIMqOAMhCIGQSEXlzvLKGg4lk
+reTHrbGc7OzsvGyegeIT+WZJbDDt86vao9NGY412xlGjv0XvN09tJ69pHTb6b0r4TDu1GdKzUhe4oBBO
+jXmcMqUEfCgrXpRkW9IsKDJT2vU+rg7RVz/58pgEQuYJkOSLop5vkp+n5ma5wQUur9fIN+GHEGII1UDy
+Fb4Ta8iynvgfyaPa2fHb1yVu7FJXvJ78Mb6s+09kZ7xmge2CzmuHZrDlch1kQRDOjHlcCqUE/SgreTZ8
+KszF6nciUbyWBqdMYL3xChYV5PvUHJ5Hk+X1WXhFdcQiqZhtxBoftv6gvYiXa69qd/mmJHCT67rLCGiR
++LWkMr7LjpvQkWw5mwJZMP4ZgZhV1ZGjFxcxa+CB/QNxyTOGknp9qpS6uNTVsCkaJJag36fZu8qVIIET
+Ws08fCGlaCSqif7EZL7V2zf5sbCzjMdiMT5ZBkgMZFRV3to3qhpzZZDflr/pPsToAL3flRiIQ2Bhz5dk
+ofIOZIEHGmP1YBX5mQVp+q4Zba+ly87O9mSLsjlekSbCZmbZHP86Mn1Ss/Y61gyYGNQGfou52rYtWwpx
+Gdu9f+qZLdKnth7C2gz2rtQFEuTBqpgrByU5G5lErDs5BVmicnuCIFzbimQzcbeYxPTCa04PE9SEGmuH
+ErqKp/dluRRZp5DDopCUuksayDfxs+27Y79rh01wCFyozSDTRQ5fECbEmnJRljzIZAcSqiASlpgSKxKl
+ZFlMRkxqWxbxRrwR3sS7pC5FltFOzr8kloHrLKomFoNnJChr+WoMmubCws1ZiFJiKqJEkUpCUcI8hhZR
+ZauDtAnBH1IJaJv1XIiZymciOh0mCW1CZuXmCeppr+i5eIJy7M5E6h2nXc+XdIJcB07Ih/rYEJdMJOJG
+KhFONJzH0JC1McJ2X6i33454K/KMGkTrm6idTuRx/RsXWDSSqPm1+/+UmUZOOEInThFQGRlS1pZm9xUB
+UOhIQBzw3uNpKYIgB/GElP2uHeQK3VGs0+FWYYt4YHgsypChhIRxMkiGkSjOM8bEWTWRTAPw5LhdDtm6
+yfFfnyQtZBXWak9ZJNC/TKuxoY8ABu7ZMCWWzU1MRgk6EpOC1AT+1MRfTagcaqLtBuCtuv1+JtBVGnnB
+7Ai/oob8nZ2d2PDTT+QbcvgxLx1Lnox/37H9/PhH3AhD3rGJHC98P3WxGj6JJ8vtcoDT0jopqqa6KEyd
+hbqOoVWN6jClcUTA+CaB8qxJzdqnBeTCCxBrnntuwMQg1zWDikJYfegrzYz8J/7Y4lcWa/PObEmaeZMN
+c5Jn3hTqtQKi8b5+KMuW7k99RubL1jGQ53IBuLJd2VluY8KNI3nCjVC/5jybJ80m/EkTbERdh94nSa/z
+f7N3nyRR1A+SDFYrH7M/kpJodZSmqD+lcZGS3BfJXq+fCbJfIKMPUkpRP/e7OdP1QFL1FmQJT5tRtpzc
+4oiadTvcnuJ5TU4ogFWxXME0L8qK+n/CnCoI8Bb01rAiZxIre1lTJlcfsZ8VCdb2tqPmsEXggTJ4JuY1
++VuIiqMAZcUMclSCBA5FcrTbOOxkOj6KIi8w7h/mwD0TOJktaV7oG3asHZbzEqCstLiIW/cp/utkXvY2
+wxJstdlgKcxNOksTe1KFJTF3LjJJ8Ng0AorsKfw6fxVrZ/Z6SOYr1tbMF1tTdak5ic2ZzFErn99iJdma
+yFlKcNJKW0rapwXwHo8lSLLJSzMFm28YkCXYwAMFctJmdSRv1iqzTYkJmcwbR/JujXAosw1JJtD+oYz9
+2leUIpnLJpmS2EuCJqVSuCtPNohFIAdAigj/TxHcFHMHKElWaqVgeBMNGilIUohkKCkTSsFMWPcHpPuA
+fPzGlGfr0iZaBPTC17Ix8Fg7yvG4bmT01lWWgcPzMOcIfeWFY2MelRIGnixCmdsWawroYmyMiaA0cVcV
+T9g8Y0hE4ok+IsIL3khE7wJhyLDfY4T/GlE1hHjj3GMmTb0lfr4pv4O1SwpeL3yKlXbzX4GwUZsj9BD3
+OXopMihAiWUjlbKQUFMLFYsKVV1kuUNNX+YCY5mm0gWwV3b0hksLMtYOojfQ5G7HykgkrCpGTqHghseK
+bJpBGoMXt7S0Txh+SKMI0uiceFm7JvCqf/bJ5CMjTGPN1So0emS1WQeFA1PGRqVMSitNgBgzo/5X5qQ1
+hjLPidpKvjiAMVHbuY/e6P0kvZoyA85hej1HZm6I1xv5/512eDyYkL5H3H7yRR99vk7r7LT4pMccInCK
+jg8vYpEYdz0pKCntGWd8RVLg0k/5CnKcwrxFY0pHtz7HvdmDEpk494DNud8/C+W/2/LH7P3/InCfkaXM
+A7GJcGavHm4LHUlFWdUG+v2CZyJoClxrf7TKIAF2WqWVhO9tdMosEdK9Fn1YyqzD/+dmv/miGPVFM88+
+POfbL7Rtf/3mrwc+/5D/AZYkF0Zvv9rU3n6j///b28/APS2n/2jh3V9fyEQ9u/KKtA/yoAye43OZJOY3
+omA5oie1BLIiyWKaqzWrzuwVMypdUdsw8xWzQq1JL+TTQ3yKIvLhE8Vt41HkmhFwMMLNbmASv+Obv8OA
+71XgVlaiB8oqQkaBm17+CqH6gJUUX6lW6eISrVde6V5+xi3a09r5uA6bbz6TLovfhD9rDnJd94i29U+9
++eZT6yvxdlyBK/D2Sj1bvpz+dar2jvad9r32jqUzlBBbBExk5F419fHyGUcLk5nmeYjEeGMu6yeH6MxV
+LuwnAMqAtjkRkXAji0xocTkIY9ICfiJP5PejgemAwAJp4e1dLPDWmK+stDJSWltWWyEGEvA4bmmdSJev
+Th5AUC1HDDGiNhFiN/HE+Rcv3mVm0j/2zDPaj/ff8kO39hccuP/Hn0/ecM7xJ2w4m1yNhQOu1JPpD/pT
+08034yiUUMJR3eNMYTLrhJ8TusIL+bB6s4zEmlxXaR80rrfb4ZU6RlGEw+rQE7HDAUgMpGUmvMNqz1Pg
+8wH48n18qq0nRy8/ywu59FtFueqRhANYjCoLRcTdn3TgK9qn+IW2SZv/7d04XXvvtWGGF0C7Wtt1TryF
+zI3fQ2sw9zWcaewJHoyPy5MhG1T4XLfIchUEiSKBLAehookd/wRtn5gdEnncHZgEVmuEhCFnNrTUeygk
+97owemlZlpoJa+slgYkbRS/A/mB4jhfv9p2TA5Cj5qieXO49ioRNd4mfz65QzaQo7MSa2fcEgiOrTpv9
+k+UfkLWfAzfHB5u+ASp8A7wXDud7CC6L5XDGB9HhLELFYVrbAy2um1mSFvddVO+KY8+uHGAwvy31NQE8
+NjmzsgAgVF5aXBjI89nEwZ1BHFL8B2kkY6Pdk5AqHr/enXRv1maQOFsE2VAMO40RdpY05KOs+EXqpIIw
+JRgLJMmK+VQyZSYRl0lIiyh7TpNLWWPBJjO5N3yfoCk5j9z5UhwsDOR5c3MMwXD2EgylVzjLlJHtyb4G
+Q1BsfoZkHVEE62Mu7nWRhcfVyHRJqojqpSkSvbwqkgBTVEWik1eRUBVFvqJAsrLIIB2J3gK9xYK02roL
+pEhForsAAT8AdQi94YdvjQ4CPmSSgsAs7osOAsanaPvU7OMITOIobXBYPSIsT2OSAqk0m0mYgMlMVpI0
+SKJbA7KODJD9Ahk6JF/oEH+OCD1nFBaLngkpwajdZWEIieWuAAKLej6SnMqVEIFq3uPEgwRVJEAAKfTT
+7wggMU00td8RIFluAuhjXCsqKqorqn0DI7Ui5jyikUXL+WzHiqpGo4GGOCbLSH6+P4+FwtX8KFmKxV/j
+4edcfI+2/3EyR/vrv/duvmzygpUP/fSCdr92+aWP7hOKMrt70fYNIxqaFj29CW+NfjWreWHTwRUDx895
+ddPfPwzKrVbcb13PlyxLngylEOb9kPQ8dRuuffdDSmCTsR9SEsJlZWXhsrC3NlJjIKxv4eqqBMJi1CVH
+NxLm2K77220fP7b47XX4zu1du5e+c9eRu6/T9v39rSMEkhU756w97JCa2Wecgc3Vb446efK40OzLz7x1
+q1Rs4ZfgZR3v91KLEkviZ399kuwM7dUnqRdHcysqKuoq6lTBUt4O5YBYKnIL+mPrFDNv4gA4u317Cm+r
+eD+YCpRYEn8PoI+SncGZ+iglEaCsrKyqrEoVLNYJ0CeLzcyKDGy2krr75rTAF2GRNkNySvtgODwdC9q4
+LEMEJbkOmTQAKeMlF17D6cpkaWUSs4Xze7zV/HSSpbmqdWi2oT/wWKUFqUtIuwk30Q6n93urHzp4YG21
+2P6F/ciKkmaiVSRcnVlsOs/7aXhiuNX8oksf7UNyTpjlm2XNuroqCIKe67QZLIstgqFwQSxokxwZylCS
+q5FJVQY9PWnoOaElLeLB3qTMANkayxk6ZPDAATWVkUiNQaHMwpSOPlVpxOqS9ruaEmRZ9lwGyZp7sDrX
+IsjtUnpdwqT0uiRD76gkXZLaO+p/Q5eYh11/uoRWmSdgn8pEsdUs99IlTEqvSzL3lgIw4/iZe0slYH67
+LjGxz6BLyGoT6UzKRJ5r4otQo81gz4uc3DWxHCfKlAcaPcgkmrhQUonK0gaCADgPEEe2OBRiNEGy56UY
+cIAEgSK0CaiUvBSP7v4ORypV0QO4hIcjeImCGukl1bhTm8E2ajOmNyfk+HQWf/PN/fQUS2S3AMIAbQa5
+VZoElXB4bCagg+Uhd2/YxvFJzcVBIk0xZ+wpyNP/+O1+DL/g9J6yF1F9ah4fsufUu4uk2XKqKK6oFDUh
+IjJ/00lTbEP2Vr20di3W7NgxYGLNc1sLMTFg79GxGCQTt34Q/mVr/K88Q4NADQB7XuQw5sKSzdm2rl+l
+dhPRxgDLhix2JG0vnfLLTOsxlu9wADhyHbk8vdbWeNkkutmxzCT1J5bFKMhsBQIJDOj5kj4ib4M8KIXD
+Yge7XcQhB5E4ACVE0agLHDKRHWQDKCA7FLmNiexsikZui1kxmqeqnLresEHaSHkqJX1WPIrctLJZe50T
+cvfuARNrXtDjUc9tLcJlNhKyr6yAFOq0FLm7hxuZZhKijKAgtDEkxPCLOcwk5SKgDAlS0paAc5j5yVxa
+cyFXzxBPSKtBPP4Hd2pvYqNOPGzU3iTbOvHqN9/UTuqMTwDgstnzpZDNYjg4NikfFTmADoU0OxRCpkgI
+LieRFUghlqKIKuaJjmkAUAzFIkfdJ8rDyup8jdFGi17ij2jfLgjZGFuoS91rrw2YWDOGE4/UbdwhKLVj
+4+YzW7I56XYDELizZ5dUpVwJeVAHSxKtnW2jY0Bmy4mZNZVQ6FUEubABbugNbgLqRnBdLS/h8Vd6I8Jl
+6BNJO3ryjqzwzVRV1Zi+wOVObLj8NizGrGltl+/rvmXFEXhZmnIXqat79fsv4tn07u5Vb38w/YRTZ+x6
+lXl6zY8xZySImVxIDqi7f4YxXbYu/+7kLv+D+nrBnaHbf9oxXZleSTemKwHbq/d/mjFdmfv+p2VCyiSK
+GWk40GsqQO/aI13OCkX++Wwz94zJKAGT2tKIVwlBkFAC3GBBJUuV38+Ty/O5VPGrVVqp4rVHvWTor+SL
+tBIzuLPTlBHRqywPHj4gGSnl3NaTyDMMHTFClSlQaeaIlIve+YlpDmmmiBz4EBEeek0e4PAQ+SKVU4FO
+o792Qg+UwzFG4mQieAaS0p6GSWEdgiAoqHBWGaBJnHKHVX3z845B6dlki6P1YtePSZ3LevNNmmn1PkjM
+QCmGr3Tm9MO7iD6DCxwOY55W+vkdIkaZHjTTBI9qo/N9v+CxykQnfZQd7X3M8fADBAsD+fZ9nH4Cij2G
+mywCz9momSILZoUW1WXBUShkYSCcaFUKgyJLSrsTDTXf3uep0Bs8SSoC4VC+v6YqNDA8sLjIX55frh8P
+RRmOhwxzr3tJCzk8ZdR12q0upxlzbc5/kXcK+amBPxyQBFVyvimyo92Nikto4/b0MqQnrmUATiMWfcKm
+KoWS0hKvClARLqkprSnIV4u9xX1r+gz0TJnQVJBCzF6jc9LTMaFLCqAYFhiFbDIyp+KgmRR+uaXw7YBJ
+MpNfWOj3FxYXFgeLLN3vyqT70QjX9rYhiq1YbSbLwYjSmjKxXJ78388EGt2HTqlMB/j/6EwgM/KfrE/e
+smiZdqySQUZAuFO7QqqSuiAEp8VcfqRSPjKrt2TQkgcRGhlvjcMYxxJjdikwypakQPJ4S4FRgqsDmI8m
+iviK0xep9UZECn2KlCQ3rhMiYxOVya0PGQWR13CZSRGUhuDsY0R55LzpXGQAEC7SrmAz2BQogfeMCIQQ
+CyBAAihBAcr6WBFTWBIfAjKcakhOsU1WmDmeQxwg42RTaMoNmCTWp8CJDQcSyJK8JB04TAv2ft5LRlyR
+iMoFgldr9BKIpMLYZKm4xCwmTZEJs6bU6OOgrFauFDVu7bEsXgXslilNVFcG7T0nCBIRtmZmb41eTyl0
+iKfiuhvIzgYQ5W2e3BxerqR6vSLHM4QhNCYjUTR9sXiddj5GsBALEHtw0C5tifbhN9rPhyQabOzfzsbs
+f5k1/TqS/tTttDXZINAEIHUbtXrXp+LB2VXKF2lLYSdWsqc9UGSDMgBAkui8BMJ9AYgAUZ9Ic6e7L4oh
+NzFxPlT7Ed1bX0J4qOt17TPtr/f+7ubErOnuHord+6m0fwv5Np5rmztNoBhAGiN6k3tgRSwrBxlVGNJE
+4nKxIhFj3pee7wm8XNSqtSlNekwokPUWgEhf9judAE6P08NLgcEBDq+X1yyFVM66KFrj3Yii+fEIXIin
+fq+BVm0unhxP58SvJKd0b4pfk2CUfT5ZLhzLdTajFInFpaBsLSvNlLICq5ay15iyHIeD+1VUPnhLlFcn
+5pTphE4avDUpQWP7uDJjVqxO2xg4RFVa2zM6Ya1SDQmpU3YkhoCNbFFMqhYjAiMMyAY7kGLSNJidzanK
+q9dyc5xZTouyriTKmsNLkyg7f8/2vUlEXWjMCxP9JNgFRl3JimdkBERCpqbNnTD8VWIoqJU7UWaHQQCC
+sMyC0FuLJ5eZeFWvyJto5A1Z/KhX+R1HXo6303i8ibwcHyTCZDtY3Ztv7n8vUe4HBE4CoLfJk8Vab7at
+NX1OiFjumOTlZsYrBbA/GH27HghqJ+EH2kFU1mrx8/hDArXt5K2tW+MN2/U9afFgo8CrLYUHZcAUI/M1
+AwdKEhA6/dt+I/3VkGqn/wlYYxH/eKs3mE778WKN16XKiWJrXJCJ8PX9A441BCoF4d9OdjWk2sn+MdZY
+NA8IjOxyr3Jvea5w1CajlRrTt1PfctqWJwHZN0DCcZtazOFVvc6kBZsNaG1s6DC9tyYvbP7bxD5QYVPv
+tWfIcLDTsu8Mh3SQ/QLprDlwXG0M8pu4mlyyEhsQGgDIJ/JkyIWzeMcDSlyICZvTzMe3IyhqEyybsxAI
+UCS0vTdApmdjGcckW/cXR8KqUbXAHbVWBEfdtHIlXnD4/FkTzqZXb52ODxe9GPpy/xzdTh6m7WQxaR/4
+YEnymoVNpKAM8gUMUUKQ+W41cGjSY0ziqbkwhgiARzFEhHkSAsL01lguAPjAp3r52niMyeeLNEZHeI21
+USXqj9Bhr123NW81dq2et27uGw3hJ97fru2kn249hoS8b1Vp/35ia3dZr/mAXjg15vKgJLuQJTpkWDU5
+IHUkjQhMJCAmwwADC9CWfijmRnrd3lz75MjU+YCGcPSaDYj3J9KI7IMBrZ7M9rmAg+AnffRZQR06nL1n
+A0rNwVgw6ZF9PqBsTlobDA4nczrYBnNWn9tFnM7R/cyUrONQDmcHH5fY0e9YycZU6AOdLJlXVVU1qGrQ
+gFrvfzHkz6ByPwP+cL5J88zT/SyddBQAnWL02VuV1Ns7kXNG5I7ebX7Mrt5hO5jZ8WdZAqi/ft629ipm
+JMzo9JOTUKV6RxVbTVzPYwDkbtF/yQ1nbXYioWgpF0KBEtggc/vcSBKTkrowFfBHhHLGkY6k5xke6f2X
+8kSrHDdzW01yzA5MvkS52t8SCV/cr5c8hydRX1AGazaX2KKMEaddTVtFBg6RRK9YRQbO5FNLFBkkYESi
+fYlRUFNWUFackmLfV/GJKVwZywuIlcp2IGUndrzt9QVlsM7CWy9HNA48p3HgJWroRJmIYlUZpCNQClT/
+yGeqJzKQT1tfgF+aiPdVR2IhbPfnF8Nxm4tsXC5RbMWj6SIvKc/1Enhbnobf7y/2F6v5uk+/OIP7zcCm
+l/uNvmArNU3jzbfhkH6muSTrPhRrprn5gX2meUqBaR8zzdNCZpxpbi8yzTzTPD3U/2CmuWn4JPtZlpuU
+7OXJN4nIDF/+WYYvthzW6YhUgSNLH0lsFs5kcMvWJuJw6d5IkozSkhLuoS0pLykvK03x02Zn9NOaE6rT
+xeowe8+He+ODM0TsKvhNFE/s7ASmy4pSY/hpizHvgDy1A8CZgy7J6WrLdmSlH+OeEuAd3OcbmSK8A623
+FJTEiwn3bO93+gXvJUmVnO5cmhJUT/Xq5vY16T1NsJBPe4+PIl+knfguiA4J+frOkq+zzXR5PoCYC4yz
+wyYvy9JIWJ0N1B4DsN75X5QxXwjTy5g21mxRkUnMZpi+BFPWHrBkrfiAZG0gOF3ODs5XV8eBStvQft7J
+JG+Dbe/ZRGhZRonr/4X/fZnzhTCNzGl6v6b0YneMwYRUuauASzaH0eE0z7jabJtpLCPLUtwZ5W+gHdYu
+gNZLSQJYbghgRUlFqLcI5vQngr5M56IQw6K+zkZdEMnVhnJPlcNyHKALWk4pOl1JZ2TiA9sZOSjXTVxO
+Vwc4JWdHjiM7vUBmJQvksP5eysogkUPsLyooiXcTEtb7tQN5o5dMVlsyWV5YXpJBKj19SaUv7UkrJLMy
+w3Gryya5xeJLqk483UyptotkXypxAKQVyPQasYjjXF5WWpIiiln9iSKNYCi9GO5/Q9uGhX0rwzR68MDO
+3DqwuHqganBI369k0oKDwC4//SvBfuF7yVtIlzZu5qeTtJy+JI2TP42UfSNon1H9WX4Xx9VQJOphl8ey
+KMpQ4nXTRA+5MmOAvWTVdC3jFGtqoeYl0wahoGHvCzhq9kb1mMWuRapaJIbcc5UdyeeFXYoi3C4RrKqu
+amwYMVxMu7ddZkiHaICH1eUHHZRlOmM+JU2yPGlyefUWfOtvg4ycAkUcrGpo8oJFk7N+Hc3dM1KXJB80
+f+HkkLofyDScl0guYLqvRrlS+GqGw0T4wPDWDEV3Jm9N0qNe3hr+dhScbmcHuF3ujmx0ZaGDufiM/H78
+L+MyvXVgfphYcOSI6qqmsSMmjpw4ZHDV8Orhpjcmx1l6gN4YGtap7wthfiA1maMfF83I+RdOLq8SbKoq
+P8jK8cjkr6EfXzJ/4eRyL8a0bWr55OSkDyGTysvKlZArapGv3uzl294QxnphXGShC1xiQJuD0DZwgMwc
+cuqgNrdidm4ean/JNqgt6WXrlbGSVXooqpRVjy1hN9tZwh2F1sy2kBqiGBI9yrLoBqbEW8nIeBd5v5to
+FV/isbis3TAG39QWvaU17cD12rmkiczGFxI24EE9X8qPy5MhBANgCEThw1ieL5cQmoNIwiVElkoRZLNI
+YFgOZmfRrGy6wYXoQEnmbfCzska3uJEQc5KHE2XZcqEEpz4+TNhxWdlZHTmYTbM7Eq9mfisWtb0ADgkd
+rS5EfsWV2jK+1doaKxo6dGh0aLR+2JBBA+sG1Iji9ki4IpRrDCauamyoSAhhfSC52J02Rsp156AYHMft
+uopbv73xOazF/MP/8OTmP730+H03P/v1Z/FDynHCTdvef1976+ep2l9aJ63Ay/CmF7Zpvwh6v/jho1ds
+fnkZF7vTT9fe0n4nit9x8N6nxs/CT7EYjzR6RZg+LB7L5TWL62JqAQIJqrkKQwgjw8R4C6O6nYjqdquR
+AiJb4JAIY+OZiNcloCgSIWzLEzATecFCfkWFx8OrF6sqPRFPpNI7oCokxhuJVge8KEOMOK7GEVX6tPMR
+USVf/K1a4RRhVQsefPbtoc0L79/69tD9e558Es/C2ajcN+/3Tz6pnas9qv26aR4eJujANp2p/Vn75r6r
+rliHAzH3vmv2v7z3MzwUT8RbTjtt715ts3aNtmTtWnmVLo2J3hk+CAp6XBvz5WYRiYRDRYWMSRIhwMza
+xho3KjKRFbJBNI3iw2V1qkwQeUKwAB3ETEwUZHELsmSGa41FKipKiv15CDp5iiMlkbygP5iT7XYyCj70
+6V01qpXIiCp9hAunjV/3fg2vxnxfWPbLAazidCIrnjh74mMXX4wD9285Z+Jjl1yi/Tl+5hVYpC2fuOT0
+KzCI1x+k/VmQqXDmzIdu+YV7wmbNeujmnw1X2JLZmnsKde14aukR+OMUbDX76Zm1chGoh/WxQFId00CU
+lTpj0p5uqCXqwlNrmZJLvSutmjfzDbOiKbnHnpt3sNLrmkoPoK4ppTVrf+VNOD5RB95ngdOR9hlExKp3
+K4VB0Bw7LKm+qRplpUrQJJUYE1rSIumNRFS9cKm0v8KllPZ5GeqXzksglamA6dBkfMwaJgXy9WiUrOSi
+JJPUbs32Cqbe5fthq3DJhO9duB/LA8j3+9ScRDTKXk6Twj+zqkb7IoGSXliT3CvRrF/KgwjMjE0zS2pK
+0OEsRsXBmt0uQqaYjRnS1tXY+zRwW8KosXFlqrHB5JUmCm1ExZL2YmLBqdU22n9sa8ee3RIl74j8muon
+5MWTRbkNYDMAQgclBHENgE4p3jj1CRkmm+lXFVaeVSLPKO3vA8Rm/qsAOwCg1++z/OfvmPe0hB8Uez6X
+AuR1MVekSvy+IAAijzwBziN8qdNtkwDE8hpD/hLyhZ4hjz3fSZRsEfGDGvF+iekZpxRbjfsY0pl8tgdz
+Fum/wZaGnW3Lu06MwgCEl6QA/kMef+Dr4i3yX8IaIxlByP0KiZLx8k4R2xglfstgMy4jIQEgrZIIzzAE
+AjN5bMIek5Cd+Tr9MuQE46zUlOq0ab+AcJx2hWhnnA2zxCpcCiI4kCDfga4ZR8fyBXInAxIiiEYWAkEy
+iw9y0YmZ/Hnr5kjEw9vg8uWFovaEQxx/u5lkmMgq5Hz6QcomT8vTIB8GizVEgEhAWm3Th/TUSgKz9MlC
+PDVU0DVt8cvF6eqNsnvXFvU8KlHSLWKBCtSLb64mKFipaxuJMsq5mWYyjb4Z9PTaWYncZHsCLYH3tbtJ
+gC0CCtm8a1pAHyFiGM91epIdEFJrlVkOYmaIowQkYBJbkg7camsYBJARKWCbAW0Hyfx0oN5U0wvAKwsV
+ayqMI1DHBZWKEr5QY33j+zjhtXUcm3U0zP/XvQcn/KIdc9I7gLBGouQYeRNQKBOUyxPSQFBXGkL4HYbw
++0K4Zgd6tcclivdoc/XYXqGUTW9XygTt3XCo+B3jRPjKgRIgSLhBuFEMXsxXqMx5McPh4NxwuB1ul9PG
+E6f5ZY1Rf6gx6q/0hbBwD76xJ/68/tXiMn5S4vtd/D4ubxJ5EDlw4mYXMmtSXETkNfCSLQYy22BLgqjh
+DV0Ra1Ev/BHZDzJwUGa7tHOQgfw2ni9yIHLc9gwIpyMpA4IvtFf2Q/xwfdG21IclYukIT1Mn/iBmpRTG
+8oklHTjNRnXOxadxAnUa+WYuAPkGeZOYfX+8VRlsm7ztVIjxi2oSk+9rJb0ymMMBh0g7935gxrn3jmBd
+yD73nhuKAxITt39ZZeBozNu2hENf7zXJ660Q63CiAggKbjAm84ngV02LQyKU1tJpwb7A6DwBNpD2vV7r
+X7HeysTsvu4njPUmJvcl5Jn0LAdgpcaat019PEsfdUSIfLJTodRIgqgxkiBM8k59vEr4fXgKRJ9gevhP
+gCHnhIIbKGaAjlUkA6bkVphMa+2DDPbRPb4Q4sF6YsX+ZkECM63CQJ8C9OySDxO4ByAIN+qtFkpFwF3Q
+XJigg20NPIdY7r5KHQolSqhENkAG4FgkBQ6BSkn9Tuv1lpAZm4I6+shg4JfflRmyF+LjBM79py6wOZb8
+5gEoR8mbhI/1fl2f52YhoEMkL7sRcWqQf8LsnxhJSAVAkXbIjBhTpWpaJLTr/DL9OTIE5InPvcBiJSkQ
+eo4ityp0zR/zJVcjqj7FwTOKPRBpLAefF0b4ZVCF/LMC7aM3sAKnTzsWp7/yjrb919cFNfBpnI3fvKt9
+rD2PLjwsb+uw+PfaDm2ObQ8DgHy4vAnKIAK3bS71EsCpumCUiZH3ToUYvXYHt4jtOd/axZa4o5BiBkwR
+HeLSQ8cqUgARmIKs3QY2UE9VLC8HKI+UR8IhKIOyKtVfKaQ92hiqIo26YOQRhU+LHO5tbODC4c/zBsQO
+eBDXPPIInoeN2IDnPfIIrolfd97lf3ro4T9dfl73vwRJPrgIRz2P1bhSu0L78Hfaaxd98MHJOPiNT5Fq
+3Z++of355A9M4rCerp4vaYtSBrngEzvm8piL3+NAuN917/lAUKjS4UYHZdTBNrhkJ+V9ISWktKYFEOss
+r3a9mFOeDC4hFW8gZctSgUWuS16ex6NvkrxAXiDf7/F5fL6IP5TlKBK+I34JNN3faDtSyaennXHnkU11
+WDNh2sSjur+0nbCF9Yc13P00uSp+3GWrBrDRieOW6L5vccdzQQs/aa38eH/S2SpZZ6s/KalQsg7UHADr
+IJWTD9Jeh6j99NTP/E4Aab3owZcHJbBgs4wSsbo1AAGJEdGINHES4jwZjSUVG4NS2ixA87FYmRegJBjw
+53lF8zRXiFtTRus0pubJEQzp5nlINf/SSd7CLu02vPO7L7QaLD33pnUPa/sw8PK126/Xvpa6tGe0H05a
+rL39xAt0/jknLJmy/73ZJ6y8HkTfbgD2OzETZJi+eA8QIi7HNS0AUMslIsfwcrQBwECY1rpZVT3UUVgX
+Eucxfqq9xXLiVVLXXiBQCiDdLX6fAyZtZkjExDtzwkYNlx6cT01CqAAEOqzPBPZZAPpIES/jeDdiCP2I
+oVLShDKJxbO0ufTQn9i7+4f8xM7vXmXgIHqPu+HIBAN00yLZFJHNY63Y8Cgn2yCyaYPkAFiHmOLQm3Ob
+tkdnwuyw7A1APSdczGtoSclwzzczhGv0hGeGhrLJS8q55x8PpMaEJT35WXLYk59tCd5mXncS7oeaX5dk
+z8iWbstPtmBky4JJg631r23gsN1aseUSSvvS9Rontja8vQ7gUkDgCqXdAk0+e/+7o7fPXuMD8a3+j138
+7q23xHxKADGf0g1TjFzNXgaSJUn51oDJxIP0MmQzhIzk0sSUPp2P+vy08c/ow9OSZnLV6CO4aq2ZXGSe
++GCgOZNLH4QmJCYxCC158lnyuDNAMafgUKkLZBi9GYV7Rf/GbCGslBBj/2cDAnaInweCMQNHBln1Mn7Y
+I0YwhIxq67Sz9hGCo1FjJ+6/hqtMAy9N6oIsmPGM20EptfDKN7vw6PhJzMQv6QGZJzETz2xj9pjqVWWH
+PgwAhXkd4ajeiBU4BJdqX2gf/Kztkbr2f83UX0eyud0yc+7faNcTTmhKnoJzYCTWR2kYJDZGaSRGaNgH
+ZwAV/Wc0qcu4ky419wZR9Hm1VuP6Gr36wDoaShPbx4S1AIR6LOrj1mrOuOV/atj7+1+ld+/vZkuRad3k
+3G++Ic2//gpAkvZti76yQGKzWr4ifbP6ba4k43OxR329/Eh97c1M+7L/TWnjmwJj9dV6gRBDrzPrnPeA
+0KakjVlHfJbpI7Q2Bv+3k52g67NfR7L4fmqb9S72vM+kSSFQQEKRHw7Gt1mGcQAAGAXWZsKYJjGvsOB+
+Yb73K0OyQ8zEqufBWU/IaHpaFYl0kmNeeG7CsfEb3r8GV15z39tS15CDFzylSfHnaS56z1io+9MOBmC3
+6OdazOMghCAiJaSZayPrcEmccCmHi3XCpR4ubnB71TzjcOGiwreR/2DcpR3JiuIn4gXxn2np9/g5qXrz
+TU2Of/8T2PopJXhgedFrWqxv9Vi+86RvTPDA9JCbLnH23Xvv7ef6JQ9Amih1gQcOi+UkXXCmJMwH43pC
+TS7kmhcSalLfDaDfQ1i6ewihKXeQTJcP/XxrB5BrpC5QoQDKYHbMWVRY4GUJPZlvjWNvF0dD4lBIepBy
+KKj8vqTmiUMh1FgBjQ1Q2RjyM/DngeRHmftcA6gO55YdeVn7SnsBJ2E+5mAWNmu/177S/rPpvvs2Yc6m
+e+/9QxZW/f0fWJn1hz9kabv+8Xfto6w/sGh253XXP5JFbosvynrk+usMP/MwAKlWyNKEmNOlMGS/Vf1x
+sfHqNgnqFaP8v8PwI20MtuEgPPgXbTS+r92sbdMeIB+Sr7Uc/E/84O7/EDX+tb6GGQBinm41zIg5q/xe
+ZJK1hjyGnL8iVGCjpF8nY6v4PIWQ1VAd5pPiBSHVqioebopEGqP1+TzSxKelDR8+YsTw4dGoPxKWFUWW
+FZXc/f728hxvw/Bs31N3VnmDh8zCxlmHBL1Vdz7lyx7e4M0pf+09Mj6w+b1j10558+J35193iov8IT7c
+dcp189+9+M0pa499b3Mg/hKIPSe7pH1QBjOtG6kwFVIul/NSDDAwr5MpBph5i1QO4BY5L/MNsv+rI1Bj
+jnNMnE0+c5p/BUFwUxdFGbDNmndeYww0zkKns5YPNA7bwcxp520JoIF8pnHYOKN8Dj7pzpjqbDursrlj
+0Uo9TthFG3i29zRzXj+/9v1yexcbvGPH/h38JBU6QokZ+WYrezlCjPoGswTamGpoKA3FVBrBpOeGAlFM
+BRJM3xdD9bnSOjYMJHopFm3cng/3ZtIuVgm1qEv5B/u98H0PhkmwOeacVFvuZIrwH4sORcIX7kCk2AGy
+rCdwDONJRWSBUyKE1FvunDo7KGN1LQIeODgvgqrVXwL9lf6gB9qhW40R3ePHNUbZYDa4qjJYmJutj+h2
+BERaGA6m1Tk0Eh5MGhuaWLS+lAQCTXREVCZKKQ3gYBoJ5xB/XimJ1jeREfiL7vuJZgXLKnxFE+eeObv9
+8iMrPdWxeRsObz5++thgvSs/L4d8HTz1sLwR48YVabklgwbVh+oaip1KwdDaQTMnRVXdCfJrvGDStCMH
+NJxy3LjWjduWTr76ojPmNA6asWL8yvLWpSc0PKh9eHH0zPPOG00mTF59xLDDTrl04vCVc8eOPmJhLQAQ
+OKTnS3qDoH8Yjood7uNjJFBiWShLDBVZQqJIzXZS6QMy2s2+rcPsdAI+Qob3c/d7PQ7ZMssEffx6Gk2l
+HmQzQuKhqJ+nzkTr8SmdIE3vPfL6j1j6y887b7nqqnVdeFh0ufbem6frqO7/17bDHrn0zRPGP3PudSXP
+lqy5fOuTtwAQmKCtZnPkTWJXbIq5ZKTgM7qr+O3TDrn33ZzJVieai9W2ACGDSMJPljTysM6qhBqWDN0f
+4EADkPes8vvN3QR5kBfxRXxOYbs3VIfyAyLcaA0g49GdcydgLN9313u3367tnDz5ojNKBF0o/v7SlvP+
+8eK2/VezMQsWPB+fYvOXw9U9X5JP5U3CB9YYq0/4v5Jn+Sa5rjwe008lp/NT9fJPxT9L45kaaq6B8bmm
+rFEpAz8UQgmE4HyzH6CD6cUNEnNKG+zTbGrEAVEnMn95D9h6oxjCDp+aJ5wE3RorLS0tKsrPLw2VhsrL
+ikqKSoqD+YX5hfkVeRFPtsNM/60fXu2lIS/XXTqSNifchViz4ISVJ1H6OjYs137a88r6GcNtjjg2Jf7N
+vs4t67EWW7SvtF+0H7Tta6Zsf5+8affJCR3GFsEAGA+XxJzjK4slJjFTg+knIkEuHCJPQaZEdzValyJS
+Z01LGybGZtRaGQ31el+83iAD7SCtokfnmFHDhsAAGFA0oFbhtuZvUkn0xf+JLvof6aCN2mp2icjfqoFD
+YpNdKFPecNqLKNFma8IzT7oCgAEcb0GHenGmDSLTAGqqwuXFRfl5vLZ7QMThKKjzifE7PJav8KwbznrV
+F7XC+mq+8ffIxrj20Ofn3PD8H7Qvds0/bVxh+7rDRs85duncBee8enFQWy1NxxjGtt152gstg/9x90ta
+vEpbJpU9c+Gcub/7XfeLjTeceMEWAEBQtNXseDYFSqE4VpjvFpdrXc8YOqPAz12GlVWkqqo6VEoDw70j
+ojkS76erRKoaG3B7w/EnnzoaZw04bOLYkpHXHPtJpGV6y0GjfaMP3T9uw0WY9esnc6be1/no4TfisY80
+XPPAUw/fOPK5heffdMmJ8wQNeQ7cpaJf8wCojVU59Pn/KCbrmnQziuXrybQBAyOVzFFUh1U8szFq5WCV
+0XyvIBY0NgyXhCmu0wl//+wV6Nj3wMbb7l28eP5xc1rfeEx795tvtD8+h22nrelYsfTcdvp22/WtF98Y
+/tOowYMGjh4zcsTZWrzzS+1j9L7f2VM1+fdnXNb55GXr7wcUZ06HyLsaHxurZ11R4kBGASWGyNOWjXlM
+VtLVMLu0h0PhSE1YdhTXVfZ9qtCO9MfJ1+nOEezp0eYyrzwZJsLhMe8gJHQgIiHNuTlORiXTF+Y3s79q
+rUbJg/j12wsEkOAS87GwbN1jR0WHDagpL2GOfE7sarWU8j0YyKFKDhV7j+9Lf14OiYSrqhtLqSD3YBLR
+t60XZx53z8yxS0+6cO6wBSdumNkSbl56wZxRR40p8YxoOypv7jkzK2uPOP+Y8qMbCmqqa4qGjy0ZPDLo
+v/ylNQ3nsNXK0DLEiuEDyrNGHnPoiMCNg45smRQ8+J6HO6dPXBgrHzr7pKaZ5xwzzOl6U3bJdMzY8pqA
+Y8JZW069B6cD6mcqWwR5cFbvEzVgO0al3sdoMOVITAJJ+9R2YGYDmAelnOmgTD0kex+PVs2zU54MMgQg
+yqdtA8ioxx2MJmkD+PeyBfzMrmfTCgsUpbqyIFoYVQJKoDbscTgK+XQrfvHgzGLR+ibW2EjDg5mhUZk/
+L4fhiXha68KFxxCSFaiLDR48fkCBm76w8NoVB9eqau3BK65duPDaFQfxvx+04lp6DsrcH6VNqBu28pRl
+M4cOndl+yinD6uJlE1bduvm1xYte23zrqgni74sWi7/rd0dxxrMpPOM3Vm474VOO9Yg/JNRMynmefJYn
+HeKAYrbtIjYFvDDlGTWHUmpFcnzWGJg67qA0LnK9PhWybowqzQv5Te+kKm6f/mp++eTu2I1YM2vJLTdd
+Uhg7o60eb9de0m5kU+LfLVv/0tI/4vxzm+L3EH/8nzq+QQB2iVwHZTB3i1MmutOhXPi+EIUaG8Av7LqH
+1orcAALBduvzlI8GioMyS79thqoCknFIUoxg9WAq7s6lLIClNECbygdWRQKnaXv24LWDCgaNnj3OXzrn
+kmXN40eUoVzS2NhYOnbJwqXjcHw8yBzrYs3V7q+yykceuW5WfPy0C1a3D9Nnrq5m97Ip4IdzY26ukfM8
+hIpksCC3CxIGjbjU1Ipb/yArR6HYeM6fiGRsYKzegrE9Htj7sTFg1Q/+iM8bEZdqn60EJy8QquIT2qo5
+SyYft+RMrBmnvZife+YlLUO11dJ78WOWLt1GnurWtl08fcNnZVq7zhMnAKtnUyAMYzbnYsJ/kq2LguWW
+sv1seU/CEC4sCPCIVmiwVB0qlQJNUiPf2nwHKXh53ogJhxx2aDk2l4+KDqouz8qvrx8U9pciya1smn74
+7HDz79/e8dKm0QffdtcdV55eV7F07fnnndkwfVrjyAl3P/nc43c2ASD4tbl0rzwDWuHimL9IdcsMYRLK
+ykQxMzwLTRU+wIjnbTCiX8v145ExkTlaa2W6CjpX9wKWufG/JOmdgbxLum9my8GTY03Do3W1NVVlpQG/
+Q1f6jQ3DRwymXLkHmqjwzuTQiCzr2t9vGWFJB0E+t8lsB0ETwQcv3RCuKG8cNqRo8IxZx43NHzv12Maa
+hYMqSmYuWz81UF4w/sSjzjl9xUUzSstGTh1UvvTqYwccdObDSwbMiR7XPm5WvX/QhEODgdUPntA44Kjz
+6chBN50cXTZAyvH6sz4orC0vcPrrwn6nY3X56IFF4enjKo44dNjWodMW1jc0DyuoalnVvOiCGWGn683C
+3NKBpZUD8ljDkmvmHXzeya2W7XETWyT86YNiA+yq3eYyT+svz9ALIm0PiMydHwAhBCB9zqaAD2bEXBIC
+Mkz0UsxjhBjO2jpbRLD3x5Y7ygc+n0+NhHTfGj9zhke5j4bvHK5VcVreqk1nvcrO+eF47dGJ2uP0+ONR
+OmhP/O1dx83+kUS6NwIQuFBbzR4Us9oKYV7MlYOUZdm7GZkm7TDrNKwVJDOOyV5PB5pPhZc94FfFGKyK
+iGzYu6Fy8HsgFK5WLfM2LKsX/ohuLEZJe0H7ac6slqOuvOP09evnaqvJH/A5XK79+JX2vXZbUDuGnN74
+r823Pr1b+xIAYYo2lx3MFsEIWBrzBpGSYmGfAY5AyRp8UmaalMOsy0ltCwAkdk8x/wmkJb0BRYDCWT2y
+ZvTkEDflJJGBnRB4/cLit91SouVqXg6LhKvx9uPavxs0cWpp0/nb1o1umLN+473zaqY2DS8tH9E4vLTt
+tvbGkknHTw/jDQOnLjpxVcPhS7YXnlZdm0dHn/ro6rYnrzllWg2VnXKXK8clTVxz9/yDFsVKtT8evLwl
+WpblM+RZWiHkOQjtphvKVNPCEjX/KiOAPjVT+JRCZmGqABLxrbpkGN6mMguB16r51KxgdlDfC1xTVNrk
+P2DsCz5TlFeKkJOMPdCm74vFh5OJ2nut2p/vNLfAVnNzFO645HW+OQDBBUAvEjbDFJ6LDCjb9kQiflGX
+iF+kfmhFMHgxWSQiDkw+8UOUQfE4Rl4AL5zctuCU9vPINdrn029CL749bHf1jvj2V08HANT9AuIMDMYK
+el3h+UWd30PSX9TTXc4z3cgF37TV0klsEVTDCDgi5qwpDboNL79oSKt72EUCzDCbNWrdqoXlLiGl5p1N
+qILoMO5oLxoscmTQbwhjoLHBO3y4qbIVNaQOpkJLN1EhxByM1A5raSj21R85qWDWbeevvLtt6GGXv32+
+9u0Fz68ZMfa0p/GGo08Ym+eJDK+qbijNZlO2Djv6rKlHbDhuvP/TTejYunDCWU+vuv6L24/J0lbjpTlH
+bvzLpac9d/ak7pOcR2y4Y/bY9mmD6444a5bI+f5cW822silQBGWx4oJsF01cPqmuMPyB/Dxx36PG7ROj
+6CulgRCPGmAEX3pnxKIojspvmDArir4fteX/CI6PjSvQnh+1sH6ldu9PeDqeufjBQ8ubl10yF38XP0Vr
+HbrylLbKIzrn/CX+R6Nv22rpDolCCRwZc6s5hAIaFwYRXk2xZcSoe56hHQukmDHmE92eLIGSvPxAgNuT
+qFaBqlsM/jwjuqH/6AFFJSdrmjYei0YceSOqazfOxPwW7fW/csr5MYyzLvzT8qVvnrb/zQhvCFW0a+8X
+H/7lS22H9miutprrup4v5bVsCjTBkNjAcCHhfayAimkoGyTU9wI3sY38jNrCmvzqwrDiKKlDXtzAbwPe
+ESHZ8KUMH8FrUqP8Ys+vc02ssYFUh/i9miuvKrrgoD9cfshJh4+pVGMHYfWsZt+Ag6MzllaMHvvvMaOy
+wg2HDqscURP0yM2zsPqgmKdy9OEnH3L1G+i74bohM08484IJ12NBxzXa3y+pP739kI6Oi1dfdt31l666
+dMBx0xoio6e0TCm5BAuuuUD7x/Wx8888cdbQ6wFoTw+/O0j/NHLk2ntnyJU6ZKXPnLhiCamA0WMGqVlw
+fp4Dl5z95syQ/SaiBcmexdI9H+5Nk/GmxweE/pD+afgV5+vrLRP90fvyI/bqtp7iOcznfsMUj6Ero8fQ
+jNWkU0hn7vlwb99uQlv/LTOvN8bz8yWRhItSh915bMsD6jMJKOOQ7lD6Ad1u3X3b13Buy58saC5vEjTn
+9pwx5xGkjl6UzM+3yKdkJB9mcLTi8L6drC9Z/tUv5bUiRjERDo0dVFVCeDcvEZRwGkEJyqjENihCeXOh
+IAsdaIQkJowf3jhwQDgULPSqDjkpaOP77duXdIo1l/83m1j32Xd7/uutbOZpiP51ueZkihpIjKVNyfsr
+SpQgBCURt0iA2mrKk6oQSiQ9BZOHLa0MLqeSnAXIc55smYDaEqNjhpEQyDsPJ/JEi5SYWK8P1hsXMAfK
+WU43VUBW2jKs2WWuuSYJOF3eostcdanHw9PwuQ7yqinJ+NmpGBh72oZFd9ueD/cmcDCilAiFPd/L26Uu
+KIFHjQx0QAp+NyHUhahP5bZ9YvSYqRUGsp7o2m4FlIt0ryFDxKDVCzGSDoyxoOVhDKJRpo0SyFKbCQ6M
+lVgwJaI/IkfWWxGpCDmU0jocHi0H3fndaN0IFNVIQqG+t17CMpS1f7178LxxA9vXX3f9XY9o72qNEsZ/
+obMu0n750z+13Z7nsu64Zt3Vl8Zv5SWbpgyys5UrQQInTDfvMrZQk5FAW9QCAEEQzWJtT/Xr9DJxuvNj
+XpZlp+z0qqpHVoqMnFquxnheLd0RX+gymhHsxSWiBgfhmJ5d0gppHxTAopjXjQSzkBLTd2zeTUqskf4G
+fcosF3I5CMMPgVBeumzCGU/DfFXOsOqNVIRlpbgO/XLIHPHvr+CZFCF/KA+i9dKKv2rvaxu15XgTrvtP
+XPv8mT/+mk/eiddh6WcfYfnnC/FmPAnX4LVjvl3w5Y7Xdml/B9RnSoo67JYMk4b9tgUbvuRy3euWmC8q
+Pg3Taa1beFE1U4oOoKi6vyrqPiunoZ+80CJbXmgwU15oSa+8UKWfvNDflKtRZMvVCB5IrkbJAeVqKOlz
+NTp4rsZhybka1sIRQNtJ7xLz96bEXIiAYL976QVmS8Cknuh6avgq2wAohVaDy0Bntm6JqD7BZL++i/ka
+VA8LhZ978IZV35AK7Xvtw0e7Px65+mbWtGO/qn33vX6O1wA4Tpa6IAgVsJh3U+bHpgPpYclp+lYLZ6F0
+9GbhQTFUMZGgbQCaj0uoPhWwIlxaDEEoCpWoiiKy2/wenmfUOHz4CB/3OEbr9XY5cqjaDLixwdrUSZM/
+/cdNl8kKpT2Xfv5oy8OvdGjLh5/39GOdT13A4qvj87fc2qF+5H7rr6igikXx64uHLv/8l9Nn//6s9atW
+WfmKjqFSl6g+WGjseCo6hCgoiQyjIp6ZwOY7ZcJYkF81KpKfs3niUYkNrITfO3x69QEPQqhVIV7GpRRb
+uZPRcgMd/kOliVInDiY+HHwpLj5DoKLdGt9HJ5z+7OZHn7xA6opfT5bHr5+6gmNA7ogv2HbWWatWGTmF
+8qlSF/hhLr+fSzQPEx2MgkBBYlRKVOsXtSgWLoWJIn0DTLEQyDU9v2FVVR1KsZV0ODxabgui4OvaAnaO
+Nuver9G9S3vr+MuevIVreO3gy77+UcvHv15+1s3xhy19/5lyJVRCLZy+xU8JswIEVWbrEFA6gBFKGN1g
+RQeK9GrCoD4EOB0gA9KKiYJCIVb5VVUAVbVVtTXVUAmV+aXqCNWlH95mQlv9CH9YzqU8tFtG8gOhqiGk
+qjoi8ic6sWbo5JWj5l1xvid2zKTGmrq6g85fd+VRteOizdrz+cq4WWcc2ZJvnCjxS+WW38+7YzHZVnfk
+hMMOWd1Uev7DU9a0XzEoe/WNfz0xvtQ+P4HbMT7lSlF/3LIl20ESQZIA6CX5BDok1FWhgXTKEzqfmXuH
+Z1KLE6+4TqSPc3ES/6FnC0sKZ/KiA41ob2GDWMaoX0fS7m4mdXUzsZ7RPbvYd8qVoEIILtlMUc8rz9HL
+opkksZONYXTLdU4ApbyqnJQnqt+SxtulATPH2yHyiiig5ni75SZQWATs3GFfxBeq8KnC4sAQ8kIiosgh
+Hk4ZPoJGjSBydZR3WqgitZqKLXiQgkGUtAnnnbZY+0ddZcelUx/ZMa6pqQl33L/p/k14OxsQzyLf739b
++2XBrB1Tz5PzCg6fffgR+Xfdeacei+jUzpZ84iwyuFBg7HlEOl/4coNcm5Uy24Ak61GJ8SjNaWRVfkR4
+7Qdq2KCN2S117XexH38dqZ2N5xxwDVaRXoMVzFCDVdKrBkv5bTVYukzOEWdMARydWnplt6aTdHrCgjZL
+r5J1ekG+lzePygmpQqcbNnMvPR7qZIPiiDkf39X84Evna7d88OOz993+pBbn9vOCVH39G+vFihL1YsG+
+68VK0taLKf9n68XOTlsvljB37fViJWa9mGKvF+N2rdT1KRDI1c6WbpS6IAf8cLLZfkeWjd/oVAjlJ5W4
+DOg/GKqylBrtd2RAuc0GV9ILrjWWn5sLkOvP9aseyIEcladfc5XqU6PDvWpjSI2qEa6A+PFNlNypl15K
+T316d/cQskt78eKTtLPJ7LdIBbZ27nfFO/Ec/jNrKNS2x1+8Ew6s/qwoUX8W7Lv+LL2N2H/9WQWAEpC6
+YAIsjzkbK0IqQ2rKV5nLSZACRRAeArZAlghjRS0OQgyOlSdBIKOtAgTmCRBxT/ECwASYUKwWFqjF+arq
+VsrqUMwBk6kiKyFxMo0wsrAb64XhbeRgD9fj4EYOdj5Pzw6EqqtJVTUbrO09aGzbGVGSpT036biaw886
+Z8rsiSVjl028f9vRRdXjR4/E0KjxI3OKilp/f/+kpeNKJs1sPnf97Jo5B2nP5UTXLxxHbo0vJrcO/Z32
+xRoWXPnRnJfPKa5cuGHU6pZtqx9tmL5+YjZZFb9cPfTUYEPDY6dua14z6tz5lcXnvjTng9Vla7DyyaGC
+f+09u9hN0r+hAtpi+X6kLE90CkSi260SSNadyginm939yqxoT7mkO9OBn+ztFpzxNMy56hwQqQjpd6oR
+1nU00mj8RW/vx8yELRYKt//rqXs37d41d84bb1xx93Mvvf4ThjDn8svvvkf7nfaXr9SPZ16w+uqrt609
+feHCg7rGPfH46Y+PGPrGzX/8FiyZ9Ilap+FbnIxgIlhNxTlBTN0ifha9ZoQy2aJ6VQ+3tW1H8t7EWSx1
+pZzDpl3ghEI4M/FN/CAuTjmIk7/YsPKSD+GUtaV5mlipfvLyYjCPOHkT6005ce3rP8R2yNpxkV5JPV+5
+zSd1QSUcm2zxBdOYebJl5hWns+1ky7bjG9u06ZQDsukOxJ7r35LTccoDYN9IXeCFSZsR0ULJKqcq0sup
+gr3LqUrMcioveFW1QOIHo7jkDG/U7QVx78m78Vx3FqP3a29qj08a/e1H7hd+QHn6y1j060j9WnPbhaZs
+xvuvCSzSawKDmWoCS1JrApW0NYGDRU3gzn5qAtPXz9pZm65+toT2aUP1WT/bdx1emS15oDxDHV44fR2e
+8n+yDs+Xtg7vAATHqAFT7HV4S/m27F2HJ/dTh1ckaBPMWIdX0rsOT8lUh3ewXof38npeh7c1fR0e59N6
+MZOpJZYr/G1AOG5A0/msKE042XwJJxu1nGtbwpEK4c5IeNbKuWetXPWE6qX1H2gvaudpS/F2vBLpt9rX
+XfEeUvpPLP18Pl6La3A9Xjrq23nx1+Jd0E9dnu0illyXV0J78SRjXZ64Z4kauHHWLYt/jZrmvsQ/o9Bh
+vx5t4RcjgWuvi1HGi1Cvy4+oNQeQz7dq8ZbriwhRRLeTyArK7Q5UGIKkiJK7ohbLfZ4RxuY3LyosBCgs
+KywLFhUEfF6rRC9LOYASvc+1Tu1FjOFszMHZGNNe1Dq1/3x+992fY87nd9+dtkRvHH3mjju3EPJEfDrZ
+cucdzxxIfV6RXp8XTK3PK0mpz1P++/o8fqe8T6xhZqKUiuquTTIfCAmKv+J8QCzlW50/B9phPS9Jes5v
++ap5y6/UTWzxr5R7/e79X2AD/ooNO7hFrd8vxRrOAmD/EXyeyTOGzEbi1jYDYASWU+TiLaHhDUr6XA+h
+l5ghdBVUb8QXFr51VWRL2+eq/P6x7V/vwcYFZ266GBvI4cf862f8Siu+Zu0xZOOvI43zQZkraDLZnktV
+JBppS/Mti158jNCR+DiTJW8/G/BKHIiXY4NOAP2SbfJitfKDuNu2GmUS4tZc1CLzow/mA0BQ/7syHxSl
+VJkW1EEIdiRASpJB9PAI/0eYTCF7j6qoL6IU/rIRG/Aa/c+FuDuxrP2u+D0AQKAAQL4v0537AP2o/d65
+hTYy1TW/c5v3VtyhHUy+Rv8Xj019+JXztRu/xuxn79u0VfuBxd+MP1QyZPk+LX767G1nXXjWKv3OrdSI
+GnwPFJo1+MVGQYVxvoMk4XwHU6hxelXYH+M8/UmJHUycaAWMARQGfCrzsFynVYOvBOpCdk+px+74lX7F
+wb904OCncJlwk15xwcPPPtb5zKP8AJS64g8121dvnoXcf50N+WacqdA0QsDUvvOts7DQaKJoAJjPzNMw
+P8+TA9mQFVKN09BO4IQntwFlQd0O7ca92rP3bXqOGy4PFQ9NWhzCwT27pKPMGFQuUpaDhJr3JZZyX2o7
+wPtSW/J9qSISVr2J+xI0hhpDVVWRsMRvSvq5efBne7/E0ngV+Uv+r39+Xtv33U+4AW/SlmtXau+O+fxE
+LNj12o4v5307SjtBu0S7Wluq07Vnl/I3aR94oRRu1+8qIU42mZONd2vXRYM3yaYLhBehnCZG+JuAhodd
+pPWVtYgQs/GKGbGqBgIKEqXd9o4RY05AhvWGUnk+gKICX2leKTexvRVh1XL6Rr1gmglAI6rdnuvEhl2V
+eBhW4lg8VrtPe1XbqT0R3E3bH3juuXvueW6r1BU/Ew/HI7R27QrtCq0dj8Xp8XUbcTQWoRdHa9u1f2r/
+1l425lhz2y7ADZfNWQjWvLBCkIAzle9aI9ZFzQtaAAgwibB2E4aaPilnRUgd7DMcvDJVQsPHU+4e4O3q
+h0eFg1HE56JR+sVjJ30S0k6fcURdcOLSdc9sueaQh7/Fbdq7Fz5+y33PrSILztSOHnfceZNq2289tKbr
+jtfXjQn/OlJyrl175plA9HkAbJGYB3CD3s0/r/ccADF6Pe0MAMmcAVCW2u8/ZOv3H+bGXG1qb/9Qord/
+ONHbX1jlbrOXv0M50F7+/bTrz9SXHwjM6/mS3S/PhwBEYExspFePBkMBMok2q0imAAEKfFapEeIttrJQ
+wzitojLaUBESjOJt3f3mvSGkN1uNhGWq5HlNLwVedMnfur7UPtJ+1f6l/YxRPCi0cfWx15c/+kgOXnrK
+KWf//6j7E/CoiuxhHD6n7tJL1s6+ktvpbJBOyJ4AEbICCdnIRoIIaZJO0hDSmE5YRBEXEHABMTrquC+o
+CNoBQXAcN1RQwW1ccBkcFZdxG8dxG0huvqfq3u50QoL6vr//9/ze8DRV99ZyTp1z6lTdU3Wqli/fxi3/
+m/yPX+Tv5dfxfOzFI/hI9lNp38lTY7+Ufzn+2D10HOHgJgD+Er4cfJnEte7XIseP2ENdakEQ2IQ/ii3y
+j9hDz5XBZRGVJkWEsS3CvuDjtoi6/eE8VJ7LAGO6CV9B7ZdrLvvTdR/KX/x94d9W3Xx/e/v1Gy/ftpwv
+X3O5dUuc6Tr7n6/Yl9lQu+CBefNmzAAApO3g8vlyEGCGOjF22fyiKjmXZh7zLtp1UoUAgsHAK9o42Gi4
+ieyU7+bL3xzcrHwL6eUH+F8ZjYLhK+UEXbdtNOo3bKPznAFUqOkzcHXjl2InxAb/sVqTf0+t585Bp2bN
+zb9hlc32sMqagoOIRm9dvpwLvOvDwZdJ47/r6+QHyKTNuFG+aHDd0CfYTB/JS97PyffcDu49+Rq+Ffwh
+GDIL0gCVDzZSATwQjicdLi9KY6XHt5nRYAxQLHTstGwwZIHrpgN2twGvefyg/K38Agae+ub9oXUxOOVa
+ORGtdz740+szcQYGyJguvyEfkF/EjzGcyYcAIDzAl8N50HVgqi8ZWSqR9Agism9XLdKvR28vHUcppXFb
+HM6dRVksBIDz4Lz8GTnZUcEGQ3CUIdjgwyyzGarVlRpdVZsrtSrRc43pqeAm9t6oGmdz2fts5eSMTANX
+by7PDs7sua82cXLhqoUXnGfWxU6eKn/iS1I3VsYPxlduTOV85E9Sp8TqzOctOn9VUVJi7a3rsoKzy1L4
+cjmrcN20LVWH+LoFV6VENvU2GEob50TwiwcvkJPx7QsGF/MRcxpLDQ29TZEpVy2o4w9VbZm2rhCPKfPe
+/OEvuPv5VoijvpHj22LHGmGNlR7Tht+2sY44xfKm2PyD127pu+227GlXX22/fN3Oh/76/d872teskX/8
++c7QV2bV1DQ27pozt7gk+2D65s11V6bFX7X0tr8AqD5jC/jyCc6Sihr7saa+iB5zlpRqDVEsVv0Yghsx
+Sf5KXseXD2WTV84cIK8MZSvyzOCxe7vDYfMIRDp3iR5nwTOqUutCQJ32nr3a6ZHnHMnRbJEziM7RgwL9
+fb30Ku66UbiPtbl6tOU2D5OrZ7vEkhGTK6Mn9wVfDhIs3B+IAo6YWxEEHpW1MTYxi/LYBjBhqnsjgARS
+UKQxXtkIQPFV+kWu60wY13JEAusX/eiVkFIZXbxmmTkgLTkrqmrV0u5ZGc1G+Y3A4raiaL5cTi2/Luvi
+MjyUuDhzw6SQZRsW7p0ae/PHuXIqIEyia2NMX5d5WPMU/QcAxDJizxw3xW3QZNowSDFoZmfk5hiyVRsr
+mbTFnMZdLJ+Qn33zxXt34/X3nTnAbQwYGpK3K2OR0MDg1zzuo5vQrho1kV01qvIsNAINgW67aiZjtYla
+Vm/CaKwgmIRm+XuUv3tK/pEvH9KRX84cID8NNZD7hgLUsZGfxZdPYFuNmsi2qib8pm31JkzCZnoKvvwA
+Xy434YNnDuA/5VA4l0+WsXKU+XRc2+n/mE+Wq/0amDnGXhrlYS8d+/IcFtObuIeU1lKuD65znR3Qz7ey
+edTSAj8eBQz1I7xAna041fMjko11BJm0qeeWxFYCwCQ6rY1RpJDe7wKEGM/KQTcDmuJi1c0aVAzVaaK6
+q9Lgb4rV4JPyCUy6/cndf5P/9Z/5V6zM/Xnfmh7qSnngNfntn+RT8jPhzkC8BC2PKv4GvMS3QiCkFiTr
+kaDXiD3VZUgdNSybTCZmMFUHZXV/n8TMOLz01r3ySfkw6Xtk1xs98sCzu9Z8djwdUzCAnJf8zgx5696X
+1bUP7t1RvHDbSaNG7KRjX57LUmqR93GH5X3cxhdeoKwABJD7eAdfDv5gLJikGEuVbuWyiE4iVUZTALOH
+xismOuKyhubi43IZmnAKZv5HTl2/S/5Ziuq9KC4xiY8dyiNHz7wkf3TJiykldWwvvdwnHhCTIRziYCpu
+Kwj09SIiJsTHRfAaMQwJaDj1woU4IEg20m9NtHBIqapFjUYR/0hmI53E/G19x8kbNUHegD9Qb/AfqDf8
+D9Qb+QfqTf7d9Z4zW7QrW7PyR6deU1MmJ5qM0ZEQDuFxsQFxsV6ayGSkVkaSTWeObP07M0PdgmxAVZNQ
+V5ARD0Xy6wVbXxlEPHZzGfrNvfllFIbhpa2L5H/PmWW/7pru6dXoVz3dcdV1K2fKffjxUpzxnx+xsJXk
+Dr3UKj/943/kF5bKMfznaW/ccufhLNI5dHPW4TtveYPJYxoAX8SXT2xT/s1pymibcmAg/Z/j0rBd3oXz
+33vte/kvuFh+9NN/kkRiku/DRUOnht7E5fKNylyuX36Af5PB/4sijpMU9e5hOmVxvkXdqaQIVxjwyNef
+ldUtUL9dS/I5a5kgKVph7YRDDv0a6ac+Gph014d8+eA6bqPy7cHauhCAN/Dlv8NuHTWB3Vp5//vs1uSf
+1215cycmFdosizAJb09/4Hl0yMe2L52Cg2cOqGOvGMyXj7VbR41rtz6LDr8x7vpiMvpgMvq6yMBtpFBV
+novXMJ/LlxSeG1UAzHytaaEdKlJ5EFtAFCeJLrZHgIhi/Ti5NS7G/66qkn+jqgkTozUK//1Vd0+D6vgR
+ParxihT4YqjyG5EEtzSQ4WEA4Wq+HLwhGBZOYCOPGmMjP1cGl5U8ONDPB7zBa7SVXBptzsAi+a9kOcZg
++D+H5HfPDG7uc1w1zG18Qd5w11OHHzpUt7ipGoCDfgCxkC8HPfOJu8BlG1enYYJ72Gqhbm+uj4QJk5nC
+CA0KAlC84AINPl6gB53RoFNtzZlj0TQYDf0Y+wQKXw3JX//p9r4+h+NuzoaxfLm84b4nntnNMB36F53r
+uL5/7lbxHccWHnWWLXzctJGVYRd+4gT49WPSL8h/NSS/+/QzlISH+fJRFARAyB7+gjfyrRBGrW5n28DH
+Gr9HfaeOsW3n5Kq2bY3bth2bvePVnbv95CJ8ir/9gb/e++CrmIYp8j/kN76Xni+5/Y5D266a9XKqfFL+
+l/yB/CGj0fAX4tXszBoJ7le7DPCAIo/Urq0490aplz8SLVHsmi67tisjzQIaDUPUWMlW/ZQS2hFD6IR5
+o0fnpddohAQjREUESyES/XrUadklQUEYpGdzbfbZxTpWTm7O2ZNuvuPn6/tC7pdPYdT9wauvP3MGTfLf
+h1auWr1ixapV4bdveF7O48vlvBcvve3sCTgAEMgG4EP5cmZlrNnvcy67dtSIXXuCNLdlOznEZdn2I+re
+N9W2bfQ0bZMzt+XPyMsQveWX5uSFL1/S1/dAI/riEvmUzdrW0dGGu4od3Rbv8GXL0l9OSLh+2V9qJp85
+wN1eV1df5/KZ1IglzIYVTu0hHlYsxY8NxY3ntGcZDIpFi1mhx7Vo0d3XE1q1sOsl+RkMG8+4RW9WdN09
+qRG+VnGMhk0F+hEsFW/VZBeKXjo9p9GIS7TIHLzGQziyYLIWRZYRqbvbuRrXXBA22os1QGmstyZ6gsZy
+qh/LhA3uP/nCR+O2ttXlCxckL+IXickQC49S3yutYESdNgQ1umAUNa77XpPc5/92gABanUDvBSa6JXrq
+mUM7jGgZNV7Fj5OfuvuJ1pFi1KHnd+SjPj3KZs1YiI2n320Gg7JZM5Nd/WcMVjwh6HFS/kaXX5zqEvHY
+XXdhEjbJD2566oaH5P6a3JicoqyZWSvW8lfuOX16jzzzyyfuHuqVbwl+WFNtJeddsPiILAJhNvBCsYT5
+wzW7V1l5dnEMsKMX1e9bt3qeOJlp6EBRBKBeccpFEHS4c9nIqWcctZNfsVm5K5Vay5Wr0hSdTOUxzKWT
+z/aNG+sUZ6z0cHsb8XlzfWiCy5OM+ryF0K/NsHs/kd+RP8YUTHjiwXsfuuNWEZ+Si/wefsB56vlpGIfx
+aMDY1JdnXX31oTtuB4R1w1+od2ymFiSPumFz1LfuiEsbvUNTcWg75x2aE1yaOdFNmQBAYAsADginmS/Z
+g8rsLJQOCeqqnmKCcG/PDFAmroL7EHrPxOBzl02euOx479l2Tiq2Y2/H04ycab+FWnowic4HBO6XX6hf
+/0fMcva1esb+OkXbRGgFDUfx4tGNmDgKsTAeCcvkxsGVPkESQ2/ig/fdSCrK5RpMkpNPvvARw9RH0Rtk
++IB8AT4unAZv8IdnXB5xBFw3I0bSuT+3BOh2G7dVUDUZqLwIB05A1wEEYzME/3YdyeeuoyBkJA2AAGmn
+WVTG+Pj4+Pj7+AfEKh8knKo1jFSFBGYbg8tnT66KwiT55sorMEm+8GBg2ya+/MxLcrm4k7IKCNQDaFax
+c1AmwZ3KXWUS8KAhPHNqZmhTatMuoRWIJ7+Sz84HI9kqQRRdcayKPHfuaI/cClcDACZFhYVAMAQZgwyB
+zNFbMbUaXZbXsVNDboN89A3571tSpnKd72HgP0/L7+8/QOeIh6gpVpa3KZZZj2msMn8Vf2ZnzEXC+SO7
+q7QIGg5sVFbZTAqpI57aknOks4lIIEBkeHAgBIDBqNy3pvi6GUa2oIxBPEm+Tj6JsdiDsQ9hyLdD8rub
+rtnS57hqOw7JHF8ud2G/3HX/o/v3HKqrr6p27xlexGxNS+nITsCHnQmpftVoUNAiD4IiMwjExlb83POo
+c2VQ7JwM8QBToFFHV61GH3NoHBmx8MPtj93wkHwia2brFfIJ1zBFnqMD0gAR2UikDFIKrbkvxBKQIA7W
+jFpNSACNll5mrQXtxnGWDkZ8JX9PRvapHmo0AhjjjHGm2JG1Bq/fs9ZAR7LfWG/IUce4iVcd1KHPc33I
+BxpHr0iFgcALG9l9mIqNR3QbfcZPYmOwP4CPt3u1RzNqtYdOGj1WeNDCfPY8F3coXqzfM5som8NKsOQ3
+/fRGmUojx3PRG5VDcQEIojZVNsMda1Mduyo1gY31Oo8VqrH2VrHU0zEA4Sb5AXVN41FFc4cpEjGO353b
+msXxm87KxLm09rnKJ5+z/ARJ0Zyis8/l2ncTTsYFOFm+RTVfnDkgP4DN6n5H7oj4b9C47EYhig/nyEZv
+l8UiSEkAIByQzgms5ezTmu51JDM/lCOEozhZ9j7DqfsmVgqFoIF2hY6hgAC40mMxBF1kOhuNkdRxExgJ
+zsYkO5NZr+QP5Wq+/Bc517XPIhxA06fZxsbmpY97iZ4rZ1EgiCAKsIEgAchjp+Qv0fCE46YpZhuPZE4E
+bv1IhnxKDgMAdaqnPgqGgACDVheRbKRiagyk62kc60p4o3wlmjAcwxCHMeUD2Sq/9738Kz72tPw0+glH
+z7zAzzjzHD/zdB73y6DOdbaIsmeL3jlM8d7ogfdkT7zZNf8M+RmVbrzHa9uMsW2bODmf0Xf8pgUGMjux
+keNY0+bKP6PXoWcRdh09In8if3bfX3AJaxZfPjjM4eAZTjhzgPx7yI9pjNG86BzDi2gtigJFys0MQfBE
+OGZUOidQbgiCB84FwT4+AHQm4+frQls3liPUi3oCjuz9N/rJT4/HEPnpMfy4Zgw/RnAHQZiIIWMaOGNs
+A8+RrrBk4vaNsIW172y2LGFtG48r8tPAqXwpYG0LghVjOBOpQcFLq+dEEMQOlTm6kU5C34i0d2zwzKdz
+syXaYKCIK9dtjEbfeyx7XN/wE7AojB1hM4ZBHvdtMB4JX6vt2O7RDvrxEM2QE4UldOtfnotLutFcOrux
+HlkmTlU49NtNdXHKi7haejaz+k5++9FZrHJ9a0AUgDBDsw204A2LHtfw6HGbXARwPPAcsAX/vEpm3xKQ
+kGmuz3JXIuGBrHcn56uGU3o4CGhBGxAg6uh9KMqIawg0oZFo5GBswKXY+6MM01gfJ13coqFt5MLBnUM7
+mNoiEC738c3sXn8vmLefQ+LuIeEu4MDz0yspejPozP085duE5/iNQJ0NPBOaH6eTR4Ogi0w2qgLClM69
+8irMkzH1w6EN8pukWdU3Q9PJi6eTxeuwEBCaSDgfQQ4DB5POfec+/bxp4p4j4bt2MV8gEq5x/t5yNZoW
+Vzkg4VogH/52OUO2MVgLJPzwYXX+VMMvZ8kayGU3m5sBeFSdhzmOLGAXfAnIEW6iy+WD1Svh+OVnbuSX
+X3/99fLH118PCBdzu/CvYjLo6Jn9AICAra6DSqdzVcwTVBeRjHTo9DcGxwZj4cX0SvqL+a/kZ37FLfa9
+is1lpJ5eZSwPdi1iTKeMYpaNGcS1zhzA9qISqzsPuKZCExQbr0Rzs+qpqotIDnRfMv+LcsM8/xUW/iqv
+tu+luMlP41+xEHSQ59qDoVammp2mQVXkWS/zoWqk/syx9dOb61fb907UdtUoMr3SdfXADBxpO6Oy+/r8
+6dxI28ctNl4Jte0GxpugzGCJfqSasGgd5c06/pW9dtzyq+vcjsu557hLGX6BEFdgNPhrRB7KCTIJVHY7
+sCWLakOoJ7fjg8VgwR3DGXZqe7Hj20rIfyWf+AfaI+wxN0ju2NnwJhVEKvDK3IAo7wyhnnwTsnOy492x
+f9upmYcCYqHAYdJH8vYIu3RDjDumwJGf5i7FJAYn54AKxu3hTNlIUGWux7PC11BPvp4TPoV5faQLOosB
+wmL5BB/EfIJq1MkpOx1wk9s62wmE5BFlVQ/JRncqAC5gyLTwSHXJmEm6LjIZA4VsgTNy8cHxgXh/uHwX
+toRjUji2yHeFyyf48vCTYfhfWRN2MvyMcu+GC5dA6HTvK9WBbpOAnMhO8OzUIGoRdMgWM/Lox20S06bj
+5KP4aRixtBS/asWQHAiBBtefly5GwdHgxtPggasnvirOeDr8ZDjeN4K4vCj8ZLgsAkIq/kLu5G3gBRHK
+OajMGloIVQjKdixOF5KMscrdFtSUgZaLHz942WWPH8Jfnrj0soMHr7h8PwAH+wE087BQtQO6nJrVw62p
+OU8UEKex3jTmZf45b9TUhY5a+t2PhbgMC3AZFlI14PoB+8wIHf5A8xXz5Q2EGEiETJgBs3Ca0u3plUS+
+CAIBmw4FL9SIgsbGtqR5I2JeZaDewIkibwnyCdD6Czw/jXdtcpk+bklv7zz3odgjpQO0/hzPT6sMQh+f
+aT6u2cp08EbvjUHow/tsmhCN8eqhkpLnUdjlfzxeJaOL5rMN3gUz8vOzsyYnGaXgILrPLn9W/qyZ503L
+zZqRPWNqSlLm5Mx4k5RoTIwMD4oJjjH4eQf6BCpsDx7D9sQM6jIRHDTeO2G8d5iJWLFt5wM7brj/wR19
+jl4ib9/5wI4dDzx4Q5/DwZUoDw/0r+p1/PdTvv5PZ3aTU0d2737hxYFdh/s/+vvJk4Objzy854UXdj/6
+PHvs93j6+0eHxID/fsf6Xgb3AR4Uk+nsuCCEoDp2TMcqz2lAoJHLWIX+73EfkJohJwAQKOM+4K5h5TRU
+Jwscz7kU1HSsGmcQp5UYA41c2Wr0fx/91qDf+0p1apWAMBNsxMZ9DAKI+wQOM5J1RINIbPJpFK+WW/Au
+bo28R95jwyfxSZq/EGykyzN/LmIuYiGK8umr8S655U2swzqbXCKXAAAPjwHwycyXjlqY3Nom2kujY9Z2
+EQnJU9w/l+gFLad2uEgRCctCDeqeqaznxfr66vW0vb6BvoEGf72P3sfbi9fxOo1yTqk37YH0WmeO+mqi
+O/YYJv2Sjknp2IRJP2dgUvovv+C9ZIiq7CFOvsDzCQABxBKyS/wPcJDAZlDnnH/RCROdg5FdYsmjj9I8
+cv7wB1yU8C1wkKr0Sm+gc2eCaiP1zPGOPq5qLtB71EUFUc7nC+4680w8kxoO8smdXKRYDF4QAOF0jSqM
+jkb0xGUR1Z7Y6LFHdQFUBSke/uFB4d4BPgFKD9F40R5CPCTfkJWIIYGx9EDyBHz4gaMv7dz58ksP3MHJ
+b8X03E7QLJE7Ed7827D81ttD3diWNkUefIIckW9Pm4z8QSAQSvZzRjEF9OBD/SV8KFkqEBHnCYgiTwin
+nFlZTxVPA1dFPwVAD3o6Add4RSQHZmOmITPYFGzKjjcaQvHEsfb2w/IbmMXds5GIQ6c3fvvtRlykyGoX
+uZOcFlPASCG59kswzwwEHm2uqU69e7/EAqEqyWgwJJlEr6jkwOwQj9sh6KJgsMfVEV15y2ekzM2MjJvX
+W72ItMnTwxbff9HcUkd/dfpacvCykJDIlBnG9FJzEL547bHoomWVs7vmxnsrc5dElTchIEFuQRYgsMFa
+RB65CoqbgLz79MlG95bZBaQqLjYuLi6WYofKwZOhKmPY7UyQmcFlubb+kc+2z157FS665Nr7H9/917cQ
+318SsH3ewqZ5O8TEy1LvuM5vQf+lt9z/5WufoNfQpiesdU3tjGb1ZD8XxPgTV2Cko/M812y4nvkUNZAq
+5RZwQxDvFZYcSE8VoRtVgbvSb819jw79TJ6RVxvI/pbX3zq1fbtcKv9N+R4uIHdySWqbkyG+INYL6clu
+7HATAGh0jzMLsCopaXI876XemqU0UBPibmVOfI6ifkNDNGJwkLLokIi+jdMfufmanQ9dsvidXTufOx9n
+Lbpofa9P0bLdsxemt/A3TKstWrZ2xQWXLi6wzevrv8xe3XNp3qILlxbP1m2u6ewvzk7Pnc54M4PcyaWN
+8EZE5F2nSnIjkqNypNG9oLqA8+BNoLJsla3iyxoAptjAINdWzAuvL71o0wWXXHv/wYf/+rYsv9cSsH3e
++c3zdpAnLp9KOXOjwhn5J3LRX6z1C9oBoYp8z2UIBeAFZuogiAJZ4LFIr17+S4Rqz3meV2QyUl9Weg9f
+sDEYV8vv4uQdOFl+l3z/Xd+33/Z9p/STKu4Ul8FX/MY3o5fyrVlFTnGnOjsBCFRxjVwG/xobX6YWmD3W
+W91VMOKd9b2oVoVKdUNR5BTXeOmlyPf1MXzuwyGSI9rBC8R9ImAGPcDC4B8anEBv6iI5R44c+PCEaO+5
+W35P/uDunirM27cPMwFhBw6ReM9ygdk5Bv/EbHqoNNlx5MjjH76HQz13Yxwm3d1TJR99bJ98nMKzk/1c
+qdAI/vROEw458EPCdpXE0v2MwBHgmj3Og2kgnsekgOd7ZUulP/ibAmINIlVXhky6jT3YYFLXmuwn19/y
+8cnEzIXtZD/ZseLi1+WpeH9hmk2uU/pJvnyAiyQLz6W3Kzz0duUEelv/h/W2fOAcehths3yMiyULQQex
+BTGiwHM8lCs0oVqhjGkFHeiCDbw+jJ7jatBkxxsygzefPIlPnpSPdZDtKcubB9+jsgHT5GPkJ7IQIiCr
+IN0HAb3ZCjUiUbSN0kj3heJldBSACIgIM00W9OGUq7NITo7LvZU1kDmZBU87r7mi+YK8+5ff+9DWXc8t
+Xlu+3Q+flI9d0zh31tzKuoKtRZdnblpVsa4uY2nTNYDQLh8gQ2QhxNA9TwHI8QYkXPg5fcAq3GNFpVCV
+ZDLRLVh6tccrLmAZoxDT8ExttT9Q/cRl9yQ8eMU9q5et6793308Y9uH+i+U3N2Ze3zZ/WXHj+oy1SSut
+txVmPdl7nTJGhMrHOCMp+c1xcm4lqBTyGCf1EcmhE42TczyHSYQu+QA5TUp+a4ycO6rdyhip/78ZI+XX
+fmOMVPvB7xojK9xjZKV7jNT/X42RZOa5xkj5GBdESsYZI+dWjvQGZYzUTzhGysfOGiPlA1wSWTjRGFnh
+HiMr1TFSH5Ec/z82Ru76fUMkHSPlA1waWfj7xsgK9xhZyXnw5v94jJTfmHiMHNZwGbgAvOiJTlNHXZzf
+IaIgMEkuVw6EmHgEHWMs0UcmC+MPov8dNYYOn+EyoPU3xlB9aHKoMugJdAilY+jwCi4DPv/jY6halccY
+eoN7CKVjqBxEcsimc46hZNM4Y6gcROI9y509hspB442h8jGuFFeAP8wfPYZK7HpD1z2W5YrrX5nrTK1y
+t0yPpIweRPXnHETlY2cNogj5APw8dt7z94rRkx2mpUGe+HsTgecqIpVVGPqa83ztfiOqb5qblQqiOCQi
+KidQqJ/feZVaDRGEaUKVWpuRHRXQDOfMmMzuySYg8kTscF++enbOAgkAkFNO7hyVmWXJZz4kzIUgGIIN
+ASa6/VKni3af4EkvaTUwWlEn7HyubugB/HHOA2GRJQnt80+fZid6vvaFKMsB++R00j/UxXgIwPsJ30Es
+XFXgZ0CBD0BOoOOy3nXn/RQROQ0z43VoEXUIerZFVGBj48xK0Ou9lnijl9c0L8X8BwLHC7ZxC7my5nup
+7uWxEGuUJkUb1NYE++hi2Nl/2dl0dw79ubcnBtOBLTPYRMd8e/eCF1544QV8ds0Na+x/PXHixAnzbnL1
+VxGXXBHW/2J/AL4Z9srkd+r7X+x/qvcr4OG84S+017EzAYNhCpThsOIQFm6UiIafjpxmBqJonkL0mBxC
+iF6gsjJJzeA9foaJ0rwEt/ykeGuJxkdHRNQwTqKeQ5vrmLm8Si/U64nFV/DjlAUyBaf0cxfyo6tprKhg
+8UVBKHJ7rOWAt0b0btbiHy5NW5oL3uAjevvQU8x0+IeKUxEp/D3FwV1OrQm8vEYqKpgFALw38LZz1DW6
+hvxRNSiOcyaAsjkz86flUve5+DhTbMyk6KjwUNW7xTvB6K8bOa0vCEz0uD6X14jiCEWFLp4KGj1AJTf0
+HDtlRVPsebjmjlvzauUfvklLXL5++SVJfGb1nFs31dWh/rbbuiLjmwZ6LrHNvMqOKy/avMly2eJpf179
+8s49wtH0kqV7v5H/TY8CvPHSVW1nxKjt9+PDu66NG9glr7rV6+bnZldmpEROX9+CQbHP5s3LjguZftHS
+K6gvOwx/wCcK30ECbC0I8EGe80XC033isajXuc5GmiJQ1cUcRDQI3l5EqwNth8v5dmYl6HR6C+j1s/Ss
+y3LAE463jV9IzVqkV7tsAiTEm6RJ9BA7k9EQZKBd1pCbSR0UTLmZASqNOKPGKAYHhRo1bGszPexF4mYV
+ccIVs5ZejaduWb/62qELiDhTDiMc3jlLfirr/jtXXHTRXtl4GX68J3XKtKw9+F3CO7nyu3uMaSVpe/Zd
+l30slYgAzC8AQDzG7IWhYIRkWFxwvg/yEOpLBJ5UcAjAC8DODSIagdBt7DqtRmfzRi2ittkbtVq0eIl6
+DrU43xQbHsbuOE82JU+KCjOGG0OCDH7eeo16Q4UYmpyJmUiFQ3G71CjeI9TPUqJvpOAgxauEvS4XXjp0
+Ohff2XjnfzH68dpFk5YWVa7ubrgUD8pn0HQUtfLfr6zoaJrXyQ6ILcv74AmMTfxznrOnq3DQ7+RzGHby
+OfnLa3etX7Vr3VpljXb4C+41sQb8YAakFaSkTk4yRYT7A52B0o93QA5sgIRgs3p3L5L5menx5kReDE/G
+nBz1xjw+mHNdwRRKCLsGMtuX0yTO5HKz/QNc83ccam/n/Y1RMVMifTWIXEBC3LTmyBdzPtrZub4kOCwp
+yRwVH5Y3LSfkg88q7HNj5150Nxe+9PstYTnm6KlV7d3dU+Xts3K6m/N2PR4v/yKfKXHcVJcoaEWR283r
+vf11p7EHHWkNvSV9J5+7HXjYBaCdx84Rprd4GuGCgoVa1Gk41OroCAQa2OjinQ9qvVHktfSuekL4BWzz
+gJeg53jCk+rICD9fvR4gJjrCGGkMDvQN9wtXDL7qFkNfkW7SykTqYofMyXo8e/+uX8iiX34Zuo/niE0+
+cs8rx3bef/z4g+vXXSwcHczhXj6dx70ymL1nD2Z/tHff3/++98CHh068++4JZX78Ajmlmc7OygyC8oI5
+gf70UiWsAA1woOE26LWijue4KwWesJUwGipmeYRqrRZAG6QNCjDQK1L0OnVnmRfloOoRTs/QC6T+gZyJ
+M+Ab8o/kpPwjXiffdueH++7afgc5RWzr1g39idAj108MRRFRNuPHQ98q88L5AMJbwrcQQ7/3JgUQ+vGC
+wAPyNoLAcbBg5NhZ4KqVO5wTDMHxBo1IL5ExJpBs5eSmIEKdFbJzArKz6ClhwUEBocHzcenu3XgFZmMW
+XrF7Ny4deujaa19/bP/r1157bDOWPImJ2CNfJ7/3F/nJLceOrcRpL32MnDz48Uvy0ZXHGO3ahr8Qarmr
+IQbS6O1tU01EEMMUVyTgeA74De7zs2ivFOiR5oh8s3rQFI/zEw2JcUkmf40YnRyfKWqoJk9UjwNjmj7Y
+GBtMP4xCMjPQmJGdnUUPEo8VNWJwcBC/cnFDw62PXn2m+eabm89cffWZhX8a1PmFZ72/7OuhGzgwROSc
+sNneywr/87z4ksbKK3bvvrKyoaHyyt0+PqekiOwyPOzzWUxEdnl5dkTMZz4AIEDQ8AfizcK3zGclGuIh
+DR4t8OcIchwGoQikgkcsV4ZtkwZBEAUQN9CGAScsY8tlIIrEwmsJIbPY1IDuYTEBvY7t7PyjshYkjs6l
+RcKTTR552aytiC6iFYSLIoB5iskYEebvO+IRo9OFJAcyUw4759PjU9P1pen+0BTVLbBZiT+cxCflr9lq
+BJe9Yc81V92+df8L99z6BN40c82KlKols6fXbCMd5KXcjfbBi4Z66YIF/jp/vW1D95Luzvbta3dc0fXn
+ffkzu+tnfj27qqCpIKWEiiEIkDH8gXiR8C1EgxGy4TyYC18URBiQ4/2RcKGK3UYPKIAZvQRR3e6TAVrQ
+g1a/jFbhBYJiyhGRzVcVNUkK6exBY9F5E41mlsY1k8nxRo1Os+n3ltdZQKcrou5TWUpBEV2nh42tYbxi
+zc0FYZMmlRTNmJaRlpQwyTjJGJBoijXGxvrool32JXbCkPtYNzcbAn8/bzKuartnOD/1h63LL9yxFguO
+f/nsnksXcJ/fNRhBnp+IVe03ZW148NIdKzI2Jb9718zsv67eNLTjdzJNoOc3iu3CtxACEZAKOVCIdxQE
+6Ojoq/IqHr3YXWK+E/DKteTnunv9bF4pU96xJUWRrV2zb/5zlY5lpUUibhwX+G+UphKWpZTmAbXU7jFx
+LePIWB4rqwra76lhRMpyPIu6r3eYoApPOaNz47DQ0PNmZGUkTzbGhEaERgTEGQ0Bo0TNGKtRzXl/WMZm
+X1l2o/y9KYYky4smPf7J83ctmcPddNfgMrJzIglr3pFx0+MiHn3wzvs3bbht6O7fJV2KPqCyRecMRpgM
+WZhV4BWGWoIVGmqVU4Rqsg6JRqshWnavDqH36rg2CeVVglbLW0Q94flZ7r0QKWcX4Li8SpeN7+xCVAhS
+gENu4/igxi1EuZ8+upAeeZHfNG5R5shZJFZFFkwdtwyCy1tTKTtSgrE7ks6I0lIT4ydFBQV4zIYCvXTU
+Phmomie538/kupDP9stTyTr5oYgTiqpfOBF3a/aj+buHHpIvkh8fuvr3KQ6ENrhbk8cDiJBQYOKQrgjQ
+kZIglAGbNalGE4TqIH8ihiXTfZnIGTV5Z5bvGQoi3+zhb8TT8gPoxEflB9S5j9zHOcUSEOk+CoHnCArK
+IsYqoIBEIoYko0FAjDfgLldRuU/Yvod8MxS05/RKQDgK92hu49GFF516E4RyHrHMw2QH1GKn4BVo5BAD
+jUf5G5VaziwnD2KzXCPPx+YJcCo7C6d4RMFAFrjKiSWnVyqNFLartLqNB9BBUkG8wHDikUA5deE6G6sA
+hhVyCl6BbeSbPeQJBbeh2XvIfNpobJbnyzX0uENPuunoHg5mS8R4BSWB4OjsYslQ0J7BRxXkuPl7AIDA
+fPkCtbwPtZgrrvkcQR2Uj8XOEMF46a6f6qCxMLglg3czOEPvK3BIwp4ze/coc2/j8BfcO2IJs01nFEw1
+IL1Rw32Pp/sUWUShWXX2FuiHX1xcvFEUo5IDA4z0bABOOUOVfuMpp5EmsBluZkZA0U70/wC59x84ff1D
+uPj40hXvPXwPiZhV2f3XJT3P82ewTP6T/JpcK//tyyfuRB98udL46N+Hrp4rP39oIxYAgRgA4Wl2r5EW
+kguSeCRs0YvARiCIbJct000EqwGUSVgATz88s9GIwYjGGFKBIqkalOVqru0X/o0zU3/hrxxkZyVfI/eJ
+erZfcEpBojKB3+TRWZSTw0etEIv0GCHlpkXmcCX1C+v75WNygNwnbn7llf+uETf/d41iU5VPsDOivajF
+n84wESuoCkVC5o1cMuTeJ8yzfcIKqABDkCCGJwcakBqr2b0Z+cRL9uV/HrwEjwwd5enuPSA7yA2vvDK0
+cyh7mMLrxNN8MXngN+zporKQ3Ml9gKf37AHA4TD5BJnJ9jyFFgSN7JfxKECV3Jfkwv1D2+QTmrpf9yi6
+YR6+jd9xa8ELwgqCGbxmdSkScH5cLOszWSMLSfMublxw0UULmi4iDzWsW7dgwbp1zB4zg2/TpIKW7jPU
+cgR59mWu3om2yhDEakmMx8BMNOGSJWjD7u/ulB+5X74YV9316tBl4ku/+pCrGa4zoF9Yy3uDL+RAKUwv
+yDWhqHxDEk2zgARAJGADETmxGejXBP3m5yw8csjNj09ISkpKio/VipPoJgpTrPK9P5PkzuRG1udSSaIv
+RwN6oT59zb6JXNfqE0vR+ukbDXG58YmlMzJuSaubvyCrorcqcXLtxXVTa+sWZKXV1S7IqnSob+pqm+7x
+LEAKbCv+Y5x7XmL63PmvzVk+J66458baRbuvrJlcbisc/bzstZGsAIC4DEDsFngQqLZQyUcIk2K23Iyk
+2n2orUi9A6jVG5cd5fMF/szzyn0VNwAImex8f6kgmn1abwIOkWtW1xs4nG8KNPnzYkSyUVlWENn6AtBj
+tITMw1/ef/+Xh/H8n37Ghdzq+//55c7BLdyan3GhvPPnCerf6MEGOka760eTIUi1JxrofbaQPbp+4ejg
+FgqBWz24+Wd5Jy78GRC85RMkXnMVXACFBTOjEUlFEbNoARKeIL+BKTJhvdvbVeA4ptEobIGbXzt/7uyZ
++eFJKSLtealCdtZM3pPz2Vk5uQmUzcFBk8TQ0Emccu32iCyws0vVpV0+8RnkeZ4n2mBTdnl6ZEZ8iDYg
+MlAfZPASeZ0uLCZAwwmiRiPwKBKe5ybOxms0GhG566PnL2lvuyAhvWtZS3myQZoaHRQXbRD8IoK1Rq1Q
+sHh5sv68xmXdXeeX+l09dctNN0wbyRgYPzqj75IrbtyyPBQACJTCDj6G94VgSIaKgjJvPeEgmEpOLDXu
+J4cSkZ7MAMAhHQsQCI/EBjxoBF5jA0EUGQXpxEkQ55vi2D/WhwJN/pkZoaG0d2Rn+ptiExNpnzKM+/bA
+hd9dcE95f147C2/I6xj7TPbh+Y1JVdt18v00kHW4aEFi1Ta9fB8N2F5rSOdF3g6B0DXP6aUcmsNx/ErQ
+AeguPOdOavrRz1xYfseea9/AANd2a3+9GJOMxmwjnQjmZlLDDvuZcIN8Ei3z5Hr8tuq2apTku8pxlxxU
+fRvGPHfy8LffHj753HdMX3XCSr6Yz1T3RrN797VIRbQDiOasJU2RafGJ9kOLocm56tImKtp9MF75rTx0
+CDcqP8Zv4CcJFLoeEgvi9GxeIUL5uYYLus3TgBiMwTibOzGYyL039HdiOnwYk/Ab/OZ9+V9E/hcAgdkA
+fIxH3Tqt5nfVjZiN2cjqTiKmob9z7w0mHj5MCBoIGt6Xg+QgZZ6yFYCv4O8CXpm1aTUizxGRTnAZp1y7
+fBGqBUHQCTp/CsBoNCByBkQDXzE4Ez/Gjw/JJ+QT5Gn8WI4ZOo2H2Cmlc2ixBgzi67k1Lh1KYNM5dSjS
+I7CDEXdzw2S/vIS0HQbEz+Qa3izWQDicf8DfhygXefko53cRjmAPjxx3JQAhbLCkNg0g8yMLopVkbsO4
+6YrDbDiEx8fFCcys6Z8b4BpYs7Nyc5VllZBgA342o+Xk4pVV+ZdqNMFejb4xcg2/D++/B8XWuoL5272D
+g8Rrpzp+3XWmUhnD75FryJeabRAOzRRfgm582bCojCIrgQCQZorUUgACtZEFke5k3Dg2dRxsNcS1ZyE4
+SKNR1oQSsg3kyxlLPLD1i9FsO+0rn3+PfLq1flbt9V7BweK1aY5fdgk/KH5aO/nj3PTfnt/QPcL8cbKz
+v5+mk2i5hgyw+3ENdJ+Ca2qhfAJlGkwkur9fs+3nZ9W8DIbAYBAkm86abtOpbngyUrOyiUQT59Cpfm56
+/5ld6jmjNWSAm67KEBLcxHi5ANRtXzBahph7kIEM9JOd/WdC+EYFX9pOzTYVhzFjOcXJjQNbuibR/SRq
+qEaz7czD/aDSibVXxUG55vfcOGQaaC07+/mGM6HgpoNmm7ILXsGBI9ymsxExKON1pkGlB8XlVD9DhpHE
+RRNW15SCRAUfwlHCUtM5OwqER9VwrvqEi+HJrqs/KXMYYj8/yze662M80lAtQ+vbRMsDt4D2HbYowMHo
+ypDiZjBm88eHPu0fOsXYxTf2u2RKs22kro2jTPoTImYMZk1VSPZw/whemm3gxXalsHaqhwCMt0bg+UHh
+qtOoNJiR0FX1mV1KuwkACeCPc6+69738bl9DtUswP8PjZzIp8fr75ROsewDBLXINV8r6h4buVFRmZorn
+w6pxqqKrQPQfbunv75drdLN+fpb+YERuuFdBR89UEpC2f5xPHVC/dKg9xWAwiFSCmOmMkkDpU5QGco2w
+vr+/f3Amq5viyfiufLPzZEzv5FxqYGytbD8HbuFK5DUUYf4rRtMzu9S2M3wF8KKrOzwih5tEJAACobYh
+jhMWME9uOkWsBlBOyVNPvqL6g1WP9D8jf3yopp9BIVHcq/1nQoT1csngTL7RrRdUuqh6QUD1ejxCuAWq
+xZIjY1qgaAi6mYoM9A99SqK56f2nL+ee63fLHFfqSRMgnt+vHKqfr6Npku2iibyaK2U0aWTqh9FErVOh
+SV5BNpUy3AScBgXghA4PXSIyXcIOBPMSGWUUwmjdmg2zVZFmgEhA/5ld3HOY1H/6cv4rVdcFsD7D8Fd1
+HRAOSQdwPM/Iwi+h3aiazbkE+vWd6RIVtaso2m9wVr9CE9yi9mkdmAokRZo5RJ5XHPxodTi6ukyl8zGB
+xs2DT1Lt5erWVEZUHAXFSsCja9AbT0TUa9VEdh53pnouKRWNU6zqfuLkpvMNg7PkEuESRdfSfs30oyob
+TF//btnIVGQjun+omskG9+zpK/rd4wBXOpoOVL45XMAjIsUXubPpkK3QYfAvuEWlQ2i/W/9wpSodFLlg
+qIpIOKD8EgSGKvPQ54RzyEUmkwumQYY+JQP9DO0zD8sn+vl/nr5i1PjjxWjC5ELghU3nEg6V6m7pcI9G
+6og0OMutS9R+z3Qes86wMWOjRhA55HHT2NqZEvTilb6vKEKthyKksFTZkde4FKJ7/OM8YOnAj1rYOAQe
+NoqIAtIVDKLhSQdoBEGzADQaYQkIGqHafRkctUfQZXeXNJkMjEFcCZMopmzo5IX/SpEqT33D6JdVkO45
+5ooo8ECveeM4tg2AigE/wYBkcsmWS/Fwz/78rKp6XHqCjZ1ekF2QwSN1qKU0HGNEA/ciPftsUcdSrWt5
+3j2cyKvVIYVvZOO9MqpwbjhU7vygqGCWSMeVjRpVL+nO0ktat16iGwJGy6B+lG7KNAQqE0AVtoeKohJJ
+sXC3VR17vWBaQQ4oA5t41gCsEYh7BD5bYlyjMAWvjMTK5JENxoMzuedGzW9UbTNmmBMFjlMHujEcU0c6
+WjUd6egMQh3p+EaVjmobqBwWFJwnIsdzmwC0SjM0SHieLNAgIfwSQUd4oog+JaKXnvr+uRujH2kMlRJ1
++DuTSaLdA6DSKkpMJpKu+YsqL8r+dDbbolgAXYcTBCaRTH/wwvgS45opUSqy7q1OZ7hnB2cxKnrM6Zjf
+D5sVbOQJAUGABTQEtmsZhPFlXunQandW52DusYBzt0GRxZkFMxQtKCBwWkJEqgepY8UCEEXNEh1qRE21
+tzeAt5+3n6+P5+RB79aFRvYfbRBu6WefE7jZ3aozDyvdWulzI3PMQDivYLrSr0VBpHNMvY4wOmrZ0V0L
+tDhCybGO4mLMyIxT0ZSuju5Wl+4Or2pNpdd7wmcjAO3xeh3RgGaTwKlbczi2NUcruiznY0F7dvqR2Zna
+AZlGc6kAlfKu2RoA74ZP6W+AUKguqNCg4jJPtYEWOdGHMH3ghUwheDGHG503cakEunExMMDgf7Zq8B2j
+GoweKtelIlT+MBEfqyvc6pfJ4Peqzp/Ipk7P/+WPk+/5O89YPHhLvh/3O9VgVDvY92daPPlwrrzqNy3N
+SzrOkZd0DN2i5iXt56iXHjBH2kdyAwQoeIivqDYsP3ZieSTEgAkSIRmmQibkUm97KIY58N+Cn4P0BFKD
+J3vFCUzjhHgTMjV0ik+8wJbiw3wJnxae7JcgCCIvdET4EzE90mxIFDRaUdMRFUC0GdEpgUmCbpJW1xEr
+kUkxMZMW0HBSzJJsU5YxU4iZFFNdUlJQkJ+fl5eVlZZmNiclxcVJUlRUWFhQkL+/t7diQCuZUzJndmlB
+cUFxUaHi/J43I2/G9GlZuVm5OdlpmWmZGenmqeapqSlJyUnJUybHJcYlJsRLJskUa4yKiYqZFB0WGRYZ
+Ea4cqe4f6B8YYFD6+FmmuRymKjOD6eFI6BEG/s53rrNL6e9VfFW2Kv+9MkF88NXjr7z6yqui+MILct8L
+L8irXnhB7lVCMfn3vZP7nmd/AHEecvbb/J0H1VAHC+B8WAKt0AHLwQ4OWA3r4FK4Aq6Cq2EbgIsUnr/A
+/z+88ySjZ/yY64/83tgR19+ZzGOvH3v92ODLNDgiFB4+TCkn99Hw8GG59/nnxf+MxJW0558XOv5n84n/
+Vrj1y8bD7A+Yyv1jfIPAbFMwZpuCx4a/951R/VEV8eqr+OdXUfmPdEz0INuOk3b6ahCprvqfkFdlvkjX
+9oG/kPc5h+6lar2N9+F9zvwHiEd+xUKlrHx3eCwu8+riMjsfn1YiqJXEGw3GNt5nsJGbO3iQVtfKzQWG
+A95Ierh7f8daLEWF+OKNxHfoB2Wu2YZ3kh5uzx/FJ9CFD/GVG7BMfhzvpHW24lw2HoEWePhtfOiwpAUC
+BGQAhNMA4r+FX4BAcEEA4sjeDwKE7rPIRDxNBskZ4ZfTutH5xX2E+UIhnqaJwi9D/JAA49VZNqZOHRH/
+TTMrxQDGrdeIKP6bplPoCt0kjOX+xr/N6KbdrxE4JBnJOg33NzlBTsT3MFM+Lr+KGWRQTsD38T05ETPk
+V6krFhB4BE7yCXwju5MsvSDV5anMVo1Js9t5mMD8s+4lE0OS42MhW1kGDRp9L1nrXnkI+YOHkJP/+8SO
+4y9v3/bySydRs/8g8vKZJw7Kw61Hr9vxyivbrj0G6IFDWEGwa3YMq9wn7owBNKryUZUC8ej/fooVmENm
+wd3IIwGyEQBWCbyXjvcT/OjnvzK4Ywat3RRLa1c/T254EjXy0KGD8iAS+mn73/sRDz6Bgjx4cL/8X3VO
+7AErks3s6cc0cALHPto3apAn/EaRTRaV2YTAhwb7RxoiXQho1ZmIJwK5HjvMKTKk5RucLT/x2efyX7D4
+k0cGh/btlWU6ESF9WPTVZ1gq/+XzU/KhdcMD+4aG9h5QZaIILyT93GE3XznKWbTxbHLYzCM7ppySZFy+
+ogcvQz3wQVttn6O2tqencvWeR9ZdvMeJ7/TU1DsctTW9VufadY88csm63QAAAnoPf6CZxM4eCgUTTIUZ
+0F7QKiKvFbS8sIEio0PO5oU6P70vp+V1Wpu6H83mo4ieDxM9byZ66WnxcRTN3Oy0GekzkifHTY2fGitN
+io6KCA/zRN1fDEkWXNdSG/wh3iPOZQYYpcBMkTfFxgV6NMnoEee95RfkGXgEi7AIj8i58kH0wh55u3xG
+PiNvx+737WU4GSeX2WPkwaeekgdfzpg7NzOzrAwPZcwty8ycOzceX8ZZrOx0+Wn5kJzfj92oRR5Xskpk
++VpSLr+JqW+dOYPr5tISmVlzXCEA8Jgy/IFmlgfdmgsavQTCibzI8RsA6ZcVamw+Wm9OBJ6IvG1kEcqi
+Z8SKjwsP8/ZGUMgUEx1mCjd5h3qHhgQFBvj7KoTyHUMoDOEzM+JyTcQocbkevDd6xPmU0cQZ/CgG+aee
+Qj7GXia/K79bdlFWGSMCiclmZDmbGuTkW2fOvEUJ8MCcLNrozLmuULkD/QvxAeFjdlJVS8EF4WGhwQH+
+fr5aDYnwIagxikSEhDhTrMBzqBVEvsJ1l7nimysidOhQFASxmV74wm41F4XqyUkAytlOkAiJhrAwQ5he
+pI66CYmaxNyERFN2Zk5uYm5oTm52ZnBIaG6oJiSU+QuGahJFTTDnQQKuZd2FA7aB8rDBwbDyAdvAhev6
+11040OlUXjg7By5cV33He+/dcuuJd8MHgysHbE7blf1X2py2gcrgwbNe4NXv3nbLiRO33vw+EGiX+4Rb
+2V60WOoHYhAIilihQ1FDOb5By45ro+0Vlfa6j21bwjNTd1goPTQ+NDYsFkIgxBCSQL+01TM9DHEjnGa7
+5II5D31DDsgz8Tn5tHwAN2EQBuEm+YB8Gp+TZ9Z8iGnyG+++K7+BqXLfL/0YFI8XIrAsF8nfDIO8LV7+
+pv8XbiqmvP8OTpXfePct+VVF/yQP7xcXaFLZvT/a/dGhvjzJoKe0JcYHajguJDQA6AhOID4HQ/jcnIDE
+hDiNSHjvD+QbE8jie7dZPjiQM1f+Wv5J/lmOmzsHg9H7g6H3p+GFf3vt7dw8+drXj7/Hf4IXW7bdM3R3
+Ai6TN95yXP5Jjp4zF0PRG73wvbkzBp9968S0XOx97bV383Ll65TxNAOA9xFyIIzuxQn1Ro6N7IRaygmh
+Y3EYvVQ2lm6jMwaqDuf0Jp0Qt+9eYiDed37n9gvWzO260NHQ0Hth19w1i7d1no9t5Vuu6L7RsXbdzp3r
+1jputF++pVy+TYUp+PBnQKKeWDHBXsgDYgU7hk+10iAI1C4qCKuU62Hj4uPjYqltxhjoXkAOHdls695b
+xgWS8OYLX7zw5ns2ZkzPus3R8+fMaRmb7rl55ZGehYTI/7qr74o7DO3Nlzz00CXN7YY7rui7Cw1DsnIn
+ySd8H/8UhNPvXR/XXCR2Cv3eFRKm8gqwQGWx3Y8XyZKswIVFucUHb3S8m5NzwnHjwaK8ooWBkxaEX7pk
+Tv0/n7r+L/JjWPGX65/6Z/2cJZcqJ3cmDZ/kvhVmgAbEfTyduwgEITAAgXgdlv+B0uEXMFr+NBZvwRAM
+wlvlDvlr+StASB/+gHtNOAqTqB0ryEvPEQB2wv/I3sdm9Z4SgvPpVQlsBoeGTOZiGRykScxhvpeJCYn0
+NgilLfhy6xWz31teOrOpPCQrOlF+uGzxI335mML/OeKKtuz7r+m8Kqv+vPOqigPjA1OGUuT0ivyFVyky
+0yZ/LUwWvoUIulIWjsh5IUFSEeHjDTxXDkgAm9U7a23qQZkI802JJhNbIjYomxUoCw1oCNKMPJFFD927
+e/eqgkwubfHSq3ftXpdJzwZ+8bNvr1m0MYzvwNmDfsOttqvOvzyEs4MPDA1najixBCIgATJgHrRBD6yH
+rXAfyChhKuZr7yuMhUF4EAgg+MO/YD8QFu8GBC1QgzECD1qcinFAIAJCgNrM6c7Bz+BpIBAAGtCAev/H
+n+LQz99vYzD6o/9GQVn80XFaTqfdICGvDdXyoRsmIYSGh0L4BggPCQ0PWR+NkRFiRKS4wQdF3wjRdz34
+ekf4eq83obcmwluzHjQBEZqA9RAQGBEQuB4CDRGBhvVBaDBcGauP4YgXcjrC2YyohVDQhtrC4klIRERI
+Mw1DIixRGBESMT9yntO7pqng9v9D9HwiIn02gI8Y4SOuh/8vkfT5301Dv/8XaOj/v5uGAf8v0DDwfzcN
+g/9foGHI/24ahv2/QMPw/800/F9OvuaRv8IlCDAMO+F+uAauhl5wQDtYIQsyIQoiAQEwD3MwA9NxCiax
+s+ejMRLDMAS9UAfDKCGgBL/Cz/APOAnvwTvwN3gVjsKL8AjshvtBhp0gw91wJ9wCf4Kr4T64Bu6Di2AN
+OGA99MJ66IJlYIUeaIceWAQLoRZqoABmQibMgyyYB/TaoUhIgChIAB/wAoAIQDZtEYEZF3Jz2CFTqjO1
+SF0OQkNCQ7iRs7dyQoPct1GLwjglQidxqLofeHgseBguJhGcoDqWKZUkpnJqRrqJHTNCQqnHQwjd9q6Z
+xLkD5hSeyiWKIoVFH3w5pc7cVI5ugWdBDq0jNCdHQUB1lkj05ZgTnOoyISYkUmD0OVEUNTSemzGTkD2T
+G2MTEiZPSWg0Tm2IjU3g9IF+0VOzYo2JUyaZJicGemu1vhpv0cBFpTVKUmzyZKlqynlVodFxWV6xKXkY
+wXmHBkXNmTnFyxCgCfLT6f1MWSbD0FPxccakpCTBX8KXjAlTouMS44O9tFof0Uf0G/wkKDIqbVYs7+UX
+6BWaYJqa5mMIDgzHG+78vjYt35wULcX4JRZmRkT5Er+k2TneIQFe4bHpFX5E0GjI1OWzsoqmzcoITgz0
+NiaZw0xJEboLlmX7aINjIyNzkqOMiaFSYk5q7RkMCQ2JiuCDIyb5eIWHBfK6IINXVKBPSGiIj3dkZESU
+b5YJNb6+CXovAS8OyogmGm+vqRotj9w1UnTs+XnTlsREG+Li4halehsTpgQmBIVi5pTw6MT4yKjkVL2X
+j1bw1f8aJ0m1KRkNUkT05IjoxvSyIt/oEF/ZqTP4aE2Z+WG+wX5av5Bwr4iowEBcEREUGh4SqfH30aH/
+lNDopPjIyOQUvbcvrWnINyo8IjIkMpLz9fOOlcKSov39A/35PxfVBE82BsWER+TmZodnr7rs6nn3/jTQ
+Fxc8NS0tRIrJzuaDIiL0Wo0gCoRE5WcaExIS5iZM/fNfXllc8adrr5gWOX16frQpJTIhybtyzuDnwf4R
+SWGcKPK8qOE1QWGRvkGBWnpUhSaK0/hFZCZFhMSZU729SYTZGIDR5kwgkAXAL2S2gQjsKdDrUUu8UMe5
+7umb7L4fodILdTrtEtBq8ypFVM8DwxmV+pFDpaiXayJovVCHWl3HSMkJsgdMUP+EBYL/KEKT/xhC7GpS
+IMB0prvYuJl/T75819351MsZgPo3h4cxC4ohgB7y483OyaJn+7AvNuVEKeXodbqYtrOn54UDK3t7Zmwl
+18gn5Nvwpp+56w9VvPZRzFPG0yVnDnDXD9qVmyEBELKGv+BPszvgflYI5SsiQUAdhwg6dqaY6wWpQORx
+nnoklAkQNRZ6Ifj0SgFdfu4z6Hk8sJRHgCJwkTKZMYflh7Oy05PYWRlwFVGuWdYAEg31BNf+RoFz5833
+zMuu+5ImGWKVy/Ri9boY5QpTg8F1LJd6mR49H8loCKHOMfzp4gr5SGPpS++8I5/o6cEd1fdgOgkMLoo8
+cCAcO/mo2M8vODRo564/VIr7w89kdpoSgKOjoRY0yn0kMTC7oDgYeS6EEMJTMnLlHHUEppe7ArXHAyBs
+EpAtKglIV5WALirptOGhQQHaGF2MIUikZnkd4dht5ooBKDsrjrq5qxv5xWAeeBiEF9Am/wlt05uLb5T/
+Lg8++ddXNj6/ds5mjiUOAgL6bc5e2FNfcM2GG+7Ll0+3PLDqssod3cBBzfAHvEk9mz+U7u0CBI5Hzub2
+hdEodnANs4OLzLQbxA6tV9bePQ+n11Fzbiw177luqzb4m2IN/nQ9mGS9J/+E3ic/RB/5x4+cjzw676V4
+3I2LsQUflJvle+Vb5OZDZBEpGRoYepQIL70EAEAYfnEqfr+9LuSJjDgBMmT6RwyRv1NETh49etQTjTvl
+2yka80k1RQMQAAjZdnVPvWaJX/5P4KcF+vdWmY2FJ4/92Srny0Ha67T/AQQdEFD+EECzTa4B0H4t58vT
+tdcBKgmuP3JEVF+RaepvF7zCvQI9vAPCeQe0aqKhXjgCKfgF1JFdUE92wXn8aljA74ZOMg0udoW0LO+A
+Gt4BB3gHVPIO2MI7IIZ3wHbeAVm8Ay7kHdDIO6Ca7IJ36I+WVX82/lO4RnMcnhaOgLdwBPYIR6CGhY2w
+h/8U9ojTlGfuLmjjHQDCEfX9LpZXSW8cySc0Qiv/KSQJR+AuoRHCNddCuNAI6UIjRPGfwmKyC+5U25vP
+cHCw+DzeAaX8p9DPO6Cc/5S1p5zsgiwW/xT6SQ1sJZXDK3kH9JNWuEoTDf0s/6dQroY1XDTs4R2wjuyC
+KfynsJnSUpwGM3kHpDG6Ati5aGgl58EAFw3r+N1Qo+LyOK2Xd0A77wDCcDoPBngHXEt2QZDwGXiTnyGd
+d0Aho/suKBc+A1/eAVVcJvTQd9xPMI37CdrJLmhQ21FF8hgu67ifYDP5BpJ4B8wUd0O8+qN0ulKl+1k/
+LbCQ0dbzR/mg/hppKBxReELpP/bH74ZWlS93jfo1QpTK335G93F+mmgWlo/9kRoAUgOzSc3wI6Rm+AsP
++pef9aP8VeKbR/0oLxTY4bStFN44IZOBc4R7hGdY24DynrWHvv+NkNHlU6iZKFTlu184wnAMpzQmNcNH
+ubtgMakZPqA+v6iEcJ4a9otnoI2/hLUJSPHwSlaPIlOUNntIKyxVw6toyBWq4dsspGvCnAfs0eHD4OuK
+q3iWjw21ADWaaxW60H4wJgxm/dIB6yYKaZ9V+00S9zZMcT1TeaE8+72h5lqlz7H+7oBwV7+nfW9sSPqY
+fkp38Zy7CxaQmuFHXW36A7BZedpnqNy6y49pG9k1/KH6+5TsGv4P2QXPkl2wnOyiemn4J7Jr+BFVR67i
+DjLZ1JNd8Jj4N9BrZoGev3x4mXgLgGY5BImPAXBBw0ddepN/jcnjJLVfLXb1SbWP0r7r69KZQgIkeejL
+PfxxmM10ZiMEideDjepLcS3UiMtgCSvfCEH811DO3QZz+E+hkAuC6/kH4Sr6jv+EyZiGfx/KuWMwh3t0
+eJjm4eugnOVbxdIj+c9YqOO2QzCTGwmM/H/gKn4ylAtroZzbAXpap3AIyvk/D38qaiBdfI7WMTzMYNF+
+/CBcRd8xXbQQ9miCIZzfAnuEAVhIae3STbQPaJ+BJO0G2KMBSOI/YbTZw5+G6TRkNFPCPaz8PvCjdWpm
+QJzAgU14Rs1Py90OQaPGmmdUXXiE8TiJ1im+xfp/unAC9gh3wiVaPezRJsIe8ScI07yt1CUMwGxtAYvP
+ZDru37CE/xpu4hrgJiEI9ExH7AaBOw35/NfQz34I/UIwTBK+hpv4t+EmRgMlZOMU9zUE0bZqgOnGNFbm
+flgoPgw3ic9Av/D28LD4tlrXcshm8Ych261PP4UgCp9fzp7XkWmwhRQMf0SmDR/QPAP1mgKmO4J4VOtw
+AFD43Gewh7sBbnL1tbGhq+/xDmiiekELAPxyAHW+4P7xAJe7fw5YTH/kOKRqAfZrAULxa8jg3oYysgtm
+kl1QyL8Nj5FdANgo53M/Af2Fcj9BF/cTJHI/QT33ExRwP8EM7ieocv+S4T4uGXZwP4FdLbPZNVb+0fK8
+A/J5B9i1z8B5jO7BUM79k86yYJcW4AUxHuYLB6BN3A1B4m7IEHfDbBpqAdp4gPlagKNqyJ7pj/sRjPxn
+ECPeBNcIjylzEmwcDiPTYB7fBiA8BjM0b+My4QjcQH8kHbz5Nijl22Ax/zB0qvHZ/Nuwld8NDfxx/Iw/
+DvfwxwH44yRa+QGcHY794RYlDbcoP8+0P/r+/+RH2kc9vw1t9EfeUX5aANB8Dac9f9yPIPFvwyPsp5Yj
+70CRNhm9tcsxRdMAe0SAdhEgmS8AehdIBv8MlPPBkMQDpAsyq3eI/w6yBF/IYnLqgBqoAfo3Rf23DPpR
+wp1kJQdcFJfPLeIe4/7BT+Lz+aX8xfw/hCChU3hZDBB7xBOaAM0qzfvaBG2/drf2hPZ7Xa3+Zv2HXgVe
+13m95R3iXeP9hPevPhk+vT6P+HzoG+vb5Ov0Pe3X6LfTn/fP8u/13+n/pWGKocVwm+EZw+cBYoA5oCKg
+M+CrwMbA7UHeQTVBe4Pzgi8Pfir4dMj0kLaQN0OTQpeFPh8WFXZR2DNhp8MXhl8TfizCOyItojvimYj3
+I82RXZFdkbdFeUctizoWnRRdG/189HeTuiY9N+mrGO+YpJhdMS9LvlKLdJ/0gzHAuMZ40PhmrD42LXZZ
+7A5TiGlnXFPczjg5fmH8Uwl5CbclhiWuSfJNak96bnLS5K2T35+SNuW6KV8lz07ea5bM21P0KTen+qdm
+pd6X+uvUmql70wLS2tMOpFekD2bszezJ6syOy/5HzrKcU7m1uc/nzcw7MA2m1UzbNR2mXzQjdcbN+WL+
++vPizrt5ZtzMzbMiZu0tqC/4oTC/8MrCt4rMRR8Wl5TEltxc2lT61OzY2dtnD85ZPOepuea5N5eRsufL
+pfJd82Be17w3KzornqlMrdxVFVu1qRqqr6lpqvl8/oHarNof6rbWL6p/siGh4cqGTxprG59fELKgZsHu
+Jm3TmqZTzTnNi5qfWZix8OaFP5y/8Pyji/hF5Ys2Lzp1QcYFl1/w/GJxcdHiyxc/s8R3ya1L5JatLZ9Y
+1lh+WFq09EBrbOvWNrHtJmuAdWu71L67I6jjwc6EzqdsabbXl81edmB51vLdXfVdH65YuOLN7qzu/u7P
+7dPtl9tfXVlyIbmw8cI3e7J6TjjqHb/2Lu4jfT2r9Ku6V32+umh1/+pv1hSteWQtrO1c++JF+RfddNE3
+62ou9r+46+IXLym65In1UeufujTj0u82dF425bLdl6+5/JsrHrwy4cofNi7blLTJeVXRVcc2Z23u3/zD
+logtRVuWbrlui3PLj1tztvZsvedq/6vTrl559bGrP79m5jU3Xxty7R3XSdc9si1h27Jtr277eXva9p7t
+j2z/5vqm63dcf+x6eUfJjot2HL1BuqH2httu+KQ/oH9R/3M38jcuu/HoTXE3Xfenqj85b067uefm72/Z
+fssPt2bcuvnWt/688M9P3hZ7W81tt942eHvb7Y/dsfKOvXcM3rnwzhN35d+1/q7H7k67u+fu1+8puOeO
+e8m9TffedV/Cfevv+8f9nfc/uFPc2bbzjp2nH8h/YNeD3g+2PPjJQ1Me2vXQl7vMuy7f9fnDvg+vfHjv
+7prdT+z+bs/CPc8/on2k6pFrHjn1aMijLY8+8uhJZ6xzh/OtgZCBxQO3Dny+N3/vbXu/2jd335uPpT7m
+3K/f373/tgPTD6x/POTxZY/fczDgYO/Bxw4OHpp+6OJDjzyhfaLoid4nHvzLk38ZfLLnySee/Pmva/76
+8lOxT3U99djTM5++7envnml/5vtnc55d/+zzz5mfu/wwOXz54ZPP5z2/93n5hfYX3nlR/2LNi7uPhBzp
+PfLJ0dlHD74kvlT+UvtLl790x0vHXvr+5Zkvd7/81Ms/vzLllc5XHjnmfSztWM/xruObj+89/vLxT14t
+eLXl1U2vvvma9rX61+557dXXY1/vfv221w++fuoNeCPqjaVvPPLGW2+Gvdn+5q1vfv63rL+1/O2xt+Ct
+mW8tfGvTW/e89dTb/Nt3vRPw7pp3T59YeeLkeyXv7Xjvk/fL3//+g5c//PXvn5/0P5lxcuHJi0/uPnns
+I/JR7UcbPnrko8//Qf4x6R8F/2j/x6mPxY9zPm7/BD5p++SpT379NOHTxk83ffrgKTjVeKrn1OZTD556
++dRXn3l/lvHZws82fLbzs3c+J58nfd74+YbPH/v8H1/ovyj4YtkXO7547ovvvgz5suLLi7/c++U3/5z0
+z9p/9v/z5X/+/FXOV8u+uvmrE1+Hfd309e6vX/z6w69/+Eb7jfSt/7fffLfsu8u/2/3dy9/9+C//fyX9
+a/q/6v/V/q8r//Xiv775Pvb7ld87/13x75d/mPuD84fB/+T8Z8N/9v7n1R9Dflx4uvHM9DOfDxYMbh98
+HQAQgBzF8yAOAAAInMBFcC0A/ujrDcpfOGa4bR2FSgn2P4FCNU6Ah2I1zoER5qhxHjTQqcYFMIFdjYug
+gR1qXAvBcIsa10EMPKLG9VgEx9W4N/gRPzXuI15OJqnxANBo7wIOkNcBwOXaB9Q4Aq+brMYJaHUpapyD
+Il22GufBV7dajQswW7dRjYvgq3tFjWthiu49Na6DmXpOjevJlfpCNe4Nk7xfUOM+Prz3p8X2lWt7bB2d
+vVJS62QpIy09R1q6ViqydVi77KulBGmuvWuF1SGVdbempkqFXV0Sy+yQeqwOa88qa1vqHHuRvatNLZCg
+ZKe5p0tz7BJNms5qVR8arT0Om71bykhNS0+bIfX2tlv6eu2dtu5eKWlVempeaubkOfYUjxpHozCvx+Zw
+PVu626TiTktPl9XhwneOXbI5JIvU2bfC0m1z9NpaJYel25HisPbY2qV2e3ev1G7vkXo7rRS5Lkt3R5+l
+w5oqlfU6pDUpnVbaNLPk6LWukFarDxRKG62qu7XXtspKy69wSPZ26SJrj90stVpW2notXVK1Weqyr7b2
+tFocVqnLLLXbOvp6rJK926pU4cpXJrXbu2izKA4lZVVSelZ2moJZl7XDttTWZetdKzl6Ld1tlp62VGmO
+PdEhLZhTIbV2Wnosrb3WHslh7ZVs3a1dfW1Wh9TQbWu1t1mlCkuvrdsszemxWpcrlFnbY+vqsrVKlq6V
+nZal1l6HtLKrzyE51q5Yau9SqNfRY1nZaWu1dEnWLusKa3evI7Wrr9XWZqH4OFJb7SvGkY4/IBp6fYnN
+0dtjW9rXS3lub5d6O20OpbU2h9RhX2Xt6ba2UYGj5FAoY+vukLpsrdZuB2VMu7TW3ie12aVue69k6eix
+WqVeu1KNmsmsEoOWo7W02RytXRbbCmuP2VWuzYWFVbL3SCvsbbb2tSOopOr1tdY2T0QpbfocVsnWLTns
+fT2tVvZmqa3b0rNWEQCztNrW20lro6G9r1ep1dZqoRWYJUuPVVpp7Vlh6+21tkkre+yrbG3WNqm309I7
+pqmt9u42Gy3kYIVWWHun6/WSJE2RRiPFZE7FhnF8RZ+jV+qx9lps3axKy1L7KprkYli3vdfWajW7aOXo
+pRV4QutuG4PKCOVSJ0DB1u1JBRcKK3vsbX2t1v9xLCS1ZW321j4qnRYXc6baeyR7b6e1R1ph6bX22Cxd
+jhEaM8aoguBG3dWgKquNlaPp3ZYVVorNHLu9o8vKxFjqto+kMYrbeh0UXaUie49DWmFZKy21UvFoo5Jo
+7W6z9ziYXK3ssa+w91olhRy9DqnN2mNbZW2T2nvsKxQCOOztvaspm11S41hpbaViI63ssVFh6qEC062I
+jsOhIF5SVldcUVhWWVo7XaqfW1Yn1VXPrl9QWFsqldVJNbXVjWUlpSVSUbNUP7dUKq6uaa4tmzO3Xppb
+XVFSWlsnFVaVSMXVVfW1ZUUN9dW1dVJsYZ1UVhfLEgqrmqXSppra0ro6qbpWKqusqSgrLZEWFNbWFlbV
+l5XWmaWyquKKhpKyqjlmqaihXqqqrpcqyirL6ktLpPpqMwN6djGperZUWVpbPLewqr6wqKyirL6ZwZtd
+Vl9FYc2urpUKpZrC2vqy4oaKwlqppqG2prquVKLNcje4JFUqq5KqqqXSxtKqeqlubmFFxZhWVi+oKq2l
+qI9qYlGpVFFWWFRRqgCqapZKympLi+tpa0ZixWUlpVX1hRVmqa6mtLiMRkqbSitrKgprm81qnXWl8xtK
+q+rLCiukksLKwjmldVLSb1Ckpra6uKG2tJKiXD1bqmsoqqsvq2+oL5XmVFeXMDrXldY2lhWX1s2QKqrr
+GLEa6krNUklhfSEDXFNbPbusvm4GjRc11JUxmpVV1ZfW1jbU1JdVV02W5lYvKG0srZWKCxvqSksYcaur
+WFPr55ZW1zbTSivKVNqbpQVzS+vnltZSejJKFVIS1NXXlhXXe2arrpXqq2vrPdooVZXOqSibU1pVXEpT
+q2ktC8rqSidLhbVldTRDmQJ2QWGzVN3AmkxZ1FBXqkQ9BNbMGCmVzZYKSxrLKNpq5prquroyVUwYyYrn
+quROVecNUAx2WAlroQds0AGd0AsSJEErTAYJMiAN0iEHJFgKa0GCIpbHCl1gh9UgQQJIMBfs0AUrwAoO
+kKAMuqEVUiEVJCiELugCyaNmB3uiOa3QA6vACm2QCnPADkWslrYxEBJG1e6qezpIrIzkLjXdA9fRKY0M
+kgNsYIduliuV5UuDGSBBL/RCO1igD3rBDp1gg261/asgHVIhD1IhEyazOlMmwPFcVJjH2u44K90C3dAG
+EhRDJ1igB7rU96NrVlpiU0tI0Al9sIKVpe96wQatIIGDvXFAikpVG7SDBO1gV9tCYz2srZ1gdVOui5Xq
+gD6wMIipDGuFQ2sgheV1cc3MoPSCFVaABKvHpLja0ubGiradhqvA6oa/gtVsZ7hdxPC0s9KtYIGVYINe
+sDBZqWZvlfbTXDTdwerpYintDHYfkyKJtdE6Coux9ZWpGHS5ueWiQwmUQRVIkA5ZkA1po2hG+dEBNlgK
+Nuhita1VaaDAoTxrg1SVmomsbQtgDlQwDBSeWhgVrIz2tAW9IKm06YI+aFM53sC42Qp29kaCCrCoNDSz
+2mk7rbB8lMwofbULulQJoC1dyaAuZXBovSsZFAeDvRZWwFJGA0/Z62A4rmRS36pSy8paTqW0m9WTympp
+BRtrs4s+9D3FeMXv1B3/32gNPeihRJW5HsarPkY5pZ8rktbLWucYxVvluQPsrKYeJkFtbg3nkg5PmbGx
+niKp9Lay3ubqMRTKWrBDH+sBdpCgG+wMjgU6VO5JTLt4YjO6JvMYyXDBc+Gi9KxW1mdtjGY9YD4LXttZ
+tFB6SA9IsILJF9UMa8elikLNWkaJiSjqkps+tT/a2FsHa3sPa81InqUslfaCtaM0gJnpDxvDwIWb69nO
+oHniqsilCwOllyv9fiWjwQpWslfl30qmU1YxWVXe9LI+0fsbXG1ltbeBzQ3J4QFpBetR0xl9JPZvCkjn
+pNSInhtNm5E+voLRsFeV6l6wqLR0YWmBpap0KqXG9jCF44oEmc+SK6VmBYOJ2tbtps9EVBlP5lL/IBWU
+Vo0vC2OpoHCvjWkb6/8DtJDG8Iz2xVY2Piu603JWz5mqyrtdLaP0S4s6RtiYBnaMK8cjPWa0Rjib6mM5
+VMXG6hF4rvKUHyvA6qYNHcXs0MG0/4g2Vqg7XrkRGbep+rlV1SQjGNnZvEtp41omB1a39mhz60Qro42S
+d0RfKTRYwWhlBWmUdCjw2lSqrVJra1dLeEqAg+HYC6vdvXmsrnEwTdLq1jYKJJtbM/W4NUz3KK3jAMco
+itO5RB0UQwUUQhlUQinUsjlpPcxlKRLUQTXMhnpYAIVQC6Ugqe9roBaqoRHKoARKoYSNls1qyVI22ldD
+DTRDLZTBHJgL9WzsrIYKlr+W1VEIVVCi5q2Cepa3CBqgHqrVHLFQCHUqzFiPEjSk0EqhiWFSCnUsHy0n
+sZbUQAWUqZgpuNeyUvXsbR3rc3QeRdveoM6p5rC3CgZUBqtZWMHqK4N6tbZ6da7naunvgSYxKkoqhYth
+rppaCEVQxsrWs/a42jebvalyt2u22rJCRnlaP625GBoY52rZ2wbma1ANdQwrF7fO5nCJOgOoUttIqdgI
+pQwfym+KWwVUeLRwPF5WwwKGX62b6hNzsYjVQltJ21sBpaNapHCS4kkxLoZ6N2/Ge1esSpxCvQr2vg5q
+WK4y9xsqF6UqXyglmtlbTzwpleZDg1qTUpJiUQiVUAhzVK4l/V/KiNJLihlvSqFSheaShjpogCKoY/Dr
+WY2lTKdVQzWUeMhzHaNzI+M4xWsGg1jtlvnZbD5Oc5nVNlDajLRYwUKRKaW08r6IlSrzkLMyhl8pk98G
+qGGYUWpNVnvvAlVWFDoWqlBLPCS3GqrUpxFtUM044MLUJQcjcm9mvYbmVPLXuuVzRKYK3VJQp8pYMdRP
+WJvSPkUC6yfgo8TaPYeVm8M4U8zoX63KtAuXBawHlTIaFDLIde4ayka1lvZ8pZ0NHlx29aIGtWeOvB1f
+w5o9eiSFMJtBKGH8d1F7dM01qiyUjdEmI1JGNc5o6U4FANfe4GER2mCcP3IUgGiIFjjgQWCrXlrQgR68
+6IXC4At+4A8GCIBACIJgCIFQCINwiIBIiIJomAQxIIERYsEEcRAPCZAISTAZpkAymCEFUmEq+9TKgEz2
+KZsDuZAH02A6zIB8OA9mwiwoYMwtZl1+NlM+ZVAO86ACKlnnq4H5TMnQ7tMIC6AJmmEhnA+L4AJYDEug
+BSxEB/fClbAR/go3wZewCa6Dq+F2eAjuI3rYCu/DFXAD8SLecC38CTbDYThJfOAO2AU/wY/wM9wDe+Al
+OAKPwFJohe3QBq+AFY7Cy/AaHIPj8Cr8E9rhb/A6vAGPQgd8D9fDO/AWvA2d8DV8C1tgGdhgOayALuiG
+u8AOF7LB2sGG8lWwGr6CNXARrIV1cAlcDAfhbrgU1sMGuAy+ge/gCRRxM3J4JWpwKwyBjHq8Eb3wJhhG
+QB+8FX0RMQDvRT+8Cw14Dwbh/eiPd2Mk7oVf4b8YhfuIL/HDWDyARtyPU/AIxuNfMA4PYQI+B6fhXTTh
+E5iMR4k/MeDLmIKvkAASiFPxOKbhq/AJfIoZ+C4JIsGYie/hCQzE+zAX/47Z+AHm4IfghAGchV+REBKK
+Bfg1LsN/4XL8Hs7AIJyCz0gYCUc7nsFuPI1ryfPoIAJeSDhcQw5jD+Gxl/wV15EX8VLyMvwFLyPH8HJy
+HD6HL/AN2EkiSCSJItFkEokhEjGSWGIicSSeJJBEkkQmoxavpk6JOAM/I1NIMjGTFDgBH5NU+AA+hL/D
+P+A9+IhMRRlvRwG3YAiG4WScjqsxD19AHnUYQdIwGK/AUFyJiWjGVEzHLFyP0/A8LMQuXIF9mI/eOJOk
+YxKuwqvwAXwYXyTP4ia8AQfwz7iTvI67cBAP40t4jGTg++Ql/Af8Gb/Fn/BH/C95Gr/AP+GXeBKfJ8/g
+x+Q5/IhkkiySTXJILskj08h0MoPkk/PITDKLFJBCUkSKSQkpJbPJHDKXlJFyMo9UkEpSRapJDZlPakkd
+qScNpJEsIE2kmSwk55NF5AKymCwhLcRClpJW0kas9HhG0klsZBlZTrrICtJN7GQluZD0EAfpJX1kFVlN
+1pC15CKyjlxMLiHryaVkA7mMXE6uIFeSjWQTuYpsJlvIVnI1uYZcS64j28h2cj29aYr04yXkKF5EXsCL
+yRHcQF6BvbCP3EhEOACPw/P4T3gM9sMLcDk8B1fBw/AivoX/hqfgadxNboInyZ/IzeQWciv5M7mN3E7u
+IHeSu8jd5B5yL7mP3E92kgfIg+Qhsos8THaTPeQR8ihxkgGyl+yDX/B1/BzDyWNkPzkA18Cd+BB+gkPo
+xB/wOtyO2/BavAavxx1wMzwIt8It8C/yODkI98MOPIVPkSfhNngAtuGD0A834kH8Bv+KT+I8rMAiLMFi
+LMUyLMc5OBdn42KsxXbswIVYg9VYh1Zsw2ZsxAasxwXYhBdgJVbhfFyKrbgIW9CC56MNO3EJeQrvxH58
+Db/DN/E/eAc+ghJOwhh8lF39NUwADuHNGI1P4zP4Kf6Mz+KvuAf/hr/gLfg43oZv4zv4GDlEniB/Ia+S
+1zSFpZbWvl6rWLi0x7rKKhTSpVw9+7/X3m13aApXWFp77N2aQnuHvdu6XF/YY+vuYEX4Imuvxau41dbT
+2reivcu6Rl/cZu+1tLZau3u54k6bWNJq6bF3iyWtPXZLr1jKAIil7KW+1J1VU6pA4Eq7OzSlChRN6UqH
+rcve7a2GDBWutNeiLe1V8OJL+3rswhzLihUWrzkeKMxx1yvOTc/LzsxkQZYSZKeLczMy0nIz+blLLT1e
+c0fKkbJysYwhqClTG1ymoMKX2Xst3vS/Npu1x+qwOXRldhUJsazX1tVm9SofqUiYZ1m50iJWKEStsKxY
+2mYRK1ib+Yo2ey+p7BOrlMQq9pZU9YnVDLJPdWdfd4elp29Fl6WvV1Ot4KGvXmHtUHlRvcJGX3mrIXvp
+Ve3osjg6WZVcTaeN1Ni4GodNrFWA1DIgXG2nXVs3Oy09LS0tjUYyXJFMVyTLFcl2RXJckVxXJM8VmaZG
+0tNcEVfN6a6kDFdShispwwU0wwU0wwU0wwU0wwU0wwU0wwU005WU6UrKdCe5gGa5gGa5gGa5gGa5gGa5
+gGa5gGa5as5y1ZzlqjnLVXO2q+ZsV83ZrpqzXTVns5rFOkZ5rzoPoaizdaywcPWWPr5+qaVHrGdMEeo7
+rb0WsUFhfsMo5jeoQtig9ocGpR/4qaFLFL0bPPqH0EC7ptjAZFJcoGCxYAQL3QJXMXFBR49llZU02bya
+R9LFZvZWXMhK6he6exK/kOJpUbqHxcqSBbbnQ28ZURQWBWWdpdvea+2y2iwai6oyLG6VobP09NhXL7X3
+diqxNvvqbiXWZW3v1bMYW9TXsGjfSp0atnV7u2NLHVZ+qbXXIiztsrcu92r16P2tIwqotdMm0pQuK9/a
+1bdUbFNo3mbt6rVo2myWFfbuNh8XQZQWtC13dFrarJq2blazaFU0llXRWNYRjWVVNZa1u0NjVTlkVTWW
+1YMjXtYL+2yrLF3W7larzurotdG9BG2ctdeitaoqRGdd09plWdG2tEtst66wdFl17bauLmvbUvsavRLr
+sbb2erXbVlnZbqFOh9DBlF6HR7M7RpReh31lp7WH76T6rdNDAjutlp5eodPe57CKNtYuYlumsak6xtbd
+a+3osXQt7XVHe1d602iPw9pKdzfobN2rlvZ1dVl7aUwhrLete5Vjha3L2m5ptWpsqr60UX1p89CXBs8H
+pdE2twa1KRp0mQeqy6kG9V5ON+J0d1m622ytYpeizLoUddqlqNOuNnuvpqud8YrvsvVYhC57d4dD09XL
+2MhTaoorbN20G6zoc9BNSFQ0fT3ilOzdSkfptqy0O3p7KPnEbkUzd/eJdqVz2kd1TrtCNcFONbPePqKf
+7ap+tnvoZ529W+Wc3r7S2q3QUG/v6e2k9LJ0edlH9Le40uqw9lq4lZ02bqXD5nNhn73XShHocVjbxB4l
+Tw9DzqfHuqrL3qE2hOvptGt6ehWxdSgNcnj0bIeV7ofxdlhXWbtdYsSIJDioZhLZ/+k6NzMFx0pLm5Vz
+9HVzvZY+vpcqrV6lA/VSpeXd29ljdQuk0vjeHpulo61bCbvalbCnVwn7Vop9Cin7RpGyTyGlT193m7XH
+0WrvoSzR9HXbqCZVwxIlLFSfC9Xnogw1zFTDaSxMz8hQQ+V9emaOGuaqYZEaFithVrYaqvmyXaGaP0et
+L0etr7hEDUvVcLYSlqSpYboaquVK1HIlWWqowitR4ZSocEry1FBtR0mhGqr4lij4ZqTnqeE0NSxUQyVf
+RrHyPjNXwS9zmgI3s1B9X6TUk6XSNystXQ0z1DBTDbPUMFsNc9QwVw3z1HCaGhaqYZEauuCUqGGpGir0
+ykpX4aer8NNV+Okq/HQVfroKP12Fn67CV+mQpdIhS6VDlkqHrHQVfroKP12Fn67Cz1DhZ6jwVbnJUuUm
+K0OFn6HCz1DhZ6jwM1T4GSr8DBV+hgo/Q4WfocLPUOFnqPAzVfiZKvxMFX6mCj9ThZ+pwlflOEuV46xM
+FX6mCj9Tha/Kd5Yq31mZKvxMFX6mCj9LhZ+lws9S4Wep8LNU+Gr/yFL7R1aWCj9LhZ+lws9S4Wep8LNU
++Fkq/CwVfpYKP1uFn63Cz1bhZ6vws1X42Sp8tV9mqf0yK1uFn63Cz1bhZ6vws1X42Sr8bBV+tgp/mgp/
+GoOfkabIWUaaQqeMtNw0NcxSQzU9N0cNc9UwTw2nqWGhGhapYbEalqihq/7ZSpinwslT8cjLUMNMNVTh
+56nw81T4eSr8PBV+ngo/T4Wfp8LPU+HnqfDzVPjTlPzpSv/OSFf6XUa60s8yMlR6qHpmdpGiJ2YXKXpi
+9uzZJUJft83erelTZ0B9K9n4o+lT56p9o+eqQWOe2ajh3ec5ge1jE9g+ZQK7WhnGVntMYFe7J7CrlQns
+GpvXWo9hbi17K16kTGAvcs+KtHQjdWqbvVfPItYVK3vX8hdZe9kl0sPD4Ad/4A+n43Q4BIeEowB8OYDc
+J3w7/AHfOvyF3OeZJpaPpIrl46Qne6Qns/QL4E14E7qhm5TIx3CFfIwslA/Q0PVeTCH7xWJypxPMkhMa
+mkqbJWneQfCdP88p1i1scmZFOpOaW9qlrQ1NThJvOaQFLbS2mpZGGo1OaHZCsalkLyAUtxSlONHslFra
+U5zEbDKajClOziy17eOCgqGo2BlYLLW0FA2QoOKigXiu2EmK69dITm+TkxQXW9qcfM2avYSQ4pYip9Ea
+ZaRv9/oGY1GU5CTFpqK9gRhY3FJkckJNk7V5bwgSBpA3O7lkZ3BxE4XnDCkuVjNESm2S85kaJ5+wcG8S
++hSXtpY6xdImo5OLb649v8loMkZubZKcNTVNRmdBc6TkzKOxvOZmaUDJbWlzJtU0GdUnyZlG09Nozmdq
+mqR2aetWi0QP72mJlJwSTdPTWA6N5bREtjQ3N0c6SbzTu7jVCbVNTmAn/Rid3sWR85yTaGzSPMtBf2il
+OQ4KsLS5uc3S7MTk5ma1Bc1SmzOk2FTUnOIUzFKp5OTjLW2SU1Nc0+TUmIqcWlNRpNHY7MSWFKfIyO3k
+kqW2Ac3SIokm0uZGKujT/51CS2mrU5hilJzaYmmrtNWJyQNpQryTT5jf1FITaaltbjI1G5slZ0FdkxOT
+IyldVFRSnBqzU1ecvBeIwmat2akzFZkkJ5iKLE6ytN2JrU5scWqmpDh1Zoli61vcepCHpRKtwVnQ0kyz
+tJQwbPXmvTpfKC4tmmJ0C46XebQgeSu1YLLJCcVOPr5FKt1qskhtKrEhkjLEKUU6C9wEc3LxJkuJAsJn
+guLOuJomWrhgvEK+ZtagfT7ewJXWNBkjTcbmKcYUp595gJBSZ5ulJMXpb3ZiiyQ5/YrLaQWS089U1Oz0
+p0+1TZLTn/HLYJac/owo0kEeWreaLE5DcYu0tUVyGkxFphRngHlefdMA31bSHOf0sZrWpDgDzfPmN82r
+U15GGpvjnIHsfZB5AAKKG5oGAgKKnWgpchqSaZdzkviiAT/6nz+JL3JiiElycvE1TQOUfE4+vmjrVomC
+9Z9iNDnR4opHKum0CIlnb5qdfsVznP7Fc1qcZDSzJmDhAECgqcSJxU6YuRcRGbeCzTAApLS+yRlgKpJK
+nb6mIqePySm0FEktj4eFIRggEIqKiigFgkxFTrQMBGmTnVcnR8Y2pzhDzAMQnJziDDUPIA3DzAOEhuHm
+AY6GEeYBnoaR5gGBhlHmAZGG0eYBDQ0nmQe0NIwxD+homGw2uejvFFvm1TeZpFQnXkB7S4rT7JEY4k68
+UElM8UhMcCf2KImSGZx+yRO204mW/UpTaTs922c0D4CUnOKMNQ8gDU3mAULDOPMAR8N48wBPwwTzgEDD
+RPOASMMk84CGhpPNA1oaTjEP6GiYapbymcBONUstzrAWqdjkxJZixlJscaZSmU0zO6cmO6dOSXGmmyVp
+jjQBN02WPBNV7OfMEUlbn+Fi8YCvWEolzpk+ZUDA4NKmtGbWykwP8kyUJ8ssZTPMs82g5ik9G6YTk8fF
+hb6HkMeA/pXMNOUNZGEwbWuOWcqX5kyAvxOKLXkpzlxzamh+ijPvt7I6sbg1L8U5zTxAICReSpXmUJXg
+JPFlW7fOMc0xWaSmpZFU65qK9uYhBgdNSXFONzshxBlqKnLy8U4+nmUb8IYip1dxsnVrqkmS8rfmpThn
+jM4mpSr1OUVTkSu35GyhOqVgftM+XhKkyH18ghDRXEQ1rb5Y2mpiJUyzW5xi8dju2kK1nTIq8cUtbSan
+UGxpq2ly8sWWSKdQ3EI13dgyFpMkOfkE02xLXqTJqS+eTUcsfTGD0iKNB8Sk6FSxuIUyQ4i3OIWzanXy
+CRSJeIoEF9/SpmrSEVjNKc58Fy0kSXIKCSotTPl5Kc7z3ElOPUufbZpDgVIuznSTkDZGobQT6ptSpXyT
+kY236kvJKbhZ4RTjnUJ8mefcRWHieNKucstERX6WBybFLna10AnO2Ca7WFxgNkmplIqznaHFTTWRtc1N
+Un5z6kAaBiWnOAtHpdZG1oxKLRq37LlKFJud05PPBbDE7JyRvFWS8qmMbc2bOKtTLE51piWnOEtZk6l8
+JiiUtzi9TUVK06mAmqR8KdWUp9Y/2zyg5+OLXEX+oEjP+Z+SYtomqsfyTXmRRg95MTareM4xD8D0ZBdV
+5poHYEay0aTSxZQ3mgRlZicEK91+L9AeHpjqzJmS4iyf4P088wBgUKAzd0qKs8LsnDYlxVlJqVhqklKl
+2VtNFhe1qsxUoJ2VySnOavNegNnJKc4a815AGplv3ovsTa15L7I3dTTPnOQUZz3NQyMNNA+NNNI8NLLA
+vA8AipNTnE3mfYAs1mzeh8q7heZ9qLw7n+ZDGltE87HYBTQfiy2m+VhsCYVZmpzibKEwacRCYdLIUgqT
+RlppnrnJKc42modGrDQPjbTTPDTSwfAqSU5xdjK8aMzG8KKxZQwvGlvO8KKxLoYXja1geNFYN8OLxuzm
+Ach3M3Ale3IWJKc4L1Sihckpzh5KdPZUlJzidJgHUM3Tq0Rpnj6WB9U8q8wDcJ671tXsiZVYo0RpibVK
+lGa/yDyAaoZ1SpRmuFiJ0gyXmAdgpru+9eyJZb9UidLsG5QozX6ZeQDVDJcrUZrhCiVKM1xpHoBZ7vo2
+sieWfZMSpdmvUqI0+2bzAKoZtihRmmGrEqUZrjbv9WIzW6cYuZcnXGmTyRhpbG4uSnZqrU4urmaNa7BO
+gXkH4Wht0wDidc1OVNTmygHQFB2AqsJ0owBT6EOBdy0/W8zRJmjDNIJefdVIKviZYqoYI7BX3kVPBT1j
+eMbnGd0zYgEI4DVlAAxFT0GB+x99dwg4gJKBONw8v8lZsLmJPreVDCTR54NaUF5ASXPkQCJ99YR2AyBf
+sLm13pVA/wq868lcPk9MEiMEwXfKQRze6OSvHSBQsk9oE6GkBOD/NwAF6MIVxFACAA==
 `,
 	},
 
-	"/resources/SpartaHelmet16.png": {
-		name:    "SpartaHelmet16.png",
-		local:   "resources/SpartaHelmet16.png",
-		size:    1926,
-		modtime: 1497199870,
+	"/resources/fonts/Go-Regular.ttf": {
+		name:    "Go-Regular.ttf",
+		local:   "resources/fonts/Go-Regular.ttf",
+		size:    148672,
+		modtime: 1792239993,
 		compressed: `
-H4sIAAAAAAAC/2xUfVTS9xr/+VIp5cvUqdipCL0mKvwQ0xR5SUVSTA8ZM5yWAgKiwg8BE0+sjCKymr0o
-U9PM4ctO0sx0N1O6mptynbe0M5qZs2u2yrnMulFqOfIeprvb8fac8/zxfZ7Py/d7nud8i+mJOxxgXjAA
-ABziYilJAAA4W9JuNQAAm0yLLACwKhRER9PjoqMRdAnEE+RyAcDv7GdnEgIoLLd/HuIdN/ykXNUwhlzr
-ULnLNWLnq3Oq1U+E0snzHiFge8SefmrKyCA7tP9Y75YM77fWNFSgDxeKvB97r3Dd7dpq/JDpwKB0sFvq
-eP1Gp8CXpkdUtn59505RKDFYpyHTo4I3Zmfd/fzUfTKJdGxkzmzcd+VvqdNN0QKzefGevwcSbkPzP7qw
-ztiqUqtSvxDuvvqDrr0eNZHfd5Uw6aY2KV5XBsNhsIuwHGpaunOX66xXXoA9pmHcCzNQ/HEe7mqm4k2d
-76UT9Y2+jC65KzxqXVCRwe6Irf3Duj2DwIb5qTKP/Zl9yX0933Rkow2Prg2DVcezvFF1Rt1bvcKKfsD8
-jytRgWjTa8LhiNTjKqeHPmsd5lTMZ5i7opv/qbyU+o7fj545QZ1469zEr51xIUgrs9rJ5F0/+pUR8YPx
-EWUvbi40/nzjefjgQpfb+LeFmO46ED0LZUgcDJiMnPWjFP3+9zZWX/F3JeV1qwAAsBfHpkgBwLHXklaa
-AMf1AGAtFjCYMmbCTjwHEmJYmRCbi5ELxYAlCHK8XCgWcmUshFyYK5Li5UTk7wi8SIq3lEEk4neILIeI
-ZCbQEdGQhIsIwWzFYJEkGAKBIEgyefgkCnWZLsnkEZFZMpkYD4IFBQWYgmAMJOGDQeHh4SAWB+JwaEkm
-Dy0tFMlYcrRI6r0k8ocOhSvlSARimQASISxnFhvKlxGRyGXMUiwZyQS8P51E0uVncSAhaOmAQRgs+CGa
-XCj+MEvOEi+RSH+yCBYpfEoSVwrl5lsuRQoPJYD/V1xJiIaEYglXKrX0Qpbxf62txDM/ZMD8sIFcKMZH
-S7gsGSRhQFAuiZpbKBDxEQlclgwRyYEkIkQIJogAroStUEiAMgW8QgpLxiXhsEHb0NhQdFAQAxuODwnC
-B2GX6H/B/DEicMWMlhYAXN4AEowA/m+bSLD2wfm9AGCzLY4SyQj7iMgQxu7e5UmaHNM/Gx4+3xjKa2Sc
-zDibUvX03vZtP13E+gW6kVvJm+e9tU4Uv0NHKyiIz6IO4XSbjvSTN3/pa5/Sc+q9GzyOZ/PxbXSe8faB
-mefhAfqX/zbVmLqGpBdNNXNptJenSkoGNBpN00u1Wk3sbL43Ve3B6Sz/pZMKr+r9NLbxm25/5wrnwor4
-sqTEbTfZj6vTt5wfVj50afs0HQWHb+poor0bHd248XT5GQo7gn36t4OL3KIJJaVFFbU52sfF3nqaQ+UM
-JV6Zm12bPaodubUPFRA8Xl2uF6FAp/Xx8XOCsms4Wvo7RdduJcXdFpjbXpreykzco9EQ9e3cHPV0f1d9
-7K0Bl7hz8/iYMCqJuJcvEuYMl3ubR7Kdaj3g1S5hfLuGB6gW4iQ1+elwjnX13XyXybQYB7vg1K76iA1X
-zH0zVnuNIisjLizsF9bIDldYaPnU3PgU0uckivbsxROu1vdl3CdPAt1L3yQ05sfvE1W11+W4b7f+dsHz
-i8yUZr54zK+3KopWl6wnVl1uTrrdUXXmGD4OymaWn563DxghD2Vnk+zFLdeSm5qba3rej3N+ZZ2p0bni
-UMpjHR0vHj88DG/oOEs/vYMWwcy6m1NyLiT9x1lxmGLnY8X+jiSV+hWKGfrdQU+ND0bJty82jv5sk2vi
-aWPqTN9nbb1seORfUvCRmucefuHChUtp1/EM59erzfa+We8XRmgDzRSDwdHV4FdaL21q8oJPMnBeXy02
-bBf/er94DllSdrBIoz07zX5b/y/NUe2eE1p5SMKB4xXq4FkH/9bd2BtUKlWCdZv4zhUxaa54vKX0e8Pn
-trbnknWakeezZqau3CfOb2tub2RgZ/56zjRqra01x44QmbRw5w69id7W1lbg4e7ezE47rEwvprAFXC6X
-5lwLUdbUqInU+O6ET2QKxZjbyb6aI3+3C3/gWzoVGRMTU9/eXrDmqb721QXj1C1afLzOpmVDKK/tfuL1
-BzNvFstRnuvO/2A0QtZ0p7VHpr/uybDr2UG+tMpzgKVsGZoQp4m/TH5k3eiWOxZrq+qFFcUQHXtesa6T
-X68Z9m2ZJKVp8y6bF1f9ZuWZodCKdX0Jlk86LiaR0hyVofxvAAAA//9mhzjohgcAAA==
+H4sIAAAAAAAC/9z9B3xUVfowjj/PObdMSZk7JZNk0mYmjSS0DCGUEIaitIQOGhVDFYIFRFEUlKKxrAXU
+2BDs4i6KYkHs2KLYV3Z1UcFedt1lV10Vhczl/znnlrkzmQnsft/v533/P13WMPe5mfOU85znPBUQALJg
+DVCYPWla39oX/zDodQD4BwDMnnfmnKXPX997CgA2AYiN8847t2TqyXn7gdTVAMD205YuPHNuX7oaSN0+
+gKyMhXPOWQoALiB1pQBgW3jGBafN+uC3mwCkqwEPnLdowZz5uT9O/TPgTwIADFy0aMGcrPG2YsCfGgCg
+dNGZ5674x835eYA/zQZwFp2xZN6cv9+77FHAI2sAxOvPnLNiqXS8634gohcASs6ac+aCJ97e1g+IOAAg
+MGzpknPO7brDdR+QknMBvNuXLluw9No/3nkekFF/BYAPgAqP4gYQAcSNYgQAA9p/6XtwGgEbIU4JtH/u
+BPLwZLjgsP5XKCkpKYEowJGYVK0uxyvl9bi9BOCOT/8CIDSKuxkIB6T6nwJOV6ADgPKfCkCguQDwEqwB
+CUYCQCZcjKvxetyHR0iAvEZeJ/vpxfR39Gp6F31HaBWuK2ov+neJr6SoJFRSXtKvJFIypKShpLFkdMnS
+kmtLbi25t+T+kgeDYtATzAmGguXBPsFTgzcGfx8iISmUHXKHfKH8UHGoOjQ2NCe0IEzCrnCwDMpIWUaZ
+q8xblltWUFZaVlM2oKyh7IyyNWWXll1RdnXZDWV3lT1Y9mjZ02XPlr1S9mbZu2Ufln1T3lAeLR9ZPrt8
+Xvlp5af/CofJkdiRIxzbTCiBO/F6fBsPkVzyKnmd7KUX00vp1fRaeo8AwoaiNUU/lnhK/CUlHIfaksEm
+DueW3FpyTzccTg5uMHFQQr5QXqgo1Cs0NjQ7NJ/jUJIGh8kmDhvK7izbauLwRtm7ZXvLvikfYuKwoHzx
+r3AYOQ545OcjXx556cj2I+cdaTkyQr1ZvUm9IvbX2JexL2Kfxz6LPRxb/eV1X5Z9IX0hfiF8gZ//+vnB
+z3/8/IfPv//875+/8Plznz/2+dbPf//5vZ83fT7882Gf137e9/PKz7o+e/Ozpz6b/dmsz2Z8Nv2zqZ9N
++qzps1GfVXzm+izr02WfLvh05v4V+8/ff9b+0/cv2r9g/9z9J++fvr95//H7I/tL9hfuz9/v3+/db9sP
++w7v+2nfv/f9Y9+3+77at2/fH/ddtO/CfRfsO2XfSfta9s10vCS/RHZrMvb/5/9IxMH+g9ANGwSi/0SO
+8jtQ33sCiCCBDDawgwOckAGZkAXZ4AIF3OABL/ggB/yQC3mQDwEogEIogmIogSCEIAylUAblUAGV0Auq
+oBpqoDf0gb7QD/pDLURgANTBQKiHQTAYhsBQaIBh0AjDIQojYCSMgtFwHBwPY2AsjIPxMAGaoBkmwiSY
+DFNgKkyD6TADZsIJcCK0wElwMpwCs+BUaIXZMAcALoPL4Uq4Cm6AW+B2uAfuhnthC9wH98Mf4AHYCg/C
+NngYHoLt8Ag8BjvgcXgCnoSd8Cw8A8/B86QUlsF8WABtpBxWwF2wFE4n0+E8WEyWwhWwkSyGc8gycg4s
+hPPJXLKAzMc7yVlwBqwiM+D38DSshXlwFmnDkWQJmQdnwkWkAiisg3a4Gb3oI3kknwRJiBSSIniKPAIv
+4hDSl0wm1aSGvExeIWVwASkmYVIJl8LVcAlcA7+D9bABroNr4Ua4CQA6YBNshtvg3zgL58PZOBvn4Fy4
+EBfgPDwVAAgCgAgiAAUZqqIVAEAJ0IVAEMkJQAi2CogEJwHIkigABaqIkr86qASVsqASRBCgK0rXdK0R
+4TBEhTUABHYD4F3ibqBgh6YJ26smnxj1i0gpaQVCBjUDAP+tOBgnBqI+ICJSIHSh+XkDTmyJugUBQLAL
+dlni3yrZ/dWRuoiPUiWi0N0fPpHXmfvuu3gztWOlurfrIBA4RSghK6VqjktpNChSgSJCK0NyCE7kv08W
+ZEk0f5+HRvi/y/M6czvzXs0TSogz9jP7w/dAHoBwkrgbAlAMf5iw3TX5xGhllp1ghoOAE2GhhIIwqDnb
+RgihrbJIKB3a7MokTudg58SAhnc5+5hBICWLur9rQkfLJRSo0J4OnAM2OCe2tESLAIqLCgsgAIH8vFx/
+js/rUcx/7MXVEU+4XvsToUFPhEbkMP8T9gSpJ+gJXrTkT7kfnfCPvB+Wrl6C8pI12l9zf1yyZon625Jr
+0HaC+is+uwpbVuKz6mj2Z6V6/yrtJzIIW7Sdv/JIO90vjYZiKIMquD4aqCgvK8nxedyy4EBKEZA0ZSIt
+QiATJmyvnHxiNCCiAMJlQMiQZqB0UDMg8m04GAxqBYBQ0q7DJYPoT4EiNUD0pw3A6JIJAMVQrOR6FMme
+Xy3WhSrqciJKeXndgIH1dRFfrd9XXqHk+HNyfF5J9ikD6waUV1BF8nmJ65mPPn/8zze++Pt5Gx/ZdXVd
+YOvSp7c/N3LlvOdWrpy2tY14Vj3X3vFyxqpVo+6a5oj9EHTNumnJ7Z8FV2KgaueYk8ejhLur778E+FZa
+ASA9zGU/GwJQARF4eML2wOQTozUgALULtA0ksNsk+2IQRZhDEGBQM9hs8hyQ5cHNDn1baPSo6vaODWS0
+yW2Wd403opXs76IAYlu6txz63mqJ5gPU9qupKi8tKcrP9bqzMmzaJnOyTYYRxAEDI7WMTuFQeb03J1LL
+iBUOSek+PySs2Xh4rfrlg7dtfuCBTXf84d6W8eNPOmn8uJOo8MT1Nzy+dTP7aMK4k04aN/4kcfehQcIp
+93e++uADr+1++Nybblx69o0dXe/f3/naAw+8tvu+xTfdeNrKGzsAKAw68rGYKR6AAiiD3rAkqhS7bZSI
++V4iEEQqUF25lIAo4hxAbGwGthUlpHRwMwgCl47hTHaKAEEkKLaZAA0mwEiY2BJ1AVT3KgtDARTkVYZk
+e151sEIKh6DOVVZep2Mtuv0D6+oiLjnHX1ZOJR8jAB1YNyAc8nkFHPfSzk/VvTjrxKc23/iQuuv+++e9
+/MaJ56kzXnl5/1sdSy/Zc9WNJ6xC19s4fdUdKzY+fvfvDv5Offe1i/8wWT20b+mZb3yKZ857f+P7tHnG
+g5vuna+dsWOFDBzO9VleNIcg02XAVRnfgVShugq7oP/BWiGDFMc+1967RN1LTpSqIROkx2TE2mrRV04U
+l7veJ7H/kBP3xmJ7b7joojuvuAF/wyIsxN9uuOJOdYP6L/Wf6gbtd0xV95IK6+/w1OW4FReR6way/0zl
+v2LVKvGeK25QZfVL9QtVvuGKO/FsVFDBpdpeuAYloVhYABJkQQ4UQTkcF82xIwI2lYeCRfl5OW4lyyGR
+TByn7REn6DgOZQLtAvbXEwCAMRdwSsvjIRex51ZjhJaHy2Wxvl6UZMmPfr84sKK8AmWZDqwfWI8VFZ4c
+f44f//XHfrklRX719JISHOu1O0T8R0mJehu12zwYmDHtoUB+nkP9ftqMQ5n5/nxhgXpLJmZVz5l/u6co
+kI90/tyRgaJCRf1d41CMCm53jrpuaCPKfo+HAMIimCeMEhrBCRXRUoooIIhcrYsiP+aGioxLTnBytSzb
+A9VlSrAuqESUoC+oEKc6C+/pwHvVU+bp/+1QZwEg7Fbvxl1wEOxwyg5EAtg0Ybt/8olRxSDN4GaKhAwj
+ho5QKBIgl5mPmbQnfabpRycA2MGueAV7brWnbmBdXbgu4nPtnj37oHr3+MDX3oMfqGs57xcRSRhNNgKF
+omggzoRWwlg3ySp8dUHfIrqPSB0dfO0A+DoiUOinrS3DeE3TUPG/8hPeYflNESWo7EZEVI/o9uWRc8lg
+rkf76L+Lf/tlADCIoehgQO3sd52X+Jt8TH3dfps692pp+m8P8geTj3wshMQDIEM2+GHSE/5sQijqyiMH
+KI0rD5gjoK4yvIBACdI282OuKLIRPIrTLlKQURbtOdViieIKlnhc6I6UKAPKKypIuETx5vjJYNWPf8Mv
+pVhhw/NYKMm+Yc+rX0lyGXVQW9evXb/gdvUrsmzI0oEXYSB2VcMZEQCEPQCCSzwAdhj6hCQKVIDx2joz
+EQFIK2P+YDIxYP17A5lostenMPZG6oKKWFemBH17sEF9hRBs6BA2TLplzaH7OzT6ngZAt4kHIA9mRLMz
+ENCJBEgTIsEJBmEA4oShrQLTqlQnDBCENvPjBjqxhXM3D/Jyw5WiPa86UjdwOBlY5wqH5Dr9zJBlSQ76
+Tht99xlzX/zx2j++svPFb86777g/9iJTtt2x9az5rz6H2SsemvX5rnWv39T43CvbtHXeeuRj+qp4AIKw
+MJrjQyp4kdAAWyYCAoog6nwsBAIIBNuAUmEOCEJjMwCIc0AUh4vcehCAEnYqGnD605HixJaovSIcDoZL
+JXtBdX1djj+HszIcrquv5fqd4VCiuERB8Qrh0K2F2fefhL1ff3ri6D+8+O9Vj2+/Arerb+EC9eCnZzuf
+zBBHYekt97bj5is/mfbgUPV+NbZkqvrGW/8GAALFAEJfcTc4IBNmRB2ZSJAiEGJIo4iEIggEFgHioGZm
+Jg4WJgaiOYAE2+NP2YMGgckjQIYTHOBQFLci2/OZaV6HESXiCysYVMj22BJy3VOvvho7H/fjPTfSDV2r
+7lJnkdHkek7fezh9d0MJnBx1GDQlJk0FQBBwIViNdytNCVCBme4GXAJNK4OKUhliNPXX5URqG0ndgD4k
+HOYaMFJbRHzeLBIOld/TcenU85tKq1tvPnMr+UfsxRFrX//d2JabXztr0MXS9qH9TzjvuLHLJleRLx5U
+5zSc+8DSix9bNiCb03L6kY8FKv4TvFAErdEsF1KiIFBA0TQNCgFAEEFgUkHmACGNzYaxMBwNDIDQNhNO
+fzqSaSh7aShcWhpkGKAmxCQcAsUFQWZMhkvQFQ5pZgBWXfLWk3sOfPK5GsOB6F13wyPz1auJ/+CaW29f
+eZA6Hjy4t0v9HttxKl6GTwx6cHLXqbj0weu2fMB5cIXu03LA8MdtCMYGVLi65GJg7vpsfe8tTNj3jPtc
+rUcGDqzHYF3QB/RA4ekXYEdMok/EXh21rWsf+d1TT3U9qQ4GCvOOfCyMEA+AB4qgGkbtcCAlhjr0cW0H
+AI3NhmLkhPIAAKFA2oxPGYGeqKqsLBXs+dUYt5G0LVJa5yrTbUOfN8cvaVTiJjde+eEvrz75hfoPnKR+
++8k+9WcSfPeK5We1r8w5f8a9l629Z/71tPzmnb9/75Hr//TIvWrs6e8+eQrFA1fe3rqk7cSpl/7jlCsX
+rNl82ekzz5+py8BHVhkAFEQFKbiQUGqRYqACtFnMRF0ahhMuAwiigGKbCac/HUmSZICZvXUD3JFaLgP8
+flGCLsMKpr25DOz/HAX1DfUfl9zw6Hw8N/a3g2tuvWPlL+T5Bw9+eBgV9UL1IfVCdfygByfRu9QNugxo
+d+Zdwnh+d52YfGcemnRnTvnEcmtOvOXyW3NdxLf7IPuHTjvI7srczuDflwmX7RBRIIalUSAalubQZmPf
+D+aKaJhgGB0FEgpEuCwVJKNo+sdMZFuiXr7KTCHT6bDb+Dplbk2wdZp2ycGDs2fz1R6eZFoncbtLAukx
+AlhbXYboQx8Ko7rK6MexZeTaeXgA/7lFfYqoTwGBIiwXrqUXcrr2jdZYnA2mWSNwsyaVe6Au6MO6oK9I
+WHp4g7AUy7duxa1btwKkWocHsQ7rcBH9uKuMXBtbJjSqbtVzH44hOAYIbAWgv4gHgEI+LI46spHQLBRM
+vV/MKAZEgDVACJ0DlDZqnBUNnhfGISgQgZI28znnvEbTfCHf7cp0mjQNcpoOqK8fWDcgqB9jXlmWJJ8P
+r+7svHXSdvXAQ0899jJ+sGvMvIbOTrxq4M9rjrtyQ+dx99eqWx5fUdu4btVZAARiRz6WB4gHIALD4Fpt
+zc46DxFtEZQJaQrwv9n1v7VoABUggE0UbG12FCkSWSRtIEnGFpTl+PWsChAkASW2BW12wdYGdpHYW8B8
+TQfmFljWsKGlpaWl88pLS4MOe3F1mRQu4RuyBBQvhEMM3TAJh+pcEKn150Qi7KoWqXXXDYAwB4nU1nvq
+y8Mhwj4Wvn58m/qtej0ZgOdgLq5Q9+zd4qvM2PHOizjqjeJ+/QuvvFiacNkf1Zd+VF9WvVj34+0bXBWn
+jL7m2amX10zBk9GB55F69Rb151/VLef0il6sbtmPgDdSGru0pGgUFjzxDfZTM9VdX6ifjyUPvxxUv/jg
+WiDgYfEdcTfI4IDmHQ4UIK6BBSAokEXArvciJaajDARoNx7yzznjswDsNpBBVhRFYpYXRjBMma+Hekjt
+H0mvLzbGHtr4Ae47wG7euFE9jQwkFwJQuA9AeFfcDVngh2KYs0NGKhirKNLNpYUginwPD2rm3kDJkMcC
+0LwNC01A47GmiACKC/Nz/T4lG7IgMyhrRn5Jjo9bUbIkhcsiJVxxhut0a+s+/AhLf1CfxEumRJ9+T33l
+Nqzc+c/PH3gMr7/zy5fE3Tc/qf64aLH60fo5E+s2n3D1LXe1Y96qEwHh3CMfi2PFA+CD5mi2Awn6nIQS
+QKDUcqrFVX9c7jyAQCiSNuNTJmBPlIZLg+xUK5OCmmDVir5S4+CvF8d+rP6oXq9egpfikvfRob7z+BH1
+JyxC8dMfms7Gq3EVXogbRnS2qS+qf1T3qy+uBSCM1uJizm83HB+/eVhJa6p3b9wLSyy6PQvA7XIwVktB
+UScnW1JZhK2yolyjIPm7ulLdrP7chVlou7j5gw9uE3fHhqvr1cWbsQL7onjjyaMA4XkAMSDuBifMNBhu
+2HK60h7EHCRiK7vRDhZ1hiMBXGgCGo8bRG11Cddd7aKr/Xle6N91F+mKCfQqcfdmtey22F83A8IuAOFB
+cTfYQbd48rqvQTQMYH/y14uGBZypX2y5/OdbvngXPdD1NdkZG8e+1HVbbDYgzDrysXiReAD8sDjqZrLi
+RJF4meAjUMEQfglFEKgoLEwjNwWAQEQkbXFAiwBxWvjB7w6HwyFGC1QEjVUlTJBKNDlSwnXiReqv6r+6
+fsPrcTnaMFt9dX/sI+yLgXO3HyTbYyuaqYQrsGPUS4tiHeqf1RfK8IKNgFyWgpx3Yw1JQhAoCgs1l6Ak
+Ev2qlvCAtvIHDVRfYSK32A08ooSVoHLfHvLhe+/FKsTdsQ1k6aFB5JnYcQCAsAqA7ODfq99Jcyki6hcD
+AQEs0pLD9hUCwYXxB6nlpC6ihOuCvrAS8a16/HEy+PHHNwvXbt58eNlm9p1edS+t5jpqvHE/oeb9xK3d
++xeAsQq2e3L1C95CYDxpYSBzGNSUlidCiifENja/jgysY9+ruIIh75Yb7zj9EL6lFt3/1x3NF+0UVmw6
+9G+1kOO8C0As02zznXaJUmLq6SzkxBYo0a2eLO1Gzj9oIPqN2AEOt1vhN2IlomAEMYjKrk6y8E+xB8kz
+6i+xNnF3rJLsjTV2HSL/jLlB46/QLO4GEYbGbwLcGU5mmzcBhTtAzM/Mu4AIoqL5ALiv6b495ANx96H3
+N2u/VxLF3ZABk3c6bZRSsDhBgAAlazScRMHAKeEBaeUPGoi+6TIgw624jU2HGFTCCoZRuQ/DeDPejcIr
+6hlvqivE3YcvFy44NEh47PB2YfrhcQAWGbZD4xM2Sv5TsrL9bpIVmeCict/b5PDj78QkcffhK4XzDw0S
+zj98JQAQvuf3iwfACX5o1m3ruNdHxAS3j89w+8Q/53vak5nBPD8Z/ky/LIITnRL3/RiGhwvKapljNBxi
+LlbqUV9TL8HVOAKjuEZdoz71t1jsiy+7DpfhVmzFebhFPUm9U71FPWUzHodVGMbj1afVj9RP1CeAwP0A
+gsr3mcdYbx5QQEKRKV1TM+oE8gOAQEFYaMCIhjxkA3iUrAy238qCEpeJYG2OP8fnCgYVdnoMKA8H7ycF
+X702YlLscvUnpHjvth/VX8XdA5pnb4upsd3kLzj9VqZyZ6l7pYXiAVCgEMZGHS4Eik2IMO6/OmArS/m1
+UZRLKyzGm0vMoQYFI7UgLWxX7/n7vIHqB+pleDE24mhcq646OKPrr590dX3yJeLa+5fd5sTH8AScg79X
+W9RNqrB7Ca3B3jhW3anuV/erO3G0fvZu5PT0wgk7JCSmlZOvm1MWK0cyDa5ci4XDgSTT5nIBeN0aYRVv
+UOZiyDS8iEEMKop+IwzeRxbjbvV9/Keqqierl/79g9fuekb9s7hbvU7duyo2gSyLXUuzO+5adbrm61px
+5GPhY/EAFDM7JhsJupAAAaSQyo7R9BodTg0yA5I249ORlN3Ow5Vc3dXXCcESdvWuKFVcuuuC3cp5KEwI
+l6zAQuyPw1Y++7p6ESn46V+//qo+e8Hd7/zteXX/b+q/B/zx6n8tXjJs5BoswOuHvzhvX8vigaOaXr7n
+1a+5fgYQ3uF2TYOukwmabgvTZegCJExiYGGCu1AzWtkuZk4r7hi3qRnC02qGWL1586H3AWHLkY/FEnE3
++GCgrgv1kBliHH32maYM48iXecpcDHlPhDljNX0fUbySzAVuy57I2Llbd9/15MS2q79T31a/EXd3nXT7
+DZHjn/kr3dY189W3mhZgLvbX+FIKIE4Qd4MEQ3ZQjKuqTE1VJbhkCbQnqGMJJDdXx0Gm+yNIWmOde3Ev
+vvJY7CNx9+ERwq5Dg9h35AHI53LdPHKnU7Lq5myCRFfKOjX5JxTa+ScNNK6NFbemjfXvYhcBRLxJveQN
+7Isnv4HNeNwD6uw/qjvVB8Tdh5cI1x2+ULjs0CBh+eGrABAKAMQhfJ+MiNodsoDCf6qWneB0u91cLesL
+YCsoIMHYwV/xOnzgtdi/SMGv6inqaPIp+VvsRTI8NqRLJTNjWwEQigBE5vO3QcNOWUD6n1HaBja3m1Na
+0b5aIf1jB77H+/Dll2NvkDbaGptGtnVtiV3PvmsBgLCKnz/NOyUEREKsfm9NflP7vRd293vbwe5WNLzr
+uP8Ng74FNBDLod90fU0DfxDWbd58+OI/AMJZ6l78TaoGGULRYiAMSzoPEkJ78V3hUTS76Cy86pNP1L3y
+tI7f3u+wxkduAgrlWmaCk33KQj7cCLIDArYDwHktjys8aCaGMUIG36bOve1qKaT9jqi6F98014Lso3km
+bkNI4lpQCXJLDd9Ul3/yiVT967YOqbqD/57jyS56oXgARMh7RJo/OppFEcYjApznVthXPyLBaKRhJguf
+/XwfbsSNW4S71ULap2uPjssZwjkADBf+fo8xJ/bLfHVBn3BOR4dOi8UiJcOl0SxOqn2/ibyGOv9+hv7w
+DnXXVyLFEew9Aq1HvhXGCzOhAEphw4TtPqa9spDQTERSiIKUhyAITQHrh7L+YYsGXmz6LXSvzYhmGVlY
+WdTMhkC0F4OQEyC4M4MDaCFo0TAxnKFQqTccCgdt9iLm1QiV17mCESP+UJvjU7yR2nq/FA5RpaKEOS+o
+9Ojj6x8lp/R59w+Pv/bkS9sXZeZljZ5y5agR6iU71/7uni3tuPSS52bfM+Wu7ar3D8KZJZiFeWTfg0Bg
+45FvhQKpGuzgh3U7Hdxtjk1aGDYAojiEm5tzgJAR2lHDZGIkNRyAxckggjC42XL4BFJBNFghWlqidkUp
+DYV5gAAVPeQSkgdGat0+F4RDFbISwdYrjsPAktlrbznvna3vqj//3HpqZyfZFfsgHx955O1BX6kf/ah+
+ru7Oy5DqNVmYeeRberswE7zQJ1rN7nfeuC/AODxHNFuskbB52efRfoho56PC/NX19PYHt3+k/hP9v176
+hzvnq3c9ceeqzcEhOPQwZmDv0nv7q6e+9P5xXJbY9+7l9MyFy6MZXgTq4Cceu6t4OVEFYYhJgBEaZUTk
+XmaTqEkgojjYpCADSwXRYIXgRHXHiWoiIoVDREOO2crUc8Vo9SuDqujkVMWLSXWeOmn72/VfYcWPWISD
+cvHAb+/ruB0WZoIAPhgWHSKiKEhIRNIkIYIgorAwYUXGYgB8HreSnWmXQQCBe4BYwISij5FaGQCREkUO
+uoO1tPbnWC55+6Wdh9X30f45PoOx6gJs20Q6sK/6nlq9qQEb0K5iTezbYepFGp+LAch50mjwwryow46U
+OBBpPG6le/CjzSIa/vGhzTJK0mBpYqCn5w2SZjeDF7yKJxxSFJu9oJopvkidbjuFJJ+PRdce3rAB64dP
+GTdg/JDPP6dbO9bhpfmbw3MuvLmjS4tNzFJPoZ8KM8EOIbg16tLkoQglUoiiRJs0FVJi7q4RJicHN4Mk
+8UDaKHFiQEufKgVBGJosP91goyFAIBKzBJPA40AtLVFHuIxvPdlemFpKIopLkiU9xKHQ/CtGqV8ubV17
+y/J3f/8eOv8959TOurFr1e+ff/i9W7EJt+NveeqqbW8N+grD36ETy3K7vjsCP0383dAHpuAczq+NAPRr
+qRpcMOWJbGqJv/vZuWfGmkZY7p4pn5iXTxe4wu6QfvmMKMyxrYQ1DaJs7Ny8B/2v1jfdf5NUHRvzlfpE
+10/krUtO/WdsBACBDQDYJu7jMYHU+YlDm1PnJ2qfp4+0sNTEuohvY+e7HwqNh18S6YcfAjnyoDoLLxH3
+QQa44lFWYnoxWGKcQFuNhDfONTIXCBnFqJAHVEQBqbAw+VlLNDMzM9OV6XKHPCFGB1pnmLmuYIhFU6bX
+jZkx8FX1hZPf/Eg9Z4O7bQtblDpL2vLhhwZP/ilVgwPGaL4NRItjZYi2mLjFl/xhDz6OMCobO/GMZ9X7
+8KXv1TlSdZdAsmNHYi+T3rE/afJw5FvilKohG4pZuAgBm7TMQD3pYCRO9IW8lBke3iJSz1RGH1LHDgRv
+7xFVOeUjlk+r6cTJg6dEcnG9VDPtgmbhiI6TVMDP9FVRe8Bro4Lm02ZbLUfiZzO3p4c222SRapadcRdk
+nzIQnEOQCVwcIu3DBn6QuXjBQUHIHXKHw4rdXsgI4Rs4MFLrH6gJJmWHOJNPTTxPXjX/L80nr5yPpZ3Y
+sebSV29cc6nQ+NZVLV9d1aK+0PUdOfjMR7FK7f+NvSPMBBecGd87DCEPP8cQ2drMfRPfVkPTbquhqbZV
+qn3lS7evhMa3EreVdk4IjPbMb9E7WmX5CqsrhflRlOwMT6bH4kfhznUtrlrB82kUF6n9WD2C9LNPUVC7
+vkS3+qP6Yx4OQAFl7Ke+px5Sf1L3dOAf8HZ1lnoKMJtGXc6/PwtyoTWa4coWqCBmIRWITq8AjwATruxB
+EDRThIncMOCHK/9cFOMWSwJES9QeLi0NKS5+toY5cQxTRTHtF2zt7DRtFd14UZeLr79l2iqm+cJppi6n
+e4WZkAl+OGlndhZzJqO5XCaulLPXPFfZBhyGfLn8c0FIPnl1CLbcsLlc5to11bvHVPnU09lp2gC6USA0
+HqonMw0jIFapmwVg6HGyia/3Um2RGRnMk0iwSUYYH4hmyAiofUQMK9nNcwo0MTVuU/G71tDmuF1mPE35
+QNtrGbwMJjMcMhQO44Lfp8vnSVv9oUjJshVMPMUnlU9iA3h86Ft6NdcJfaLVWUgwO+5XiX+FxTatDFfo
+jpOcSG3dgIqKcvPs93n9foEnwJ770gNf37E9hnet2HLd3a9fsxnL/vrGPeU7Zt+3qG7I9I+wf8l9/X93
+at/InG9v3c7u90e+Jc3iAPBCbbSvF0UEBJE0OeyEjBe4F50iD+eLommZKh7FzRMPRCnMdmFdpK6eWx78
+sCbuIQPUn1av7vz++5rjegUkDx5HLu740vNLR2zLumlOQLiW2aRCI9cbmUg09c5sUQ/VtgAhMFcUiHZV
+0fWG9oiHXTQAbTEB65MG65O43nCH3fwcCtbx9Ua0dbIz0bPpT+p3nfXN99/Y+eZXOIFmxAZccuoB8sLh
+lwAQPAD0J6ExtX9laOKtX/97Wv8KdqirX8Pe2PiwukBo7HqMNmvf4QQQnxIa0/pXhib7V4xP0vhXPB72
+PwxTREU99OIrhzr3fbVVfffVb9QuobHrHVrb9SatO/wSHde1E3TfCv1caEzvWxma7FsZ2tyjb8Wj+1Zw
+jvrAV+j66Qn1cVz4pfqReog0ElDb8ObY/tjX+LjaxL/foy7nNJYhwMI27Mu1LwGeTKq4FXbWBg2vUYe6
+ejdW4OiHYi+9LTR27aIjDtXz3zMegD4hNIIdJqXzmwxN8puketCj52Q8OTP2KB0Ru4Fc0EFzOjq6vtP8
+DHnqXjJIqmYVQtEaP4q8ShJECmIbUBCosMDYzUPIxD41peFQSTC3hMXEPcY2HjAw4uM5SX5WOCGzPzLP
+TYr4QuV6nkQ4nDfsVpfHs2db3eiTMhXnDTc4lcyTR9Vt2+PxuG4dRt66a/TYE2ZOueoPHWMG5ow9bWTL
+c6sueq5l5Glj/XVjOrZeNWXGCWOPvxMA4Rl1L7ZY8re19NUhkJC/rUSUZ25nvp1ft7F3zlX3khviOIKI
+TSACEBF44iLBBUZ+4xCaiCNqyoln59dxDzRHuN5AiwXaaw2EIxFyQ+Mt2V7Pnx4cMPqkDDdD0p1x8qgB
+D/7J482+pZEMunv02BNmTDWQHHXicxet4kjmDhjT8YerJ888YexxdwLCItwkjKLsZngm871M2J7Fql6A
+okjoMgAiiLAsnnsPAmkBQoS5IBBhaiBayQCRimsYJAFhTTpQlocVVEqD/DBj/hxP2BWsLSZMP0dcwVBf
+Uh4OdXVg9qnzl44MVZ/fof546vylo4LVK/BZHHtccx/v4+qT7D8ABH5Ul+NOoZHXJ9VEe0H8BmjeAoaR
+iSnqj9AXrvN4lLDiwZ2d23I784TGV19Vl3d9p+7FSprDfvc2nuezG3IgAGdGc2VECPjd2ZkZEkEPUkKb
+EKmR4Vegu+3X6DHtxYkh71SPhdaEuHcO5Hh8Sq6mdctJ3QA3K3XJ4aUutcxq9lFJcfnJ+W8e+f6jee03
+P3L6FR03Xj7vxx+3eXHIt//A0sKnnpIqtzQ4up4cdeOQbcKKHPVC9YmJ2ll/AQDPvS2AM6PZmUhJFr9j
+I0oGAiUCooggISy0JFhKktxqQ1keLPPKCwIUCW2zgBoADfJE03j2Ksx29nLjWU8C4zmF2qnL0llZCnHQ
+d8Gexx57Y1fbhSvPfXLv3rELyLxto377suMv739edH//b9QDHatvf2j6Ns2/dyGW0V+Fe3kdzqhoNMtB
+KPgUmZEyF5HQJle23SbAeH8OQQHHCWhuMH4ED6UTCwvCwYKKwoqaapHVHcgVfDdV1PvZwur9XH/4ZVYo
+Ul8bT3fEMzedcNvw6KYTNp161lmnbjphU3T4bSdsaj3zzMXzL79s7tzL2vHFTSdsmr9o0fxNJ24aPnzT
+idrPJ2yKrrxs3pzLLps753LQYwJCnbgb/HD6DqclNycoIZWR14jZEO0IDgSeDjGo2YkOx2DHxEA0KPJ6
+slSQDKbBwVLGtDSFHJ+iKFoNWYa9WAvFsH8xwv8N+vi/CnYyKed/pn+FIwmOekSdoX+wF1uW/f5s8lVs
+D+kTKzj798vU+4HAC+penK7X4/WKlgPwTLt55iYbQlMW5TGDQYkoL3R0dKh7yVexAmEJ+3+Np2vVR+mP
+4gHoBQNgatTtQ4L9wjlZKCYGyszMACNeRnhAz4ySJWcG9An34Rafp85IU60o1XRnacRw+ni1gJnMg2Yh
+1KDYx2t/um/d3TM3PKJ+ev01SP9008uvX7fuzoevePeOG+9Vf/7yVlw7vvWiT8Z/o3Y93jZ/8qjmxqaL
+/nRzx6nX37e7751DT5t2xvH1026/6Notrds2P03umdc6dlbdrJNa2+do+A4WKTlb3M5pWMu92xUEuXtc
+i7PxIsfU6YtcTdZFfCz/c/C2T7aJdNu2bdt4ZRqIf+FxHj/0gdn8t84IoyT2KSWylIuCTJsAgYqs+EEE
+SRalNpBBILLQZkcCQFrs/EZnQyAwhV3oeldXlAWLA3nWALnDnsPXELQGyS0/6+m0bCsZFOZ27Xfq++pk
+fBRrsRYfVZvVVzETz1evUg+ph9SrcRnJufz0HV9/9mzL6tzVbRdtvOPKM07HHdgXI/iIOkl9V31dnbIY
+l6EdRVzO34qpVyLp+92TL36dK55X9+j1128dqtF2Jh1Idov/5P7/6yds75/s/89N5f/PNfz/DDwMFNju
+ajPiADIyPrSYQQABpgSiFVYoHguQBZBbgINCHJJFAMKlHh4BcBYZ6caWCEA8AFBRUceO+0gtfj1/4cyz
+cGa/8xeeufKcHwfbs6X+fRfkZZW/Nfc4esOsxVO+Ov6soUv6v6t+cDKZ5pk1ZeMFczju58A8+nf6MYjg
+BNsOVs9CaqvtRGZKGmVE+ne2seeqy/Eq9S/mj/Q69Vr12vH4LD473vxJ87tjpdCfrgCRVd4CEmbqEMKD
+OPxqA2SSNTnFU8eSRWbSjzvIVx2x7eyXIJxIJDL1WOuOTsRDWt2RkCDPYaiCNi7RpxahZMOmDJmIEpFE
+wmwLKjCRFsBuE+xtYANJtEkLnZpIO7lIO7hI5+Yyoa6qLC/NDeeGQ8GSJNHOPIpo1yuRWp9X9oRpWKHM
+9gyHjybW3q4fr/7hxr0LbKV/fmTMUQX6W1ryyoYT1atxovooPrJ5DefBPHG74JJGH3scjdFxHt0mbmd0
+JLCMbiYv6Xkyg/lv6GNJhmE0Mh05+rZPzodhvxRDZjGAVGE9FW86f9tDF1740EOrxp122pgxpy2gmx++
+YOW2bReu2rZq/rgxCxaMPf40bV8uAhBGCXeCE7Jhrh4aiZfYAbGhKBFRMyPshp3RIwQ3NHIyMgAysjOy
+szLNDDQHz86LF+axdG+tOO9GvEedFdtNP8ZKXNaBy9RrO9Rr1b1GzLGJ7CAvSqMgDwZE+yfVTxk+zJnx
++84MOjFeHuXMq/Zo5VEDupVHNUUunDLuuicWb7rhyusePHHVgE1F+CzZsXD2aSMbzj/7nrGLBl1+zsQV
+J5Wffd5CbR3LyB3kz9IoCMLwaEPq+qjkwqiZZhHPCeJEve7JWcCOPj93d/CMXEvdk88rax6PZTnOi0d9
+cfNVQyOrr9s56/JLT8PR32LGa/fPIM8ssdHKh0+ZO7l1anvtwtJ3N43s//TK6wAQThcpiXKZLOYS5Y3X
+7SULoyeCp//wlbqLBWjVXYBwo7qcfio0ghsujDozZILgQopEj1L6iXZ/ZUkfglbxK4rDRLOWXH/MHyBy
+zEcaIJanDd2esiCaO6S4w/yy4Ym7McJ1TPMq4Rs1T0bnLb+PRjuFRtOX8eYnNeopgDBf3UsvFndDJozf
+gYwVhlEiUMI1IyFashF3xxofxtUlMG1peroUpVyLv/NK9gjTm2EF//3IJy98ieef/Xt1r5CR9dETj9z4
+m0ta/hvP47iJjsFrhEbz3mnW1Cao0IjvpoN0zMGDmhz9Xp1MKAAosIjzycEMTgcSSvSyEBbqFgCX6clm
+bUAJoS261U/JlEC0RIMQ1qQDadnpDpcqLtEe0JRnRNZrySs0P1sYC+Y0TDx3+fQxE/oMXfjMma3R41p9
+U7LHzwaEzWQHzZBGsVhatFgvjIxXQM4gE83yRyc7XSKKXFemRHybDxzAdQfIjhPJc31Pbu5640QAAuPp
+QHIX13Me6H9UHZfgq3bmVCfqN636G5ddceddV/3urruu3nDtejrwuTvufObpO+95+rRff/rlF02nXQHz
+BEg+b+sR6xHL+P9fwczoefg79TysZD/OxavU5dtwGS4br45WR483fwIAEFjOu9SP51y4wAN+OINzbQ4T
+JoHIHgRiR2Qxb5nI7XZk9Ae6xok20XYZEBDJCRJPehYpLgJCxFYHikSc5PVKEoDX7/Xn+NyK5JJc2VmZ
+GU6HTQYRxAxDxXPqYp1RaOjDoMKdb4M7sYXOVtvwhqtXrlTnYs7h64Xo5YdfIJPJwf7LTug6O7b9x7OJ
+L/av5T/iQjwjZpPcv/2TS6WBjx8CENRlcFZJcVGhgIITAR0oAdXVq4BCux0lICCRNTYUqXgZIICEsBgI
+pbxvCW2V2W17UkFBbi5AQbAgmBvIDeTnsQuPP1zhsOdpMlg3sL7eVHW6e0QO+kTmYeNY0sPR847fdE/r
+mtUXXXLnk3Xtfmw/NFuIXnH4hbJObLnw7MWNvzv/xuOW112+5Kqbq8+cf2EZQ4ks1/HlvFpy5GNpmHgA
+CqESqqEvvK6dzogiAAoiK7uoziZEzkIkQZRsUtOE7aWTT4wOycZMR+ZlGda+DjKrYJWJQz4BCKCNYJsT
+bXaUHDZpEciyozULHbJjUiDKXJ0Z7dpvgP/8F7REi2pqiopq+tb07dO7qldRZVFlRbnidoeCoXBFyGUv
+1g0I7XxI2MZBdnwwknYXkJVnnYv5u/Ztu+n08y+Z+KTaFan5adMpwhQmMFetWqXOw5zDX3CBEcctLd+z
+eUTtsxdeU3hh5N4ds7asPdSeUnSAwFPqZKGM67t8iEaHZSERspHFHyQUuCNpjVkqpbkg5wKlo+jElBVT
+3Meje8mC3IVSojAH2sC6Olrf2XlPy3b0PPThn15Wq3ZNmXe85gCq/4nVTL064f5BePLjK2qHXbJyCQDV
+anzk9bzGJwsWJFT5FIMkSu1sU7Un1PvIZvqpFcJa+SObWahuALY5zeofW1L1TxgjSRVAW3gClKUMCG/n
+yVDJaz09Ya0lIEpiuw0lkNItNgEk9WpznE4AZ5YzKzPDXLE9ccXUE8GkFa/jFkHSitVd3dbshnNZVrIk
+soUbkfUgX5VdJt1WbjNXnghjXbrNXHouX7rb6c62Lt6RuHj0RDzhirCchID6+A8f/dDxOnm9OxJvvAEW
+PJo4HkVwWQLtK2UUwW4T7W0gZTiIDSTb4gRMnCYm1QA2wQbCmh5ecZo4FXGcipxFhYFc3kMoK45ZZhJm
+/IrOjKAIv3qyWFsylp0Z28Y1NgwqGm1f6XxI/ykB40e/Ky8Ln/VdRVnoLABBxzlblzc3rEjAOiij6LQ5
+qASitDABXbuJbimAJEgaut1g7XE8s7MZptnubLfiShK/jCQ8dWdJMm6/MOdJAi7ckZIggzmQB2WwYkce
+SiYWpYBgk9HWBhIIVBIWOVCWhzRrCcx2s0iHOXRkSQZpTWp4u1m1kxvIBwgW55cFynL9zPerKDnlipNV
+0WCkvMK0S2RJjmsByxXMQ2qnTBw88tLWU9tHNUyfYcHR0zB16pChU6aIu21DR7XOar/01NkjG2QLykum
+NgyZMnnokOla7aLMegYQcMOyHcRSPc48HQJQnhAmiyBrFWxaLq0oSq12G9FTsMr0woCF3d4w4XgqlkIp
+dVO31t3K43LYi6r5WRIvLmNdyMgMvLurgD5I98X6kLNi15NYV//YYfUQGUyuP1xn1pzh7epcHmOZzOsF
+K2F1NLcQJbGyiMiSFwjImUiFDCRUNBHSCqjajPwpS+GqWUXO83TKTE+Z8YYON9IK1xJ1loYrmBmsJ3kG
+w8lGcH1pRXnqokNvRdw4bla/vvP5lBWIY02zeX1gy6upqhEBiFb/J6/nd+yzdFXDpLAduB5MXQtoM6q7
+yi2gWlneooSqQJtR7eUGYDrTvG/bkyoDWVqupTpQnasdUDq/pIk4Immt52pr7QWSLLXbUQb5qIutsML2
+uNp0HoKEFXsiaFlxbIh2QFlWrO5KWLMHVu9QUJaMPVLFl+OwkR7WbjfWXpkAnGrxdmPxuXzxngyPy7p8
+Z/fl81PKgkLXOeYJlYDFG28A1fHI5rT3wMU6CjaUMuxOKoMkL0yDgsNEIQE4VQ2pw0ChyOViSLg8Lo9b
+SeJDZjIiurK2IrKFK2oDibmalua3arJDXm/1J4V0ASZSe7caRlNwSuJARjXjojhIevm2VDTSMEb0qsYr
+mWgbpY1csgEIr6n8UF/bIm1tYUNeidzD4oIWqKRay6PLs2WFTJ71FaqTmCxbl8gMLQJDAchBXZbPSZDl
+UlM8U63VlOKQFSxpscciv4nL5fKrLzl2mS67iatmlhXV6lVN2V2mL9kQRyLJC7st2ZTakBUsacnHLq/W
+hevyaiz8tW2fbjMXrYsqEO7LPpf7svNhtm5+S4gygoDADkd9ucBSRm1GikUREBCRiAstoAYAtxy8ALxZ
+pJcXrTmCuunNcoq6V5GzHfbwnXemqiXHZvUxcv9mcmlySbmatxmMOveg3AR2KIRzEys7g+w6ZpNZdwfR
+aScyiPJira7HYWa+pIMhrQ4zGabA4QBwFDoKC/L9Pq+H3960etCMhHpQTwqz1agRPSGluRqvG5XmWs1V
+oFoNKd8DzEOxwLxfCOz+hkJ7vJ5U5v4jyagnLbbAaI6mxXEIXvbhZ14m1svT6k239VBdyg7NtBWmeD7T
+M0evMxU/wBHd8Drd0I6CKLTbUEQxPWIlVqDUmAW0OIrmebFiZ+8BO+YHTosd11DHhhzTXYn4FcN5OwpR
+NOPoYY6BXSKJeNo4ErKBZzABSkc0DsMRLdQRLc4tLgjkd4uCpi8T1pRZWnRX6trtWDF+4404zk0c52q4
+RD+zLTsrgwX6RHlxHGcz+KXhXJkKWHeTxkE56mEd9erc6qrKMhYrK+oeK0tLgBRbNC0xFqfYs8dAmO8s
+O1nQaZOty3sxLNdFQULBKTuoiIK4ME4WM8yti0IClE6POIxGj4ICjSIFxQXFRYXdZT+jJ3roh0RaGrA4
+/rHgrJ8nCGfAaUJUGAJOkB6zUzR8zn4e5D0D16qr23Atrm1TV/P/U1e/m/jXNgZiyBTvGRaAMLRGFRuK
+EA44URBENxKz4VouT2BF5A3EBIHMkSjR+2zlYNyRaTzgLbZcrFeM3wce8JS5y1g1tb9euwL56yNyjtZr
+S/bkRGrdygAuJETx+hc99BSuxujh7YsffOwxda2668dHTvvLyp1fxKZOX/30F7ETyvbvxRk4F7e0t3/5
+ofqYulk9ac0adJ2Dx2P4x46zV6hPq5/8cisA0WqM5fXggzyj3j6ga20Q2lPUGyc+Tao8jtoVDys+NoNH
+3YqPmf7uVoBMPtGuPqnKkIUqHJG0zulGBhlTThIyL2GKhSY9Tl5pVk5OTl5Onpctl/XiSLNcTwS7LRef
+0O496ZbLXXQJay6EU3cELNq3kK9NlkiatSc/T158dk5OTmFOocJXb0tLbI+uY7th8A/z1pMeB02fajhk
+c7oXwhx9+RIKdtlGRRDEhSmWX0IQRBQB11gBEzDIyc/PyckvzC8sCJhscKRng64burOiD7/2pMSi0bgD
+8dpqeT3YIANOSqquzgUq0Ham8oV2vQGIkXKd9IhAu9lcwmO3A9gz7BlOh1Z7LVtrr5kr11p//RUTF2sR
+tnG90PpdiOU8XuozuhEV6D35uEOPCmSh1pvBsHdTPdbbOlNNofg8riwWPS0LKrJemJSi9cUeXKb3vngD
+e/PeF++Iu9W/1TW33qPK6lv4Jxy/nv1uhLVHvhWukUZDBWzQcugdLNDuQeTNz/S/CFrNg1dvMMLdP1Gt
+ikEUCC9qMjudm4/NVHuj7imQ/LTB+lRPt6+AiupwdVgr0xmgtXXT05pdQcOXpCUA8/CW5FPW7jyAF61b
+uOSpB9Vrtl/y5LKXz/nL2mVzXzlvlrD6yKvqP9ddeWb9qGlXrnlSfab+wUm3zT/h3rnnzr7uopl3zV11
+uA8AUK1eWhrN86Uq4W//VcV0Ddjs1G6ja8zKabt9SHO68mmt9HGg9R25+ztpCqoD0QjYbfZ2sFFb+7GX
+YbdEsyorFKMUW28wd/RSbBZt6bEc+1ym39PXZAsrcUQ3Gn//X9G4twPtNmqzW4hssx2NyPUJL8ndX0pL
+5QFgs9vaHWin9v+IzO6ysrLKskq3QWrnsZKa6ZYeSb2DKZueSa2dT4n0roGYXihYhXZbMs3FpkDyA1l/
+oNO9j9NB/nPCD0p86z+hfB2nPHv/PyS9t6ysrKasplec+BnHSnzDAdMjA9R1+uF6NCa88YbBA/Eg58Eg
+zPqvZH4A2LMyiZPanYtN2mdk2OY40GZr7IEDTBk3Jr4rp3s3LR8aHGiDDKctoy3FbzoGduSXlZUNKhtU
+X1fbr2+f3tUhgynZx8yUVPeoHhl0TorLVA/Mak9wh4Cg8+xpXU/VwG//naYyeJXpzKB2u62VkXzQUdhV
+b5A23Uvp94sDbfwlarMv/I8Y1KtXWVmvml411VUJKivrmBlkXOx6ZMoj2z7Z1hMTuDGn0156ndO+HzTA
+K/8V7fuDHZwOu7MtEx0ZaKMOW9tRu5kMTf3OsZExGqjtX142aGD/htqGmqqyfuX94mQ81n4naXJLeyRr
++JRLWcSzfXbDtGlDhk6d2pNOaj/1VBb7vHQmC3dOGTpkmn5GSPcJM0GGKuiLx0/YXjD5xKi3Am2kHGWb
+3w0UwYMiCk2BCduD8WeO5Ge9uz2j+rMUHzNOtbRoXxZ2oEwRUAaWrWMjc+zIKpQFRBTnSMgbruvfXQkO
+GRwnwLHA9+aRNBmAytAGDqDooAvTvxCN9AQLJigPABlvtbCTvm/vmuqqynA4HA66QyHtsImrrhLFF3Tr
+uTYVUlwOynVB8ARd4RJyZIK69I2rMLDhFXwuVhDA829/+OXn1PduXrlz9Y07yLI+c1du37H7xb9tIt6u
+I/Az5pH9D9BJq3nLkJGbGr5pn3PvZXj2JW3TZ98zRW1ElvU3U53Me8WUw+RocwFKYnkhkSVAkD2IkIFU
+cCKhUlNyDHhEc8rYblgL7CpaYFfuFtdlAfpu/WU6buobD+pOffrCKxN7zdRKM81w7i3ZV9+e1HiGar1Z
+pNG8N0seXBJ1xLuzaOZFNUgylSW6xmY2a2FJBSk7tgTMGDCV2m09NndpieYA8PFT1h4v9vQ9Xph5nKbP
+ywpmGadu9iJcpucnJeJ5ZXc8a+woS1SSrYhKUlpEzQAylY+KaX5ODgC7oef6E7B1pMWWuUzSYavO5lfh
+tOiqu5LxLYTrolkBlCUDZ6rj3JuF1DSk7ceCdDz0TOX2+BupsS7gWBfmFOYn4+3sCW/NOEyDe+wu3S7s
+Cf033gBBw198Wud3IVyfyHFmB9Q4DAx4oE6WWm0oSYPSIm9E9KgkL3QcBflQPmd63DmTRILM9CQwTvp0
+JPhq2yfb0qC/wcgrIkf2AuA2njcvw2KN23lAKFACa4DSIYldaswuroS2AwHSnvA05QNtEFiWda6f3sGG
+3WQ3dk5km1JrYmP0S2sCIEF9TWdra8oXkRK2rjVG95bkRfn1mW4UaNKqUj7RlpWuuQ7bVxs71QNsBxlr
+4zHqI7cDkJP42pxw0Q67npPElhiQBNJtjWLCGnP5ShicsRTjeZpH2iq1lvtOwWkz1inH18n3wcZOdbgu
+8fHlsjvPkc8ASK74NKelE07SW9DYRJmy4kgBzUZEZsP1XAEJf272IjKbrefbbGwpNqfN6bBbyGY3lqPL
+5MZOde22T7bxpWSasgbTeS+4RdALauG46MgsJwHsRZBSKEWR0qbMDILjjaKWIWyj8C0zVHPwa1umf9/e
+1aGS/FzFx/rMYgU77cJ1tf4cXibrLyJun0tmp2F5PalnFpy/D62gvNFLMCThgpmnVlTMOPXiMyL9x80Y
+5XRe8uwFg9eu+eKj9ssIueSST+vPqcodO7O1353oRpf6r3XrhbsH3Jpl99xQmOm5ILJElJpeO6D+tEE9
+dOutN99yBOn1HTk5/Vomj8rr2qPOxZvxlj/x/jCsj454EFxQCk8ndtKptKRK2u3iHBl5qle8vY4zsb1O
+jQaPSfCJnXXMd6JVSSmZ/L0UwFobnkJFAVBKldJwsIiFE/1et9GWJzNVW55UUTRLq54LU1z1ktr3iJmJ
+9zuq9fKRRvOcD7+hgUqZzhQFXKP19jPXnhD2DaQKi49oThX0Zs0KrOUVtjStgHjzxhTtgPBupqTSNwUS
+rsAR3XBZquFSZmOYCCKu0XrqpUMmVSy8GzYBr5fho9VNWDGyp8GIBblTYXQh02w9I2TaByZOAVi5I0+P
+rDDUylmwOiVuCWHrQOoAeBJUS7RQRy7gDeQmoedIg54R2E6F4mRdIR4NyzfeMPEUD3I8K+ByY/fFo9I2
+mzBHQj7uK76XrCHqQI+h7yTglmhYx7bCW1FeGmSTVvP9CThnpsM5xS5Mhf/wFNuxB1pcn5g3rdHjaV2W
+A3CBRpEyHpYWhVZtrmwcu4TodCB1nDsJisf0NSpo0evuYp2Rjgb6QZMK76xtn2zrCc94TrVR/6mdjfPN
++RSy0YdPQhQAFyamefYE0CAe5ZTURv1oc5IW0Y9jNx88eJCVF+O9v/zSNZMXyVFYf+RbYYYwU4+B92N+
+dhHDgUwqCCJpMnuLjWg2+3aNhInl4TJ3mcKCwWVyuF6/AbKwttY4TBs1x9qXlCheP54ybMMFF6Dw6dYz
+2A9q7LP77WTU6egtjZKmueo/SodOv/+OH9CBfaZNu/+O79Vf1Xe/IE34+4tPO4tMUBfcdDIAEK3vkzQa
+XOCFXYmdn1idBkpMMbBrgqUNlBxvA6Vp+u5wcreeUEYWlFaogmJ7OsDUMEltpFqiCoDX41aMXlK2VL2k
+tOSneD8p9TA7A5K6SsVtVistXkukRdDGMBQl/ThLT4yyVIDpqBEyKmFQSk+O1EDd6OHjhoBX8XrcBk3s
+KWnCU6biNIm9xo6RFDRRdyXSJBf2RJ05KImcMEar2hCrhklNGVsiZcpTQtrSkCZsltqYaHcHTQfVjTh+
+TpxcJddnIY8jDXn0NKs4ibpi+jGUkkra2cPpJD7NZScX7kuSHl5zIomtzPQbZKWRPbGjWlkqQHsKvI06
+FhSlhXGoJLyjhT4uFb5cX64/J1E2MlIib6YUWeRj+LZPtiUjnmnmoPJeYdJorn2nmR3Z9OueQIEK+h3U
+2kGM3eeooI/mbrc+aYlm2mxM6xrDQowuY8wISug01q7d67R2Y9K1hrzyvo5SNe/rWB8dYO3raFxI0rRs
+TOzXqBx7v0b5idiYFA0bqUYb8WlOm2w4JaFfXb5NkilLuxWR9XJNpE6uiJQ/Z8UuieTxZWTYbFr6sEEm
+u5VMOgcTSJXB73E6pW6Jn50+ADEqg15XtTihqqoIRBsmlEklVfEFjZKqboD/URkfa6LgS6qheo+sSyih
+epY3UqDQduRbYbr4gB5H+ut/Fckwp8c5kIWDoOcQUohHC/WoRco30saPasHmwP84ePRfx7kZHamrp4DR
+GrKuh2jG+o4Oi0xodZI5cG6CTDCtI4mslIddftvS1NqV61B6gWEydLzaLodl3iiubGtZanKdZESzkRkF
+kqWkc2XwkUdLVmY/ccUVO6ziQj7dtu36hx8y5EWP1/eDn/8reemry8tiFjgc0gxOp30O2O2DjpYhwYJf
+1O40Ql7G60eXm3oHskB98vvHJDx9e1dX9Yrnoxx7nN5C5p6F6AYL0dNLk/isxgJ+PlzLZxd6wQ83RD1+
+lECbpMba2ymIOEEjWDFIIKAk6GpGZNJkkmuY2QS2wAQjQAldYIHWBw1K0J4EI4u6xPFkrxwfeMHrDYdZ
+8CWgCRuf+cj7RtcNqLeOQzx/zbJB4YaJb91uHYvYfNrs7CmFd140Iz4fkUCrei2fD1ENEfhRb8lrCFUN
+CraqPAJCGCUoRVmSmgLWp/bkp7rs9QIEm8BqPE3m2+OyxnvnzQFZHiYbUhdhL9gTXgC7vbu0JLwa7We+
+JbGIzoKjvKylPUX6l4fLvMeW9lSRQN/yih4HUsjyyRrNb22sSzua4riZnAUrZ5Tt4QKn2e98tqOe83p6
+mumOJUaWK/NipJ5DVWQF0SpPFyeO7NNSYT1s9iOf1p9y9iMztHua/xi7gFkwqadAimY9FYtt3MlnF+TC
+0mh20pQKje1hKdE9lWp0RSAl5gkgLdEsn4/Zip6wiViKORf8ApE462LLI3eu2qz2ZvgkTLyY9eL7xwmD
+zNzNOH8KYVnUwxJ8E3gkmKX4RjZveiYVJ8Ck4pKR88vZxHJ+07KJG/w9sarrYf0C0BO33njD4NdznF8F
+cH7Uk6/j6LXiyC/NcpLfLTXTUpIiiWvZPp+vwFegeMImoqnZpl1sUrAuNk/HLw333njDwr/+fH8tTLO/
+ikCQUPMYpRuHaQKkGPd2rHurLuLrcW9t7UzDqjM6O80ZLLeL+/i+Oj3FvtKL6ZibDLiXLDV/uqP7X20p
+dudKtaVO7ezOkabOzlR7SRBT7yUuZ4aHPc1eSoD5H+wlSsM04o/0OPb2xh63krRe3YUj3njD5FHCXhLE
+BD4JTUmhBoaHmH4vpSLFf7WXPDqeqXhWnG4riReYqGnzlMXF3M52Qz4s2ZHLVIHOs5DNWCjwqIh18K9s
+hPhKEoC0IcCLTBCtFYnHA+DJ9+Sz2Ik5Dtieehww6jilHAtMrjMLIJKLOcl8HS3ufxcH6HONQnB91FNU
+kJ8nUCE+4IjqDhE29P1oE45KusF0G3GUCiR5xpHDr7h7HF/DC0DNUfKpxx2d3Nz8apqZR8KCob47lc4H
+1N/+P1GTy2IGZ0jVkAMlsDWakYM2OdefQe02w+FXpvVOlLXZdkOawWbjpiS3FrVMrsFmY71e6YE5M+12
+aQ5I0kjW5aNH2AYrLLM/S4qYu4b942L2J2sHz1wfvrDWec/grWV4BRPTzo6O7iMsvlvV2bnqcM6bJltj
+UZ3TZm8G4B6cpUafD8mOPbUzMMMMZXHIVL0M/rNeEnUJs7G7lpN18fYFZ3R0GHlA4gN6HsyliVkwzJXR
+y8xeYo0upVboIQVGw5Llv/wvpDzVBdPnvDxL1qVJednIfRTWfhl+uFCP8tmQpSuBDHaQ7W1H6zNRlQSe
+qlmGWbnPsPO6FZe1d0O3VhmWG7SVSysSnBQ6u+T1+vU4KXcrCC8n8owXCpjpRyxjaQjbB3xbpGOcnuHG
+UKOyvS357dTMtpAv4aXUHG9hI45KWLpBck5fD9leVhdDOtZvsVArtQyIrxmkM+Wg//8LezOht0istdOy
+NTs7TT6L+1Lvzar/p/Zm+nw0dUNnuq3JsARAeF69VgyIuyEP7o863CiJHpQlI++yVxqm2G2EHwcJDo0q
+PhzCeEF3TaR6T9QafCUxUX/BBGvgzVoVrbMu8/5ojCxKYGSiJ8jC0osNR5DJWNMLpPcHV6/lcw+LIAyr
+o25g9igS0Y5A+G7SKVBpQxkEUea5l92HMel5U3xmU1kcFAABF6R4g1dEFxcXh4vDoaC7NBwuZzMRynyS
+nIBIIocp4zv9efXK+RpO50pJbPb+HMvdtakic8zJHMUzmq2sJn4mABznXQBigaVvkSSbfYt4smnPDaLi
+fYsSgP8P9C0yriG74vz7IrltkWxeNFLmz0pyt/xZIzfazIVly06fHR0nAZX+V/JnzUtIms06pcf0WfE1
+A39t5v1F8nre9WJDNIf5wDUfrUi8vPErmD3O4lnByLKCRRCoKKS76ldagQGBiEgWx1+y3rtyWedVoyUG
++MHvDofDISfvuC1oBm4Ju2mWaBdNNlvY8NtcpP6q/qvrN7wel6MNs9VX98c+wr4YOHf7QdXIHyLbYyua
+qYQrsGPUS4tiHeqf1RfK8IKNNKG3Ip/BKY3mMzir4N2otxJtcuIkTkE/lfs67MQmU5kVmppCcPTBnJHk
+145pTmcfkG1yO3tVK049+sxORr2qcJXi0Wd3sjDSMczuNH1EPc/wjH2qk/UowzyFR+J+sbiMheHaqDtZ
+vgx3hcUQQW6I9CxhVYnghow5UspYDkCohOVo5eUem4RZTJYepWyO1WxJI2nCp3GbzypnfeDNdNNe+9l5
+S0dwOGxztKjjsQlZLdjAQW2ONv39Y5Ix9mU2ud149ZikzNW7uldl0P0fypjVEDyKnH1vIexRZE380aCv
+IWv9ec7bpellTbOikFtRPYtZmQmpS1ibLbWEcS1m9o7mEuboQcJYBllPkrWjM63ierazMy5L4j4uS+Xw
+czpZqrajzSa3xu8NRxejvtZXjkWGNKr2NkSnh9etglcNsh1tVLYtPBaZa4kq4XC4PFxuaDbe5PMYpI5Z
+tT1Lm/pO59H0WWdnpzarebJ4Ee8/XgbvRwPJ8lWcRyQ5F20S683tYJQEmZm2y3o6Lxmatrlgs42yGZTs
+x14DWVpzzO9F+xivGHLa/bS1vtDCHUtl4WBJQf4xCqzWK6KuxOcNh3oS3d9q+515O5Z92JhOgg8/MMM+
+qimr+fe3WnTi61yOa2B71J8sx5XlpWFBHq+JcxmwqqRjFOReGvAxqcEKDfZYtJ8zVxdB27GJIPVps8Dr
+XEcRxDOYs7FnSZTO15yOKMf76Gl2+NkJPRdDCS1BtdLERck9hdMC0VZLO+EeLO+IwkaihJWg2UDnvj3k
+w/fei1Vkxd3GG8jSQ4PIM7Hj4l0XzToL+V2eX7hCyy/kxRakKTkPULscJGSomzUTR4XiXWi6ZQGyKglH
+qqHg1KzJMSsjjPZqyUPCtdSzN94AAgqAuEPcDRT8Rn/OkCwSaiOECfNCAHDYwbFQy7vOcBK7fbB9YiA9
+kL2VAzXY2dkiCIJf8PP22/wft+LK1D2flM97jfhMF6gSVPB88mGsYk9HB2NFR8d7sQp6onp4qzBz1S+/
+/PLLqsNbGS+0O7ukz1vPhbYn/E4Sv8YFrXNymEtWlvWi1nhz5qPAaI2ZASAXchm547dufcm+YNI89o6O
+TjbTwiS85p9Vr0gaIa3XmH3De9cVwxptxdUsF81hlxxtIGc6iZ3I9sXdGndmGDfPXimgk/p3Zpj9O/kG
+KM4oLirI4/GU7Pg2yEru35kivd/o5XlzipT+eG9PSwI/OfIwABknHrDKUxCoXSYiUHExSBKZIyCfoshn
+lBuRoWIBCUgikdoswAaEVgPGi9GYNHkV1pLWIWvZ7Q6jBixlkZA6OWVxEMuDtSwbCJwIQD7SfebzjXUz
+CyplG1XTI1dswvyPetTWBc2+qfeQdSZpz+Bzq448A0CGiQ8YtZKhnub5a0tPMdS/p2H/PRQk1gVZVd0p
+ZB0nmr+jw5RhzZet99Yuj19qiGxvS993tjQRMKnv8NH911aqWaxyU1It1rdBxrjr2pBPXkeZDZu0g7mA
+CWS87C+pLlFPDAYKTCjbdNhECJ4cKyCrpDTgEiDSPuTE1wZdZDjsNqNS1SyztGDIZNmCHGOHNNpADGGV
+ei3ZwX2YN3b3YYa78SOt97JEd0KmeUMvRNFFXYe1AKT3WFoYl+ix1FlneisNtlmdldxXiQ+K+3iu+rXR
+bAmByGwRTYDEyMdLyH3g9ZvcVrI4KI3OfJpzMhU44e3JWIIEZQkSGqDxVCtfzFIUlpfuZgF3lh4RTPRb
+KpEKlszcauBzUue7H5q4CI2dH35o9MXeIR/3f0vfmH2a1fM/jKubDz80ZuBjK583009bWQYXWGJIc/yv
+XDM7LGO3mNRu7GTCyXBU94pb9Ljy0qgjEyUxA+1SfHqJBHbJvoDnXpNWG6ZQF8U2JOy5Lm0MvLumyOcD
+PlxZaTWFrE09ZX9n5bg66uVbbrzj9O/xJbXofoMEf93RfNFOYcWmQ/9WmZCAABvVWbSa20cyOCEX1nE8
+MANtJh5lTtFhqWqWee67HXkyqPWiExaQcFCmf+NQDQm3m2iJVsWVm8Mw6lbLlaHTmOqWE9YZbRRdwdDG
+zs5OlDvVAXVjZgzsVF88WTvoOjs7O39busHdtoX9XZ3F91N/dS/9t7wesiAHlkSzvNz3D2idMVtk5nER
+sd2YN2sIpjahxAqgTeFb1G32bDQ7Ozs7JztHcYcUT4jlp/gT2WEY4P0ZPw7hW2rR/St0w9XKD/k8iw1+
+5EF1FjldGg0Z4IWF0Sw3ClRbPwHD+10siUSg7IKrdxTgFSwAZK4x06JA6/4oEi2JKPFxSzQrMzPTm+lV
+3CFPiG10aiW2YW5PZ8R+VX3h5D7ff/x9x266Wz0nTmppi2XNsEudLJaJu8EBuXBbVLFLlBJwZxBBdKIk
+UP3mXQwiEQRxmdapUuatw5k3QpoLkjRKiitqkQhEFNakhUsEIa0cpMEKoh09TNSyM8EBDrdbm7HEjx7E
+ICqehMvzrk6y8E+xB8kz6i+xtnnGbVncHaske2ONXYfIP2Nu9WTLHZnVtkym/5SqOc736zhjN5zDOs7a
+NYjXi6XDu9RAqkfYtGD/Cf7hZPw3duIZz6r34Uvfq3PKDPSl6i6BZMeOxF4mvWN/SkBf06X0n0IjOGD4
+To3hxkUlSyuSEcze8NYP+N0vA0BflZi4qo2duOZZ9Tq+DqEx9kP8640e9UKzvB5EsEOLkYMe73MKei9X
+Mttsg5r4WOtEqz3mC/FIEoBkl+zamDxFYa1Q+R2aj1e4bw/5oGu+Psjq/fiMkElHviWl8rt8OkH/aB8J
+EbDJKKIiPJ9tiHk8j8SJLhefNOALeVkVFXqLCD9U+5A6JVLGaqlavb1HVOWUj1g+raZT/Z59IU4ePCWS
+i+ulmmkXNAtH5pl5u/epk4VmcTeI4IHNUWemTCg/1IkucSEQCKXCsjgxJORCRKk4N8E5GGaQRKBregKN
+hjWyGaAGVIMVSrOMPEp2lsPWjY4JgsYoGjs3vsEOvb+5+74iTqkaMqGQ5YJ6UaAykrgCdPADFgUAXGZ0
+MzCmgFpVYIjBCAhr0gO1RO2+kC/kDfGOzwnr9CTw6AFjwcutfDLXTSGBVxCXVfF1ECEbxkftTrssCWju
+ETdFk+iCIazsQ0NEBUNEMwEyMzSiihaiGilyjKDq1KamTk5LgSbkv2088i25i+cA5kFltEykyCjiQlYx
+SlHXH0bg0xfy57BJ6vHfnSiouK+pqVN93kKBeLZd11+SKGDgv5vv1Rn6TQKohAJqM6OSd2o+AIX2OMQx
+79O6iI8TYaNGAnJJZ6eB+04+w94DtdG+Dt5YBkBELTNBT/M2ejoDeNyZrPLJuCOyX5uE/w+vqr+3YE/W
+dXaq7yThjaAACE3aDNlopsNukwVK0BbnvBLHnRq4K3HVRA2UndoQWZ9P4KjKdawmtN4nK0HffR0de375
+hbxPHp/8+6lksWq77A+Xxc7brOllx5FvSYNUDX6oilbwPh2u7CwbEEm7VCRppryQl7IZtVZMza/C9ji6
+TzzRuXNnglLC++ZumU8ejT1zyj1zLH5PO2TCKYnzQ/I0c4o3M9cOdck8G5KfkVbJPCa8fF5IpiMzw5gT
+YkuYE8JVtD4XBB2amrZMAon3qeZ+TbOWPKF/DK8lB1GK6wmjcYwcbxyTopY8qQmMnNhlxlomng7yqEAN
+JFUtOfPb2VM1k/HwFkump65RK8lN7BZD4/3d71Mni0FxN9jBDxujLs4wxUlYVvf/RZON5VtkZaTkdqKC
+Nvi+LX6WxFmfaKtottrXwkxwQRF8FnVxAch1E1FSUBYNXEuBJaxLyywioPm0mQNFnguyPIo7E7SQB7sb
+SOKaowHrI8904EQmp3ihZ9gGKywTDQ8Ay+r2eXoWjCRTLy4iBu2SxMRCPuu+VmBB1JmNgshlhcRrp+Op
+71b+c6We/NDgPO9DyLe34lCy4hvcnrjBjTR4ndnkr2YII85sM3nIus/1/giiZMYvfPq2BEmENSw4ISXs
+dlvibi832mgYkKlYZ273MIMy2mSkAz06lL7he4qMJHLWoI+Fp2SM2bYsgafSaEstBwIIY6RqcEE+bNZI
+k8W8kgIY8Z6A/gEaH+hVkLnJWlJKpERBKuVowvTwWPNAZQPk+g2U5ZQom9ZJVxxpNdrU1JmEcEIpACDc
+p57C9Z0bTo66FEoEyHYSbVK64RfwGzMUeIsH64xI/kRo1Xv6W6dCZgKAG9zhkNYhQZdds5N/HSpceNVd
+4yYMm7G+6x+a5P62T33StzHwYbZwx+Er9fuUegrXUblwfTTbT4koZCIVM/TlcXcuL/vUyY7cy2lZo6GJ
+2VMQBIO23eBSgiQixbsLshhRaTjM2BCIs8FEzAgQcSYw7BqHX88DRBoXbtHwo1SPEBmzjUCf37PQWK5g
+w6TRPUnTqoriIPrcnv8Ts6poXTD9rJ5ZZN0xjOr5qaNDz1cUCsQH9L5Op2tRjJAtwQObukFZd+T/5+3J
+GF6pejhFyLoeWjht0vsNWGduheFsjUdlEjITDURg/bfa0s5YCifB6QO3kjpUBRg+RubXMY/bsqYppeNa
+myWIcHT2yX4zdpLYk60Y7tG2W4Vez6SHUNP35tLtQwNx/bU0wOnImQTXolOKHe+5Sc320vZus1ApZf8y
+C4HSC4P4ukEYIWnG3P9zM7c8EYz0NHMu84dd6q4fvt+lvnB0edDGsLE/eq+2OdJoa6+2yuRebUP+r/Vq
+8+h4p+JxhYny0br0GTXts458LL8mHoAcCMG1UYcTbQRRthvmXS0IIEoMD5stnu5kBGglSW5ls64Ha90J
+jBS9PoBgo7z1gZE4rv8S44UG8wVOCy/LBi3I1yZjK6xG0Gkv1FoiadWs/jpLgQc/e5gEREqEts0vq2+p
+V+CF2PjUbbQ2difGVEqu7rr77sc+/e3QFzhlSCs+gKfifLzv1MF6ujkZjDP/8T7n/Efq09r+l73CTMiH
+EFTAsOgQxUkIZiAlpAlsgMSGvBBEa7rAjkxCtGSnkXRiRRnr8REOh9y8+QIL17uVYE6OHjkrr5CCOhNR
+65pagkGWQ/7J5b/89b1Yvevpl+7a9Oyjd7128jpV/Zf6DQZ+PBx7HUdgvvq1+gKtwh+++je9drf6tdr5
+zs4xmxtPXPIa56yE/WN/VGfgfXi3+ioOMetYN/L9yiqpl++QkJhTu8qsw5S19i+sAlIvAoiP1Q9b4bSx
+b4sMeMtgfZ/PaGXldWdpoWxvkDeM4SWQIgYxqCgRrdV/kInsfWQx7lbfx3+qqnqyeunfP3jtrmfUP3do
+13b1OnXvqtgEsix2Lc3uuGvV6RjWfa0bAcgeaTRkggL7Nc2cISOIGTYWmmKWKvsrGn/V7dQSGUUBBBHM
+gv645aRlWhmV/4ndGvTuTqZpZMBaOiFg3HzvBng0GJa9xfL8srIAspQsxZXNfDzhkHHV9Q3USpu1rCfc
+2HnSVn8oUrJsxVzjNi8+qXwSG2Be5Cm/x2/ksUgvhGAXiyAToSCHSLIPbZJxua0GGVnWY3fOa72CUmQ8
+1rBXkKUvHss70SoTXBMaowPVQg7ckJTpqKd/5/mPSXoSb/6p5SjuB+gmS7/1s9xnmX95kzATMiEAqiYs
+Pk14fNmEdSdj3g8ZYXwg6osLlvmM9yLSIzuUR3bioiUzKUhyj/i0yA5leZ9rjgKrzXI3YBOlpzt8j6BJ
+rhXuWwnk+XPcrvQCl+QmMETvFauXQBO/RP9Kot4pgIujjnyWC4NEME6R8oSqojTyxCOvCYBJ6iferSqf
+q58CX0FeogJyphMh05OQUnhIq+lX6CY8Zkk9ECAA5Hmui3zwd423Lg8KoowgaHJCmwLmR2h8pOskphsY
+QmtsZmeEBGmgcVNSb6FgAKYQBVMrxfsToNCeBvKoQLpeyuF6yZflc8c1k72boBi0vM0UETzV4nTQRCTB
+37DiyMfCx/J6KIYwi+lkI0EXEiDWoHyaXkAA8cmMyb2AAMliA0Abi1lSUhIuCburwpU8rl1fJwRL6gaU
+V1SUKq4gX3+Jz5vj9+fk+LxCuIQdSyuwEPvjsJXPvq5eRAp++tevv6rPXnD3O397Xt3/m/rvFqZ3B/zx
+6n8tXjJs5BoswOuHvzhvX8vigaOaXr7n1a+lcyz9dL6lV/P+ZiWsN1AWEsy24thzb6A4Fml7AyUgWlhY
+WFJY4q4MV+iImmMDdTxDbKSgwOeYMCzPfemBr+/YHsO7Vmy57u7Xr9mMZX99456TGHLlO2bft6huyPSP
+sH/Jff1/d2rfyJxvb90uFhj2YZx3Fay3SRmKQgL/jtYnyMrAbn2CunEwu6SkpKKkQuEsZCkVR2Ohka3Q
+MxvP1uXzaJx8440kXpayXichFIUEfh5D3yArQ9P1DUpAvLCwsLSwVOEs1RDvgaUG1inZulRHtifOMkQR
+VqiThY/FA9AP1kb9CVytREmuQFEySg0rQQBZEuS2JM6ynhjDE7P2y3XGtpmvGIAjrYAt0QyFTd3JD1eG
+7PbiozG6+1CenjheER/N0/RVeqaPNUf0XJMJgHCuOpleLcyEPjAxOiGB4+UoyWWcHsmEGNGcGj+OXF64
+QkcuHTPlVPOGurP13Hl3WccN3ZCStydOcMaHDt0sA6Tcv4KYev+m6U2UsH+TexP9z/evcZj0vH/JhKNu
+YPnx+Dndbf8KYgI3j6FXUcL+TdOr6L/fvwbWKfcvaepxA0uzdEQRvOpk4R2eG3sOz42VFRTj2XuGpBIE
+wFZAHMSqALgZad2vYUBe7AALjRc4VEPiZtUzX/mcLD3zNagE+a28+94kNjVDeFrN6NsQl9lWsXrz5kPv
+n2kK6MOa3ztPnUyaxQFQAVOjkyrQLvqAgJ01Cc5EQoWmwgIijTcGeTG3qtTKrjJD4w4Eq++6vFTxKF62
+yv7sMi6yVXXfX3W83qGel2fwMiHiHjLA47bssFnXrV7d+f33Ncf1CkgeEt9V2xq/Jxd3fOn5pSO2Zd00
+JxDwAgjv8N5R2bBgR6alc1SR1eiyMMG0ygpsCdtJ48Iiwx6L5thsALZsW3aG02G3NOw16G7UnBvU/tkw
+Yjmh4zX1BPKOfEvelW4BLxRBQ3QwSyt12CUieVFEQBBpE0hEahd4di5FQoY282nwmnsmN6x4FHeQ9WwS
+eY/qJOJRMyTDyKj+ZFLO1tTUKXnwOCvFhCYzLIMa7cTdkG1ORRcRJa3tkICE0Faetm5jHiaZ54hQAQlS
+sjAOxx42yNrsaMiGbC0zW2GtFzihdGKxP8Smvod9NWKxMnzyZge+tXmzOqAjVqfJ4pFvuSwWwIhoox9t
+kIus1xLbD+O1ZlA2hIUiXxnz/gxlfZF4C9qR9omBPJYV7jGaIHkYlUxK8T9e3SdUotyyciWn0p49jE6M
+aGTQyoOcSgdX/nXdNOfVHjzOOoe9CXzQC07XqFRqGYvhsBMZRHlxikHmFYRRSNYGmSfDJ8wzd+fk5PTK
+6RUOKuFSNsucVQ+nGcqeohil21zzWIrSlHRjzq01NnrfdmPOT0LX9sqUc3sszdudic3ba3qAd3bv4Z5q
+zk8cuFsr99Rzflgr98yUrdxTUC2hrXtWCop1a/OeWNejyQXwDjbTjPwqlgmYZqx9oTnW3oRKkAG91yOf
+ZS/3MMs+mGKOvZ2sS8PfsR0dJl8f4Pk3zyXytYi1QhJbIakVv2WQgx5PS4JKN8WhhHdAj3fi7w6XEuS/
+n+DAA4kWVuaTdcmc8/Pwmsmz9by7lD45rDgeDgJRbkvBuJAGYW5mAzSBe86QEvQw1rH61zRb1xIe6r5l
+E1pjpWKleHq8pj8+h6IAjiTyk7UlF3m/ON4gLt18BR5gSw1qT8FZzSsiAduXbdpr6YG58RPvjs5eSQec
+Hi5ZIFqiPs2D5rPMOUk9tCIhWGnd5BYaJ4mIWfBEdRn5gctIb6MKLcyYLolymx0lGwogCakkpZwgCCjo
+MpIIniAt/nDIn9OrItQ73LswkBP0B5nkMGTSbPo0w3u7SREZkzSxN41WkFNM6zXndkivc7mqhMcSJYvz
+X5ZsbU6UHSiiLLaln93RKx1wChnoEbab6i8qdCsApaHCyqLK3BylwF3Q8xSPNLRLmIRzYhLZuo02SUOv
+uD5hkzbn6EVgEgp22UbTHQQl5kFgBUyQjxw2RDM+QtOriUfaM0GPR3Y/F0aZ0cg0glAVj0Tq/Bf663ma
+ufBeD3NbhqTRK5XJc1uOpijSDG/5r0a8/BczXswQtkVPjDeplnLEDfsDCFvUa8UScTeE4fyoIxepmIcC
+NeoiAybf+Q1uuJn/PkyIj86kIFBhQRIkuxHmEi0PXQMwHvGR0FF7ONzLU8Yns3SXhqRGZyWgWGRi1uUt
+WuHgPA8Tjm4SMSpntNZIft54JhsACNeq19K9QiMUwR5NBjxcIooQiB9FyEVJ5CO4+afGJ7SpCAWc0KK9
+UWCRE0Frni+K/LwZJhkCU6LDJLA9CY7vLBBBEqUFqcCZlHR73k0+HOGwwoRBthd2F4akyQcWkZhulFwm
+CUS8kpRAHoB8rrye1421RTNYpaxTopSaiZoBa1sGgoSHfQWj70S3pxTa+VN+FfVnsjIEVjLmys6CDMhQ
+3G7FER91gxEMIxr1bniTeskb2BdPfgOb8bgH1Nl/VHeqD8TbTxxeIlx3+ELhskODhOWHrzJbUBBwAohP
+6bVvG5JxYJwqYgu0pGtzNIbG0UiH6dCeMDWe8iBIj6gyBD1sHgoaDlhU1EMvvnKoc99XW9V3X/1G7Rpv
+RkK63qG1XW/SusMv0XFdO+PjqYBAEYA4WF4PNnDBvGhGFqtxEZDGk2rzeDGRLJJ4Uq2EZsZ84jMC7ZJR
+NOCz2wHsLrsrM8PpABvY3G6bPbc6qGg8MnuKkf6xA9/jffjyy7E31Dn6kkkbbY1NI9u6tsSuT+wLEp8r
+lQ0nszpRgVIkJlcCvB4x3XSpXLMWsdt4qSybjfk3FDY4iRcc66JkUtc6OKk+TlnLmCne61qjZzbYIANc
+sHCnRkyz/EBEapds8QFOg5plg5gFiCAQAcgaK5Bs0DOQmckomunKdGVn2TPsJlUdCVQ1hidaqXoPmxZt
+pejc+NzeBQDCKr1W4vSdEgIiIRNSZhnoPiNgLgczy6DYCoMABGGRCaG1qE4snXArbh7wrWMNSnyoVbct
+oIFYDv2m62saiM1hoaA/COs2bz588R/MOrfxAPQJ3hstEzZa1pk6c4IvdWjiUtPjlAR4NBhtcx4LWuPJ
+mbFH6YjYDeSC2BMMrQ6a09HR9V1HfI6eRv/+HK+FSfQvBkHWszLTUL8wDqHRfuF/SPu6iM9K+9s7TcKf
+odUucbqL+1LLh2xJLU1Dy+44DG3+z9doJeRXnSYV/Z2dcRpqdQHnRbOyueMzcanJUWcrNU0naEkCkFWY
+447Q5EIBt+K2JyzXaDJqIasxsN6grdUfGpdrBbZ0X3uaGLyVkD3H4FNBHhVIk/Bjx9XCnhk6rgaP4sF3
+BBsAAW5Tz4o6WBWcE5HGbQLddR61IMjz34WJgfRPGwQ9851l6ocVPfOdOTsHGGkCPmXDFVfcsT63KqCs
+pls71u18W7xB+PhwNftFrEZfQPEAeGBB1GFHShzWNckogXSZgCgiSGx36ato1GIx/CkQoEhom4AIgCcI
+iAit3Jk9iVcRgAc8ijscYnOlCqo9zBlb7w7W+uXycIjKEV+Y9n99yyu+M/GSs84rWjzrg8aK5z5+Xd1L
+f+poI6UFm8vVN17p6MoAXj0IYqM+g80N50YdLhQlBwrmWR00yzpAbE8YwxZPqkuEAQFMQEtKHZ/P53a6
+s60T+pJnsOnM9yTOX8PTTePKOnvNOuOi9ci3wng9JlYDMc0Fk1OFNnvyDDaxKZD8QNYf6JksfcBmp3bW
+N1Wf3+d0ELt9yFHGsA2yviWneivtHLY6Bmqzt4PedPWYR/h5y8rKaspqelW6//M5bDqpqdTDDDacpBO+
+p/lrZn3SEADyi94b7pyEHs3xvCeizU5NbFFjdA0JWcGSWgwdS19mS+sQI3qk9wwZoeMR7y1kCg85cjsA
+OYn3FnLCRTvsqJVPctMPCAVKYI3ELGg9VUlM6B+Uyx4R2g4ESHvC8zSPtMZNXt64ySk4bUaLEKN5kMes
+ejpsmoTMhRbvIW3NWy+G83YUWqJyYbtVDZvJ6/HR6Vryuj3xVOKlDMnj1fXCE71R8rEWM5hylS5tnYzU
+0TqWKgbrvJekufKCaHCp3DjM7PphlnaufCri/J+YK28gnSpnnZxx1MHy4uvmHor7vArh1B0BC2cLZUux
+YaoISNJzreTaEsLX5yp5NT96QTp3l45KN3cXvc1Uwqkd6CYO3eY4i1LiHOek6sMe5jinhEw7x9lagZh+
+jnNqqP/BHGdqinzcpzEm9Rjn+DYGQeO1bb7u3wzCSmMQgS0D7YLNvtAotEjj6uwVj3mleiOB+0VFRczr
+WRQsCpYUJ/k+M3uIh+kjflPGxfps+2Rb7Ka0wbFGdiPEs1h0SNBkQs7WfZ8FsDvR+1nFciIcot2xMNOW
+kXp8dVIEtE+Pb6SIgdaYL8go8nfi3s5UUdCyvDzN3ZlXkFcQyE/l9MzuabB1t9AZG27N2gSmHHBt0EmT
+iX+bMrHaSFBjg1oZk+3tFh4vSiEV1RZQqy/cfOf/pFyk9IszuVCv0SofepCMydp91ZCN35uy8U6ibNSA
+3WFvZ7xztB+rdPQ7yjsp5KOP5RWLhCz6X5SQZNc4kxB1Y6oZ+ZqMnGTEEpLlpAx+tyOMNrtxVvTKNAxK
+wcbkJUN2ppWXGiusVWDMlxIEpkQXmLKislB3kck6qsh40p0vXGzqejxjNMEh11l0aKLslMDeqLMI7Y6E
+86Z3tpM47I52sIv29ixbZmoJykiUoP5Heymjuwj1tb4jo8hfi8tQRgoZqjBlqCSvpDCNFLl6kiJPitOH
+S9LI1EeQJkvkNoOI3XXOBUbPeqsI9aRyqiClAKXWOPlMfIIlxUVJopNxVNGhYYykFptPO9RdXx1N2UAq
+XfNaoq6pBpN/x6pq+vb8SgpN0xusQnIMiiaoiQgzw1OJR1ZP4sGI1k009jB6pdUxpl/AdjwEoAhqYHE0
+g6IERZ4MKphTpIr1AdiiWfOyKI641h04DiGjbptyOGr0oHQFiyvLi2uCNfmKEuBDsplSDOewzj6yJHO/
+QBjLK8rrBtQP5OOyIwPr42FmctrrX6nfkv4Vo0a5c0gOdxi8TIrdysiRFf2fwX0HPPaB5VU86Cz3xjz1
+W1fFyFNnj/RkHjqDuRHEmzOUUaeeOrLSddhOLsRcWt4rHoAWNJ+C/Az3KdTDKHhd9ypUotOW0quQ+CDJ
+q1ALNqetHZwOZ3sGOsBOHfY2w8GQzrMQbUjz0rE5CKI5gwcNHzZo1OBR/fuaHoLMY/UQUJMTHJoxwGMN
+8ffkODiLHWKML+1zTrl0ZEX/MXrovwc/wo/scOP8ubSd80S9zUwH4PIovyqvBwJuyIENOwhveGUU8bKT
+OwMd6OBRXdBacMkiyHyMge6XZbm1TrOXeD/rS9oc2kXdXjZf4a3F8zweSj05nhyfl7qpW8vE9LgyWU/+
+oIIYr+ENKkFkgkxm4N1dBfRBui/Wh5wVu57EuvrHDquHaBmnz2By/eE6c0wU3q7O1WMTXPak+3hMqgr6
+QgRfmLC9YPKJUW8F2kg5yja/GyiCB0UUmgITtgfjzxzJz3p3e0b1Zyk+ZhWALS3al/XPwswMkpFJ1jhQ
+pggoQxtkZAxpdqLNRubYkZARvBe1NopzpDhRX8lA80VwyOA4AY75bXbpq4+/LQNQ9poDKDrowqO+zlAd
+ABmZGe1ZmEky25N/Qfp3o43H+BqYb7G9Zv4CnkOV369fv0i/SG3/vr1rqqsqw+FwOOgOhYLZbMsZfjfW
+aMTHhmrxXNkKKb4Vy/W96Am6eOUPkiMT1KVvXIWBDa/gc7GCAJ5/+8MvP6e+d/PKnatv3EGW9Zm7cvuO
+3S/+bRPxdh2BW5lM/Yx5ZP8DdNJqPnlr5KaGb9rn3HsZnn1J2/TZ90xRG7EPjjDsbsOPxDwqYaiAi6KK
+DUUIB9gZJLqRCNScjGCpmmap04gAjYwAZI6NEkKGEx7jikMhatWNi+MwfPpYbmkpQGlFaUV5WXGh38cc
+2WXuMuYG9teXa7Xw9RE5h/0UqpA9OazafABPKSWK18/C04seegpXY/Tw9sUPPvaYulbd9eMjp/1l5c4v
+YlOnr376i9gJ/2ZkKNu/F2fgXNzS3v7lh+pj6mb1pDVr0HUOHo/hHzvOXqE+rX7yy63CzxopQID1R74V
+ZkijTVqcG81kyVLhQCYVtCNP75ImS8yvuQZ42yRRxDkEebUE07vUUNvamAsb8mFkqYFaoq7SUkYIX3m4
+zF3GM7rLZJa9z+d5MCL4XBAOVfjZQI8BfQkf+sEpgKcM23DBBSh8uvUM9oMa++x+Oxl1OnpLo6RprvqP
+0tMYCYZOv/+OH9CBfaZNu/+O79Vf1Xe/IE34+4tPO4tMUBfcdDJ926yB0+ukiqE3bIvmJtTPVASJIJag
+JIh6qXV5vDA4uYYmRb1vhaU2uEfwaJkJadRXGfUnSW3UHCFvWKuALDx6BWRC0W/PRTgnGYXA6Ytw1DUJ
+vTyNeqoCqILV0dyEGpzSIiKIhT3QbURzSgQD3UmWBlKjhFYiWXiUEskEMqSsyhlkYJ+6Kkc9d0a8xtyo
+y5HBB3dEndl2IrCUY4E0JdaOJxeFpO4KrCHbI2w0ZICZxTwpqsCjbgCfR3FlOPTgks1STZLYp9WoKVFj
+BtpaVYm18puYtTteCEF7NNcoJzG7DwhNDjsh4zWkK/XBSymrTBJ7CfQyJicdFbYlmhHylmoFKnZ7ceoC
+lSTUEotU1DsMDJNLVWLvx3HFIwdFSh7j9kbFI9L80bxUBbCJd+6khCCeB6CRlTXsfESC0UYWi924ssdT
+gFL/PkBsYr8KsB0Auv0+04f+mP4LLb5SBK/oJzfxWRrl/PcFABDhBPaslbClTrJ0s2e/zlMX9HnJOi07
+HcEmUnI3jx9U8vcLDQ85pdii30+RTgkpQRfrEst/gyXT2WZJbo6PbwCEH0U/7hT3/Sfrivh+7NTSDIDA
+MpGSQdLrPJYzmP+GPkYzIREJAGkReXRCQCAwhcUlrIEYyZ6j0S5NGi5OTc5YTp1ri7BVvZawnzJhKl+F
+Q0YEG2pOFCbfORyxpYCEcIKRuUCQTGWzSTRCJn7esiMcdrHeq2x5wYg19w/Hnq4n/BkJfpw/meRu8SB4
+YAD//l6AAsUWggACBS3Be64kEop0qhb/ZuU8QZZWxamaogTFlqpOJ6HMBMiRt0VK9vHYnwy1/JsrCHIW
+ahqJ6R3GxRRzVvRNwDNcI/FUYGpJ+iWwW70bdwnjgUImXLZDRIHfWvx6MZg2pLba7DnUq1lCQehtZrEW
+SCgQ4bJUkDy9K+3jGhIPMmYKmU6H3vtXtvmrmQTGi9p2Hzw4e/ZBOu3gwcOTxge+9h78QF0LCI+LlMyW
+tgCFYk4VL+cyQU0RcIG26QLtieDju79S94kU71FnAT3ynZhJXpJlffbCWP7+MB6WYuYlgohruJtIp/Fs
+mUqMxpO1CQrdZifY9S8yZiewluI5D+LqbWp4z0fqHs1vMRPvVU8BCjkA4gipmuczZME5OxwomZlyYRtK
+IIAkrDHzGESxqhkAKpv5MItefBYygCiJIK1hR5ooCRcnAHO4Gua6yOEJDVlOazqD3ZaQziCylSamMsRW
+vPa1+rE1j6GSUw0A4S46Bm/h8zvyojnEZCpOtFCcMe+ug3TMwYPA5QsApAypms8jMebTs/mRBGWyJj6g
+WqpqNkYiV8aHk/fi8+nj4AAoJ8y1lqriwDVpp5TYAtVB64RyZqL+nf7ctZ+8EIvSSb/9xKXDuOYKV2gI
+a2vP4j2szZnqpTZEwrTfGqAgiVRaCABVzQISUtnMBkxpa+4BTGzlYDWiOXsqW2ApJ2wLGBLlsPmrFbZK
+yzg5tkY8fg/Z/N57sXnaGg+vsM6S4/RW/wxAHtHpbcxQYgRklFvDCWamN1RahsBoq44DEooos6kb7I04
+WI80TpgEw1p7aKkNXWG2dCOxwaAuAD1yyZGPxQ/4Wv0QMPsd2rgZo7WOqjL9uH2aJQQgc2QkpC9v5xqH
+o4AixTYOH4eqJT12p7TlVFvTAvyWdAG2eNrvw+++2/Onv/31LfUv6k14BvbHfnimesPhfIbNiuRMgM3J
+uQLCNgNPAggg3cHlyAdLotkK98AJSJpcSNHIexQRiUCQtxOqMpNyKrnC7cXSwQrjEBQIUn4DqeLPa4SJ
+hjL1CT4na9NuKlMuRi4SrovUgmcg1PskUBTc85W6h84/8m72m9h39MlY/uh+9QdDqPBTnI37H1TfVz/4
+CY8r6Bip5qh/UYPafJQbAMQrpGqtH+GOfBcBnBAXMwEEQ8yMDqd9mrXxiTaRUNqL6mKmAyIIMgpt/AUL
+WA1POywoAL0xIQQgUKZ4S3UxC5qsYi6tulJlgMZEkYvddpyqPoTz0I52nKc+hFNVisWfPbTtcyzu+oYx
+byNm4S1Yg2vVNeqf1IXqvzdu/BofO4Ih9ZMjoE76ZmNcRgWAI9+SvbLM+5+x+MM1LP+a22bE8DPX8DmZ
+TrRRgdqENQ7JTgWBtorI2AeI1aa3vBa0Tl1WcBEpfwOpsCgZuCVamJPjdmdlsSBErt/tc/u8Hta4zBP2
+BDNs+bqf1PCqo+UAIrtOWfD76zrHzBw3KTYifhgpVc99RF6ITbvl4iphUfxcIpo/nd+ZHNC8gyXYGaeS
+z5JUV9ksmueQLyGRTjTPnSwA87yREs+bpLQ56ymj97ES3hV3QxbPG5qzQ0Zq9s8rAgEoEajZtqqymbtO
+JdSXU2BpfKYBGo/5qtwAxYX5uX6fkg1ZkBlke4O1qsrxuURB8cqSFC6LlHDLNFzHHBrl4fB9+BGW/qA+
+iZdMiT79nvrKbVi585+fP/AYXn/nly+Ju29+Uv1x0WL1o/VzJtZtPuHqW+5qx7xVJzLbBEAYyGk52Bg6
+YJ5tFHXbKFuf7r+QomYO8ZED2o3DlqvP8Pcpj9N1scnsbHLdFpsNBEoBxAF8roMNxkczbOxXNLFzxdiG
+ikmb2dSgjQJAoN38jBMkA0CWQATRLTBS1GEEfWVBLCXBP5Liw/fSC9XRT4nZh3546imhEhCeBxADvM/d
+TIMhximceG5LxmlSYCBoAhqP+SmSBWCeHrItoCOs/Xle6N91F+mKCfQq81gG1HKeec/85qSs7BwjQbay
+GYzuAFzXeBNyxNnHNVSfgKMl94o2a3KvJYHZSFwG1Hqwc9zHGl+nNcTgSS+VzZKp2hIe0FbJVGbdsY2f
+7MaA2KTDXM+jEw+k6tlMLK1N+1jaFvfVejYjMIXSZoLGAWrhaKfif9WzuZbUHD1djtTdeSefKQjAZwo6
+Ybyeo9jNJjGlKCdpHl16+bFYH3pSpWFzsO/cBaDPyEo5L6lSG4/Uy5yXRFr5BzVJ85JsCVOsEuZWJY6r
+Yt/pAxCHi7tBgmpj3h2TUIIAvYDPu2O+BvbXGpjYskNRFGrLq0YMM41JNqkNL5P+TF8K2w9PZroSNFmU
+RHE3ZMDknU7W4M7EIwcoEKBkjYaPKBj4JDwgraJg4JUJwCqsWPmPZGMp5EHEoBJWMIzKfRjGm/FuFF5R
+z3hTXSHuPny5cMGhQcJjh7cL0w+PA7DsCzs0Jk4cOTaSaiMHdJLqIweMUQPWCQN8L0wGEL405+WdZuwF
+ImtjR82G35Varrx5LBTFt4sBawJwPZjfw60uwrSC/meycEOXTFu7hgulu3aRe154gZy2S5/lYd2nzdrK
+/PHNafpHtM3ps7hP9M/5nvR08530tBfT7cOjb0IL32Ro0FbrBkJ0HS6Y57sLuOYkCwXzaM8wTiida0FN
+fwmruPY6NEj0HDoABO4HEFS+vz0GPfKAAhLKJ//o3yQaJ6EfNB/KQgNGNE7EbACPonWXLAtK2qlYyyK+
+rmBQYX0mWW/J+0nBV6+NmBS7XP0JKd677Uf1V3H3gObZ22JqbDf5C06/dSEwxxAIl/I1zYhm2whPqWBa
+x3qGxA+ypDPEPMiSzxAnON2KVz9DmJSwHeRbSkKxX+gnsRr8IfYKHfYsaST9N2+OfRe7/1kAS9+dOP1N
+73Jls/m1LtOXnPCVcfobrmPDWay5iAFhMIBYKO4GBcZFszIR0M6rBTMQx8dtBC6dCfaIdlK2JdgjCihB
+D7NHgooLEu8QZLD64YfZP+Gg0SfjxGe/VV/v4d5AYRuA1MLXlAvFsFhbR5Ai2pEnC9icRBYQRBbVZMeA
+wzgA0sJoszq1e7SbmXdF+XnuXHeu18NW7WWzVDPYzb+uBOoGALOLS8DnhaCPKtz3GNT8kLRc/Uy9H1uw
+5CkMYot6v/rpU6+8+y5e1PnOO52fYPG3f8WiTz5Rv/jrt+rnn+D55KNdDz20i/3R/JIFAOIQLlcjonaH
+LKDwn6pBJkNuzQ7Ra3kxjFhAgrGDv+J1+MBrsX+Rgl/VU9TR5FPyt9iLZHhsSJdKZsa2AiBcDCD1EXdD
+Ncx5ogqJbCYdFApIQAYir5GQcZsb6JW8mVMv1sypyPqcILRwALmVAdQYDZ2qoTrk6+/xhfx2G2voVF5e
+UVHOLeRaHsTSUnlYPlR9/cCBkYgvHJJkWZJkhfz72ReLvL4Rc7OUh9eXeIvmzBUzXnVKc+cU+krWP6xk
+zRvh9Ra9+CxZkvXocxevPm7jBY+cc88qZKYPrrz3nEcu2Hjc6oufezQrdh0gHAcgtogHIABTEm6cucl3
+zCRDDFBoszwwDTHjNikf5TZ5HL9JnomFWIhnsptk7F8Y+e6lF/+OkaPeIQEot3Nel7O5L8YDy0wPkpRh
+d9LEgbyVlgG1XOxDVrAkG8iU+yKXi/li+HRDJckjk2nrPpuX5Vutev550vz887HHtv17W3zWul4cSzXd
+IWfzc5b1tcv2oSAqPHjG4ks65Vl0wkZliqI2zI13KKhstqMk9WLxsEDCcxEESRRYNxSphsUFC/QjN8+W
+l52Z4bQcuszxZSwUu2kcfI7ln45OUjva4n+7PZ360WozlnBfcT+YAA/unJCbTWUJzWx/QGhnDjts53Pk
+2fFTzXKWtB7zvcw6wyoDUkR2mao2W/T1Z37tXuwV3uWhVpgYOApwjRVYa8h93Kj6upqqogKP22E3LRDu
+q2yk9ZEsKue4/eE+tCKLhkN9hLoBjUKktoj45T40HMoSfN4iIVLbKNTj1vc+Vt/DguziPIU8KVfUjwwP
+WTL0nayiYNhTGJ1+dvOYtubaoozMkvpp5zSPmztuaPjm4l4VfUrLIqU+J6VOX9nA8lBDpKasOFNzmMde
+Lp992rxeDVduuG70TerhC/LGzpjVe/jKucP6NC8698LBx19/5YqWAaVN509fOH5htKB67MmzTy0Lzjjp
+hOOqKoZPnj6pSLORJh75ltZxHoThhOh0NleXZfNkoCQKKEsiEllsMkhGsR1EkGRRYk497gjrzwjG6xBr
+yUSAcLAwkJ/LRvbbpERi+fRkMGucOcgVE28Ah1dy8tTcvWHXvw/uwcs2XnjxBV/h4oqrL9p49dkrNYwP
+P3/H0Ceve7t17AMohzvC6x7eeNaJUxZoeJygLqdfS1t4dubdT3gkIpiz7MyBciC1myOaqnnGXK9mIKS3
+OSaoPHH0XLVZEdQ/EfpogDU6IGuPFJ8IAF7whj1hj53b9AMqIjl+LYHOOqru1pH7Aq6b/njZbZ0TW665
+dhYjCvnhiZtPPO+b13cf3i4MWrFib2yoRg6O91NHviW7pC3cD1YXrY37wIy5q9qk4wT3VVaW4auSuvuq
+knxUh9gKrN6pU+M+OJYTe1iWzfzfSzUq9uYJvayQQBTs4hrrdBDtklXNvcdzgdJavfDACp+cM5wAnbbw
+IKfUG3Yx3erREBlY56IRt+JlmTDl5XUeiwvu2s4Fl5+1aeMH56k/vfjMktG1rrgbTmiMff7LQ7sfw8GY
+rf6s7j+s7jl9xGMP09y4Sw4I11uLhBOgBsbAxTvHeJ1UFAyt5TdzdarjaT69zKq/Yk1a4psHsReDM3ZQ
+IBVEjRWCNZup6VXTK9cn2Qqq/zMdRIe7SvLd5Elbef3o8sFnNrxjLwiWurn2OX5xXPuMPnXcsPCGdNqH
+7Kqce9qCqoYrr7tx7Ho1llrvhJtW9Kx3NqrLhdE8b6oSxkRHO1CixShKbkTWdlMLzrRxWgJAP42UgFjL
+fSq9yUSAynI2GyXHy2K6VWGbLbfaw2af8Fi2rOXxhCoUT8QMays5+s/hjd+99+Cfr7j8sTfVA39Ze+H4
+0rUvzp+3/Nz1Z13wh+9eqVCXi39AAUOP33Dea1MG/2vja0eq1PliwXtXzZn79FNdq0c8vHLTs5qNmcn6
+fwiNEIZANDeTe/w01aKriXw/8xuU9RErIkXUzzklyixBOczyknGzu3bYmOapvb7sN2n0sKKi45qn995R
+1jRh3PHRwvLhNzmrFg+9+4kXHt1Yd9LjT76wYOKTr7x68shNf9i+9fZRi9es2RxsnzzdkMUiaTREYAzU
+RHu5ECGiEIqkCRAN4umV4rVk4vBhNVX1g9nFAfvQugGNhMuJ31dergsK8fuLqM+bRfjQ0wHuen8WZR9H
+ahtJ3YA+BG8Zu2B4Yd2ZW5fnDhs1JlQx5Y5xrr51A6ryh7ae1zB41PktkYHnPd1+8ePn1u94+qKpZ4wI
+FI9cNG78opFFFcfPFaR+kyZM6h89paGASjZJutefg5LTW5JTMGr8pF4VfdpW39ra9lLHiZOueKrtCRSb
+p4VnLVk7dvTqeQ3HLbli9ITz507R6M7OrFphJhTD8GiDlj1FiQ0FCtpUe1FkEiSIVDDbUfc382RqYWJp
+MBSuCLHtU3aUU4nWpj6PXkxzECE41VPo9VJ/GAbXRL35SPQp9SLQpnAoQOl4fVY9aAW+AmkHQcC5mh4A
+4JZIbzE+NzAOBiCCuMCE1nzr6R7X8GJhe+XwvLwBYa4o6nJM8eecbST1oT6koq6IRgbW+yVJ1vnMBELj
+fzme2Div/MSFk0YOPP/+tv79p5w+dNjsE2ZEBre2rTlp6b+a+z+8YvT8ESUloxeOrRh+2tiK0lPvXl00
+Uj3tLmfG5EkDhw6/6NmLW3c/t3Wk7Mp3P1zUu8h1X/Oi41ZVnPz8czunz1k3KVhx3KkDB50/b7gIgNr5
+LcwEL1yQeHr7LUe22P3IDiQdvwkgKZ9aDudMAONQltIdyokHcvezWLdjC3mOkBvyoFe0HAgIImGOE5qs
+v3BiyB1yl3Lp8zB1pTuPPEH+Hwzyz2aq33ytql8fONhJht190aq7ybDYy7evvuge6sOr2CmknqcuV0/B
+m/E7JNyFtEz9Tt2Bek5OJ7MJhEbIhHC0xGIRJJkBYU+Q66eE899y9ltO/fhse6bv3NAUtStZLFBmXms9
+5tiOaubi1K973T7ldz19OKQ35NX9mxGF3VLZ7pNkWRt433nN3UtyMiMXrm7EXf9UW4TG2D9+2r70Ubro
+5nGxp0hV7ANAyAWgf5eqoRCG7QggSZglzaldFXejJH3GbvlP+PODZYItvxor+lB+dy4S/JiTw09SimG8
+eLy3V924AYVlw2aNGdivdz429Zk1+C1/RXmZb7P6713066dqpw0t2ebI73383BFdr537p7P7TJvWMgAv
+jQU0eqnLhYFCI/hgXdTpshEEL1Kzuiw3btxo9xk+ctKy9/Xn7AkgapcSE8byuKb7Y31WpQ98YY87zC/U
+HkuNj9cfLq9g+U7Kxs6TT772hs6he32eq289rUldLr4eG9DW9gl5IZb51PUT1n5dy0weQAAA+o7QCPkw
+ZEemhdaZGnNNOlv+bjqr8iG/wMedVeUOUhEpEv0D3XVsq/m8VCIytldPP2Vx9I/lxzUOrAk6r7gs7+Xs
+pYOunHo9KmhH2++mbnvw3utXVv+Kq1a1NEaGX6D+7Rr1qxsAj6jqKfRK6XiYAU9Gc/pUhkOFIiU+7Qzg
++RJGQ/sqoEQgVFijD1xbDIT0Yxc/nGMoXnkOyHJvOZ5/nPwG9/AtSHwRJwaODVJTx8r0qePHjBje2BCp
+7V0zgJssqdQyO5gH1vehFXWa1eDPoqZy9oVDWYQdTVY1PbdxdvHsttZJ4bYHV40eMvuiUY1zRob7lBXX
+DRxWXjVm+hkTe888aUFDdXHjzAtOUgq8ziGzVx834oKha88cs2Fj+LilU2qSNHfvObeeMeXyM6fku4ZO
+XTBkxFkR6sh0Z9zlLc7zyOGaXAeeHugdzJG8vfrWh5pOH1XcUThh9dzzz7foc80uKRBmcl9z72iVVQVb
+3OvMt27tkyDZUvdJSNUfIX1bBACEagBxltAIHpgcdYgIKGC8559XIER37FZbooTdPzZdUx7weDwsb5Dn
+LGn1Fsx/w3ZSnRJU8LiaM9YtXy/0Odj83kj1dLr5+B21d8V+9/vVG+4gZ3XN02zetepyoZ7P2MqD1qiD
+NfPPSOg6pJu7/c0Tqxcnl36UdXtaYzzlHnkeQs+EzNKwZLGFeSmAYpq+Icm39tChD/6hvvav009tPW/j
+czetueoqdTmtwfk45edP1O/UG8LqVHLmRPXde57/+itN789UT6F/EWZAHVwVdQMCzxsMIBXy+SgCw6LR
+rZf+pjrqZVqevS2TkCkQShakgBYmBnp4XMP9MfaBg8rKB2kWjWa0EovVwucK8h2lGathbtxpRi7tHLB2
+yBn3nDWoeMzymQVTLzs18sZfChtKh80qHzKuylU9YmKo8h/fL3jwm7X9xw7uIwy+Ncs7ft32BVOWTygt
+HnnamLvuEsW7nBn5VQPyy/oVOr/4cfP3d08TZQcYsv6hMBNk8EObZrWETbXOLU/jx4QsKFb4omdRcSAe
+J6tOzpTy220IHjZ5KtPmt/tFCjLKNpYlpe8NY7/4g74g4zep0/YHytqeaRmPMfXL2WrXSmN/dBg7J3fb
+hs/YztFiH9QnNEI2jGc5vICSZb/E4yDV8ThI8odmJIRNGwiHeSSkvM7cK0HF68c1o0edt6TtdyRLPTDp
+sifIjH47y7fFHnzlAk3OuD+Bn5eBaG63qz+74LPTOvUFP/lSn+Ymb/iKhA+FE6AXDIWLovaqkkKnHh3g
+zVSFuF++f9zY9Gm+e92K6M/9wnSOiJRWW8xN3fHdvxmSn7Zwz319HfSCXsVFXhba9VejTxdgv6Lf2ZlO
+l5Wwot3OsFHkcsyg8GB06sD8/MalkwR3xdgzJy24amZ57TnPXNH5uycWV9e1bcLZ41tHlHslR3D40IpI
+UYbQ2NHnxNVT5ty8fGI2uqZvve3y+YMnrNu+YNneB5dlqsvxKtfc295auXznRSO6muTIhFMXLq9tWD5r
+cNXc288BBJu6XIgIjZAHxdECf5aTxm+3VFM6Pp8vh1tPtA+tiOTk+P0YQQ8zn8rLufm09enBTbXFGd/1
+ntzvPAxsVdf/Y+rigi8nTM+/VX3iQTwDZ7S1T6txDbv1hA8Ijc1Rm255vOqc2/thXmwLIAxTl4vDRQrV
+Rkf4IqDIHFqauBlpE7144KQ3D5ykBKgxAMzASW6OouTnKixwggo7c1kdUCQnx8fdJEpEaaS6cZIlyApZ
+cfdb51bl5L0V8Mo51WMWjeucv+q43Emdk3x9J55z63xGx2l3/3jHrSesH9z3ipNvHbbq7Hljq4Qlh68T
+J13+9NJDD7wZm7bxinOmD5DU5VzGTz/yrfhnoRHGQt9oTVUxYT2jgPLpHWssa5+r54T0CvTK65VXKtsK
+q8sM5467PiIR3adD6svZ9TXCnR5FlHl5mMBURHLcbP2s13ogt2HCrKGTz5teV+wc3/T25BlCRm5lQ1X9
+lMFhu7+4744+RX5X5aDmul5DK/yZ1F8zrPztycf7qodPX9i49A51yIKmmr5TzryofcQt6LvwQvWz9ZUt
+LVOGlTW2ntWv8ero7y5eeU30xpGDz5w+oGbMybNOKBlz7RWrB61R/3794DWrls2MXA1AoYjdR8Sn9Vy9
+tu6ZekU2Se4xN69ARMphkFXZdsvG87FcvMQsPHvKLDzejtTi3Wzd9sW2bql3Zs/1w+LTum9ztu69433B
+e/JldusynuS9TGohzr2Wjh69lmzJycpt4rYvtvXoqrTO796iz2uOslx6kefSo9hudVhbcpDSDla3pR+6
+nMo4u465jXuctazncnM6S1s4nZmdqM/4A7G9G/VyckySyWlJhikcvOhiq0lHsCzTl873prQFxkITm3Rj
+7E7ZJrfb0Ya29h426rhx45rGNXn0/eqwFf3X+5UlOf+P9uzrDNv/8cYVNph04TmG8npr7nolxMeRJuUb
+5sdT/wPa4Ls4qJawsSgh85ABFopaKihLuDcjpXY5MfuQ5V9ZMhDVuVrzDj0PkVXAA9XXasR2L9YDhEbQ
+FnjQNuV6HcZ6KxOAU+VKOowV9xjnTV69vpctGHRt2fbJtjgCerAXoejIT9J6cTf0gku09WcDUggqhFAX
+ojZM1fyEZatrfd2N+AIlQPk0EKkVJClfG98mIGIA9WJrFEESFxqgIAiF5oy3QpZPxDDwVJZVhmxyUTUO
+HGi5bCaY1yGJmSo824V8cOLH5619tX302LU7zqialpcdLWo6d2JF1dRVUy98dcp7ap5wldqbNo1sGrP6
+4bZFz62fbhM2C86GBZeOGXbm1P5TxsaWsTQZ7Z7E8nRZb1ER7DDJ7MgaD2fprRnymwEgAGbvb/2pVga8
+CAAK2dmQKUmSXbK7FcUlyfnVwTDjB8++v2wP/V1sbLPWb2qzPlkbAHne2FTxAIRgXtRt+JcN37IxGLBQ
+dzEvNChXbLqYS4DbglpziDYTTn8aYquylyreivKQJLN7jMSyHAa4B5ay7A0fj9kQOegLcre7OHWfqqpL
+1AvP+CP2e2Xhe6sbzlo0P7Lyj5vmZdFA1ydTb91/1Z8QHj8b1+AVr2HZC6cWF7+QG/ZnDF/9/MUb139w
+7RjNtubzDnmtdnOaSbM+CyJ63XCJ5sNLKqwO0YktT7BKakHOP2oldc+10+lLpo+Sj5pvyUcNpMtHLeyW
+jyofJR9Vyw3ZcWy5IfmW3JDAseSGHJvOSJUboq0z9tq2T7vnhiBMVvfShTw/f3zUofm+LHc3rQhsARir
+5h1GgQBfHACl0KJzFuiUlidCiocz1l+ntVMK1wUVVzA0ecuNd5z+DT6pFt3/1x3NF+0UVmw69G+1kO/X
+XJYzwnNf+8Ip5rwp7ijgc+j4xTa/mfKLrYSEBIiRASqLgrzQgI0DFLKbryszM7NvZt/KoD9YWVlilwur
+y+IXe/R5Ccki3G3bSNzuurqgYqqpoCLse3/xxnl9+8y79Yz3Y9MzK447++4lhXnD54ytLc6kCzqv+uvv
+Z8194NtL1baNBdHTxo89bUQRWXnWm+pD6o9vXj5qY+nsLSjiWb/eGmuvnHPPyrX3nhoW+2i66T4A+SmO
+axhONKfzURkRKPLpfFwtSbPZaK4Ar0FPfCy1sieFcaBCiQ99VSqDiqIwPIsT8NTy0S2oBZWwsOFFA7sX
+u3x7yJg9j5gYXRR7ao+JFPXF2snKOBbksVizpg8CAFJE3A0B1v+aIsUcJBZPlJkLC6ANlsxvFgzG5cko
+iQgowUIDTjB4lq2lcSmeMl9YsXHtFlT0A4SFVCyHhvAHtd/UPUuWbj1nyJ7g8Wc1zXlu3B7Vy47D3OOP
+H3DuU5eqOfj1SReML2maGFshVm826S+sldcDhTq4eEcIZWp4ost5lopdyxeiIEuUSZZI5mhMcdiIzpAK
+K6BIidhigGv84ZCcKwW8dKtOqBsQqanO9ee47UZClFPW01o4bxpJfX0jjdTW1rP7vCxnUbmIMhXoD/cR
+K8IKfssOGbVqT1GvkUMHFkysiI6dNQozM6uG9q3wjcjvWz+639h5TSMrB1bNqN4XPG5c89QT+p6ktfT5
+7VFxd6y9fOQJtVeOntNYiBv9VQ1l5/RpHjk43ztw6jmTzpixaeKQK6+54uxpSmwaENgKIOboMxRmJmZ/
+54LZ5hWR2T0SJTpLkx6RVv5I24UAfGqGlhUuy0aiPctHZxbZ1j1k9549sUH4kWaOHT5N2HhokLDx8GkM
+Ac6zmiMfi0ReDy4Iwe92UNRy4zOYmpBQEEVhqT68bbG2LqCUVYOTEtNrmDgOLgWYMQ4OkVVxATXGwS02
+gELcA+Nkob5g2KNwwwYj/F9J4lpkYD2NeHkp+MC6iCRLwZKKclIf+/k77BDwhhdjL53+j/3lvW6/Y+yT
+d8kl7e0l8l1o76V+o37Ty46PCb6uD2nF4Us+2LhtztVS/tjQOeeExo4p2vduNcvrVpfrNToGJ4iGACKd
+zf3MAWaDFgmWIUPmo0L9UYqTTK9VYdne9+0hf3pPrXhZ3H3oz2LNoUHMLwFwzPVj+Vr9WCBN/Vhht/ox
++djrx7Q9+xXXmYWGPVdoHkC635NrTT7YNKBNoxFArw7Q4fSnhewWbWfasrKEWU9lQUNVBuusStIXxPNR
+NLSkevhnU0EOpjtihaaG3Ggq+N2b/+Nat/x4rVug51q3wpS1bvL/bq3bCF6P1l9bvKu72ZylW3YLdVt5
+h6K4qJyn28jMPr4mdhynC4EGdbnYl9PFB4uMScqyrP9GiRAAzseA9rNeMFpEtUnKIAPKC+NQhclQnDjM
+yQ1ZkKWwHHF2+/MoEbdbqTMKsjyMry7SMHj7VeSPD7/cdcM4slB9oUNdTirRRRx4xZWH3o/txavYB8J1
+uerzsVcAjqlGLj9eIxfouUYutU159Bq5iwCkb8XX4XhYvHNQuZui2Z+7GESng+jGEptHJkuE7Qc7ITqf
+SmxIRYG2xAE5CLRyEH7L8QLA8XB8dLjSS8kJFuYoSoZcXI1KWAlLskQlOVzPU8Hr9UzxuvranGKSk+NX
+IsrAgdqziN+nZ4kzZ26OnwWJSUV5heB79eJTBow5vcq1N6ehf9/xq9rqjx9ZNnzsUN/Cq2YUVzZMOPPV
+syYPDGQWz7hqoW/o2OFlI4+vb1s1vk9tQ85epapt7IBLyVOxMeSpPq3r1O+WlNVevmLp4x3BkjlXTFzb
+tBFXPxCdsmIcJRfH1tmalg4MRB9YjRub1k68Yk5JsOPxpSsury0/C72XtvbR7JazjnwsDBcPQBgWRnN8
+SAUvElqASIw8G7EpUcO0GTPeis38mhJR1zBsj7aZcPrTEOOwvVe4NFjK72f1dWaEtk7/QWu3JvhcIrvl
+QDh01t+ev3nHP7+O9H/7i1tveeyPu/74b3Soz297UX35u2/ynpp5y8W33NFx4xVnnNG8tfGprepvrQVv
+3vKy+hvvn8LO67S1WvnNgnlGW4sUCpNqtRJOZeNEtp7FAAm2QQCuiH9bhj4dsftBbDO+PN5VpNspbMKk
+ekxabcZyuYzm+b0eV5ZpTdgT1u3pdvwamGR3O3GtuMnrrcetVkclrBV3Q3+YtaMIRUSLWSsKKGp5OdoZ
+zHdSQA89ItAWA4Y9Flr540JBn/TeH/r361tZoYwLKjZZS1w5uvF3X9zqG3dKktU3bm7zCN3qGz1u4tQT
++5yUZO3lVDWUa9aep55Ze9Nvb45bewAIHgBhI6/fOS7qcPJeSQ6EcfH6Zq1PVFzpZ1OMH6lcd+j1RIoi
+yLlcZQR9suwiYh1TDEqdsHGPOh9tX6p/ar+seCAvJ+sTXPermqWen7vRteTCd6j9VID/oAYyX6uBDKSr
+gSxMroGU/8sayFDa2uB8LbwfSFcbXEh7sLf02uCP3nsvVp5UG9xzzWGxJfmhJE3NYSh1zaH8v1lzGOD2
+4QBDZvSaw/xmatg12UbJIdVNmSfYOHfmJfBopYbh+4Tz3ov13sOlo/rQ+9pd7VjqDfM5TQJp6w0Lu9cb
+yv+TesNzj3wsjhUPgI/5wRxI0OcklDA+pPKD6fl7JWD4wTSHHjUdeU+UhkuDjBBlksaZYK3oKw2HtB/r
+xbEfqz+q16uX4KW45H10qO88fkT9CYtQ/PSHprPxalyFF+KGEZ1t6ovqH9X96otrj1KHmM9D7YHudYiF
+SXWIcg91iPw+Ju4GFwwzb2Oc9SnuVewzCu3Wa9QT7ALFcO52gUp5XUq6HAGF4wHEd4+55jC/2XSwHaXm
+sLDHmkP56DWH5CH1W/URnIR5T2EeTlIfUb996rnOTjzvuVdeee7/QM3hUY9zveZQ/u9qDtk98zb+/bpn
+qkjTdWzXirO5cct/FmYbt80i7bYZByhMAEivAyPGnXONceX8c+xDfuUEhJMAhAc4f6ewLCeCaIn1+oAA
+CAT4MOl8npHLj9+Ez4VWEfVzN1M7ndxhT4j775WEoC6bovLhs1/98rc9Hdte2rqHTD/p77/hd2rBo1e3
+kNcPDdLWsxVAeoDTZZierCoQoqkeds027pz8U4R281Pjrqnw2EFBNRr37YgSpKPfxrff/u3I2/i2uPvQ
++2L1oUHsv9r3qctldlfKBj0qUyJp38ebgImzgTGC/xznBAdBaI+DFCaCaFEZvhbuvIjzQVvPyLfxzbev
+ePlQ49v4pr4izhL2szZbVcrme77QlA4gIAokPg2R34K0aYicIAXxJh06oPGYU8YFUBjwKOCC7KCiyIbG
+ifsqK+J3c3xPbSbtPxmX8J9QMK/mXWL15riH0ryYa3dxaSnvLeCGYqO3QIHRuUuTbRBFnG0TZKofVaXW
+x9iqPSm0gvGreK4gsMu4zyO4BcX06tllf7XB4TgWcWesePueQ3V7dhlY7HrXQOJdfvDtToUGENii+z+y
+IGD4P/IMowMMjTvbPAfz9MaIOoDxzDgJA3m6+0DRTsJISoJv2WMS+2erHyT1IhEeP/KxOE88ALks5pWN
+VMiy1C4ITclem2O7Uy1MvFOVhkOKO36ngrpgXbBcv03pxs3j3//pOwzHSsn7BUe+fOuH2Dt4Il6sXqFu
+UdWmx9dig7oxFjv1hUZ1obpevVo9Q7Mzthz5WH5SPABuKIJzjUH3CIJk0lcvUSxu5lFpXg8bD3BVAAEZ
+idxmeUcPS8chQzx7w+sByM/1FHmLWAK7uzSkKA5DK7p12yxYAjSoWC20LXvwbD+OwCwswgnqDvUL9d/q
+U251Pd3z/J49Tz313h/F3bHTsRnPVFvVW9Rb1FZcjsfHFi/HcdgLQzhe3aHuUz9VHwUgME/vEZELQZi4
+IwvB9CPkgQiMWWyX6vEp04DzmxUJOoxhyEXtpUGlt09Tb0HmKJCDA4fTgVqawsAgN++0YpiIMEn96uZX
+A33y1e9nTK4ODD1n5W1vrz8DK/D7PepX99zz2vP7yfJr1Kd7T6iad/GoXic9fHzxZze8uGoWuy9csu6a
+azRe8dkIvPdxKWzQsurcyTMRWId5d/I8BMGYh1CcPPwgaBl+EALeJzdp0kEwPukgFJ90wE1tpzHegCnV
+Yxtv0NP8gvRzClje4ZFvhUZpNvghDPXRAW6kJBdZk3AFyXggPPW1zQgTF5id2kI4sbQsMqA0yJk0MFLr
+5lm9hMma1hw1HJKozPJ9Ne8EFtxy4Okv1b9+9eNv6MOqqhuWz+rI3fNUDm7quPS2tWu20Un/Uj/6Xo2p
+L+GZeCa+iXfU3VEVU2vC6j/Uf3yxa+eTQHmtx7tCo+4HnbfDlsIP2mb4QQuS/KA9AfTkCY3X7jFPaNzp
+wv+7ETc/8cSyRRdf8cq2I7D8/lV3XLDmsr8vX3y90HjeefOvqep1/eK113UMnXHy2et2RkcAAMLVAOTv
+QiOIMDSp31dBMzW0bdJnhUbRggiidiPWnHtXk1b1NKFxW9cSTY4r1fuFCzh9fPC7CdtdVn9oQY/+UCPl
+TSQU6LSU73DTrAeAQmFiS1qP6cBkj6mXVLacvIRcfv/jMf/J+Df1L7PV+/GX+/A+xJldraqDNdfAX2im
+5y31EiOfmR4WZoIAPhgWHSKiKEhIRNIkIYIgahrS2Fh6ZWqITATwedxKdqZdBgEEzlN0hUOUl36DMgAi
+JYrMBhjQ2p9jueTtl3YeVt9H++f4DMaqC7BtE+nAvup7avWmBmxAu4o1fBaBZsOJAOIMoREmwKKoMx8p
+GeQhYAYs81l+IA97L5QEQkhBs8NumgTpHnJJzAOACTBh/LgR0b59KivKy0rDhYFcxSmz1irMdypL2SQ8
+sH7gcDKwnvlOK8orjC4bfr9WuaT32GDAsiQzv6o/xx/WYMsrwgoZP6Fvfzomr6J39p97l5VLdZVDl04d
+4ZVdfce5T14wzN9/bN+KVyv6ju2XO2zBKcq4vi7ZO2La2UMrBkrlZb3fz+pTnjeG9u87AX/ofaq0onr8
+iYGxY5ucc5tO//9R9x/wUVZZ4zh+zn3KlNRJ7zyTSYNMAsmkUQypkEAa6QGETJJJMhISyCR0EQuIqGCJ
+jbUrFgR3goigrmIBWcSyrgV7Q13bvu6uqyskD//Pvc8zk0lIUN/f+/1/3jc63Ps8t5xzzzn33PucW05C
+/LYpfgnd2oHFWzOXV+gRP5aNqK9Ynrl18YC2O8Fvyrb4hGVlLV5lJSWRjfOS14hLUmQ/QMbfWr4e4uj5
+SZcNNXqUDXWs8dRY7jGUT/l126jnIdz626937LjzztTMa67duPGinTtue/HDtta+fvlfL68IeWCWvba1
+9bLKyorK7LtSr7m48vLMuM3WG59nPL8egPuKz53ANho19mNKfXFe2+j1h/DkkUPyZD536DiXdeZ5LmuI
++XJVYIlFv9U2GvUbbKNR57eNqsm/zTZ67tKkqyXLzrGNerZNLBpjG6V6fROfC6mw+PHI89pGozxsoxOm
+uk2jqZCaYk5MMIYb3KZRl2U02ORpF43h2Pk6k2HnU1MLphr9dGFT8xcXe0VPkcK9vQOTLTNj47OnpkaG
++SSnpQX9JWZeeUlUPp8rp0pZJZPD8xfPiBwwmNKNUnpxSoTWO7u0MSUppGje3PCMLVs3muUgJjdJzEMu
+PadY/DhSsS4bc51WlHKdFtMOY19Guy7UCoAAgyGUiU9mVlZmdnYmmwwFB2k4QpIuW6/lyXtH5Dt5H/+3
+BvTf/PD+pmVnnufuDggrPgvyV/LfAOFuAP4TNkZUPeGjm9AeGjWRPVRNcNtDfcE30BCo2kOZ6cVg4rLR
+hNzd72AVwfQvXiRE/uEZ+e+Ezx36I7fgzPPcdHHYi7w/9L6rP73H505gE42ayCaqJvyqTfT6I/jskSMy
+5dZcPHTmefyH7AfnOQtmLB9l9jznLJjmf+osGG33i3wuaCD3vyMIIxY1181q13OWI3IDda/M3T3UzNp4
+s9zPh/H14AthsDTP29+P53iBnvAi6jnmSPdtEFHu2x1iywEgBtitD+y9Opgaz8nRlKczxcXFqnYIujfM
+PQ9kp7uYCTQWFx05surV3a/J//730iVbizGyp/lSeqTzxCn5vX/Kn8rHwsmzw29H4ODgKwBI5+Lcg+zM
+dWpeMrWFBo3YQl1GUGO5h7nT5DZ2mmJB8TcTzNzLUNXEPbjH+Z78dwz9zxUP390m33Pg7g13GGfgzDPo
+jSlx96fJS55/q5j1z8kA3GWj+OG2b0aN2DfHvjyPhRMd8gGuST7A3X3nnUPN6jn8fu5frO8l5cVrBUIX
+RlTiA3AttMIYroJ1Kp+AQCOtLZDZMBWzGxFNVVF3tqXLy97EFEx5Uu7tfuAbuX9v5Q4+d+gDLu7M+/L3
+17M99nK/OFtMhhTIhUpYnRcYHkJEUjk7N5XXiCmEoGbEFxI9rE8/KJUtSVHsZApaBTprdgvC+XJFu4XB
+t3x+fFJiUnxwUry/ni70mgy+nOsAKbvSgZ1jGdm3m0oSOY/jculZ2ZbR+3i53c8GpM6YO6X/Xltqmn1X
+/5SirOSA8V6ZmwtL2mZHhc+0lhTaU8Y8yv04HJYSF2y982h7+9E7rcFxKWFk6fA957x7feqsUsf2oqLt
+jtKZ0zzjqh2V+5TPndiO+qtD/xg7aqBqR0Wr/Mgp9P/xgLwfOz6X35NPk1wCsh1vGf5w+AvcL5cp8w75
+Qf4mBt+hzOxjFDU4oR3Vfe0Z8rXnZBUqIidIinYf1J7IxHr9EXzvyN37+dyhpdw9ylwdEJoBuOv53PPb
+Vy/imLSfY1/1eD+xfdWQOWJfJS/3bz9+55H6/ssbj+LDaS+j8JN86r7V6YQ/87wyN78bQHiZ0avI83zt
+OVQ49/UEuylG7Kx3v4DLX8D+F1wU4O45o8wN75YfFLew84obFB4Z1cqV6/Kaaa+JVB7EZhDFGLfroAgQ
+UawdJzc9RDZRYrRGPcDHTjca1PMJ0aNwpQy7+wVc9QI6Xti/fwRjlW8EEgD43Xwu+EPkyKm2MTbYqDE2
+2PNlcFlhI8PPa4VlE3O2l7RCPonff7PiXnu6ZdkDjk/eu6QrPKdx9ofc3XfKS1LqN1TWXFw7ZcDaNKU4
+LQIUG4CQwHRoAERD3ciFG6IAIrsfO0oxrGp41yA6YSobTQ1+ftGRwYF+AX4BAYYkiU4U0WPTFrtsNn4E
+3aWPulCVbznC4dGh6XcqGN9DbnehSwdh+nOjDYTNcd9ieIePZ2+NOsfeOm6ay94aHjqxvdWN684jf3bh
++uwhBcmn+dxxqAqAsOTsV9yzfANIMDMvx4Ac7z/K1jrWyDrq2yspPtYQYFK/vdgFj6oRVdRkjjqosWT+
+rUV79nnL+fis98M35d67aP7OuTte31pQdOWxzRUPTbptUsyhQzfd9mBY+D2BARU3vnH5xX+5tTZcvZvp
+7FfCbjZfi4GnlEOxk0AAAgLpYMex6fE3ZSC1alRBdR17Hicb296o3rAQ63HDgisry4QoWkEUjedmP1/O
+aM+c1JOiFzXJGgLilJMrQSPH3jmTwURZlzVy5Qr5dOPGizdu3Ljx4lf3HzmyP/Dblz/45z8/OPE3EvfW
+m2+88Zc3XpM/k5P5XDn5S9TIJ+RheUg+gRSVPADuKT4XQmASVD3ufT4bbNSIDXaCNLcVNlm1wpqYFcFE
+rQqqFdZCZ53qlUQW8u/Dc8pmT475cE5OxJKF7WsfrPs7Skcv3tS/fnMvHii+vLs0+6KL0h7LiL+7ZbBq
+ypnnud319QsXus4DcmfEImbXCYf5eSUjlh31jBZHnQ+e38YTHhYaMsrOo5vYzkNdCE9g61lDdwuPb/Dh
+t6g+l+vVM5i8egrzhjz9CMaqjwe9C1128EKj7K6PKB8X+ciRg2CcqOnQn7elTXmx9KwmwMhpzbDQUQ33
+mbDhbh/KEzR++NTej/ZO0PjrXOc3EfTsTsNkMMGleX6xXkSnDUWNLgRF95wyCQgSunzLUyEEat7X6gSt
+XY86XSQ1eotWdew7b9Zoz6zMsAgmMMWb4mPZQOelmZRsYd7jLMF0uq3axekFMuohEeWqpJ3XXXcEu+Ub
+Nrx17UMP31o6f3Zu6by51Q9t4p1b//WvrXLtqcduHZZ2Phq8M3J5F1m/ruP7YeU8PrPTikXsvFeTe5WP
+56j7cGDXF6rfZW6VPXEy09qBoghAT31pNYodV+O249LjlcyWm0u35DODruJ5S7WLPeHSy/5I0IAckVDg
+mfWjbOyBL2O5x5GuWENAfBKzicWL4shMPES5Wk2kx7kUdc09UfNQxeZjVxYVbH19x9yd8xfdm3vTw974
+rJzvPbi36Nb5kyaF1976l4svf+PGioDAe8LDHrztpkOHYiYB27vyleo3MjUveZTXyFHfayPHtKiTR+WQ
+1nmcPI7r1nF8b45A4DoAtDO/dRrXbvZQAVXvYazfRZW7zxoGAxHcd7sr7xXz/1ivaMz8bzFQT107j7z2
+Lh3QBe7dd4E7+wkACReeVO+XV2dNEVpBw1GY7vM2UeXuxYcwHglLd8N1LztMeKe8Ct/Vb3cekS/d+/Fe
+hoaPqz+Ss/fJ/Tif7W/zh0Wuk1bEfdIqks6p2SZ1trWfDZBs00yMMsvgBHSdXx+V1pTn4+Pj4+/jHxCr
+zMG5TJcne39jLD0DfqH/3Lqso/LhRW8dlTddp7Fvp5jJ/eJ26gyQQD6A+A2fC7GQ4jqpLgGAhlf8WCtr
+mVHMIxFa3ePR+bO4lmlSkuNNEAuxxkRDkpGuMOCos0js0DI7g8UFZGaiez7EN3/onrt1yg9xYdn2+9Ym
+GzPqC9Mkf/L6K8oU6XXPKRJ3d0bray/s2TR/IDh35SHUH1w2VO+aNbE5nWhmY64RFqmr0YpdX8NBh/Kl
+TJdKmnWiay56nnTWukAA46TwUAiBYKPiLEsTlczOV42zBUBtFxcolx89io8dedDVvDtuUdqyk+iGf+Zz
+5aV4z7nTPgQ9APeFSO+HW3LAB8iIUTJGg4IWeRDYOEQQCHW7hEvdfDpfBsVGxZAPoJceUFPA6OvrTCN6
+G5du/Ou1Dx2ZW/3AZUeopl4oP8Dxpx67ZbiFXMiUMe/c+t57W8E9h94kFkEqpMO6UZbiBHYgXo9a0G4e
+xyyscxuNf0tG9gkaPnUqwNT0qelp0zztyF6/zY5Mz/L+DltyuKL3f6tBWR0cxqwR/NrZqqiJz1apSb/p
+bJXLxo9NA/KzX3ja9SlaDCdm5xKLwBcioOuAauhS7z1W1xw0GvbxEFHuYf/Sono4QxpZmEBBYz83i7o2
+ERwUqKw1UmuZ7lxrmafT03MtZys93KCOtaJpXlPcojK7i2qfXq5804cpkjLOOSm3xYXjt5yTiauInCCJ
+fjGf/yAVM2LLj6uf72eepy4IQDlPw70l/gM0LhtHCHMSM7JZ1m23DFISAAgHpHMCy6UL4H6yfP/woHAM
+J8vJp08pe9W4LCEDNNCu+E8NBQTAFR7GafcX17lojKSOm6A0/xxMMikmu/Hkfnk6n/uTfKFrjTscQNOn
+2cHGu4VPeImeKxjhIAriZlAu6iAAOeUannDcdK4icmwaB5tZ2ixOWSvyp0eevcHbEBBg0Ooiko3o2uxo
+0hHqY+dm+fLjOBUXHcdyLH5Ebn5dfkJ+BP9C+4Bw7EwPf/2ZdfyW0zl8/5mr3WcMvQCEQ2IRw/USD1yT
+2GRSEEEUQHG+ztCdOYLu+O2ZOXF7XGmzGDXHb1BgIP0fTRzrx2iQTz/34ukjH5zaLb929Et5CFfTzx8+
+d+hVLn3oZS7zzPNc6dATSqceTffmMXSP8LjM/BzCj00cRflgHx8AOs3w83UhqxtLfWooHZ/6GuWahHOp
+Lz97Dv23jKF/tBZFgfJgEwjCRAw4p2Ezz9Ow0SyYuGUjbKDBOWzYp4wF53KBNopT+eDH2hQEy8dwIlKD
+gpdWz4kgiB0qK3RujGPoG5ETgdvkmU/nZke0wUDRNgQZggIDRiPvPZotiK57gMbnTRW7RWQMZ5TbATiF
+L8KTahu6xrQhmiEmCks1KAg5Ls6MtOLcNs4s/z1NGKE/52rCWB5o9n609xwWuCbdbN+qMF2zA7TgDbVP
+aHj08BAWSnX8ZuCAYyNrDvsAnK4cJfBMIXRPLyGzVIMedekKWtAGBIg6ustDIbMh0IQWkjb8/Q+4C194
+Yfh4EdU3xM4tHa4he4ceGL7BrWsC6b3fzEe5F8x/nEPilnR6EQnwHPOROqOcIjWzHAi5QFnaHYWtK6Hp
+CTp5Mwi6SBfPTUxlDMiXHMNELHp0+PlXcK+qLp7l8k9ni9tR2d+0iUziNpHHgIOY8/sPpz7NN3FAJik+
+zTeRSZrfXk7jLgdkkhZ+SzlDpjFYqxYDAiBX8ctYsgaymVdnMwCP6gFLjiMNzIOTgBzhJnKYHay6++KX
+nbmJX7Zhwwb5xIYNgLCY+xFrxGTQQXReBAKBeR6IAcXLEER0YfSWlWDq+j7TH2uam38Wk+dFvhT809s4
+h35Xcz/iMlZHWF4wraNULY0zoEIpHuj2e91HvV7/OC/yJerx+gmaETrlk7gUfgYdpO5nxRXx9HJZJqbT
+hT330yyoaNo/ttbO5uaf5ZPzIv9Ia/0LXePhfsQGF046IFjqKj8DKwyBrEl0mSbYFBwUig3UGTf3YxiW
+vv1z0EuRQGAW9yP5JysfCHF5Ri89Ag/zRrkoZybnSkOoIYh6rh8hULw7hpbm5p/JgVH0inRFlDlCG/cj
+F6HCicmLVOCUugEwCqoQRlob6o61NTf/fGwURcM8SEvrlw9zEYy2gZB1QK1evXqYY66uCaoU9nimND7w
+W+DKh8eFi3CzfJL7UDmzn6fT6wi7XV7VO34EgUcOuFZAzFHuU1E9BnKcQl3mjwy4SvdypMEQKOjCkzFQ
+yBQ4CxcfHB+I74TR2WXYkXD6KRYun+R7wgfCSMzwZ2ED4WeuH41HINTmGeh2P18fUeA5gt7/H/AJhEC6
+tcWFj8GNk8EDLw/cVPzIpPCBcPz7CJJyQPhA+PCnAAgLSDLZwNYMIvJCieuazHyoQFC2c3C6kGSk17Gq
+u8Owpefe+xyOe+8nyff3Ou69r89xDwAHTwNoChFVe49r4456MS8164gC4nS2X2PMy1nntfDo2AZT939P
+I+Lz6g/ls64fsKlx+Nn3NX9jZ/iCQILJkAm5UADyfGdkVWPeDD8kdNs1AbsOBS/UiILGDt7eOe5reXPK
+A/UGThR5a4DWn+P56eVB6OMz3cc105kB3ui9OQh9eJ8t41Y2UT30ozrHo7Dr9OB4lYwuOottGJ05e3Z2
+VvKUWGNIMN1sM7tgdkF+3qwZWbnZuWlTp2QmZybGGyfHTo6KCJZCpAB/7yCfIIV3wWN4l5jOrAsG/3Ff
+CuO+pGNb5bUP777+ut27b3hoYdmD3AXbPZ9a2NMjj9zw8KKyB09n83lXnjlMTh1/aPexlx5+9GgrGtbf
+KP9D/mHo0J8f9nz1g/yP1tGZfpD/sVUM+OXvSv8J4d5nut3lGx9wC9WhUOE5zAVauJDVH73HvU+qhp2K
+3gnm3ifPsXIaqj8FjudoWYKs8DiDFK3EEmjhgld99O6Hqz56V6lMrRBhHrSSbO59EEB8TOAwPVlHNIgk
+Wz6JSS30tg7uenm7vH0ePo1P0/y90EqWeubPRsxG7MUk+WQrbpNX7cVe7J0nF8lFAMDDGwBcPTsrQ60T
+ga47GqK9NDpmKRWRkBx2YRUu1QtaTu1EkSISloUaSz1TWW+K9fXV62lbfQN9Aw3+eh89dQum43UaxbmT
+N+1VdJ2Do8ZbdMfeOLIn/EgYBhzZG3Yk/MgRvJoLpngPfSv3ez4BIIBYRHaL7wEHCWxmcN55BZ0I0LkF
+2S0W3XwzzSOXnH2f8xO+Bw5Slf7pDXQmSFBtoJ4dpqGPq5ry9B51UYGUS6iYPRfPxIWDWeQuLlwsBA34
+QSi9nzXUT72fVemQ9eXg2q/WQBVboMFLp9zHLHiFJCPbnxbojyEWKvSJiVTug0JCcbd8A3bjMn747ZTt
+b/OiX+qOtwSB3IVv4BtyqpyK3CO4xlQozd8tXxmXPwkIhJLHOZOYAnrwgfS8qT5IkGM7PAQkHAJPgHok
+r2XW2jq+gs5oQQ96Oo3UeEUkY6YxEy0GOjVAo4HwciB+v+2mm+QItJHHzzSRuOFT1jO4GLcBIKwjd5E3
+xRSQwJI3zbUGTcqABwSe7f9llv1a9xp0g1BBb6pKihW9opIDMz3u2qbrK8EeK9DrelovWDg90jh3ZfUl
+JE2uSrPe0pFVuPKmmilLyD57XEzG3MnJxdPC8anOX+KKOwrLlhVN0rG+N5vcxRGxEIIghp6T8EeOGBCY
+dxeuDAB4AXi762rxerdvjQasiIs1xcUZGWaqAmI3gAeoG9Uww+XY5eOW27bdue+Bh5//22st3Zvny1/j
+9YeaHasXH+Kv7Lzp0lvu+/rlb1A0dkwbfuODjobWSxVd0k4e51IZX+LyjFoEnO+aJ9ayswN1pELxSGwI
+4r3CkgOzsrKZA2vgBkOqFuHU4SPkmFxnIY933HoFbunpkbXyfwFwMI/cxU0TC+kMCpIhPi9Wj9SxBhM2
+AKh3jywNWDElKSmOp0x261iNu5nxriveg0JCRaWd9E0iJtx78KZtD7925o0Hdx3D2/7Q3ljdttiwcPb6
+Nuu6+cv5Wxd1t228qPGSi1pu77lmc8/t22uWTC+cnZlWuT27+oLyJQvyLCWW8fgCzAMhB3T3AkclBjge
+7C5u1Lsvf28gHnxBBVUFdaKsIEoY5FqnJ9aW27bdMfjAwy98zRiDoXKPypiFHQOX3XLfN8e/kU9LndNI
+6ocdDW2bFH9A5AcuXcgDL+oBCQgKpMFjnZOubogoEKHS0+znFcn6iVExjQfjVvkozujBmfIR8sOZpqGh
+pjMKz+dz33JZfNGvfPN4Kd9K88m33LdNTQAEQjgbdxH/Mhs/puaZPRbC3FXwrIqxQ4laFf3WCeFqh/Zw
+tZytvR2hvZ3h8yzhySSxE3xAfEyDmE6vwWd+moIZx8mke5955t6eCy8kdQsaMXXPIzi1sbr5g/t3fQAI
+OwmPpz3LBmbSa2cJ3TmSmZGwkxbtvvBCMaS6Uf7LI3vkNxoXNH94//0fULgO8jj5VqgEfyg74McxtTjf
+GUv3egFHgGvyuL+hjnhebQCe75XtZv7gbwqINYheEcmByk7WkfUKx8CqO/4yMHn6xhXkcXL1uquOymbc
+W5mzQ14EjNiz5ANcOFk4oY4uG9HR5WN0tP736mj5wEQ6GuEO+QTnTRaCDmLzJtEZOQ/zFBpQTVDKNIEO
+dMEGXk+/8ywGTWa8wRJ8x/ff42XfyycayZ+mLiofOt4IgFAmnyDPkYUQDhl5ad4I6MVWFRGJomHc7VK8
+HJeyfavhEB5mShL01BJPD6d4HFkLYjtIjMFllnULSq8/cNHtN151/Z7GDRm3x+DT8omO5vaCWatX3lfS
+mXOlo2LNwoSVqzoUWe+VD5A3yUIwep4LiTzvuZAy97hQLlQkmkxGU5yoZ+NCaIh6bks5GKKe8gwO0iir
+zr0hXhsLP7vl6pmWS65/4sIrr2jHoq/Q+6UH6+Q3erRc0h8Xt1Qtrd6c3hH32u0FaU+uvx7ouCif4Eyk
+6FfGxRI2LpaOGRf15x0X5RPnjIuMFkW/Ni6WeLZfHRf1//1xUf7zecdF+QBHyMLfNi6WucfFcve4qI9K
+zv5vjot3jjssIrTLJ7hUUjTOmFhSPtITlDFRP/GYKJ8YOybKB7hpZOFEY2KZe0wsV8dEfURy/P/YmPjH
+3zYknsuT84+JZe4xsZx48CT+vzsmrpxgSGQ+8jRcOjaAFyTmxY2MhiIKAhPceUKF53Coj0wWxh8Of3GN
+hgjzgXBZsOhXxkJ9aHK2MhYSOhTSsfDsBu4i+Oz3j4X60OTQ0WPhFe6hkI6FciSZRC751bFQ7hpnLJQj
+8TS55NfGQpIy3lgonyDfYjv4Q/nIWGhkTtBcvurmKUd3Sl332sxzy+tIyujBUH/ewVA+cc5gyM638g5m
+Gzr4hK9eo3ohpR9BURwSurBLeLvLbJhTrtUQQZjO/IvF0Q3T7IhuE5w34xTmP5eAyBOxw+1U8dycbDcJ
+csqNeaMysyyzhIomdf80tT0FxAbQ/dO6aPfNeYr3N3YxrOEaburwzfjWNc+F+d5/+RH5B3qNXubgi8+Q
+J2QgR4ZnutrOfSV8Dwl0bxwgeCEikLKQEV/Yk/WIOjowEHqvngaVvVsAXlbw8sotB/V+9OnKlbBeAOgF
+9nHLqDlnsb1xCXFGaVKMIZjeKmMIjPXWTaJiZ4pVbKbsF8S2e2VmKcZG9qOdmzu+wbF48XffffddQz1Z
+ObTLbj969OjRl5/pFF57MM3h2L5hzwb/dc+X7N1rvPPixg17NlS/9SkAAA9tZ7/S0nsw9BAIk6EcQ5Q9
+uVGTYoiGn4Wc5gJEMXky0eMUJHqhLAhJaSRL9uZnoc/o5CAUiFjWpFSR4q0lGh8dEVHDOIZ6Du2uq5ly
+yr1QrydWX8GPU9ZVFMJmgzf4cN4+di1qdDhBUT+6FMMqEKy+KAgFVEgyaXO86SA1YQ203KzR5Zqa8kwA
+5fPzcmdOTzUnJZhijVJ0VGREaHCgwYeN8HFGf12Y6wA5bzQZDK5TqcrnBtsDk015o5xqCHUdW6V3ITLe
+hAa7BolQ5fh5bBu2fH9S/kD+xRy3+5HN91fx7ZWZ11zd2XnktdeK58SkNO1aU1aWe0H9ip4/3r5ox7b6
++fNyckqv7X32pmuEY8+/9eXZ4f3k25tvuXX96TdCrroKv9x8W/Cjm+WcmhqvpXfNy5kelxtuSr2kevWW
+6AGzJd2YExKds3bxqlsBAc6+z+cI34IJtuUFhKBGDEVBwyMQAdF9D8cULQo65DmB79Aj5+1FCHCkw7WH
+M7fcNWmcjWzjpwgaQdTYxy+kZi2g9p4QZeNnrDEm2mAyGgINBpPRhwp4gJHdMyBxpmxLYka2KdsSoN71
+zhk1RjE41KjBtQvRcung4a1y5MJBSw8Rdlmu6MZvNz143+XyX20k2zL8lUDwZQsJl9tL9uSR3PXzk6o3
+kBDzgbny5g0LksrWM1lvoBYhZscKBSMkw5K8RT7IQ6gvEXhSxqFrliMA0QjEDhrQaTU6uzdqEbVN3qjV
+otVL1HOoxQWm2PAw5ic42ZQcExVmDDdSx+Teeo0yvPiIoewSWyojmRn0MJtGEQLqLCaWzY6Cg0iiMnsV
+g4MahLJ7Tj+Ga6/q/Ri5B/IWhlRf0Hlrv20DnvnqXw9+86+rFm1e3rSFXgTD1c/4dD+GhvfEHd922dyh
+5PefwaD3Dsvft/9563V/3nKDsiZ29iuyRiyCFJgN0/JSUk2xoRqeAy3SbxkADoGzAxKCTYBIpwxIFsyc
+PjnVzIvhyYEi2+2fbVBPezGnUS4vvdRfIDvwFUgdGaXy7NDXxsA58ca5obF5i6fPrrUEx8xbt5jzi5Ui
+M6cl+S4KyCjvKMwrifPmByzFyQEo6INiQozxAQLhvUN9e32jfPDKhz66Ittcv3FB7eDe++esLtm2vCCt
+qiU9duamZcUXbHzuCgTLykuvr9ElpWeZoy5Y/8R6AB5uBtD6szWUUIiEeFiU1xiFqDGyObsWNajZ7PpK
+9EKiZzN4O9Cz1g3svlWd6M0JvFAZFkbN1fFxplhpUkx0WGRYZES45+14PuJY8/TIpI+j8weDSTlbYhLv
+efjoSw899NJLD9XbWhsbW61/IfW/XHbwCNl44EVsevmhh/987OE9xzZs2LBu/fr1G9bLyQMDfOeZW4Vj
+Z+7iradzgMAH5JT4GbubLQjm5c0N9KeOQqiTGOBAw23Sa0Udz3FXCDxBAGygobImhVCp1QJog7RBAQbq
+50SvU3fheInhyaje+W800AVzg8nAmTgD/vVnsvNndHx9+81v3be3ay85Rezr1w/fQk7JdfjIcBQxyZH4
+6vCPAAhbAYTbhe8hkn6vRPgTOhFH4AF5+6hlIJHdKlGpXNMebwiKM2hE6nTA6L5cEOnYFWfIUC6vEYK3
+YrX8KC7HaIzG5fKjWD38X2j55vnnvkXLTvTFW9GMl8qb5L/KHfK/du78Ah87i7HyR2dBrvxyp7qGd/Yr
+oZq7GibBNOqFaKqJCGIYZXwZAMdzwG8CAqJARNqtORDodbuIfJN6AQqPCxINiXFJJn+NSH0AiBrm548d
+jaAcDw0JDjbGBgcHhdId1mhMz8zMSFR2XYvBwUH8iiV1dTv/ePWZpltvbTpz9dVnFt4ypPMLz3jvom+H
+b+TAEJF10m5/NyP8D/Pji+rLL9+z54ryurryK/b4+JySIjJL8QWfLyZFZM6blxkx6QsfABCg6uz7YgKz
+OesgGuJhGjyS56PVUCsABKFIiDoLM2mRCLRdm2irCMd8g+YoTkF5DVH0szJzNAFwsPnc/KOy5iWOzqVB
+5HGLR172SV7A1nrYBVfmKSZjRJi/LzXZu5fBqJs2jpkjhAm+l9jucPe3UuJtbC1GfxQXkj9d9oeL+7ff
++eLgtVc9jBcu2751fndLecmFNzILOuknP6f1NgytXNHY275xWdN6e+ttvduv6LrrtvkL+5ZVHW20liwu
+m1Fdx2g46+z74gWMhrGQBblQig3KtDRCQA3wKGq8UBADkdcHIMenoBcnlrGrPDRk/MQmhZDpoAU9r9Vf
+BDxwXvQKGnrzD0EAZcpHB8j8cvDyQqvO280CCjjLm879tvzW8jor6HQFupFFPSCwecIq2EffRDXk5XgW
+JqAR6WVUYysZryidVMcAFBfOmmFJm5wYK8VERYbTQU6rLND4qPxG1faisFk9tZFhVO7WMcXibxOE5/i8
+rWcOy2d7HBjx7Ad7b162+rKqBe3ykMX84+0Xcn8eVzYW38BkQ+hekfCXOwrSn153bfQ6y8LSAxc+cOmQ
+rcExRk6a+pdVHW2wli6eP1OVk4Vn3xeLmJzQe4imQB7a8wIykGjMKPLJ6CX6ouDFqx0uCwjwIuEvAhG8
+BNHLrvQ3nU67FLTaHLoBXG8FvT5fsY1wIwIQzUqDFjafU8VvKE0FL0MprQcN0Wvs56llTFkqPDmsrDci
+h1t+Uw3sgEcB3aGV5VlUCzq9VtcxYRUjBZtUycnLnTE9h94qkjY1JSEuOjIoQK8dLTm/TTTilRMmxlgN
+s3apJqZjbHmNy7j0Dxf3X3vHi4Pbr3oYl1x07VXzl1sr5l54w5ksW8Wg/L1kxI/lmwdffvzOuYoeEcbR
+H12VTC6o/ni7P3H/iwI+se7Nu7ZddpMqI2keMpKBXJ6XL901X5aOIs5XhCNFj1pe4LUCHW14LaWLqo01
+S0GjcXFGN4qrKQAa2HxuyQkLUXamjS6kU5gzXtERPk4dt4wGREI/jlxlRzEwLyo5GSA5IzkjbdrU1FHc
+8/qd3MsKDFSMcluYrudsrr7s4lhZd0s55dglIQ99KieTevmuMJVXDY5xedWi6Pq3b0eCiy65RI6Rv1bP
+in+oaeaeBxGkvGhEmCcilp6zZSuAiGHJgchRV113k0sH+L+eSRkgVdgrnxyQ36H1XCv3c71iEYgQkOcn
+CgR5mAcAqwJRDE1GjDeYDAIBtYBYNHzJwOkY4bOBMThQ2z27M1tAnAdsxqYaNxAqDQaKRTylDIf8xwNn
+Uvi/DpBLP8TJA5gkbweYAI9SDzwEeqIZz8rbMWkAJ8v95NIB4bPTMQoeVfChxsk9Dzp6R4WACFjGI4F5
+9IjKOTQJctOEQww0BlaRSwe4LQPk0uFLBobWqcSheMnX4mSFRttV3HR0DwFQ+xjGG+IpUippWPbtdDGe
+UWjIa2D4Elrtv5V9e9uHP1XL+1Drrk6Z4hDUjbPJLoJiN1I/1QJjYZBTw1EKnL+qcFIGTu8bUOaIM85+
+xT0pFkEwSNS27488wTK3hzrl9kI7CIhCk2pNFXBBXGwcNaGKUcmBAUZ6rBeM9P4++imXSeevSK2nTMoD
+2m9Er9e/lPeeha27sOm12mVHBl4gUS0XXfFh64Kr3hECMF2+UX5C7h7+9pnbUcTHi2NeQG54WYf81/1r
+B7CX4TgHgL+L+dTQQlpeqpZt/OSRsGUaQocORLaXkW19I1gJoBFBACGAp5+amWjB4HgjzsF39uJbQ7mc
+IG88JPid/sehQ3wSAMIBuV/4mH0vTclLVCbqWzyEUrmedtQqphjp/nKg3w4Y3ipMbv3L8Gy5Xzxwxx2/
+zBMP/DJPkdOVcp9QzurOzsvQEkIQyzhERELmjzi7cO/F5NleTAVUgCFIoB+dmUgNsUZEY/BKsmt4O796
+6CHSNFzI/V3ue4p8z/ndccewdmjoKQavjYh8Cdn5K7ZiUVnsbONOEZHtMT0bL58k6WzfTWhe0MjeDY8C
+HFrwfbLy9uEd8klNzX/Us7L52I//4czgRfdLMnhNqmkDqJywvpMxsvaRf9nCRZs2LVq8idQ0bbp00cJL
+L2W2lxl8myYVtHQPm5YjyNMuCao/nlWGIFZLYryySXfKFMzEObi+W57fKF+OH1TvHO4Vf/yPNzlNcV2B
+04VCPhlCIQ7S8E+Pe6PIrLNeygkqHjRNzJghUmOGKKDY5CHcBKl0RyrXjyWp5/SYI0GRG8nOVrrZWTAr
+oMjy+/6u2v1+Z+2G31V7wO+sPfJ31R71u2r/rRU3qX95XnHxcfFxcXGxWlH1gKkKTqI4sljDKV+ZyjCa
+zb4+VcMD+a/ZtzRseOTQpvL76vNuqV+/59Cmsvt0gdYLUwuXdFTMWPV6UOWqhKILOypmruJiLrvs1Rvv
+/tuWK5XwSvmbyMvrtrUu2bjssciuLhrpAsROAHEe/xkIVBOrIkkI0wxsyRlJpfvSSjFMucU4GDsH+Ar+
+szP7lPHmOgBhGruXOyHPRBU5sGkJkCZ1WY3AAuU2GFOgiXZ5oyEDTLHAFiTAkg7BBv52efjQQXloABf9
++ydcyK05hJw8fGhoK7fmJ7pL9KehrTARrM1AwbAlISsQIKNhIXPhC5kGkyGIwsocC0s4NrT1kDyM3CFu
+zdDWn+iu1J+4NRSWt3ySxGuuhAup195oRFJWwKyFgIQnyG9ig4ew0X16UuA4oUmdSwncguoFJXNyZ4Un
+pYhUy6UKmR6u2JWT3FnZrquTRHrwUPHUO3LZErvrUF3v5RMPI8/zPNEGmzLnpUWmx4doAyID9UEGL5HX
+6cImBWg4QdRoBB5FwvPcxNl4jUYjInd99IKl7W0XJqR1XdQ8L9kgTY0Oios2CH4RwVqjVshbsixZf0H9
+Rd1di4r9rp561c03Th/JGBg/OqPv0stvumpZKAAQKEaOn8SHQwRkQlleqY8X4SAcCMGkBKLhMyKJKPAe
+hkggPBI78EAdNtpBEEWhSf0iFcQFCXHxcQlx8UbaWQL9LemTOPo1kGnxN8VOJQl0k0VwMDfB++LP6xz5
+5eWLU9ptn9c58sqnBy9KtbWP95KcxPwcKS7OvFsnP5sjxUV4Je/WyUXjvGT7J8P4GfyVig9PHbWZg8Dz
+wgo2NKwUkdcgXf7q1CIbtLW0My2l+7wJVlKbOTuzCQhbzp9VuSyB7adW/7zEScnImeiskV7NEmihk1iN
+MZgUdexYLj+BffPkAvyvjh0dOE/ePA+PYLn8MmYOfDSAGfKJgY8GgINeGOCn897qHmjmt1uLqjc0zTlL
+pyIbUSfa9ywqq7Go/nq5l4amK7+BgQHsVX5AIB2Aj+EbgQM9XTHWs7meCPPON3RbMo3BBsRgIViYw50c
+CuK+G95FFg0MYCbegNff9Q/yT3DPm9I96tZpNb+pbozPjM9EpHUnkUXDu7jvhoIGBvDNf5J/3CX3yN20
+7m0AfBl/N/DKLFqrEXmOiDiPVgjYQNA9nRcEQSfo/GnlRqMBkTMgGviyoVz8FD8dkB+THyPP4qfypOG3
+8L+wXH5MNrCzHZjEF3FrQGDzGLfGbFAPVhL6meDPUf3Frgbki4YODnAfkFNyEakCQMLJVdxnYhUEQfXj
+PqgsFYexa5QIBxyhE8srADmO2fmpQkJuAb3fgqXCpvGSmw5Y4kz+vBhBr33zD3ANTZkZVEsZAsmxI7f8
+Mb9xZlKtoOVtOrmKvx9fwR0lWVNya7QGIt8obwKEb+Qq8mfNDgiCqsd92NaK+c5Qhpcyk+U4sgI4RK4J
+OA5bADmspufw1FSyeWyiB1YWdr+OMkjSL09LuoEj3NFbH81vnJFUy2v5Np1mx+lo2SKvLMlKzq3VGhC7
+UNnLB+QB/hVuxq/PG+k+YP4V8gA7Y4QkWq4ig8wvqCHPVx0fcZXBn32WWAwmEj0woNnx03NqXgZD4SlB
+suWczxkXT4ONtChxDp8a4GYMnNmt4EhhcTPUsRgJbmEjWwOo279g9FhsYBeHksEB8sDAmRC+XsGXtlOz
+Q8VhzHhOcXLjwJbISfQAiRqu0uw488iAi06svSoOrtH1fDhYDLSWBwb4ujOh4KaDZoeyw13BgSPclnMR
+Mbj4qtKD4nJqgCHDSOKiCatrSl6igg/hKGHp0gOMHEAZOXsshie7LqqmzGGI/fQcX++uj/FIQzUG63e0
+PHANPHIcW1DhYHRl1NMs8yv7yvDnA8OnGLv4+gGXTGl2jNQ13smYcRAzBrOmKiR7ZGAEL+YLn+5kYe1U
+D5qPt8bi+aHmqtOoNJiR0FX1md1KuwkACeBf4V5175X5zefk1C7Bzsi9csZCiTcwIJ8cUL6v8Sq5iitm
+/UNDrR6K3xd2qgFXjVMV82trsBjwqoGBAblKN/un5+gPRuSGe5Wed8tLFpC2f5xPSFC/IOnlBgaDQaQS
+xIyDlARKn6I0kKuEjQMDA0O5rG6KJ+O7YhPhyZjeybnUwNha6UUXBryKK5LXUIT5bxhNz+xW287wFcCL
+ro7xzLYmIgEQCHSw6WADnQ4updPBSgAvOkKJ6s1BVH+w6lUF/8pw1QCDQqK4VwfOhAgb5aKhXL7erRdU
+uqh6QUDVpRUhXIO6a5AjY1qgaAi6/YoMDgx/TqK5GQOnL+OeH3DLHFfsSRMgnnYBDlWzwGiaZLpoIq/m
+ihlN6pn6YTRR61RokpOXSaWMLiVpUABO6PDQJSLTJexCJS+RUUYhjNat2TBTFWkGiAQMnNnNPY9JA6cv
+479RdV0A6zMMf1XXAdtkAxzPM7LQa+05nqoag79ArRoWl6ioXUXRfkOzBxSa4FVqn9aBKU9yezHieWxQ
+/AcBj6Orsyidjwk0bh16mmovV7emMqLiKCjWF17xDj2BiKgugUTqM8OgHCuzMNE4xaoeIE5uBl83NFsu
+Ei5WdC3t10w/qrLB9PVvlg2LIhvRA8OVTDa4505fPuAeB7ji0XSg8s1hA4+IFF/kzqVDpkKHoafwKpUO
+oQNu/cMVq3RQ5IKhKiLhgPJLEBiq7MQ4J5xHLixMLpgGGf6cDA4wtM88Ip8c4L8+ffmo8ceL0YTJhcAL
+W84nHCrV3dLhHo3UEWlotluXqP2e6Txm9WJjxmaNIHLKsuno2pkS9OKVvq8oQq2HIqSwVNmR17gUonv8
+4zxg6cCPWjA5BB42i4gCbgEeiIanO3QEQdMAGo2wFASNUOl26CQyh04mtzSZDIxBXBGTKKZs6OSF/0aR
+Kk99w+iXkZfmOeaKqHoh5ji2lYKKAT/BgGRyyZZL8XDP/fScqnpceoKNnV6QmZfOI0HCaDjGOAnuDQ7s
+E0QdS7ViuKqKXMOJvFodUvh6Nt4rowrnhkPlzg8K8maLdFzZrFH1ku4cvaR16yW6mWK0DOpH6SaLIVCZ
+AKqwPVQUlUiKhbut6tjrBdPzskAZ2MRzBmCNQNwj8LkS4xqFKXhlJFYmj2wwHsrlnh81v1G1zZhhThQ4
+Th3oxnBMHelo1XSkozMIdaTj61U6qm2gcpiXd4GIHM9tAdAqzdAg4XnSoEFC+KWCjvBEEX1KRC893Sjg
+box+pDFUStTh74yFRLsHQKVVlJhMJF3zF1Ve2H5oZbZFsQCuw2Nzjwbp1p5xJcY1U6JUZN1bnc5wzw3N
+ZlT0mNOxMz9sVrCZJwQEARpoCOzMDwjjy7zSodXurM7B3GMB526DIou5eTMVLSggcFpCRKoH6QmLBraU
+p0ONqKn09gbw9vP28/XxnDzo3brQyP6hDcKrBtjnBG51t+rMI0q3VvrcyBwzkHpfUfq1KIh0jqnXEUZH
+LfNI0aDFEUqOY4ZwzzgVTenq6G516e7wqtZUer0nfDYC0B6v1xENaLYInLqtiWPbmrSia0ViLGjPTj8y
+O1M7INNoLhWgUt41WwPg3fAp/Q0QCpV5ZRqmDfRMG2iRE30I0wdeyBSCFzt4o/MmLpUQGhIcFKiclh+j
+GnzHqAajh8p1qQiVP0zEx+oKt/plMviDqvMnWqswGIwG/hXyA3/XGasHb8kP436nGoxqB/vhTLMnH86X
+V/2mpXlJx3nyko7h29S8pP089SLN2z6SGyBAwUN8WbVH+UEg2883CUyQCMkwFSyQDTNhNhTCXPgl76cg
+PYHU4MlecQLTOCHehEwNneITLzBP6mG+hJ8WnuyXIAgiL3RE+BMxLdJsSBQ0WlHTERVAtOnRKYFJgi5G
+q+uIlUjMpEkxDTSMmbQ005RhtAiTYiZVFhXl5c2alZOTkTFtmtmclBQXJ0lRUWFhQUH+/t7eijGsaG7R
+3DnFeYV5hQX5s2bPmp17Qc7MnJkzpmdkZ2RnZU6zTLOkp5mnmqempiQlJyVPmRyXGJeYEC+ZJFOsMWpS
+lHurYVBoEHU1G+gfGGBQ+vg5ZrYspiotwfSWG/QIA3/jO9fFk/T3Kr4q25R/Xp4gPvTqKy+/+vKronjk
+CD1RLa86ckTuU0Ix+be9k/tfZH8AcR5y9uv8nQ+VUAMNsAiWQit0wDLoAQeshvVwCVwOV8LVsAPARQrP
+X+D/H955ktEzfsL1R35r7CXX3xnLiddPvH5i6DgNXhLyX3iBUk7up+ELL8h9L74o/mskrqS9+KLQ8T+b
+T/yHwq2fN7/A/oCp3N/HNwjMNAVjpil4bPhb3xnVH1URr76Kf3gVlX9Ix0QPsv0V0k5fDSHVVf8T8qrM
+FxHaAPiVvM95dC9V6228D+9z5l9APPIrFiplZ0GHx5I9716yF3hWiaBWEm80GNt4n6F6rmToIK2ulStR
+1rjxJtLL3fcb1rgpKsQXbyK+w6pNvA3vIr3c3t+LT6ALH+Ir12Gp/ATeRetsxRI2HoEWePh1fOiwpAUC
+BGQAhNMA4j+En4FAcF4A3ZODCLCKoUmIGEJ9aJwmQ+SM8PNp3ej84mOE7isJRDxNE4Wfh/lhAcars3RM
+nToi/oNmVooBjFuvEVH8B02n0BW6SRjL/ZV/i9FN+7hG4JCkJ+s03F/lBDkR30WL/Ir8KqaTITkB38N3
+5URMl1+VX2FlH4WP+AR2R00o3b/h4XwdgDS5nRERWHCO/3UxJDk+FjKVVdAg8NxhTlr3ycPIH6Qrob88
+ecMrx6/bcfzPH6Hm8YPIy2eePCifbT22/YaXX95x7QlADxzC8oJds2NY5b4lZwygUZWPqhSIR//3U6zA
+HDIL7mYeCZDNALBK4L10vJ/gRz//lcEd0yFTWdUNAvXz5ManUaMstSKhn7a/7EI8+CQK8tDBx+Vf1Dmx
+B6xINrNX3IILHPto36xBnvCbRTZZVGYTAh8a7B9piHQhoFVnIp4IZHvs2qfIkObvqHf6L76Un8LCzx4d
+Gn5snyzTiQjpx4JvvsBi+akvT8mH1p8dfGx4eN8BVSYKcCUZ4F5w85WjnEU7zyaHTTwCECtlMhmXr+jB
+y1APfNBe3e+oru7tLV+999H1G/Y68e3eqlqHo7qqz+Zcu/7RRy9evwcAQEDvs+9rYlSf/yaYCjOhPa9V
+RF4raHlhE0VGx4446Pz0vpyW12ntIICGCBq7jyJ6Pkz0vJnopU2Lj6NoZmdOm5k2M3ly3NT4qbFSTHRU
+RHiYJ+r+YkiyILl37UO8R5yzBBilQIvIm2LjAj2aZPSI897yEXkmvoQFWIAvydnyQfTCXvk6+Yx8Rr4O
+u9/rod5nJ5f2TJKHnnlGHjqeXlJisZSW4qH0klKLpaQkHo/jbFZ2hvysfEieNYDdqEUeV7BKZPlaMk9+
+A1PfPHMG15fQEpaMua4QAHhMOfu+ZrYH3Zry6r0Ewol0L8cmQPplhRq7j9abE4EnIm/32KygZ8SKjwsP
+8/ZGUMg0KTrMFG7yDvUODQkKDPD3VQjlO4ZQGMJb0uOyTcQocdkevDd6xPmU0cQZ+ngS8s88g/yknlL5
+Hfmd0nUZpYwIZFImI8u51CAfvXnmzJuUAA/OzaCNtpS4QgACe89+JT4ofAqJYIHmvAupb4EAfz9frYZE
++BDUGEUiQkKcKVbgOdQKIl/mclKsnOUVETp0KAqC2ER9RbH7u0WhcnISwNSUJMtkCyRCoiEszBCmF+nR
+3oRETWJ2QqIp05KVnZgdmpWdaQkOCc0O1YSEWoJNoiZUkyhqgjkPEnDN61cO2gfnhQ0Nhc0btA+uXD+w
+fuVgp1N54ewcXLm+8s53371t58l3woeCywftTvsVA1fYnfbB8uChc17g1e/cftvJkztvfQ8ItMv9wk6x
+CEIglp6hMQgERSxjjv01Im7SogCCex88pyzkKne78czUHRYKEBMVGhsWCyEQYghJoF/a6n0ehrgRTrNd
+iMGch74hB+RcfF4+LR/ALRiEQbhFPiCfxufl3KoPqPO4d96R/4Kpcv/PAxgUjysRWJZ18ndnQd4RL383
+8DM3FVPee5t6bnjnTflVRf8kn31cbNCkstvCtI9Hh/ryJJ3erJYYH6jhuJDQAKAjOIH4LAzhs7MCEhPi
+NCLhvd+Xb0ogS+7bYX3/QFaJ/K38b/knOa5kLgaj9/vD703HlX997a3sHPna1195l/8MN1h33Dt8TwJe
+JG++7RX533L03BIMRW/0wndLZg499+bJ6dnY99pr7+Rku/ampgPwPkIWhNG9tqHeyLGRnVBLOSF0LA6D
+sJCgWLo50RiobmuiXkdGDkEmBuL9izqvu3BNSddKR11d38qukjVLdnQuwrZ5V13efZNj7foHHli/1nFT
+z2VXzZNvV2EKPvwZkOiptknBXsgDYpnL9wS10iAI1C4qCBQDCaS4+Pi4WGqbMQa6V41DR/Ytu7decYEk
+vGnl0ZW33rs5fUbG7Y7eP1imp2+599YVL/UuJET+r7v7L7/T0N508cMPX9zUbrjz8v670TDM5jfzzn7G
+9/PPQDj93vVxzUVip9DvXSFhKq8AC1RW0v14kSzNCFxYkF148CbHO1lZJx03HSzIKVgYGNMQfsnSubVf
+P3P9U/J+LHvq+me+rp279JJwxefk2Y+474WZoAHxMZ7OXQSCEBiAQLxekD9B6YUjGC1/Hou3YQgG4U65
+Q/5W/gYQ0s6+z70mHIMYascK8tJzBACRkLKRHaVNqm8HggtiosJC2AwODRbqwYESKDGLeXZITEik7gKU
+tuDx1svnvLusOLdxXkhGdKL8SOmSR/tnYQr/h4jL2zJ3XdN5ZUbtBRdUFAbGB6YMp8hpZbMWXqnITJv8
+rTBZ+B4i6EpZOCLnhQRJWYSPN/DcPEAC2KT6qGTnwK200AJTosnElogNyk4EykIDGoI0I09k8cP37dmz
+Ks/CTVvScvXuPestOdzPQ0e/+P6axZvD+A6cM+R3ttV+5aLLQrge8IHhsxYNJxZBBCRAOsyHNuiFjbAN
+7gcZJUzFWdr782NhCB4CAgj+8F/wOBAW7wakByFQAAQetDgV44BABIRAPSB4gy98Ac8CgQDQKD4s6C3b
+t8Shn7/f5mD0R//NgrL4o+O0nE67SUJeG6rlQzfFIISGh0L4JggPCQ0P2RiNkRFiRKS4yQdF3wjRdyP4
+ekf4em80obcmwluzETQBEZqAjRAQGBEQuBECDRGBho1BaDBcEaufxBEv5HSEsxtRC6GgDbWHxZOQiIiQ
+JhqGRFijMCIkwrUH9I7/Jno+EZE+m8BHjPARN8L/SyR9/nfT0O//Ag39/3fTMOD/Ag0D/3fTMPj/Ag1D
+/nfTMOz/Ag3D/zfT8H85+ZpG/vKXIsBZeAB2wTVwNfSBA9rBBhlggSiIBATAHMzCdEzDKZiEJjRiNEZi
+GIagF+rgLEoIKMF/4Cf4BD6Cd+Ft+Cu8CsfgKDwKe2AXyPAAyHAP3AW3wS1wNdwP18D9sA7WgAM2Qh9s
+hC64CGzQC+3QC4thIVRDFeRBLlhgPmTAfIgFCSIhAaIgAXzACwAiANm0RQRmXMhmFwq5DqSL9JqG0JDQ
+EG7kGq6sUA9HhcI4JUJjOGR+el3ue3NJdi7nYbiIIThBdah4LkpM5dSMdMM6poeEUu/AIeziiBjOHbAT
+DqkcO+TA7ipL9OWUOrNTObrdnQXMzWRoVpaCQKJ63YQvxw5CqP6HxYRECow+J4qihsaz03MJ2Tu5PjYh
+YfKUhHrj1LrY2AROH+gXPTUj1pg4JcY0OTHQW6v11XiLBi5qWr0kxSZPliqmXFARGh2X4RWbkoMRnHdo
+UNTc3ClehgBNkJ9O72fKMBmGn4mPMyYlJQn+Ev7ZmDAlOi4xPthLq/URfUS/oc+CIqOmzY7lvfwCvUIT
+TFOn+RiCA8Pxxrt+qJ42y5wULU3yS8y3RET5Er+kOVneIQFe4bFpZX5E0GjI1GWzMwqmz04PTgz0NiaZ
+w0xJEboLL8r00QbHRkZmJUcZE0OlxKzU6jMYEhoSFcEHR8T4eIWHBfK6IINXVKBPSGiIj3dkZESUb4YJ
+Nb6+CXovATcEpUcTjbfXVI2WR+4aKTp2Uc70pZOiDXFxcYtTvY0JUwITgkLRMiU8OjE+Mio5Ve/loxV8
+9f+Jk6TqlPQ6KSJ6ckR0fVppgW90iK/s1Bl8tCbLrDDfYD+tX0i4V0RUYCAujwgKDQ+J1Pj76NB/Smh0
+UnxkZHKK3tuX1jTsGxUeERkSGcn5+nnHSmFJ0f7+gf78HwqqgicbgyaFR2RnZ4Znrrr06vn3/XuwPy54
+6rRpIdKkzEw+KCJCr9UIokBI1CyLMSEhoSRh6h+eenlJ2S3XXj49csaMWdGmlMiEJO/yuUNfBvtHJIVx
+osjzoobXBIVF+gYFaul1LZooTuMXYUmKCIkzp3p7kwizMQCjzRYgMEn1OxUCEXBZnl6HWqJHHefysTbZ
+dYFaXrkXuk9Ei6jeIYYzy/XuK6kSQeuFOqQHot2FzslJb6bKCwsNBQiNCI0ID2MWBUOgKdZg8Gb3U9Gr
+g1xO88RgZR2dOYr743XXYfbsBaUZ82YQryPykzjnCLd74DK8IuJKH+u6W6jnzaF6gaMu2gBh0tmveLNY
+BPHwJ+XqJb0vckRkl1ZFuh8E4rqZyQSEaK061Grz2H2G3FINctxM5WofAdmFPepx42TQ6WaUs/xwTnZB
+mF7uccdP5K/lnuWZm/pKimceVQ2BSbFBenoVUFAMoVcB0eMvhsRE98VXBpObUhZuU1BK/pSQhPz+GjNO
+XmKpmrVv35HrrsO07PuOYtX0BZYw3CGaa9aW800BWsOSi28ZGKpXSBeu2JrPghY0wGz8k+geiGDkuRBC
+CE/KELl5HEIpcwAJ1ObMTml4rJsAXTYxTTbQU4uRyQYd4YzsZjtm22BKUROsbEzXiME88DAEW9Ff/gH9
+pZLcm8+8++gTj217+4q8Fo4lDQHCZ3dWXNJUNHPHFdc9mPNa9SO9N8zteoZiV3X2fT5W+J7dRRpI9ywB
+AscjZ3ef19Ao9l0Ns++KzGTp76/TIiiryT5eWj+dn3I9qY6ZKZXrSeMlAzFKBn+6xkmmy6H4NX4uyqEY
+jfIp+dSho/GcntMO/WfopwGyivQNXzO8jSQzOSOjcDr/GgdFwxMBcRwEzgV+Lmh1XwMQsqPi+vDlS/1m
+/Rv8tPQtvFlqZ+FHJ/7gkEuGP9Wu0P4LAHRAQPlDAM0OuQpAe1gukedqVwCqKeofeUlUX5Hp7t8x8g4s
+5h0QzjtgvSYa1gj1kINXQgnZDZeT3VDN74Fr+T3QSbbCMVdIy/IOqOId8AbvgHbeAbfxDpjEO+A+3gG1
+vAO28g5opXHigGPEoZTl90AMCx2wWzsJhoV6CBTqYZfwEvQJL8EuoR6e4T+HZ8XpcCF95tJgA/czBAn1
+8CzvgF3ibpZ3F0uvhwdd+YR6WMN/DkHCS/CAUA9xmmshXKiHKKEeYvjPwUamQ7fa3jzuMMzhHQBkN1zE
+O2Ap74CdPEA971B+ZDprx4X0Pf4C1xHN2T08wE4SBzvF3bCT5VfyspDsZuX7SCGj4XYeIFB4Cbx4gCga
+5wHmkT0QToLgKbIH+hgNpzP4/+QdsJd3wFreAesoD0gQHOYdcCnZDdOFL8CP/MTgOPg9DE4jfUfpylmg
+l9bD7YIybhf0kt2wjHfATXwZtBET3Ex2w0PcLriDfAfzKC/EPdCn/nr4z+GQSvdzfloF9z7KB88flwYN
+jBdpMJOGwl/Az8WHsT9+D3SpfHlg1K8eYijP+M/hUpXu5/w00bDUkxeuH/5y9iT+AmX4y9k78Zezn6gy
+tnNsPvaj8vU57GC88PxRXii8DhQWQTB/KdjdYT1LW0rbTuGfJ9wlZEA9bT+FxeT1/CGV23pGFwdcOFFI
+66W4CS+BgXeAyKWxtv6RS4NG/OXsUx7PG/AXqFfDnWI3bOAOsTalUTll8IA9U9rsInFQqYbK871q+DQL
+DaQK9B6wR4X8W4CuuIpn/dhQC3Ch5lqFLrQfjAkJ65cAfROFtM+S6RBOQw4g3PVM5YXy7LeGrL+/BF6s
+vwMEuvo97XtjQ3wJtLwD0lw859JgBpUrV5t+M2y1PO0zVG5Z+c9hx9i2kd1nf1Z+EER2g5b2e7Kb9dnd
+yvPZV5iO3A37ye6z3wgNEEKmwz3iHACRekpMkd8U089eLp4EFIWzN9KDgC69yXfCfqER4lS9aXP1SdZH
+6+FZoRGCXTpTsECVh758kH8MVjCdWQ/TNSLspfpS/CNsFO6CYq6YlZ/O3w313PVQwTuggfwTDvHNsJ2+
+43cyGfPh+5R07krwonmYPvwnHFFlMIy/nYXA3XRWZrTdDMn8tXAp9z7U8z9APTcZgmidwjRo4C2gFfbD
+BeJsWEb+CTEMFu3PzbCdvmO6qAGeEb+FGL4O9guH2RiwRtVLG3iAKm0/hGmDYZf4FkTyD8Mu4TDsFoLB
+LBxmbQ4U8li4i5VfBrNonZpWuJh/C7pZ3sOwm5XLg8BRY41S/kGmByjNgsEsBsMcNsZ8C7uEOlioqYPd
+mk2wW6yDGE0wq+MB4TDs1wYz/dfKdNxN0MDnwU7yLVzDPwJJjCYLQeAegXp+DVxPf9wm2EnThE1wN78M
+rmc0qGPhzTzAUm43TOYBvMSboJ6NMTfB9XwyNIvL4G7xJrhbAEgQDsNOPhl28sGwRKS8qIM8Dx2pZ/CD
+Wfk+OsZRnYrvnL1Pkwf54iamG/QMD4oTgBfFg/sa9nPzYLerr40NXX2PB9ikVX5APZXhS7AYX4I+fAk6
+8SVo5uphFlcPbfTH2uSAm8krsEAL8LQWIBw3QQh5C4LJbphH+wkP8AbZDYD1cgm3C2ZxuyCU2wXruF0w
+m9sF7dwumKfGK7hdMJ/bBSHkW3iWfAs7uV3gUMvc4Rorf2953gHX0J/2MMMXNMHQQOhtjDfBzVqAD8R4
+2CocgDZxD1SJe2CWuAcW0p8W4G4e4FqPsIoHNg5t536EGfwXMEe8GQ4I+2ElvwfasP5sPJkO+XwbgLAf
+Vmjewk7hMFxHfyQNvPk2KOb3QC//CPTybZDOt8Ec/i3Yxsa7VwjHvwLf8K8A8K+QaOUHcG449odXKWl4
+lfLzTPu97/87P9I+6vktaKM/8rbyozKk+RZOe/64H0Hi34JH2U8tR96GAm0yemuXYYqmDvaKAO0iQDKf
+B9SXRTp/GObxwZBEx0lBZvUO8xEwiS+GSUxOHVAFVUD/pqj/tcN2jMKHSAV5lXzDeXOp3O3cMV7PJ/Bl
+fC9/jB8SqoSDIohLxOfF05pmzYuaIW2jdoX2du1+XZDuaZ2sb9Hv1w955Xqt8XrPO8S7yvs67+PeP/nM
+8un1Oe4b5Nvre9RP8qvyu8HvRX/Rf47/Bv+D/h/4y4ZYQ4GhxbDRMBTQGXB/YEDg4sBHgzKC1gXtC5KD
+ZwV3Br8RkhDSHnI4NCy0N3R/6N/DCsL6wvaH/RgeFl4f/mj4sQgpYl3EuoiHIn0juyNfjUqNaox6Pep0
+9MbooZi4mDkxLTFfThInzZt0+6RPpGlShbRb+sbIG2uN64z7jR/EFsXuM7WYHo3j45bEPR8/K/7uhIiE
+dQlDidWJe5L8k3qTDk8OmNw9+diUpCkDyZC8Ivkb8xrzDylBKZelvJ06LfWG1B+nFkwdmBYy7VjapvQC
+S5TlvYwbMjdmfpJVm3Uiuyz7RE5ITlvO09PJ9FUzvGf0zvhgZtXMk7Pmzbp51jcXrLjgdO722frZ1bNv
+nf23vLy8f+Y3FkQUbC+sKnyySCq6oRiK24uPzsmZ88Bc/7k3zP1nybqS10uLSnfPK5l35/yA+dvLtGVd
+Zd+Vr6iIqbi/srbyeNUVC1IXnKieUn1d9Xc1JTUHa4NqW2p312nr+upO1ZfV76x/pkFquKbhu8Y5jYeb
+8prWNT3TNLSwa+HriwIWVS26btGJxd6LSxbffKF04c1LMpZsWxqz9Nalf2tubH7ROsX6QEt6y6Otca0P
+tOnb+my87fb2Oe1PdtR3HOvM6HzUHmvffVHQRduXkWVty17sCulq6drd9ePyi7qhu777vZ7cnu9WXLSS
+rLyoF3p7HVrHRY6P+rL6tvR91J/ef2f/31flrbpzddDqJav3rwlb897arLXb12nXbVj30fre9X/bsGHD
+TxdftzFo44FLzJcc3lRwqfbSTZftuzzn8gNXVF/x6uaCzQc3f7JFuyVpS+2Wvi0Ht/znyowr2648fOVH
+W6O2rts6sPWfV5Vdde+2kG0btv14defVu6/xvWbWNWuuefKan65Nv/bOa09uD9g+a/tl2w/uEHcs2bF9
+x8nrYq6ruO7W6/nri64/cEPADV03vH3jrTcODawZOHBT+k1v3px185qb/3lL3i0f3Drj1stuffTWn26b
+c9ue237a+eIf+D9U/GH37TNuv/32j+4IuOP+O07dWXXn8bvi7tpy18m7Y+7efQ/c03LPZ/fG3nvNvV/e
+J9237r4n7592/8D9P+zatOv4A/Me2PrA8QelB694cM9D+ofKHvrh4caHt+8mu9fsfuYR/pG8R2595Oge
+/z0te27Y88be3L0b9z7/qP7RgkevefTLP8774+1/PO1c4Xx9sGLw/n3ivox99+777rEbHvtyf9T+6x4n
+j895fPvjxw/oD8w6cPOBN57gn8h54rODsQd7Dz558MdDFYceeJI8Wf3kvU+lP7X9qS+fbn/6vT/F/Kn7
+T84//e2Z1GfWPfPds2XP3n849fCawx89Z36u+bkHnht6vuX5p19IeGHrC397Mf3FVS/e8OKjLx5/8T9H
+Io7MONJ25IojB4/87Wjc0c6jTx/95KXYY9pjccfKjrUd23Ds5LH//Dnmzy1/vvXPnx1PPb7w+KPHT7+c
+9HLRyyte3v7ynpd/OJFxYuGJO0/8/ZW4V7pfOfjKqVdzXr3i1UdfPf7qT68FvZb+2qrXfnz9or80/+WT
+N2rfOPrXaX/d8Nfjb6a/+eZbf3v79Duvv/PjyYCTOSfrT245+cDJD95NfXfJu9e8++K7n7w79F7Me0Xv
+d72//f0X3z/9wcCH/IctH97+4fEP//NR0kclHw189MlHQx8HfJz+ce3HfR/f/PHTH5/6RP/JtE+aP7nm
+k32ffPKp/tPcTy/6dODT45/++FnUZ2Wfrfns7s/e/lz8fNbnqz5/6PMPTsWcqj216dThUz9+EftFyxf3
+fvHZl1lfVn3Z/uWGL2/4cvdXO/+26m8/fu39ddbXtV9v/Hrn1/u+fvHrj77+4Rvfb6q+WfXNnm9Ofzvj
+27e/q/3uje9nfL/l+8N/1/991t8b/37330/9+Mm/y/792U9ZP2366Sn1XOYxvADiFNMFnMTFcC0A/ujr
+DcpfOKa7bR2KN1dg/xLIV+MENFCoxjmIg3lqnAc9dKtxAUywRo2LoIM/qHEtBMP9alwHk+CQGtdjAZxU
+497gRyLUuI94GZmsxgNAr30EOEBeBwCXaR9X4wi8LluNE/DVzVTjHJToStU4D0G669W4AHN0u9S4CAG6
+b9W4FqbohtW4DnL1iWpcT67Q29S4N8R4f63GfXx4H01hz4q1vfaOzj4pqXWylD4tLUtqWSsV2DtsXT2r
+pQSppKdruc0hlXa3pqZK+V1dEsvskHptDlvvKltb6tyealtHf5e1Vy2ToJSgBWZIc3skNXUGq3vkud7W
+67D3dEvpqdPSps2U+vrarf19PZ327j4paVVaak6qZfLYmkdjM7/X7nA9W7vbpMJOa2+XzeFCfW6PZHdI
+Vqmzf7m12+7os7dKDmu3I8Vh67W3S+093X1Se0+v1Ndpo0h2Wbs7+q0dtlSptM8hrUnptNFWmiVHn225
+tFp9oFDaaFXdrX32VTZafrlD6mmX1tl6e8xSq3WFvc/aJVWapa6e1bbeVqvDJnWZpXZ7R3+vTerptilV
+uPKVSu09XbRZFIei0gopLSNzmoJZl63D3mLvsvetlRx91u42a29bqjS3J9EhNcwtk1o7rb3W1j5br+Sw
+9Un27tau/jabQ6rrtrf2tNmkMmufvdssze212ZYplFnba+/qsrdK1q4VndYWW59DWtHV75Aca5e39HQp
+1Ovota7otLdauyRbl225rbvPkdrV32pvs1J8HKmtPcvHEZTfISV6fZHd0ddrb+nvo1zvaZf6Ou0OpbV2
+h9TRs8rW221ro7JHyaFQxt7dIXXZW23dDsqYdmltT7/U1iN19/RJ1o5em03q61GqUTOZVWLQcrSWNruj
+tctqX27rNbvKtbmwsEk9vdLynjZ7+9oRVFL1+mpbmyeilDb9Dptk75YcPf29rTb2psXebe1dqwiAWVpt
+7+uktdGwp79PqdXeaqUVmCVrr01aYetdbu/rs7VJK3p7VtnbbG1SX6e1b0xTW3u62+y0kIMVWm7rm6HX
+S5I0RRqNFJM5FRvG8eX9jj6p19ZntXezKq0tPatokoth3T199lab2UUrRx+twBNad9sYVEYolzoBCvZu
+Tyq4UFjR29PW32r7H8dCUlvW1tPaT6XT6mLO1J5eqaev09YrLbf22Xrt1i7HCI0ZY1RBcKPualCFzc7K
+0fRu63IbxWZuT09Hl42JsdTdM5LGKG7vc1B0lYp6eh3ScutaqcVGxaONSqKtu62n18HkakVvz/KePpuk
+kKPPIbXZeu2rbG1Se2/PcoUAjp72vtWUzS6pcaywtVKxkVb02qkw9VKB6VZEx+FQEC8qrSksyy8tL66e
+IdWWlNZINZVzahvyq4ul0hqpqrqyvrSouEgqaJJqS4qlwsqqpurSuSW1UkllWVFxdY2UX1EkFVZW1FaX
+FtTVVlbXSLH5NVJpTSxLyK9okoobq6qLa2qkymqptLyqrLS4SGrIr67Or6gtLa4xS6UVhWV1RaUVc81S
+QV2tVFFZK5WVlpfWFhdJtZVmBvTcYlLlHKm8uLqwJL+iNr+gtKy0tonBm1NaW0FhzamslvKlqvzq2tLC
+urL8aqmqrrqqsqZYos1yN7goVSqtkCoqpeL64opaqaYkv6xsTCsrGyqKqynqo5pYUCyVleYXlBUrgCqa
+pKLS6uLCWtqakVhhaVFxRW1+mVmqqSouLKWR4sbi8qqy/Ooms1pnTfGCuuKK2tL8Mqkovzx/bnGNlPQr
+FKmqriysqy4upyhXzpFq6gpqaktr62qLpbmVlUWMzjXF1fWlhcU1M6WyyhpGrLqaYrNUlF+bzwBXVVfO
+Ka2tmUnjBXU1pYxmpRW1xdXVdVW1pZUVk6WSyobi+uJqqTC/rqa4iBG3soI1tbakuLK6iVZaVqrS3iw1
+lBTXlhRXU3oySuVTEtTUVpcW1npmq6yWaiuraz3aKFUUzy0rnVtcUVhMUytpLQ2lNcWTpfzq0hqaoVQB
+25DfJFXWsSZTFtXVFCtRD4E1M0ZKpXOk/KL6Uoq2mrmqsqamVBUTRrLCEpXcqSOTByiEHlgBa6EX7NAB
+ndAHEiRBK0wGCdJhGqRBFkjQAmtBggKWxwZd0AOrQYIEkKAEeqALloMNHCBBKXRDK6RCKkiQD13QBZJH
+zQ72RHPaoBdWgQ3aIBXmQg9Ugw06oB+6wAq9Y+AkjILhgjADJFZSGlN2hgfe46XXM9gOsEMPdLO8qSz3
+NJgJEvRBH7SDFfqhD3qgE+zQrVJkFaRBKuRAKlhg8q/ifD7azGcUcZyTboVuaAMJCqGT1dilvh9ds9Im
+u1pCgk7oh+WsLH3XB3ZoBQkc7I0DUlRa26EdJGiHHrU9NNbL2tsJNjclu1gp2iYrg5jKsFb4tgZSWF4X
+L80MSh/YYDlIsHpMiqstbW6saNtpuApsbvjLWc09DLd1DM8eVroVrLAC7NAHViZBleyt0n6ai6Y7WD1d
+LKWdwe5nsiWxNtpGYTG2vlIVgy43t1x0KIJSqAAJ0iADMmHaKJp1MX7boQXs0MVqW6vSQIFDedYGqSo1
+E1nbGmAulDEMFJ5aGRVsjPa0BX0gqbTpgn5oUzlex7jZCj3sjQRlYFVpaGa103baYNkomVF6cBd0qRJA
+W7qCQW1hcGi9KxgUB4O9FpZDC6OBp+x1MBxXMMlvVallYy2nUtrN6klltbSCnbXZRR/6nmK8/DdqlP83
+ukQPeihSZa6X8aqfUU7p64qk9bHWOUbxVnnugB5WUy+ToDa33nNJh6fM2FlPkVR621hvc/UYCmUt9EA/
+6wG0Z3VDD4NjhQ6VexLTMJ7YjK7JPEYyXPBcuCg9q5X1WTujWS+Yz4HXdg4tlB5C5W85ky+qGdaOSxWF
+mtWMEhNR1CU3/Wp/tLO3Dtb2XtaakTwtLJX2grWjNICZ6Q87w8CFm+u5h0HzxFWRSxcGSi9X+v0KRoPl
+rGSfyr8VTKesYrKqvOljfaLvV7jaympvA7sbksMD0nLWo2Yw+kjsvynqODMRpUb03GjajPTx5YyGfapU
+94FVpaULSyu0qNKplBrbwxSOKxJkPkeulJoVDCZqW7ebPhNRZTyZS/2dVFBaNb4sjKWCwr02pm1s/wdo
+IY3hGe2LrWx8VnSn9ZyeM1WV9x61jNIvreoYYWca2DGuHI/0mNEa4Vyqj+VQBRurR+C5ylN+LAebmzZ0
+FOuBDqb9R7SxQt3xyo3IuF3Vz62qJhnBqIfNvZQ2rmVyYHNrjza3TrQx2ih5R/SVQoPljFY2kEZJhwKv
+TaXaKrW2drWEpwQ4GI59sNrdm8fqGgfTJK1ubaNAsrs1U69bw3SP0joOcIyiOJ1L1EAhlEE+lEI5FEM1
+m53WQglLkaAGKmEO1EID5EM1FIOkvq+CaqiEeiiFIiiGIjZaNqkli9loXwlV0ATVUApzoQRq2dhZCWUs
+fzWrIx8qoEjNWwG1LG8B1EEtVKo5YiEfalSYsR4laEihFUMjw6QYalg+Wk5iLamCMihVMVNwr2alatnb
+Gtbn6DyKtr1OnVPNZW8VDKgMVrKwjNVXCrVqbbXqXM/V0t8CTWJUlFQKF0KJmpoPBVDKytay9rjaN4e9
+qXC3a47asnxGeVo/rbkQ6hjnqtnbOranvhJqGFYubp3L4SJ1BlChtpFSsR6KGT6U3xS3MijzaOF4vKyE
+BoZftZvqE3OxgNVCW0nbWwbFo1qkcJLiSTEuhFo3b8Z7V6hKnEK9Mva+BqpYrlL3GyoXxSpfKCWa2FtP
+PCmVFkCdWpNSkmKRD+WQD3NVriX9f5QRpZcUMt4UQ7kKzSUNNVAHBVDD4NeyGouZTquESijykOcaRud6
+xnGK10wGsdIt83PYfJzmMqttoLQZabGChSJTSmnlfQErVeohZ6UMv2Imv3VQxTCj1Jqs9t4GVVYUOuar
+UIs8JLcSKtSnEW1QyTjgwtQlByNyb2a9huZU8le75XNEpvLdUlCjylgh1E5Ym9I+RQJrJ+CjxNo9l5Wb
+yzhTyOhfqcq0C5cG1oOKGQ3yGeQadw2lo1pLe77SzjoPLrt6UZ3aM0fejq9hzR49kkKYwyAUMf67qD26
+5ipVFkrHaJMRKaMaZ7R0p6p3NdI/+XtIh3H+yDEAoiHUFxYPAoigoSeCQQ9e1PEw+IIf+IMBAiAQgiAY
+QiAUwoD6QoiEKIiGGJgEEhghFkwQB/GQAImQBJNhCiSDGVIgFaayT610sLBP2SzIhhyYDjNgJsyCCyAX
+ZkMeY24h6/JzmPIphXkwH8qgnHW+KljAlAztPvXQAI3QBAthESyGC2EJLIVmsBId3AdXwGb4E9wMf4Mt
+sB2uhjvgYbif6GEbvAeXw43Ei3jDtXALbIUX4CPiA3fCbvg3/Ag/wb2wF/4ML8Gj0AKtcB20wctgg2Nw
+HF6DE/AKvApfQzv8FV6Hv8AfoQN+gOvhbXgT3oJO+Ba+h6vgIrDDMlgOXdANd0MPrGSDtYMN5atgNXwD
+a2AdrIX1cDFsgINwD1wCG2ETXArfwd/hSRRxK3J4BWpwGwyDjHq8Cb3wZjiLgD5InYciBuB96Id3owHv
+xSDchf54D0biPvgP/IJR+BjxJX4YiwfQiI/jFHwJ4/EpjMNDmIDPw2l4B034JCbjMeJPDHgcU/BlEkAC
+cSq+gtPwVfgMPsd0fIcEkWC04Lt4EgPxfszGDzET38cs/ACcMIiz8RsSQkIxD7/Fi/C/cBn+AGdgCE7B
+FySMhGMPnsFuPI1ryYvoIAKuJByuIS9gL+Gxj/wJ15OjeAk5Dk/hpeQEXkZegS/hK/wLPEAiSCSJItEk
+hkwiEjGSWGIicSSeJJBEkkQmoxavpofvcCZ+QaaQZGImKXASPiWp8D58AB/CJ/AufEymoox3oIBXYQiG
+4WScgasxB48gjzqMINMwGC/HUFyBiWjGVEzDDNyI0/ECzMcuXI79OAu9MZekYRKuwivxQXwEj5LncAve
+iIP4B3yAvI67cQhfwD/jCZKO75E/4yfwB/we/40/4i/kWfwKb8G/4Uf4IjmMn5Ln8WNiIRkkk2SRbJJD
+ppMZZCaZRS4guWQ2ySP5pIAUkiJSTOaQuaSElJJ5ZD4pI+WkglSSKrKAVJMaUkvqSD1pII2kiSwki8hi
+ciFZQpaSZmIlLaSVtBEbvYaQdBI7uYgsI11kOekmPWQFWUl6iYP0kX6yiqwma8haso6sJxvIxWQjuYRs
+IpeSy8jl5AqymWwhV5Kt5CqyjVxNriHXku1kB7mOXE9uIDeSAbyYHMN15AhuIC/hJvIy7IPHyE1EhAPw
+BLyIX8N+eByOwGXwPFwJj8BRfBP/Ac/As7iH3AxPk1vIreQ2spP8gdxO7iB3krvI3eQeci+5j9xPdpEH
+yIPkIfIw2U0eIXvIXvIo+SNxkkGyjzwGP+Pr+CWGk/3kcXIAroG78GH8DIfRif/E7Xgd7sBr8Rq8Hm+A
+W+Eh2Am3wX+RJ8hB2AU34Cl8hjwNt8ODsAMfggG4CQ/id/gnfBrnYxkWYBEWYjGW4jyciyU4B5dgNbZj
+By7EKqzEGrRhGzZhPdZhLTZgI16I5ViBC7AFW3ExNqMVF6EdO3EpeQbvwgF8Df+Ob+C/8E58FCWMwUn4
+R+Y67CwBOIS3YjQ+i4fxc/wJn8P/4F78K/6Mt+ETeDu+hW/jfnKIPEmeIq+S1zT5xdbW/j6bmN/Sa1tl
+E/LpGq+e/dvX093j0OQvt7b29nRr8ns6erpty/T5vfbuDlaEL7D1Wb0KW+29rf3L27tsa/SFbT191tZW
+W3cfV9hpF4tarb093WJRa2+PtU8sZgDEYvZSX+zOqilWIHDF3R2aYgWKpniFw97V0+2thgwVrrjPqi3u
+U/Dii/t7e4S51uXLrV5zPVCY665XLEnLybRYWJChBJlpYkl6+rRsC1/SYu31KhkpR0rniaUMQU2p2uBS
+BRW+tKfP6k3/abPbem0Ou0NX2qMiIZb22bvabF7zRioS5ltXrLCKZQpRy6zLW9qsYhlrM1/W1tNHyvvF
+CiWxgr0lFf1iJYPsU9nZ391h7e1f3mXt79NUKnjoK5fbOlReVC6301feasheelU6uqyOTlYlV9VpJ1V2
+rsphF6sVINUMCFfd2aOtmTMtbdq0adNoJN0VsbgiGa5IpiuS5YpkuyI5rsh0NZI2zRVx1ZzmSkp3JaW7
+ktJdQNNdQNNdQNNdQNNdQNNdQNNdQC2uJIsryeJOcgHNcAHNcAHNcAHNcAHNcAHNcAHNcNWc4ao5w1Vz
+hqvmTFfNma6aM101Z7pqzmQ1izWM8l41HkJRY+9YbuVqrf18bYu1V6xlTBFqO219VrFOYX7dKObXqUJY
+p/aHOqUf+KmhSxS96zz6h1BHu6ZYx2RSbFCwaBjBQtfgKiY2dPRaV9lIo92raSRdbGJvxYWspH6huyfx
+CymeVqV7WG0sWWCbQfTWEUVhVVDWWbt7+mxdNrtVY1VVhtWtMnTW3t6e1S09fZ1KrK1ndbcS67K19+lZ
+jK32a1i0f4VODdu6vd2xFoeNb7H1WYWWrp7WZV6tHr2/dUQBtXbaRZrSZeNbu/pbxDaF5m22rj6rps1u
+Xd7T3ebjIojSgrZljk5rm03T1s1qFm2KxrIpGss2orFsqsaydXdobCqHbKrGsnlwxMu2st++ytpl6261
+6WyOPjvdZNDG2fqsWpuqQnS2Na1d1uVtLV1iu225tcuma7d3ddnaWnrW6JVYr621z6vdvsrGthF1OoQO
+pvQ6PJrdMaL0OnpWdNp6+U6q3zo9JLDTZu3tEzp7+h020c7aRewXaeyqjrF399k6eq1dLX3uaN8Kbxrt
+ddha6bYHnb17VUt/V5etj8YUwnrbu1c5ltu7bO3WVpvGrupLO9WXdg99afB8UBptd2tQu6JBL/JAdRnV
+oN7L6A6d7i5rd5u9VexSlFmXok67FHXa1dbTp+lqZ7ziu+y9VqGrp7vDoenqY2zkKTXF5fZu2g2W9zvo
+7iQqmr4ecUr2bqWjdFtX9Dj6ein5xG5FM3f3iz1K5+wZ1Tl7FKoJPVQz63tG9HOPqp97PPSzrqdb5Zy+
+Z4WtW6Ghvqe3r5PSy9rl1TOiv8UVNoetz8qt6LRzKxx2n5X9PX02ikCvw9Ym9ip5ehlyPr22VV09HWpD
+uN7OHk1vnyK2DqVBDo+e7bDRjTLeDtsqW7dLjBiRBAfVTCL7N03nZqbgWGFts3GO/m6uz9rP91Gl1ad0
+oD6qtLz7OnttboFUGt/Xa7d2tHUrYVe7Evb2KWH/CrFfIWX/KFL2K6T06e9us/U6Wnt6KUs0/d12qknV
+sEgJ89XnfPW5IF0NLWo4nYVp6elqqLxPs2SpYbYaFqhhoRJmZKqhmi/TFar5s9T6stT6CovUsFgN5yhh
+0TQ1TFNDtVyRWq4oQw1VeEUqnCIVTlGOGqrtKMpXQxXfIgXf9LQcNZyuhvlqqORLL1TeW7IV/CzTFbiW
+fPV9gVJPhkrfjGlpapiuhhY1zFDDTDXMUsNsNcxRw+lqmK+GBWroglOkhsVqqNArI02Fn6bCT1Php6nw
+01T4aSr8NBV+mgpfpUOGSocMlQ4ZKh0y0lT4aSr8NBV+mgo/XYWfrsJX5SZDlZuMdBV+ugo/XYWfrsJP
+V+Gnq/DTVfjpKvx0FX66Cj9dhZ+uwreo8C0qfIsK36LCt6jwLSp8VY4zVDnOsKjwLSp8iwpfle8MVb4z
+LCp8iwrfosLPUOFnqPAzVPgZKvwMFb7aPzLU/pGRocLPUOFnqPAzVPgZKvwMFX6GCj9DhZ+hws9U4Weq
+8DNV+Jkq/EwVfqYKX+2XGWq/zMhU4Weq8DNV+Jkq/EwVfqYKP1OFn6nCn67Cn87gp09T5Cx9mkKn9GnZ
+09QwQw3V9OwsNcxWwxw1nK6G+WpYoIaFalikhq765yhhjgonR8UjJ10NLWqows9R4eeo8HNU+Dkq/BwV
+fo4KP0eFn6PCz1Hh56jwpyv505T+nZ6m9Lv0NKWfpaer9FD1zJwCRU/MKVD0xJw5c4qE/m57T7emX50B
+9a9g44+mX52r9o+eqwaNeWajhne/5wS2n01g+5UJ7GplGFvtMYFd7Z7ArlYmsGvsXms9hrm17K24TpnA
+rnPPirR0h3VqW0+fnkVsy1f0reXX2fqYE+qzZ8EPfs/fW/AWDMCAcAyAzwWQ+4Xvz77P15/9Su73TBPT
+RlLFonHSk89JvxBug9ugERpJkXwC2+UTZKF8gIau92IKeVwsJHc5wSw5oa6xuEmS5h8E3wXznWLNwkZn
+RqQzqam5XdpW1+gk8dZDWtBCa6upJdJodEKTEwpNRfsAobC5IMWJZqfU3J7iJGaT0WRMcXJmqe0xLigY
+CgqdgYVSc3PBIAkqLBiM5wqdpLB2jeT0NjlJYaG1zclXrdlHCClsLnAabVFG+nafbzAWRElOUmgq2BeI
+gYXNBSYnVDXamvaFIGEAebOTS3YGFzZSeM6QwkI1Q6TUJjkPVzn5hIX7ktCnsLi12CkWNxqdXHxT9aJG
+o8kYua1RclZVNRqdeU2RkjOHxnKamqRBJbe1zZlU1WhUnyTnNJo+jeY8XNUotUvbtlkleplkc6TklGia
+nsayaCyrObK5qakp0knind6FrU6obnQCu3nS6PQujJzvjKGxmPnWg/7QSnMcFKClqanN2uTE5KYmtQVN
+UpszpNBU0JTiFMxSseTk461tklNTWNXo1JgKnFpTQaTR2OTE5hSnyMjt5JKltkFNS4FEE2lzIxX06b9O
+obm41SlMMUpObaG0TdrmxOTBaUK8k09Y0NhcFWmtbmo0NRmbJGdeTaMTkyMpXVRUUpwas1NXmLwPiMJm
+rdmpMxWYJCeYCqxO0tLuxFYnNjs1U1KcOrNEsfUtbD3IQ4tEa3DmNTfRLM1FDFu9eZ/OFwqLC6YY3YLj
+ZR4tSN5KLZhsckKhk49vloq3maxSm0psiKQMcUqRzjw3wZxcvMlapIDwmaC4M66qkRbOG6+Qr5k16DEf
+b+CKqxqNkSZj0xRjitPPPEhIsbPNWpTi9Dc7sVmSnH6F82gFktPPVNDk9KdP1Y2S05/xy2CWnP6MKNJB
+Hlq3maxOQ2GztK1ZchpMBaYUZ4B5fm3jIN9W1BTn9LGZ1qQ4A83zFzTOr1FeRhqb4pyB7H2QeRACCusa
+BwMCCp1oLXAakmmXc5L4gkE/+o8/iS9wYohJcnLxVY2DlHxOPr5g2zaJgvWfYjQ50eqKRyrptAiJZ2+a
+nH6Fc53+hXObnWQ0syZg4SBAoKnIiYVOyN2HiIxbwWYYBFJc2+gMMBVIxU5fU4HTx+QUmguk5ifCwhAM
+9MqcggJKgSBTgROtg0HaZOfVyZGxTSnOEPMgBCenOEPNg0jDMPMgoWG4eZCjYYR5kKdhpHlQoGGUeVCk
+YbR5UEPDGPOgloaTzIM6GiabTS76O8Xm+bWNJinViRfS3pLiNHskhrgTVyqJKR6JCe7EXiVRMoPTL3nC
+djrR+rjSVNpOz/YZzYMgJac4Y82DSEOTeZDQMM48yNEw3jzI0zDBPCjQMNE8KNIwyTyooeFk86CWhlPM
+gzoappqlWUxgp5qlZmdYs1RocmJzIWMpNjtTqcxOMzunJjunTklxppklaa40ATdN1hwTVeznzRFJW5/u
+YvGgr1hMJc6ZNmVQwODixmlNrJUWD/JMlCfDLGUyzDPNoOYpPhemE5PHxYW+h5D9QP+Kck05gxkYTNua
+ZZZmSXMnwN8JhdacFGe2OTV0Vooz59eyOrGwNSfFOd08SCAkXkqV5lKV4CTxpdu2zTXNNVmlxpZIqnVN
+BftyEIODpqQ4Z5idEOIMNRU4+XgnH8+yDXpDgdOrMNm2LdUkSbO25aQ4Z47OJqUq9TlFU4Ert+Rspjol
+b0HjY7wkSJGP8QlCRFMB1bT6QmmbiZUwzWl2ioVju2sz1XbKqMQXNreZnEKhta2q0ckXWiOdQmEz1XRj
+y1hNkuTkE0xzrDmRJqe+cA4dsfSFDEqzNB4Qk6JTxcJmygwh3uoUzqnVySdQJOIpElx8c5uqSUdgNaU4
+Z7loIUmSU0hQaWGalZPivMCd5NSz9DmmuRQo5WKum4S0MQqlnVDbmCrNMhnZeKu+lJyCmxVOMd4pxJd6
+zl0UJo4n7Sq3TFTkZ3tgUuhiVzOd4IxtsovFeWaTlEqpOMcZWthYFVnd1CjNakodnIZBySnO/FGp1ZFV
+o1ILxi17vhKFZueM5PMBLDI7ZyZvk6RZVMa25Uyc1SkWpjqnJac4i1mTqXwmKJS3Or1NBUrTqYCapFlS
+qilHrX+OeVDPxxe4ivxOkZ77PyXFtE1Uj80y5UQaPeTF2KTiOdc8CDOSXVQpMQ/CzGSjSaWLKWc0CUrN
+TghWuv0+oD08MNWZNSXFOW+C9/PNg4BBgc7sKSnOMrNz+pQUZzmlYrFJSpXmbDNZXdSqMFOBdpYnpzgr
+zfsA5iSnOKvM+wBpZIF5H7I31eZ9yN7U0Dxzk1OctTQPjdTRPDRST/PQSIP5MQAoTE5xNpofo1On5BRn
+k/kxVN4tND+GyrtFNB/S2GKaj8UupPlYbAnNx2JLKczi5BRnM4VJI1YKk0ZaKEwaaaV5SpJTnG00D43Y
+aB4aaad5aKSD4VWUnOLsZHjRmJ3hRWMXMbxobBnDi8a6GF40tpzhRWPdDC8a6zEPwiw3A1ewJ2decopz
+pRLNT05x9lKis6eC5BSnwzyIap4+JUrz9LM8qOZZZR6EC9y1rmZPrMQaJUpLrFWiNPs68yCqGdYrUZph
+gxKlGS42D0Kuu76N7Illv0SJ0uyblCjNfql5ENUMlylRmuFyJUozXGEehNnu+jazJ5Z9ixKl2a9UojT7
+VvMgqhmuUqI0wzYlSjNcbd7nxWa2TjFyH0+44kaTMdLY1FSQ7NTanFxc1RrXYJ0C8w/CserGQcTtTU5U
+1OaKQdAUHICK/DSjAFPoQ553NT9HzNImaMM0gl59VU/K+FwxVZwksFfeBc8EHTYc9jmsOyzmUSePUwbB
+UPAM5Ln/o+8OAQdQNBiHWxc0OvO2NtLntqLBJPp8UAvKCyhqihxMpK+e1G4C5PO2tta6EuhfnnctKeFz
+xCQxQhB8pxzEs5ud/LWDBIoeE9pEKCoC+P8NAJV667TARAIA
 `,
 	},
 
-	"/resources/SpartaHelmet256.png": {
-		name:    "SpartaHelmet256.png",
-		local:   "resources/SpartaHelmet256.png",
-		size:    45926,
-		modtime: 1497199772,
+	"/resources/fonts/README": {
+		name:    "README",
+		local:   "resources/fonts/README",
+		size:    1913,
+		modtime: 1792239993,
 		compressed: `
-H4sIAAAAAAAC/2zyZVCc0bf1izbeuAd3JwQP7tK4EyC4e+PuDsHdvfEgwd3d3Z3gENz11v/sfe771j57
-Va0Pz5zjN8azas5fyopSqEiESAAAAFVGWkIVAIAC/OcC4QEAgK5TmTkAAOVpJS6uLCMuTq7sZG9uZWsG
-ANAn+MYrfJUwwhn2N48YWg+CK96gREbNUMHml79JDIU/ADsfZeJ9Z2nm1xwB/VyZMuYaCRugM6R6hpZl
-YKI2sxddlV72RJnMz+abvvWecp7qdkZr6WizopVtJ8+oq5qZCeAS5PidIqwsxkFqbbkQE70qLCQUtvL4
-Pq9fQ6NzXiFu9f7+ucyIR0kAI8sY8ooyXxcaHqqTClarnfvdXMSw6zpYK3CEE37rc5fBQYCElIdkA9I1
-wOjCfiB0/IrIXLxNyDz2C9eRvdbU5x5CWx5ZVEKr3uWBTSCGwhYwBAyGRdyBaE4BSJ5OkvHcTAc1Bnsb
-Wq2/De01LbFkRVhSMUDmfz+3+0Ape7931ogxfbu9Ewjk14kIRd+hRkZ9DNU6Y16w67nOKNd5sRj5dhEJ
-2n3GqLDIv8AScM6wbBYWVlmkTxbkm5LjT77seS352/GPd+q1C2e7z5O5G8Ly7cHe0Al1iNnQhmhNot3t
-Awaq1EJF1bE7FAAAIDpI/3QGANAG/nOhUr6iEQEA0A5W6louWgryfCb2YGYjU3tjM2YPsAPgP0fAg88D
-7AA2czEi9wDb2jnzeQhS/j8KPjtnvv+UWSjJ/x+Ji40gpZaCMrm4vZMZ+XdmTmZWSiEkcnJyASdTcz5V
-CdB/406m5oKUli4uDnwsLO7u7szuHMz2ThYsbLy8vCys7Czs7N+cTM2/OXvauRh5fLNzpvovk//XR8LM
-2cTJysHFyt6O/D/fRsb2ri6ClJT/rfmv819BLlbm/yfJzvm/n2ViD2b5T4eFjZmV5X/DPMAO/zvlYeTw
-X5DQ/6EE/mPF91PVzNne1vU/PyXEyyXA8v8p/k9A3B7s4GTm7Pyf3vf/1v/ftf+p1/rfArT+9wAPsAOf
-uJOZkYu9k7q9va0QyNbTys6CXMHMyIVc1MTeyY78OzObAMv/lP0PBwV7UytzTwkjFzMhdlY27m+sXN/Y
-2NRZefk4efk42P8L/780/++IWP7HjP5rAVj+ewOEkARY/v/bJIR0p8i1DwCIAGQkRNU9oM5zELN1JRs/
-7d2nu8wyKpMmqDNCvgUCseIFoVbxEgyVlVVEoZYf4xEi/FN2wiKOsXGBEYhl9BKSnPmViSO0IaUjzKYI
-WHU1STLmJa+f7zldrs4WlxcZuZ1CzqV2y04Vpwu8pYo52nYDRA6CX2B+SVAWjuk5T35PGE2iW2/3y3Oe
-4kW96bfrDYITR0JCYqRKGDG8QT0g6HvF6Eya8nlfwo4bDh+j88GYZt7i3rWn9MG+JLpXFPJ+7D8XJIxh
-9Hm/HibrTuaMId5NGCbzF2iy22wbqPxZj7Ww2v5y+hu7R61NAjIAI+yFAg9deMr2/TQP91ePyNCxMWE4
-dDqdh7vRzWXrUe7KTm+w1A1fn/+dvuhLr0BoD2MfC2Wl9OXN/XrnWTOZprSH4OV3BbI+G2n7XS9NgT5D
-ust1z6Wbrsw/jV6nZ2fbgvcLas03W4UwiuQjuq8Bs8br2rCsGOnfUVCAAZUFTeAeITX8D6MwD5tyMp8r
-0ZjTHwOag8a1FWFh8O+kkh+zN4hkeurq6tLZCGpeIdxesM1WWk/crMkDSdtbEBdLqNTqkpIhCDMWwMZD
-A0btg1/g6/2Vt5mj43UkJJbPmqg7i3eP6vZoOq7rZuzrr9V6Czl8FGCIudaYX08AYEQoNhiL/K5M3bN8
-hFWHo8T/aTe8OJEMNokEjoNs5BWfNNTKlCw7K0snItM+Gu3WM7rDWEzQcW+3fF9azstE9bkF5MtEi14q
-R1t7N3bpxycMdHBkHI6TOBzoyM4mnn6uQA4Svc6S6SbwRz5Znk/ltprn6BAO7N5bMYlLWZodef9n7z9+
-XYq+lApKgBGuCwsEDSQ0qr6wGeL3gvjSuzCNWPQ7eNzXm8AHrAo0KE700WwknCRiL7ussrX4OazDO4dJ
-ihOL5Ok8Rj8+NyDbeof0edbpTQVjZfpe6izYKFhIXk///m11rv/+/Xtd4c0tlxuoI6GTKxLQGa2s49+H
-SGdLIeCQuXeM0ISGKNNL4vCzrycAcOwzAH/rGWi+gA56CSf1KgvKRxR47YU1fqC6puuHXUI3/oLfz9aO
-2d3n8HJE+n4xOhUSGALj6j4RxRQFKvo2Uh7jBAxVCBHuP5sH9vlTsKZeMUmKlzXCvsH4z0K1B/aDjl5B
-ejnoTZ+iOAUVi09Q/Wfc1r640Q7QEv7AD5ygEnZuZmxsHtwtC2BM2ZT1lk66noL8YobhFbYUzUrRrjT9
-8WqjTdrVNfw1y6koOZPASVHSl0TmntIZ8WzSUtcrvh2+oEAoqBmo81/k4mGVAcoqRsjhKAROxPkWN8RD
-HK2VlRPqRuX9GM3FoS2onzc+exciYC89jVKSmbuQkEDOEGk/vy6Cb+7nK7W5X96jWsIxUbotUoxkWkIz
-aZ/gBwCXu0+BjwusrOQA1X41iLqxRpVneMzPiZMoLruNFm7E6aAC4QjhBE+f2nTvo6mcMQMloRifvmfA
-VmjCoeKEbVCglUq0FEiy2CvtSObqUafvA22rcc+Q2ZTFsom7r5jgSme4B0DRFJtlzmbsAz3Y31vWz775
-8SYUfSQuMMwa3kjDC/Isb4jN/y6I3+PKEd1EYNgCU0oL7Omsj2GiWr5E9McAn8JxBrsGFXbNhjGUGO6W
-g7LgUCTQMMwYOckLI5/a12xdXl63P4VCVN45I660gJcSh3cmvmHclDTyX/x7udpFGENRiqxwYfKxL4as
-MsnbAzlhDH5rS1dL/SkQiO7cIUMLSjzLYVoyYPbpsY8NDFQp51UMwihP2wU3+7+fGYSzK73jwz5gRpxd
-JSZiqalsZudGF85/8OShO6Qe4A3P3EBCQ0PNpQf0/xB2CEt3b0wl7mFXo72lAkhZ8flCGnbQgQFf4imH
-0GvWt/0/3yE3NzdTNCel5A0wEh6EgeSsYMvZzHsAqU6PCVfwMcJM3p/y9HM4bZke/XwOZHS1CyUu8Npd
-A/aWwitH1v0p+A8CkHvBpOxF23mJ1S6k0WG3D3Hi7zRosCOi9V2fkv3nNYUAQTb84T3FhuutTbbQNcZc
-U4c02eLN6/SCFVrfrkxzjaEIBudlMRQUQJH8S38wCLcgovH1HZikcomtdUPX9iwYJOLsXfyPzUMtx7Gi
-SfeqzzvKVV7DXynrWxrWKxi910nlpt/hfr3XravPKyhpZ0ljIOIctrGx8cWXxS3YoH56BwolrF1dxmAG
-XR41hImiqJsvYnPtMiKP9wMHeJR09fyz7Bmb4L1RR2GgRsqqHdWAAuR+r9JcLjTjfhMQ63NEDGnfVngv
-ua5i9YufHBxHEMbKjOyXq9h9CpAvTLqy608yMOrHvP/lpDzWD8qnWQn39Os0G099lkdt+PYzJW3IcC03
-r2bbc3NTZTPs6k9gXHEHJut0MUb/8EoE+5e+mSnO8uchw00NGbdJ1SZHQiEYnYlo/Sn3zF/2IZVUk3an
-1TA8Op5Nt139j40IBtNozyH2pZ03jSO5wQZjl8b47SF3NG9LH6FYe3ft6dteLIezgtB8GtlR09H0rVFx
-Ds0SeJVBGKYQNr9mhZQyqh+NuBla0hgCMk+N+Mhk6p2/3MfD31Ux7vihyO+VSOLIlgOJoyUtrm8/2fyz
-KxwO47jyQqQfUEojjwnpglwrrHDA1GKdRblZs5ig5dhC8NsS5vo3ar3R9qZp5kDP7f1HVc7USWAtD6F8
-dgKLvrY24XaHp7PPQASmIxoZzR+bgcWHkkmV2rVFHp+nK6AVxGa5OsZuu0twTFYKJ7h0Ea7FEzhUu78Z
-5US3p0S9Tf2GKYCFZfbGHLpaiIW2MGhLuzAJnqPIcjTTnM8JsYQJ4hjOIzyu7BW48uwfpct7o710Gw7i
-IDx193fU28TcDNPE5AmN+oh+IvO9m30US//sH3G3G2kp5fXTZOsiWoXJVsbLao88yeiODNTAKp93Hi/7
-dMrF7WAp7Rpns+3ENT0/xIu9iXwe7lf+W/7XY2tYRalTTvo8BiPRbffhFRvH8rsXN5TUWnW+ZKQMdY+B
-Oo/5oiEBzyfUfVewLyy/OoI/hwoHlBXuv+DPNuiTzRiu9ZG/IEHwnhHOK2XOOy9oNMGYc2uyhQ56xNzM
-KBI2Oh9TXwL9n6RCsbsQVdXC3aDA575nAuYZLuif5pTA7cQXlkXL8uo4gZsIOwRC2AmxMcY5iPuaOTe0
-SrUdVB1RoLSmiA9Mt81dPIj7A/aSNawzqTvM3YrMZpD+W8q6Kr5rOXyJopBi7/I7PEK/j7cNDnzuKhb9
-9ipO21yPNRd0iEiyl8zMB+4nAl2uxx7EGuHyHYRhmrKjQxlZjRJ8Aom9/sG89F5/XR0CQJ+159MfYKEc
-4SKVcCjRk64abj2QIIDoHz3zGyn14tSIJtuHP7ZhL4+oLONXeFV2dPjwXC7uJxEyg+YtdUYhyBJIHk6e
-N8BpKoVqH/yfRq1zHncjqSItObLJbWgcFbF1VOmCgQ0xPn1malrj9dGPNAMscENZC540z+5NXJ3kOeE5
-ku7E6vGKa729OK6crGgHvi2/o6Krd3o23WdwM9NBL0AYUdjd9piXiWK05XptwzXc7teOBNb1kJquyy94
-Fx3DpN62SC6uuLgZcJiHoaB3W+yMc0RVXhO+gxJB2AUTwfsnvBB/MpUooEGj1/0pyRQ7CShV3QbozCqv
-odAyRSy/P5utM81KyuYFFY2KfkigBrMNUu+LaWWASOZZYnZXIEhzbGeVLi+RjtXyNMKAUpWTNPKfGxqg
-66UA9ojTZ0W+GW01B6sLcxtrjTEPZCIv8x6K+wTDTacCjVDfJkNoHRalVkvm4e8T1qTWt0R4fOP43rTH
-wxZGx+DpKpTynFdBtu7kTEGhwoyF3n9juG/SWAw+fjI60qj64UjQHI6slbFQ9pZPvtDB5JLYS8bgBUec
-4Ilp+YssvnWnAiM7ZrvfDliwDSMdi/gDOC+ptjGf/hLf8hoczHzxvpsy6Qtr8HKXkHNzjnnhzQbVvtHK
-cbwocg2ga2ZS08vZy5e3tUg9b0Jcoosa93/Kr8y/6crzETBoOkCu4BHozm2yvlu5dO1x9qFDuS9mMpKL
-UHNu3on8JGykQYEEX2qEcXJaUe64QU3blghMIzNnmlKB64LN7tPPV8XMmzkxoJ1D8gLyaUWVl/vkybAg
-M+UlFyq1tg0/JwroGBu1IWwSv2f3JftIi/X9g//gcXW+RPKUD1Hh+6JRT0ItqMnXLAL1CLGwKZBiT5U6
-BAd+/nxR0A7skWMXFOqFyrvwvO5/85Cr8Rk755cjGrPpDSJyugi/JfH5qmYFl052E3QgW/4YFNUL/v5e
-cPvOct0x8oqsJHA9IMeqwRFMtNb7YYl/iTCFMiZMqpv8tJbkUOB079L2kFrZRfFVNmNNOF9OsVux+x3a
-4N9Mck9KTb7+pa7Fw0vYU47JwICuAWTkPvrl1Qq2l0FABxaSQMVTh7RrHdKe98IUMJJnf4LmG+PGOlRe
-xBva8HoTbWKtWo2pFFKNIIanW5gYXeE+F/46jll4i2thzXTAmIcIJAvD/vKl2r8Haet+b65VZLCd82IT
-T2LM0oM221aUJGtJIV/aOGjM7YgiGpzZZ07cCGa4/nr1GRj/GkxklTLa+nrRXoPTQRygnKO4ymG1zLkr
-fQ+YRhP+eaY5FqkSW+GM7frGJxdGtGgOp3w5BFQ3ZHHZVTwLXWxmRGAUppKCghyahXwIR1Qp64ie0Dey
-Ef1bWHNDgcZlsy76IjodKnJRgvZ1s0mtb4IiGV4lpXxgmnLHNNexMu2IQRXl0oaetkzdQsoycvh6vmWS
-rR1fTx53Y+RryVUpjyv1Yqrm4jRpz+gf2hW+jqITaQ8TZbRuORkIqojXjWcc5tIxhTxRGNLgQCxicU5L
-OE9K8QvisvFYCY97kLgqlZR24s4IKUz0/b5/INf7F12BvuSTnBIuTA2cMRkO1BaDfV60DjxZ8T2V1m8p
-Ug7DxAeDvbXfCFSVCKxZxPOF6coG7hFhjOYVT/GH2ni4cwzvS02wKs/GCf1IJFyblJIpUTAdS3lTqOgC
-LPFU2ISm5UI+U3daJ/4Vs8MrptrvPVDt4L/9LOJNflnlFB2QE/wh1VoCa2uGwWyAgu2MApnVi0aDnMPm
-vgZNm891BGOw/hYw3KbY5BpbCSwpQPc8J7fzlpQcVOR6GnB9b6qe39tJ+iqVFUC5BjtwmCcQ0ZUuZfM6
-uKDJD9WIrMyNrwRYktga/htFcT9YQNGBPjtruajw7wYu01DuuCxOek46mCILkXNw/ZB4EJlk3wA5W1ht
-WzhwHU+R/18DbhEi8Wrh3f3rNQvFtCNwtrcLYzSV/I8o7JsUvdRXcs1WBlEPbivlXv2HORu4AGDgFAaU
-lafSEIQIP+cBk20HZyujwqiHnhCKQUf+iSmkYvaDuyzsRVZxR8t1BQE6VzQAeE8dIpmBLw6r2HLDvSgf
-PStDxhoFcp/Mu+9J1mZOzp+NFc7x9Y+S0nj8MVCTKeT7wrjXviB41QNjtlAbKfb1+quVGs1l270WP1wp
-q1icChE19aJbY9ZLIhODiIwJeDCt3o96KwSyxqSboPlKQUrtQ/GH1L30hSmcktTnCrZvGmb/58QqglWb
-FC9aWs7aZwW3GHlF044fqLW+PI4TL4WK4p+lxHXn0L0sFHlR8NXTOEt39jDWmmbPg+Zxtlh63bwU1Fhk
-//Eakk309HEJuu4bNLTeTPK1ihpd0gfHwhHO3CVEZZ1OFrOlv/FWkpW2jJxW7egZbAYQH+iOdjIV9Uf7
-pBnTge74gZGjkj8XRuJJvOHgsLRVrhYFWxOjsWMJfb8Zzolw3RHXpgC5WIugpjAE41Bd67wXe/Gbu7IO
-Kq/hWukUYAZk1gkgOn9i8XTbMwLxAbGUH7o7vii9arS7UD7ht4r9yFf9atCLR+y2eG7YmEMT+q2yM1Yj
-BTBtfXDtL2965sKmDTxqcPO7lCVT6s+S0YELpfV5u49K9JcUMn5bOVGsWAqiIMvW62BL4Aso77AJKhib
-dKgeqCZGI7UWgg9lvpytZzA/e7ND+VZ6nQZl8k5gkW3UbIxlr2hdqu76iKkIHWMlfZk+rJShF8e3Zsdb
-gpmbJTSRziYuVxlzGqhnjh/kSS12IbhwbEnKdsaTq8pJtey3K/FMDczcYxTVr280TEmTvPg51hLKMJyH
-xn/+Z8c7ZIFO9idCzxte4qGAgw7zv2C/cSQ9csvCu2m+Uydct8B1hnrNXDoglQ74uFYRtnTsV49yLXqY
-44XhB7ckFZOjavkm/RwB2DJAFHyLltNBHPCJjLqF9teGmp0OtF9xGYz4SQ11xKY9OlL6XEN1+Ie1MFJm
-MQ2TCenaA7+NM7FbJpeOfE1YZaq+Z33GJWR5BDrp16rtVPVDUs0wX6ZVeKR6bwlRAM7j3bwS3h/qFrxo
-T2jwLHz+OyZsf29zu5kMyro6Rtkkoe+/Q7JVfAcsWO7g5m5jOi0I4EiCbOfOpucjwPb7jPQ8Q5rcGmZ3
-YFd0OfPC3/ZL/8/X7sDtmfVz6E4xQISDA+gJPyA22ONwrvYHhqqFe2V5TM/SkjIBth/i5KbDhuOwVbvd
-Dv51XLChsFhPaxHqUlm5hRYGXHTFWNTJQUA79CVDrhSwK2/2r15oOIlDNutZo7Nco9R72E6dUlZ4jRZy
-M665IJa5YA6rFXy4owO9lJheJubU7sxsuR9dT2cOlzo0vgObRzYdRSRfl6yREyPRt+u/HfogMgVcSOLQ
-SxorgpIM/55LHyvoeW7vMP/E5yehjj0WdE5oRCx57WTHbiuus+C/g244c8Kw/Uv5xSmguLOiK+1AIU3e
-ZX82cl/K33n9+WdfxOlyDGsC6BGCnOSJXay14GBfylAhTpKZ+HvgtIFH7kAk099UXswK/QH6urHfPtlU
-Pp2PBKVNbW4VKg3fLUtLkFhnMYt4vyzkhCF1p81JY/S0wCZ5xqVaBAVsN60n0t+vdkv74VnZL3t13oxB
-uTM5CEvNsZADZU+Q739qKdNhm39sG4ryY0Hflj8OSpH53pVsUcISz5pvvTEdFpnAyAnPvVH6jc8ZUB6W
-ivczug01AsyzPTtj6KWvvve8Q5m9ieUcB5xm+YUYTF0qGYPdxhUJC9UR+imuA9iDC8BIWLs3HaA3e6A/
-Rp9PSicUl8VBc/loVUzk/gmeoke8yAxeUv88HGcl4t0o55O69pJM2Zp2JE6wjPI4L+qowqfA5Ghv7ZxA
-MCBB9uPIdG7f35BU9OnH3fxJj7MFKs4qgQB5oOl6V4GC7+gxNAl94RTUO63N7O2HO+hv/VzFH1iz/U+k
-UB9shiw5BPelEaXPP2c0wgZnz2sCHHnsXZPHbxU2GJBwri854hs/itbxDGCX5ogXT0MUxHT0JVFYRDqa
-RS0fQ1DDrJMMw39tpixu+zp81cbpB6YdAqMofEoLJH0DH5OKn5/lqzlTt5QYp18C/BK58j/ecJ+rkZW2
-tdompP746PZld63qiBF65BSjpnOJkzQa7JAlvyOmKAgu0M5ribn+Y6sRVAXwHOLdpN/6o9vqxd4tRaz9
-zClOGLhIseh6xsr5njX14tuiOcODVvr2SWFHG4e/nXPgDok7U293V7zVmPvMOWKG7a2J4zstA6PlrnFc
-kfCHx7j5rq+u4hjM9BbsPEDDniHM9IFsFFm8AJmWK7NsMol4ftI0pUoNBnvwe0eUK8FK8a/zQTWY7pgn
-lFinHe5PWi34erHlYShxNTdeQln9X/7qvONs25218wSK6+LhrXs5ko8v0KaXOBzPFHBT1gp/k1FoYQOf
-8apFYRKXv2HRu7xtuoxpZpyxuLa+2wN8ArfzQhPCrXPjknZULpEMhp7eGr4VftDfYHRfBtFfov5+tuv6
-XQk9h6Cr5jVq3e7jw92IKw9zRAafdtRr2WZA/gcTY0map3+jkOb+1gQYS2Q4hNDnG+B2LePnj8cDIWjU
-08lsYsumWXyE5wzEFzPEdXGDuqN5jSOg9Sb85gIyUZOUbT85djDx+/wzQbzQgM7Gwr1xXQNx/T6UMoYO
-sQsm7woOeN5tsJo/bwhHMkqeNU/8yvAoqPqdogFEBeNYEojB8l2N5AVj1a5AjcyZKhr0usXZjMCQYPN0
-l7Em/HyQNknWZadnunm1O4RRI59h/LHo4xwn7a2pzXtwP8Kf5zMG+ePQlXpDrwA/9eBPTwYyQoVdgyt7
-xZzabQ0QIvmLbKwAQw1byfxUQkE+QgV00jO/+RrS53qerQvK5t0hRknSR+8n/S30r5IBqmE2wPAXhRaX
-8gUNMHC7XKTvedUZHja8jJqr7bpb7VVlGn4+oxvas5RClKTXtSG3RBCgZl0QC9EfsiQ//H5E9Nw1ie6L
-IEIkoF5NSqSyYNTCM2Di7AE8gOvrCqV1FHZ+04Y6QY1WCo5eHTw2XgnlMG/wEC1bm/+paCYkZAKSDzJK
-DZFBDqLOqSPOaRGYOKObYzEoEZ4qvXQJjLSKenNbC0hccyI5aaCEdOKtdZiglI/AIX9BobhcvLvGw7wg
-JJ/IzW9Ba4MPSXpAsuxpxc9MEk3fi8mWNNi84BvTypkDxBtTdUdzusyRsJ6G8yc8/2vCG4t36c5CMui1
-7Jz7kc1eqnaITu+c2YDKV6FylDQN8t3uaU71aZ4L/ESGOlmpifgWWUEWLY9m5/5izJfiP5mbMJOVg2M5
-GXGR+7PeWLK11ez4p9m5FLMZbxGKo+bQP0ozAENAlJOoiBCBq2CtIsshZu64mhwhCecac54LPw9qA3/f
-ZiC5jxxTwkqLzXqTwtFEnv1R7Q+yI/xBiOZt8IvnHBYOWoe5q5rHV2YeqNM9zD069kT/0COtVVsf2lQM
-QAPvPLzhFdCx7eEc3k7ugxl+1dAZzy1PCePj20sbVkzvXPjNq+HcbYDFPJSlHELTVKjdR8XDLdCvmT88
-2+43EMh+OByXH7Qtj6CHUkfM5lLm17Nwv0I42/j2AAadk7XmuDGmLYayiLFqUhWHx5dYha/b70mwYqHc
-jjqLe1w2xy+p8Tk11VTtILuj+XdcOD4a+D2XPaV+SyLcuz6VFhBCCyMNhCUCIQkedKQlOeIPQ0V5UG8E
-TVzodYl9j/x59CQk1rMeWgVvAEpy/+WNAPhNcOmibI3quWXkPJA18Vs/x/Moh1YAZmc0zdzaL+nJXLhc
-TsLtyzJC2S3f4JMV1gc31YuHQT7Cu2zOZLwcYf02xieeoe5owFUhzNTdbefM6P3dq+OYUseNXLzLQAYt
-oMO9rD8cA1oJZvm4i0qEn8o+HL8Sgpv/F1om4u6dlMS5+NCJB1KL1sM9FgpsoIaouaQFuXAMAA/zPt+O
-2AZ802KFDLq/Lw9z3G9hImoH5shD5pMNXNt1+sRdH7jPtQjGtvXsuEJ4CfT3gwJ5SFbLhB71HERPqsFE
-j7FDJtjl/jQT3EgMXNmhTSobUgKZC1SUPuAs2xURSsepXc/ZbC07SqyvIfWuNn+uKHy/I2paf0WR0lp4
-HCRGUuvQc0VhTYR4J6UiIws0jWTUwPIPyGMh5T5+IRxyagjFE33p7nh7s7DYcori/N2TwMzuv1R5XPl8
-m9X4VXrhqTgBFEC+kmb9xj0dyuoUi0+AZ276Y/QWDDOOcWEg+edTw8gJBSX4qrrE6pOGDzgfTjOyQ/Yn
-W0HEHW2qqCMtU9GCHbC+y7RJCxCJsBzkgz7sDtpeZiO0KfqhJXVq9nziUR7oL/6vG8OUxTm90NlC+QJ9
-lhPtRspN7frd43ccyDcCZLCcALr5axLLOxT7+hCChm13EYho5OUIiVjbQB+O/cYUMwj6vjBolefehver
-CyGlC8WcJXagUJLNo5NiKrbNw5hbKSctYjnwALBcFGwP4WWlXafY3ezYyNQSvdAIG8vUMjIC28lYUWor
-8yRfQsXs/6JiOnWBCvgibnhMTMoSu4uDKiL1EeRVHldVg0lf2enRavklxJPfVZkuYUb1BJ6xQFwZGT8X
-z1HM+p6geYuQOCBT0AQ0tRgnvCWiU2X/dth0XE5MCcD1ed3F6esKuxISI+iLlidc/Kr4aR2rP31JdNV9
-puGYrE9IufUorSahyypNzyx+FNLzqVJaT6Cc6s6t4Boyyz4QiEp4Sn2mfGiXd6h39NuVyFZL1gJ6+2be
-SPvlmSrHDZOkTTHgDjc/hojbWu6Y2+iKHX8DM3lXZAAgKEXD80dYIHS/OxHNS8zTu6fMfsS4VPUuez8M
-78d4lpqeIH1bvZ+qwKnC36Ny1js2auJsmOByFdXxTfHX6OvKWr8OQ1g0W+lWTT4yNmnLOu+Eoi896LpM
-mVn1/M0Nlq6VRaY6ilY/SelKQJI3t0hOkZFNBw5/2TMQJHlflQwqzk0LRsVglwTh5aBFM4NORxokurrc
-7nB8GwKShKPMt/xex/1IWQIPJaU4dX5JB9m9CU+ob/Brjfl8OBnqK25/mbk03u/hEvF0G0j7WLroQZhF
-+gz38x8VlQAedwXmFbJZZ67skpQxKs2rWtpSlGZPZ/A6H645+Oq16h3o1Lj9MPLjxeqkHIIdyZFZISE3
-QLDuo3Jc++FEgoge9XwEdRvlsu8GlZiSNvUJZYRXZrs3eF6BsCg9hYxJ6LYKY9SM8YlKxzPwJtAbkeo2
-yBdvxBXi9V1enO5ZIAPWzWawhcpw3mTnBgLkLSHH+gYm/8XIYE5ZO3dW+sI92tbRcCYtA4IgvloUrwmP
-M248s8S8SdvZytn9PGlvFQ9LPUBjnBwn4jO1qfzjQR/Ww27w9coCadT2pYYLSgFwbymUnc2njIAIxwlr
-pYesjEjGODLpe76/bt/8/k7dlBL98CLhS9SELmJjAX3pzbrdxUpWE0bG3dwcCEObdmUguTUN+Q6v82tW
-WYn2fbqggA0LumPdRUVkzrWQe1dlXm3sBXs+Ps5qXssdEersyIVoNuEutiC9lAQBewtV1VW/Q1y6WKhp
-gmrgZqNH+vNHZTtfwN9v2g3SWQJ2CLul5Kacg0BkQsw8V3hXj7SONqgHrtBy8TYbQTJc4SFplsKGl3Kh
-Zk0NjYVhEwt28jxgGpkGflSsqrd7+WSBi2zU2ZzfDyNMl1BYZPpfWi42ilajSxuJiXMbN706yKDoyl+H
-B3FUMoE/mFalTkv/uOXTu+b6OFuGJAQR24nP9cRLv8EMPZOoJbSWbvvU58ySm8kGidwd2nKx3mzheH/j
-5FZros0tadgpgX3xOD3iUbqS0yS7sbYo0yh7mEMKJBLR9cns+p4sKtWOcYkv6HUnqVYiv/rPg0pBi0R2
-qzhkr4jY+pOT7+6R1eyHFA1jlb2h6fK/qtOJGqPz9iWjECeXQlvSHDfYA57TOq75jN11vdx8cRZIf94P
-/T1Oyl9Q7Qu/rvvhO/mlYpm2UtR6Yvi2PH9JG/OllC+5d2n135yyMT6nIC+sMlfZJvWJVOATPg/4Lzx7
-hUA1hYN1/TDicL9IIEuh6yQI+yzkW/oF2gJnLQUSILiaDpvqGDwjpywEUCah2t3LdspTWlcb8qnt05nc
-9vVY1gQccCZGhtQLz2gldGkwf78Cgyhh/fMZnuH4vRL1RNICto7DvYn1lhDML0LstteTc3UzOX0ap7vg
-EP99RDgxQwQKYFh4BRVhNIZk2uUBPIdSuL8LA/3hf6mpBPIJ3LxOGCDvMZtBBr+hYSc7Y1y6K09BuWjU
-K4JehhaeF8zQvMe73QYwpVzWU6brk5xVqxazZjLUVPRwZRbykiNomUP40YYY06iYj0Ki0kV41Lp571OW
-c/8mBpEzoBZSOq7C/OEzNq00qcBgIWZz+dG74ijJa1Y8WNvx1qclkGtQmpJHPcsNGaYWQAMaWU6IU5X1
-NwXUOrDBj5DNBz7m+S3rlWBKY/w56kEc/IIJHQMblIP57uJ3durBTcMadsextoW5yuZRvUt4a98Ko8v0
-I078V3b4VTrUO70nj0HUQROmpRvdIMytU8M02Z0M1I56Fq/z4b6GpqZN2javKsSwKxajNmmBEJEZ3hMl
-X2Z4RJhkxfjDEZhRcpoJnhChP3aarKHytNdkzuNZyPCqu6CndkKdts07+bFTZTJEjP9YWMoR64dSsi1l
-tPLvB8UHgvOSuXyhi+Aswa1q7X46LarwKtbH8hEqUUfdH2N5/d4yTM/ZjSCj7IOYOlkK0RltNabmVBKn
-WkxA/297Sy3g3zfiv3/JGpwMT/34w8SkohgHtiWffOExs7CUhRENITKdJhjJPfGTx6W6om5wwSEE6DOU
-YWw5yQo78nuwsotWjXuyM2HOLh3B7BbW4RVETOsj81YqGRp8B0gz9F9hJGjj2zTze/XpRiPPUIrAcB/D
-omt5uqGGH+hXH87p/jxY2nC8wagyzxLM29VSTx3ygkIwt8JVPkaFM5dM/Gy+JkNk/H58d27M9LyN5IS/
-JTrTImusV4TfKh+TkM5k8frNe5oK9J7F4cFMxJqtvx1c4DTLpMI52rLy83v7ROT+fVGUUMecegAVYfY0
-IrNy+Z1V8l/KK+6mP9bzjzSUp67BEgCZqQl+9U/wrkB3jXvL0SmC48VELGWemY9PkEvz/NqsiPe/UmyT
-1NFhMBOUejWrb6dWzHBOgHG8ENMtsp3GGPSaBNKtQrO48he3yQcXmNM/YbcuJSu+vN7ey0v507EV++v1
-jPinfc1mF+p9VVK+lMti754jxzmv5MpxFZr4JFuMMXTNNeGkGc+cJxbuiHaXMzvgXNhnEeemwwNBxIv2
-Xp297+VKnka13/mxWVEbWv1DBLWu0zWTuE1HYhNPr7Nuw1DJJP2TaYtO/w6UgJNtoHOrJvQV9DbbTVvs
-ZkIQhXndHs696AQqszUAIVLa6q9fZ2m74Oq5O537GqDx9Y6AH9BlShw7luJJpUTxiO0IbXxbeebmGxac
-i/+0O21G4inyFigaq/HT2asdvvKAcSrhZ/wdl4x/i1zEiRizYU0prnyXTX9Bb8dXtcYDtjNNvE08C8ut
-VN0ZyE3UrGs3nN9gUH2uGvu5dp0OJbke4rEZbyCnfy5VVDA4D6lvSZzE7XvMOv9sJqvDjsKfYDFOGkpc
-9JMh2VyVY8iO6MbmZStyaLB8fInp5xb6FFs8xPvo7PKzmLQW8lzAiBqy1QJDvlBfYbH6wxBFg+aahTc6
-fJ4vP66u4y5rHAQLe5dubPSVSJhIYa5cZ47x8qvAM5EvPQvqPoN0nGMcroJwXq1Z5f1avQKZOhD8GSG4
-289gnmJnT5ycVJhqeDrfbycdCllc72nsau4VabUaCarPO/T8irjDNJyXeTyOeznk91QkyYklsVBqYRn2
-5KHdB9ZTI58qY+05CcJkP75VPiAwEpPw5LVEFfeF0nQHJ9B0J7QJu9ve67dj3ecVVkbdPNfFMjIiDczP
-MxZgLZfm4H//g816IxWFvJR68d3KYjedzcxWmVTVoGZz7F9ihQZK4TnBDGlQc+DTPfqW47AuhaJYQOki
-gyhP7sEJOoW03RwOlhfqfOLLyDcC4YnfaA8rGfDHSuNRj4kWTkkCY4mxHO0wd1XvXzkCP1kdwBgKCNJ8
-VuuD1nw9fVYkqhlJBoHq9Z7CMZhYHAzzxzBWX4OjFfdOnkpdgVSfVj0dyIcsyBzCkqvsZDkMgWzhnVBp
-lNfubri302H4ntHHFxnsNPbzJf/wapPdrYyotSc3xQgZ1f0F31OvrbypEIzSyWcUxZig2pEZQV16bY/6
-g7WFiUY+9fxI7OsQsn6GNQC8JPnA3+2PHhJ3n/4dWSKwOFCEhcuwXGzm/PXZkR/SFj0yGRLQIGMJFnK5
-Z+BC+Xd16zI213T85UkFvhKvvP/EsvUr4ngmxkgIAZGrtpwwcdJ11WuS4dr2dbKL4ZZ9DlrwOIYz3ems
-xQYtmaIdyWRYwnbgH8xd3u546Qw3GerL5SAJvU8c93vr8qkc/CXLhTw1leJNL4M4UAe2K0+YZxdQVj4P
-d8PdmL8NNRk/NSVeGobKX/HndZuoGvpKhrDpsXQs4VH/yr9VOGqaPQd5dZ0YNqytvnBcFuQ8Fssqo5nI
-e8QG4+1cQ1lyqrwuQaPMojyWfClLr6md3uDuY+aU3tj8r2f8Js46xGAxsZ1mFF2yefVB1LKqRcH5K0U6
-Mboqw49r1l1fcgfrdF6OsjIKmMVTJWnTdS4GfNuxmSpBf4DKosICM9Fz7fey+S7oW4OaO73et/okSFeM
-GHdcNg8l4e92HK5uWFf/P1yXGJikCupVUMtI8NSwE4AyXMhGNklwyfmrhpHkoJYiLc6ZMvbqGkQvC+uP
-ar6CRLdZd2+KxYbn0ZhGJDLCmUdtbou6N/5L2sxmgYIl04w0QXK1Gd/LDwRAzJ3t1tHYl2LRuEd5ToHg
-6Z2Szci27j5LfC3r+rUtq2pB6pIf8dRlRaVoW6yq0yaj/wSEjnBSc616qWp16pe9LnnLdGzgAmLLS5gm
-W0u4TvDXtUSJ20zFDBwXQwu9pjORaJ6cjJA/s9n+xhbPXB5JUc2v7BV6dgFFWy7UfWBqLvkbaLOwJDr0
-aJKGWFgIhI04pdJcjWQYwZqFT+QXR+ttNsuTZOn8j7cuAU5jhTO0ACkOy4wkw4FtKthMO11krusImQKF
-4SI+ySeCm89kH8+ZqrHRom0wuUn8fLn6JsWVA1dDgHyRWnvEUUmK0mjmr1NqilyZvz/CTD4+82V81tiE
-SdgJ9635S/gkonErb9Vw1Pxs5u71Rt/qE260t+Z1/RUHW8Zr7T/Z0A8tFpSeJmpkzSIR6h5M6IxIY2jT
-F46Wu+pBeVTbidTlydQiutt823XOR9bxn/y5yekGKyUoWew3CQncUI5CDU04mTYNfABna4f58uNLI+4Z
-SerD/M51YPeXtsbtjmYG2zdbBUEFo0EYM2CCTeOztPavEJH5EJLnTasedbbvLrt9odjH1Jt/axKWY3xO
-llScPfTKyjZV0pwrLhWgAIjBiN2oRcQyulr1rAxRMwm9l26pLtWqXjfmrvulyveV4NMMjkKnvZLJ9Qvj
-6YtH3mt9+Fs5Jdx5d8LYxRK/kxWZ7JHJcgZ+QycwNXeNKrxQraVOC+DR0Q1lyG152V6K5Z0Y8mniR1uo
-6myXcdXyN3benVRoUN0NVW1NBChyOVQ0U3R8Tqct6hCv8llz8AxhJrluBOClxLKQSotcIsCcOY/RUwQs
-m6/PM+TPR6jRVauzOhfWKO8iqq8cwpyoDyl95REre5D67Pl3kxQgutj2xWTHSgzpOiZeZLjsfJuqvFsi
-2bcI02BkLDKrio/S5G5DVcU1KI3oGdn5vZ7gOmfskjOpMnk7L0A29YtSmddXe/o8Sa5D++Z7R5RqlYcf
-CxBB3d7eAmFGqbFsMmgWQqx/BYHMQaQsYUNcHDMOc6vw3MXRomlr2RoBtA7Ip59GSPs8z8xzBr3V88u7
-CL7O5Grapko4cpDZYzxMVgRkrnsriVek8QXXSEB8IKoEjXKwYjElWejzD7K17IaKkp+84JYZp21fTgGe
-1WGh8+mAEDmfFeiL+HwdjYZfeypdLd6Omj7ZjlwT0yYkXmfpFXFUkRejURJaf48W5BKn+OBqrPaqpmnS
-HA4WyL9C0Vx+iflNOORNIb+ozy+JzxUwg7ctaHOxkZUiekfp8c/MjmEL1Y4wmv5T1fLPlzBNsvaxY86K
-hXunPr+7/SSlWAziue5Ad5pM8ZgG2C09L8nSU84rKysH/Dv7IRAsgDY+4EMtdN64DQ27ogiiTuMmlUIB
-yyx1wBDmuKmqGRXa9EUaYouKY+6XM6RPy1DRIN1FFfBOcmiseh06wHp7BoMyPUuRt++Wgrra9DC+wp0V
-G5JI2Rxd3ob0iXe+n5Sy3bi6qPCDzIHAmHy0EqU7QUJhtEiGH/IcOhEq6mgyWIPpMh4MX6IWLI996ONw
-sfE7RYSzAqpCZY/Tj77GhCfdi/RnNxJJoW567+hO4lB1khSQeKjs8xsCqXePL/VrlyUend94Eakb2zdP
-YSCLj1jA4XTettEWbjYajOMcJnxHvAV8jCYMh4o/+B8prg3dSGe25xvWD4yo7iaP0+Ccg8Q6sYY+R3Pq
-kGcD8bbcuJydqmpDP4JXu2xOhs+l2hTuOvT79olH57f314wG3tXEwM9J1nze3GXlS9xo4q5yw4ErJw1o
-p4HQSaww6Ah5HKdR0Lfx/nb38xZ1lTZZFKj40RGiIKZWbMQm3P4FYcqbdT9oxDcREZFu1WUWMaKuAaid
-R9eZi06UWKSxIuY6Kun5RcnGNKGXY4hJtm9fSkL5ZToD5K1hjfrrbe474EpYKJ6LvzwlKHnYbnfwk/S2
-aoePduyJVvXeYYGeYootw7cIRsEI5jqt563GleYWb+H85Wc13nvQiErpvPj9nmuPKw4M4WjjHR7DyLoW
-u5WxL2emWgmmpu4KoW0b5dQgxWxOuzuL7MKyv8Y21QwJFPjfhw2qhs0uFnWh52A90yNq+o2iguYBNfm+
-jPii5fSlPSVsrDrxXTlDf0odgmE/FqqcjvyyzPxiee+jcrWQXik7nwDuiBYgADimRLjq0Q1Nu+i+NoCS
-0ZNcrAbqeBXZeiQxx39nSeQMfa7q9GtBanp9ffJFZVwVW5TEmj04szWw7gZ98G71tj43ttvvT7UhE9wX
-j5utbU1VG+cFWlgLFIGp9A/S//EGw8ccpiereBPF2NKbxqLedsNK+Zgfsvi3cqUgi60FLFsnW+oHx2Bd
-+cHJvws0EGm9SGZ1CRryXC4X1GUJGr7cnZijBwzZFDge4P0rOoPVOfAj3eLRzXC9NGoT6TvhMNIYv0Qy
-vxPGpn1PVyfTpU2Ztax+nidirUt9s4aLyAlpC/7H37NkHRB/YJj1Z+dRQL02jx7MtWkLm8n22atfzW3+
-L865Y0Sy7g6d1d/tT7WRvjuyk8nMS+LpL178jxarFtdFg/f8Rr+Fj+Yr5j0I1edsdnfneiCfqjcqFxa0
-JVSb1M149ASRZH+Pi3zVQTsP42fCzOX3aqZVGMOtM0+cors5wjnHEqN8uOTEk7OVzRvfqoFrEN8fJrBT
-qVjmqiUnOGLg/XZGs2Bpv6w3pPirsi2nFFgVteufjoKr2rjD4T33yM0qbHXWo7CUz9LyywmJ6486NU1N
-36TFrWk0Vv/MgUQ3p7+/wuY6K2ye5FdhIah5JtoAXItu/+T3D52MKL/daIM3MYMNTHDJuNa3ADt+IIVh
-cGIqAgGsRdILaFb44LctW1Fsnk1DV8jsSx4wSgdx3qrvrODkUXxdKn+1rSFSdvEkCzfIG0nsyId98xB9
-iKCRVy+sUZYPmRr1WWDiU6hbzEqOggJZwjLkk0on3P+MVaS/2sjOtQVDQhyVWWbzMB/1rvXCzkA83RZD
-8bTtPOeADhl+LZvtB97t369laTBU/hZGzbg82kfTREKmuHjBKPWNqUsIo/s7ol70n4xcLxsW5L5OVnA+
-FvDj9JYNiM6fycVtBpaEXzMfkGfnAi01jJbJYdFUWqoB4VvdMOBl0WECK6i5CDi8RzMhC2FFbYASqCPb
-jZbQZNxPi5JONTDu5a2cQkAPKv7qh78vpwqZVQMvrMM0YKn0jSE0Kb5pn3Ij8SGrEoNpL2e5kq2bm381
-d+CbyXDexNk932h4UcIfGSKLfQIHBkMR2IHTh4u9Ckfe2Y0Nsafw+KVRRgO1E4m9I7Js7kvaQDzs+DW2
-/KC60wGPPYRjDJZrV9DbkWl5qbJF3FxamOt8XLXDJ2HcKtqMCxn5NAu+wI/uhrOojT9mojeXUTPPRQHy
-uQKtrhIMol+ZwcagTVZlYEEGp61B+TGsVr82A2TQ0Ei+CQPDkQrrjpdmLhm6RROgqcmN/CtMd1BGTWRs
-S3RtJ9M81KJ/skQ390mptTUc8WT6idiuvHshjaurTqVbCBGb1ri7O03Y9jizw+hgTXh9qc4gu/EDWPqW
-e3SzUINMltO4kcZs9x04SgpQ64bx1e8dqdYJCsoJGlhXEPawyIfz6esNn7IPcNfoGbC6xApxHThHVSUP
-uKVoGolL0wu6ajjhqEKxLisiTZ2UJbyTuabvslHj84OBx5IZPsKEblKTyxCNEDr0wElUV50NiYSFk57z
-kV1auuoQBo9myMJBWXu/HsWSGcSv7+gNP+gzX/1RELJCblUfI5I2MRHgzvdOeClr7rQ8X9GipnN3IHL+
-5PnNqvLwZB/MWS0A3ur4bvwxpXsh16w04DMn1DJx63jq8j3uATqiWQKiit7gQwdqRfEWTdl5fagm6+nZ
-KzDMV9ONnEKJ6dnMSwmsTxlGnWI4bDY9caiZI5yfjWBRvlLilKJjIsFnVD/tiFeV6hcO5PskgqNUf6B7
-wyw6kgb7Uo9glDWQy3hHTfdEvpA/1/Feav6ozNHdd4QDdZ81bISPF+WMce+o9gBcesBl9wkduwc24fLe
-LAa0MbE7/ZQ7HdfHYsNl3JQvLp4kR6/T2fyackOxH5UQVXrmrzL5cplGaoH48yvPKNoWvWSxsT9KXlmP
-7y1rNekDEnS4kW2CZ66Z6GT/KrjHOzYjvBMJ8eLqe/HrfUj/hBHpIyhUMWnYfq5P1G2JjkdzMsVvXWbr
-jg+ndaCh8kl/hjNATf50ZuvWdyBIyZhYe/CvekT++GYyRIL28yfdtQ6qq8QSV0DgSgMbeUkYmHICwSay
-Ysg2bsgJ5LDdrbyFcuTio5uHvL45c/VaJ9FXEiSkZx5DqwqOn34jzOb0fkdbqyqBVkTGwLfqKPSu2iWr
-AqCsrst8+qb7/y5BhSVQY2CWLNUJNpXOk82oy5HJkkbGY0AGNB+ksQtFN2RE79fd3LGgpuUXaA95pEX6
-DR+YDC+skh/qUgCP+LYuZKXuDSJ7Zpbk2hfpNuEZDRjtuEiK7v18Y5GJp0D3LGTpj6VBrbHFsy3lfzs9
-g8fdcKKJv2stBzb3vDIW9Rpc+nNwk36e1t4eLlrXo0OT65WcaIWuT4Enhud2bmdu48z+9rmjnCxdRQFf
-COet1sUiuK5Y6Z+K5/bYM5BaKJl3q6MLemZOJcZ8clTFZPNjE0KEGideS2u3uDez57m037QMHdbrCT7u
-vjX4f8X/bolvxCdGLB7ZOqshPc8mR/O8fXJeWKir46sswrWx2GVey0H3qX4IoTtFYqFWkX0aBfm+IMuD
-/GO0UQexh+0fKGcLtK3BvVZbZCb7NX85V36U1q7S6fC/a9PzH5BfRxyrE8zE25vn6zDp4AkXz41k3JN6
-CTsezxTkycKLemyQSq5xD2hC7/Sp3d0HqsQF3guiTHCk0HFqNSNT0kC7n32o30BEBGa2Y49Bp27I19iW
-w3mgT7xR/9Jx2Hn/X1fBiNzrAX2hsBrQpvf+6WGbh6UKeGzVEW6+ALlt+TeHcy2ZA7nGfan0nCcb7luR
-oFgjEcjnTJ37TVhqXF3/gkJ+uQGwD6sIPY7W7m0nx/0jdhvT7eW+KEG47LtYjwbcDPTSzQcMp9YUuwTY
-utyLCv+8EkDtxxC8TW1m6tWOdQmak8EvGdeExxZS2YqqLS7ozSSXRVRshw6IXBPyJnksvjiimFXPV5hT
-f1Yncdg4/nSu/qld5WP7uHgYMX1Mkk5de4DKjwvcawqKt2n8nn4lr04VqDCqJz5Mb8QmC7/12rCOnoYF
-neLQYxYL1XS9gBeXhxF3uP+FdvrH9zBJJWqXWrr2d9LtA7J6ycEf21p03xU3Ofye22FdDY/trDm3m01o
-fiFFCavp/UNNHwBYkAFUqgruvQlgDWbJR6cbQrJCwuDNNnot8chmo4ga7rdSbTQBPCJ11tDHlvhqV2uT
-y0XK/GPSW3jlO3m6xDHv9AaHJHBidwzmw/vngEY9xW5AoL+atgxnWmm9L4Cu5q6ijB76YXDi3fXTZw26
-uv5scXlXZnSvRJ/F3+N53fNAHIGtoqHhIm8m0QCstwm6eOpOVPGXc696LF0lI5yIlsrfvBR9/F3g+o6G
-rzOvsye07wZ8+fJm0EM2FzDPNKVIYW2lJ/45XAz39Aq9RjKwZmpKXTXZ/vOBCHbHmUEkZTyEl2fTCtwd
-junbqi3VX7rnu4BHbw4F1eSG4CXdt4wJzU2fcxqQxgVK5sbl3kast5walvZvOfxo4bpORNoI5ASbBJx1
-UqFi2kvNprO1zna8YQldWCV2yVSHXDwMv4/4PiXdf1TOiiXV8d9hH6bKEiVUIX8OC7FKjbsZY/jNoYla
-5RwWwfWg1xiiy3k7YxxI+urzhbmjLjFYQYGx2tb1I9TnNJUUTVvFVH9PCoyWcL7UwAiw+3UEYjy50h9C
-QtCRCT5w4Dzaze75YAd1jP1yRazDbtzGzmzx9VI/82IJ1GIjnj2gFILb2DEdclTzPqBDhA73BrziEvy9
-P8oSXoLk0Dzsx1ZQmZ4l3WvX48C95ghzx+ixmSVntZYmJrExPTPv4LLjaatnfnX/nIDFy9vaLaVZ5D5j
-BrGARcs8zLvEtvljAwNtbUZSPrhXMUVGbZHfE0wnNABGMlrBX7xRkwo4udY5lRBfG6HLW7ZoJjV9QgFE
-nry7o2/wcdsvgIhDdqVA2HYMWBvzCkmzZDt2D42hDok7+ep7vgouq1B2MDVVAEzG2iAA2OzqP/HCj/R1
-eAH+7ANDmr4+Woit6YVLZFGY7/ZI2L+5nuURmJLSqImqTzjwxxNc5ClssyGhtm52TgrVmXfECFVzwrFv
-b8yNIRT9qauL5Bd96WCJI5jb8Pq1M6uvObX5B5QI/BkG9a+F5PrMytlm+BoVnmf5YouuWy7U19aoot48
-0UWy3elgpnRZmxx1tWgDtpea5SaToDquF45oCc6XaaOLOC4vdi71RSqbg9amR3cD5DHVR2cE1LWfPNYu
-mDnMQWDOiluqgk0/ibeTCc/bC6wp83vl3/7e7wSEAx9IJp/ip4KexCZ0nMC5DyU2+zxyrY8vu97vDPV6
-Tev6BAUeOpjxyoHNsapPlT/r14pOmmADXJECYk2RWEdrlMsqmwzeFUOXqyIM2mbJAtlzecC0WPXrAojS
-bvUsI1ryvN14+5r6nsWYKg162i+1dhd5fw8063o4Tdxvlg6brhL8KGB4CY0AmL9nyXJYNTR19Rqh+PAv
-tmsM55aVKl/fINEfRCv/PwAHQPi/FPQ9uCNhe/17keofbjRttGVB1Pt3Cf+1Bjmc0RlxfPO0Xmv4o3m1
-1TBFwhe3wQvvyB7Ge9p84A+ss+677z7NB9rcGNQiwVOm6mYF458rqzyMqd/hjlJdW3WqtyyaSL21+PNc
-QiqnjUTj0ChUiMqXHyOdktyj9VN36MjlyYY/yx/t67zEe1l4GS4xmXLjslGhfgATAuJwZdyLlRRuySsf
-Ls2yUatNQJBGa/4Qxm29zfDXqDGhH24wg4/mw9j/lLWeaJ3e07zd9gUg884JHxQi/LfwhaC0yKMh8E1f
-TaiQ/3DsAcxy23ZtAudQw0QAHSEQ+I17q224ll7rnzRi3nHHHfe6kgse3kwJBsCZaiK4Mo4gYF4DOfOU
-rj21RWk64kSIIjasLahY8VP9hqmBhvGOwB7I2z4Giisr13EDbEnywo896cF/gk97R3bH4y/V1dffqBjN
-ofH8LtqTtje90QbTApjarqHe92iw06CndsH4dsMPwkG8cYFrFwPl7aTbKF5ROhfeni+hIW0deLloG/al
-IpUPm7VI2y0KAGe9pLCzADgaxlSFWwTQSoXsAg9WDbRpYIFLowoxB9qP5Qoxv0OAfcYbRMzjOwDrCFdR
-KTfYwU7Hv8Zn9O2jJg0l3wy/sKyWmU79+mpa9Wo0P2cD5/0r6GXOzNsYEG4uVwJwPNbzrjv7V79aTXL7
-Sm84QW99d4Mamu88+OA/GvQ0+OHEVw0+o890toARI0a8AR/c59Kl2m7B4zGtgjyna/t+W3lbEgCGdAFC
-kpyc4dq/LkRUuX/jaz0v6oUDPxU0yNYn9dt3OhykVYblPL9UGNIqHUHj4KTlX+1L1LrcXUeScRTiUfmz
-Xm5alexjiSXUsf55hWPGbMkpLonatd+NBX1DEXNeDmD9kZN+2u7rXdMFtNBVaFb7xVOnnXaatIA7offw
-nQH2DUCY/QAEwSmq07Rp07S1b4E/n0+LNinLpgHkH88gzI1Wrd8e3EwAMP+2MDbqTCff1Eys/+Qziz71
-eOysATcSUwAAQABJREFUs87arArQMB1WeJ/WPgl35UYkAEDMHN2oonQIAONGvWfJmfpvsOkPlv/cGnRa
-SM5S3fokWBCbXPrLjW3KGzFcX5Aq1UhPRzuc27JfTV3dLmhkrk76/W3GjBxooZmK0JsR5DRrBtY7YewV
-/qjvmqSzMuKF4zCUlyiQNP8nm4G/JJgOLiQAtN24AFx/ULBaOyDkGFFpGjkqeTzbdIdTAZktW03XKBM/
-aEAcSaZNDit4f0zx2pQAvNP9PdGuIboSTDf9vI1/r9LJ+IeXVQEQAq4TP+/V8h9hrk6qxoDrbAxI5NY3
-cN1XeU1++ZC4rv5Koj0oyM75M0Y+smvbtvsVeuzjj7ulsiBRb39xTIihexWMeYcGv5CznXyEn4h2sJ/C
-uWFoOd6jmgbgQqSrn2074MB+luUQtO+99BuB2oyPGwUogTb/cNPoKDIcC9O2XUoLsSpIkgz3PKec/qkX
-mHwU3gdlS8A5qa+NP1KD/oRx5D+KcPMkvWfb/ayiYixlDLJxKNuF9WP4ydGfU3Cx6NbCUSOY8HLhR/Is
-WZIOoFId9WX0Zxd24pe6z1+bfkBZWgTfG1As3vAHOVV3AYPkLsKEB7XV1vDhCR25n8Fvc7xrGr2VQTIt
-nADWdhqSTcx/koC5nb1JyMn/jQSAS8BIfSCZjtDeYgEKZ2jnXcY/7UYSp5skV3oEwnGEV2jeIwdMNdh2
-LdHAx/RbWoIfbmmy9e8aXwDleDnjqESpL+KSxJitQvszXOg7wbZfvvBbz4UfnLSCBpL9n8QK79rvz3j1
-7KDqatsD0ls3/aTSzW5JEEZ/jfQPaxDEuZHWzd1nwQ+DFQF/LMT7Z5N0imrTkU80rf01Snf0nDlzclva
-GtyIuUMj8EElJTYNsbXKNksKRVKojf4w8kq0h8D6z++P+HN9k3Qkc0bCfzBXeVIgFi5c6JAQgpjF12ik
-CgyVDhgAs4hjQCPque43Usvov5XLPsckr/sKtEDN/XXBpwJuOnb27HoIZI+NJrtV6xbosmtIC9D3AeCD
-v/KIycWs5vxBchrawYEKYLqwA+9x2c5wae8M9PfXHDhx4sS9BAAXlKUfgQCQ+q8R2L/157Am6rrStuvI
-b6f+aNBj2tCjDGxuOIDf0/SOLwFgDdYr6Z/Q3QBCiBCjuGw7dkb4CIhUcTdRoQpthJFsV6A/wQexujoi
-mp+XKKqs1B3WSfbegwMb/aGFZ7fW15vG2Jcs/3ua2fIbg+QTMPYyNG7xoZGipt0MljKOf9jlYpD8A886
-2QKINx5yce349i1BYO2NYJmotI8//njA8/od/HDqPwmH0SGHU6Di0+ENu+dfRkOc3fQrALiZqP/DtZqA
-L3hmJGR6oQMSDygBmkc6jVKWjrtEoirfqsOOrPTa2fGy+wkETfTUsQUjh78Vyc2r8OV/uPVGf5Fo9KaP
-/frXW9UPXTUQhCvR1e9OFWfwe4Gynwobx8GRW8M/UZ/IU90wBurW38V6h2fS4UkNtmZrI5tpFNjbxNgB
-jEAAuLkJzLsfc/bhkkTpOiqpCv6LBpnx789//rM2A7zfn4e46YSt/RP+Ch8CedwvQ3TSFc4OAFlBkfho
-HwtdVXZXtK9HlSF9LxKL7iqsHFsAYejwR7h++qinbvh9vr6u7iGL6EPr/uGGtvTuTunBLw9j5NsN72hJ
-wPbgS/smvBI+Oi2U924Nyv4g2giRoTTNXoHj+dr8Yc8880yZEiBkAwFg6xBICdIlT94hAI725+uqhCRI
-M6CtBchQSSXvnzFjxhaloQEz8Kb68xcJG9v3r9/AXSBp75edeiEAydSpELWcj34MrqtLDPbXmQJkZAp3
-IF/LGIiy0y932LCVbPwZww0XfOg3Oe/zUydykgLgD9r1By1068c9Wm5B9kKdvQ3N+AEGXB3/nYxWrFGX
-i5Liut0nl008Z/B7nmoB6v5G+CbS6utZjpQV1Z4zwyK8/L7t27cPI/FWp+0rY6AB6AeF6JNGx2gkxxlz
-6iVFp2UMzVH+QWGOoQ/DmDhIcwJgiNFs9KeMjbz/QXDd5gi9d5UD6bp+qtEibFeV3V/K0eCByldXWFFR
-gvGPm7xZcFWgnAiN1aKddXXrsf4vVNDkl1/uV4JYPIKL+NvfF2l0JyzgR+GL31PYGryv8IMw2MTv53ze
-TAtXgsWgXg4b2sUjTvgIrhXomHDRokVVFLC3ItJ0xtgwljY4LFNeGROBJQNgGJRVnDQLn3rqqSWKcJsj
-womy/Z4b5+40zgBnu5yswBcPuUeC2h7CZM/Q4+ICPyu1aBMo/ZuIsPEnt3zwu9z6U8Cokitqd5mY8zcU
-5uRohHmCiz6fUXhPveXX1TnL/t2o6TvRoOlAMwa6j4iM57fN3c8888xt4PWJ5HaA1GtDHslbywA/Hq0X
-9Q9OZSUFgGNCGPhwIsqSA3ZaDCLDnuA9x2NbelHzJ/B+oL8MYSsM/KZsO630gNR//3dAGPzuEtcQbzBW
-YSjqkvIyLsQxsZhcg4P6TF9N240dZ9duhgXOWtnDEtFOjlPrqSVccXp2sx1DC2vqSoOBbzAzrlFKGaXo
-x6luwZiKkbGi4lIzKKvjkzXRulfOdhmFI5FH+/LGn/aQ5QZeRmjxzVv+6G5oIqyB47x8ADl2QAjOc443
-xcSh8FZffUaP+7BtP0A4Md3UyB0NI+dRiE81jeLa/CHJRKX+xe4/m/+TuJr5yng632BRERkwPIRMA+me
-FzCW/5qW32YZnRWJ/g/29D3KHuYcwxuviNmZOdXC1DtqYG6ueorVedFBuV501CAvWj3Wi0zZO/nsN8mL
-7DPBi+41zotOGutFJ1R40fGjvWhFuRctY7NzAwJiO8vJgiXh4bq3UXmdhAtgcs2NFysf4hVUVuQmdu2m
-Asb7VgA4j+uab8Le5K4/uyF61j33pG9x7qTqdicYN/ByDqYGnnhSPIILkCXVnecAXcqrCPhyOfyzzNno
-FJaOA/4+kyZNqlIeJ3w0F6bsYIvie2BaMak6RFsPU3Fm2MPAwEd0Ey+7DFT8IMFCA7ACCLe9/4T/k/JM
-S+jq5b9rkshNbC9oiObFk2uArr7d5lt380+drz3yOiJre+URmDC6N7rCS5RxarkEA24Zu8ZKWFgp5Clm
-o1ZBEdRCXu2us4e88oHBnbQwIgy/bavnbdruRTZu8SKb13uRLZu8xKYtXnwzQoWkXgwZ7DQMh5IkIWaG
-EuXlk3h55YM5AJbPrf/UISQAACpjoGA/dcatt67EN/pTQH9z4jvabO2HyRfx8kn3G17Sd/80aE5lEB1P
-+AuczXkbW8DzjNETCEuHR50hsBj+OxJYSyR80MK9HF8SJAYNGnQghVZqycAXCqRr35FWHyfUN9D/w+sr
-ysHy3hB+H+EvZxgQ4rQlWEsSz2AfWOdDNkrw37vM47hphHUIKN+4r8vKbVaQGEMGXb54ZfN3tKjo8CFe
-pLrKi1dN8hIjMNqWIQRg+EQuzC+mZlSwPD6jGwJt6gj0gNGAq6bp9yjkOEweaeDDlDu3y5qEj0awdbsX
-27DW81Ys9RqW852WGqYM7twX9TChoClSOsJA5WmpCiFVMK6S72BBS0GdqA9EoHkuS3+7eLlX+FD9VdX+
-6qQFa18AePk/Bt46GN4MpuBDTCveGgfatInnBe0e5JTuP0n7oTTxJQO9Lt7Jo4yjyHuny5/jlgQo+L0E
-VvhMm3KfILVsYw/S6g1UmdcEmOWMYYQf6uYrrjD5lPO85v/a/UcajUFd5q6G3mZTWm1tLJ7IFwel3MzO
-raOYQvJ7NzfD5MFso4Z50cnVXqJ6Hy8+fKQXYX94Ip893OIOGMqTQrYL5pULM1TwLmA430v+cP/FxPzJ
-hpCDIBlS6nnlSdkXr4fpDz4UzWCTF13+upf4z1IvsXoDU44dXkLThVguBzmon1wqgoA07O318oYN8nJz
-+NCPf/YjCYD/jHh+p28oWbHibwq3nX/ZPwIeVKGnvTgtGL5bDT+IuQ8Vs1JPs9Sh7uvGoPciBO4lHi/x
-MvsGdGw636VLsU364lYMATDVP3fD1QuJSA4VsPz8mITRIYoqb4phikCVzO7zo3L/dnkoSIYLfUrMBWmV
-IIr6sh6p9roL7DY/r24L1wBsxQiVGmF3VkWtPIBhqIvo1mfm8dEp+3iJAw7yGko5++EzdEJTgOQdicmS
-Fb5nhaiF2hAv53vJH+6/8vrvNk1Q95pEMGb18hA0o4q8hpEVnnfIMV507SoUxDc9b+nbXmTdWi+xYTNa
-BwJDRl7BaUsQEKeVrMJq7BESBkrrt8nVRup/3aCy/JWHnbkXX4dZfDURs11k//TF7LoNeB1X5j3NIH0o
-wsDC4CnbxIN/OMuBQ0im3bOvglfdKHQAA62bXreLOTG7PyDrWxzVZPiPtH96Nhg3KpAqAmSF6yUFJ6mi
-j35spoBnXXoYX18nSRJAkmzcKsGLpIO6uvbor6sX9GskefE779Qw+9psrU2vvQ5Uer4xMKXXaa0XQ97k
-cV7kA6d4kfM+4jUcc4IXL0HN12ip49J69PEll0d+Z7kAJi13831O6lmZ/laN+KjxXnz6KZ533rncm36W
-5516ohfdG3U+4a8qiDocnCb1om857DsEWYGNwqVrnCbawNw1PnpUeX2s4AZOqA1i76/dnd84Wf/5BZ/Y
-EWH58M3z/qDpOt0x7SHwGfNB+3jIf/DsS8LkCUbY9jAmfpQAwNe+nHFKjx3AsyO4CxYsGELEKEZnhbvC
-9d6es/V/JNY68poAQLXPgxCO9oWJKig+s1OC2AVe0cYHpgBaFky58u1VIp34+cn6yA6+yc+XTnvTKSqZ
-VsyiER1Gi2CVjxx9pJfYe18vUYphT8yePOXVKlOlX2CaOVQ/52SAlG0JOkA59Oqr9vEiE/ZjMfc9XgSt
-IPrP572GpdgL6kijD97KgChnYhWfdhaMH8fMgZtttAQZhu2nY+4f2ZFf4OXk5R1TX1PzFYKv0pQQXxWR
-2Oi3Dr75D6O6btEeAZMaLiQQ+K2lVO0HEPPL/RtemgkPaX5Gtj2nCS225X8mTEgrTaJKSdiOnNQrVSC/
-R0lCpAhM+c1RCUmVd/wdTR7TiAnAGO9Hy5OakrN161al+5cCnN1B793nEmuz+h1A4yv+sd4d4bBX7KiD
-GFXP8+IHvc9LFGLR3ykrPAJXTNKUUboNKX5drD6o89gozGhYPNSLH3ioFz/no3xL7QNedJ8qL6JvXSpe
-AkOaI/SaM3KQl19cbO8tNYEZrFdfzLdAcvPicS1xRiL/dcstt5yqtAgBw1hL+fp6mNuZBx9KO34Rw5+a
-bEIRXrLmwztH8OJw9CqD7g4GWRkFLD6Ff0qra8JkhjEBMHz48KQAwDovATBaGgCRrpB2YZI0qjwAXuoS
-04h9CC+QMMEJlrshaBXpXlCgO3ik925zieharVtSQatjp9ZDKFT3YXmPjh/lRT70Aa/hlA96ifKRJhC8
-ekZHW3pT0T3YqR2qp4yFCLJEYakX3/9gL/GRj3mRWaezB2EvDlQzCKHFxInPY8kyp6TULgCxfOGm+WTF
-DRVaD+ZekPqGvHxZOr1v33zzzWOkBfD4KkU4Y99/h1+gxESUj4dggfVeRd1Xox1n65Id8di+GO804sut
-5NkGr+ndpdN7e86m4sAbo4S6g8MQDvARCIFy1A0BS5UqLa0EAAAluczxPoGXAmD6Icn9/zRyHWqLpXMb
-IFyCrvRZp7SKgfO1lFuTamNTrqMIndFNM5zYkSysnPdRLz4ZXwJBKr/ifWZIGWZ3J3R1luDCjpHIyffi
-U6Z53oexE8xCI5i6r5dbOdQr5BKZ6E40HtRW9E3biwDlJmuPp/B6CQAROCuC2I40Ih0EzXxdiRAA6Z4/
-6W7MdFr5oXP6S5kGaCBOcjeMxLvKqeYxyQCfasDdBt4UHjCafrTjbD8AIEctXry4SPg2CGSq0JFdIkSm
-KTtVjDz1YQHA74kIE33eWBUzFUUV5efG008/3ebd5Eun0inXJ52EoHcJaN2CPqRsnVMfwYLJI4U5XvSU
-Y734SWdg4GOuL1VZRSTLSqeaPSut6i90aTWBNslOEJ+MRnD2TM87e5ZXe+BB3o4xo73aUSO93UMHe/Xs
-B4jnsZRI2zFxGZLrWeJkXypBhnIZkNXGC2699VYsj/3XrVu3LomQSGSVU+/BhoWJleAZ7a0ZJQzpsh1+
-b/IFQMpIA44zKo7esGED6qgvUYgY76vsKQMjoX3VhLzSpAMNgPBqlijgA1mK7Aojkzq8r+SRIKDu3ScA
-rqYKs6kH99C9vLshvoENzBXa+qS6dcwlmT86nPn9ySd68f2mogoDVupzb2f8pogJ2kP7atlYxJp//ZgJ
-mAOYQtror52IehAU5qMNwehRDIN1TBEU5+OE17jU0lwEwWcp5i8+bUjMdEKfNK14z/3tpsXw4SpU+008
-xbzb6A+KVHGdC5iIv1w/4KNlaN+H8uoGcQW36cR40thxo/xp/7Io8wpd/mcCQMzZJoQmkcBTRWoA+pai
-OOGnOZ3sCebUmTy2pRE/LCRcki73XQM/tXy5PlCwxL8V2AVnWB+yox5HqxHQHz6HUfFAtsCK+HkCZskQ
-dE/PJjMSy5YyGEa02gHbMsf3GlgJqC9AMBSVeHWlZV5teblXg2bQoM/FJQk6aJlP4EfedtttRysQ1bSD
-/RGA7jUvbloMn8hWJgGguksISkiKzwoZ8SUAnHtTzEx64SolYamkvgDQ7UzGp7ozrAzA41RIEpaD367v
-l+0tQzXhWJpsQbVVeINVCAWE1f9awpcqTQ9we5AVT/wL9UVarVbF94SnVUly1jG67T0GVXiWFx9bnTT0
-CUZfZ/4AT+Ag3FbhVI9oSsufIlSEg84ntOBkSI5j+R4KzXy8hfh+EeR4Tx/IAQ8mAPDVdhMACATN/yGu
-wC1BQ4i7fEFoGy/iSaJ1ylCCYLSSRrE46h7yCQDTbyVIy1GBN1EnbCKHEKkk8+CQMDELJml2ErYkLcBZ
-TAxarZ0RL/ZP3uv0o0XSbLcO5HTMf+ZZXmIoGoBkYZgZ2oXRxxMIFyngQzQDHZ540003TZVxiidl1baP
-YJDmJzVw+OXtMD8S7jbSBRoA8W+Sro5HzU+LfIEnw70Ga5s/DEMIDFUHpOP8gtVpS5599lnMw9SCw0R4
-Q3w1I6wBSAD0FA3Au8YXAJwH1rJkjW0JThOJRtTM723kP3MmzI9A3YWik7TMCh0DLnUMSBNt4O77cYx0
-Z6Werc+mXKKTgPCYNsxpGm0DKTxU4QQj78tovc4EpIUEeNQZAidoyi71S7cAiXlVYMrABEjpyfumJLaf
-sYq7zPQZMP22eD98DRuBtqQMvIsSxvIT79Lsf/vFpd544QmDVnTCKC+hkb8c5t89wPwtdZtohCeVEcpU
-XdLOZItwVT/VAhwKl8JCuijU+FKB0gjAzeAjjzxyrJ9oK/7b/nvKnvpD7An/jtm8eXOJJIyp7ClDICF5
-rEMlNKiYJJE5fo9lOqB4JxCsU4l8Q0ce/WTd7s32R3sZAmnKg74MTU0AiPm1p7+8xEucfKqXGDYmOfIn
-91R1e9t6UgV8An4DurCRytFNK3WUFoD9MDaV9PsrTc/YMdpKbbMYDINqurxT+MOJ19yorS374xQ4ePBg
-ad36hLh+pka7SokT3+JK0Py1n9PTKoA5IlICJAAqmKUb3e4jSaTbfQp5HxqeuxBs0wD8HrECoHr6LnEP
-S5R6j8a9Rb4hUFg0zLhEzXwhW8YsjvBGjp/hJaqYkg2o/c3QpABowdl/XuLn66KX9uiLeAkA5T1SMPxB
-IyWaVPq+4mBMCYDakAbgBtJS+MvW71k61Xreuxm02XgSHBfx5GjDDgfE03d+h3LPlKeKeKj+3FjhFUm9
-kCPe1jCBr3ftujOnfD3BuR2B3Ma+hBr+WxuC4P62BYAkJ0teUXb4Jd7DTjg0ARrXE5rT4+qgfvfdWPy/
-QLjaMGZ04SJa8N1Id8KPfvQjzkf3ryVBd00X8//14M8MfOIj4cHHZx4adpF+cyeAwsV/aTsfVjH9kasp
-gFYB0gYiRuapIaMJAAw4HBhvtAU4DDNc0fQLC0PqpPfZPrNfuGrVO2D38dwkI7deN8XL4j+l2osffSxZ
-UJ7Y3TbgWseA6Ipnb1L8A39jaERrNZMGEOjqPVx/Nb7VRH00wu0FYFeuNGvxlvBnI4xwicvDZmcCwP+W
-5nYFihfTcT6sImDZlt2MNQAKrUGy2woAUksagJ0BUKX9QtQA1c0qqnD96CEumAZga11Um9RcdLlWcyEg
-BMvoVzHM8044yUsUgDJ3kq+HNKYnVsPvew0w2iC2SAKgLSdCVh58jgvGj1Na38Dck+imrSZ0Spy/LThg
-bocXpgYyupsAEF7AlaVJt1Afx8VMI9gQG40GNoA0AJl6T/odADMNgI0c+bwXCrhzTjIRllFFHZxs+W4a
-kFO/axFlPFmAIY/aNx7Wxfw63FPCEc0T3+/FR2D0QxOASrNVrT4BV32v0RyiFddrpel+hTmaaKmR0IkG
-DvdtvJNdmjBNubC+7OuaMPC03bVbeKG9unxHAlLC1BxpdgiffrwLbtcXXPIJTtIGoIB0nV9wDVLENABg
-5NPJpgEozjnB5jEB4OY4Lq67/dkg9R6MgResWbMOFD/SbFuwoR1kxdg4Mf0wL77vVJif5oba191t6Knl
-0+eQQWAIrOb382iJq6ERYbVNghP98Ow3d+5cdlb1Swe6gkHTMZNdvgNeTAMQVkJpUkaScCsnYYIR3z5L
-nPEUgM4MNAAqo1sMwseArSD9cxVljhOE9ZQXpwXE45H7uCDkbWwBGrF8LQBk6XRfdaUXP+Rw1H7oNgNh
-2VPa2tX1oN8do+/FvHYpPx9nwFA1GmtZTSrmrySVsixoy4E9beBoUt1O/wmTCm+BBuAXYAIAHAYCAP4L
-NGs/T1p1IU+JiD1tI6D6VZIEXweBTANg+UZXgRX6qwBOaimNKmVGQF1BlFYNuyDx7CQxRj77zop/Utwz
-QQUlKbX5opQP2x55+MC8v2N9Me7888+XuvpvLfO149ySVwm0tF87aftytGPugCR9XrLrgtRwBGXYuJ4W
-LgQLAVIqAZCJDcAEAB26g04yGwAAm2kAvpDYRafbWYG0atiFid0wxf6l33DvTR1LIzIGaJeTF5m6j9cw
-afKA6p9hf/hEW6a7IgHx2o4dOzQ30LVUDu1NIZsdAJOS0kxpGtlffoO3phqAG0xlbDcHXzkh4YJS8v0+
-kY3GBEDGUwAA1fg7klRwMwGgQKkpdGSP2QWoOrXmYrW1D8H2r9slIXyUIcodd95hR5IcOTmw5Nca2toM
-F7HR/zlYtidCC6/ze5lvzGpNAAieGQJJW60fsnjjBSOhwvqBazS6gwtbWcMv1Dc1/PbXaroEfp1wSAst
-5MtsCkDGYBXgxRdfNA2AihTQwZoGWIVUE1UMJylladxHEBTYk5yjrAvXr98Gmf06aaLi22Hv4yLMERUD
-S34d6CzRA482sIz/9Kc//Ro0sVICANeWALANQaQdpXMBlhg4fd35/GLNhJe2CXdyhBu/aXpNeAFf8TIt
-gHhm4PXuRKClbe8feYzcBZunTDsBA5WivcxN46nY7tWrV7vRnX5NdqwrRA3ifTfGnDaNPk3hdsPvxGwb
-5lkSyI39pm7nrhX5E8d6if2n8nVGatMPiC9LOHf7QWIQb7kIGV8rASquLY52OwJHkGeCEvc3QyBN3qV2
-i4fCDn7KBX/GaMRpw5ClC6dp6530Ad55L9BOQNtx1FamluKoiIILuOfP1BHgiNEVxuue7Yukk2HQqSyK
-75Futk+Qn162bEXU2/nDuv0me/Ehw6IDy36d0l0RR7T4K/17ANuiCWcIHAQtDVcN+tvBIPGN2i0+4zEp
-AC5sQGWXpNnUGP31cd9Ge2+Upy3nYPlpdkgUbxPgdJyA8ChLMRqAbn0U1+8ibJdfSQVZ5enwYhl09Lsn
-rgKoXr5L3DNrltUz+tlzH0zsf8CSHN1gEw1ONrp0A376GIAsgltuVzBQ1CsAMMFoFAapKKm7+AX4diYg
-HN9X332esubxXppEUdBamwYQtvOhhx5y2gEzq9yY8jVJG2Rq+kI6w7nS82wzAdA0URq/izgDYAKAPGJ+
-d4QxAEHlinnakvZB2u5+eWXKFEPOrglHePHisl0MQ91dpT5RPv2vEd2prctp1HYGBrWtRQGg9MTpmjAN
-IiYAuDQzvVFK0Hu3a7Y6J6bF1fpGUROQPh4zaim43aYpQNoagEojs55i8ruOlVTifP2eflIanAyDwdql
-Anq6y63ZvBsVpxa9tadXtcfXTzQATYgonIFoGe/uTvu26h9ndJPRi6WY/uekOYd5SRjwf3PzTNLxu5mQ
-cHFt+Q4ufbNdRsBtbSVuKU4A1LG4IrZ3BlMAfjsBEEh2pXUV7amrAE3biCqjOw7s9Bp+0Jam6QZ+p4UB
-0wIxFL8N7dS0pQE4qJoG4EwDYBepMza76L7uO+ZuRH/gLhAA4MelSRsX4kv6wKYAHdlMUKwrwFQ6AGUD
-2CnALTirKJbcFqJ6TpA7jsnIIwGwoZW29JwK956aaKAxOrnwwgulcZqtqJ3qu3XvwQwctnEIGC0SVztw
-el20386SJvRnd/oTFhjtpSW4xqWKG9JZFt/fnpERUBAEgMpoX3IgAHg3DSBciBrBYwLAMZjy90RHPal6
-InLxxRfrYyfuyyuNJHBPrHdPrZP63ndCrNGJ/3srv0UXLr5FXxoAaQYfffTRAaG3mLDvBQpfbnR39Oe+
-w2lX8KvJHdEAtGJH/rpMjYBOOhcByKYALO00swG4fmGTkGuMC+qxPlqKqaoQ3sb2CLTHNqIHVoxtq2FD
-8EYJAJ62JIAtBZJmMMZAEwD9ZS+Av1oWaADQoYRAMw2A8BLh0Y9Pudd9ut7BdEzb3jM3AlJiMWuRzVYB
-VCG/EJPyvJsAUHjKtez+hOtTWK/u/lr2ghqIFhhtHJ2oxpv80b0tAWBapgQAsqNfaQD6bDc4si36YnCf
-yXX4R3xlUwBWApgB7JkCpEMG6g/cDux39ZqbdcQGUKRbRQStpqaG07R7DBQKC7mwBtBmp4fydMsrG05M
-SNGWpQgAWatV394kuLoFb+0VCtGFjXiBBkB4a7g1DQD869Zqo5++vhnIaTjbt2+36/WEU4cfMS00WQcu
-bAowffp0ae+GF8LbQ3+jeB9WDbauuoxWAQTNL1RqigkApgI1hJl1V3E8Nk1QYTyjXQ38fO5nj/PdRxpB
-9ItU7i0tReEGNgRk0FPqa5/YdBZkYwiE0wBCQc1ffTrScljG29WbQ+25Ic5Gxt0Juggl12+/DZg+H2ma
-bQM2h6vEu+GBNdWGmXaOYLW7PCRFAg2AQlISJX6nev5GjXKVzBXOuwlfp6leCI5dDU1FdTFkr3CoVoaD
-z33uc0up8KrGU9de0YSeWMl6aCAQALynfDwcWtK5gB6tNWYB4RNptt2tgS/wdl06eNvC844CEBIaeMfo
-PV0nmMCx+zwRBNENPOnCCKevdj8AvIoPhOqnGXwoxNkCJvIV4rAV2GXpiX5C8yu/Yi8yT3J1lAorTcA9
-altKAtMByMBXWeFyrUyV2wVlZ1DdZllc/9eJzprFth0g8hGhZhvHbdeie2KraXb4ch33vYRNhK9Ulfwd
-uHv56EkLR9COQGzFCLhbRsCVGPKcqqaIdh2FCgKeddAkdz4ZtXkVDKOvwFi8APkV1IGOYfrdmxy4Wczo
-Uw+yY9g6YvhRPbxH0Qw0xbF2ZosZVY5ftj7iamWCPytXZatcv2wRgB4nnORbWLbqBvyUHOUrnZZVAw2g
-tTr54a7unrQv8pXi95bBIyWctJeINk+kv/WJPSW1qbQGafCz6eSTT16tQOKG4tlHQvQ7VQdsbQBS8pWj
-R4/elgOBbwLwapA8mvdU4QTpyDsRq6Umysq8SpUE1igqaNLfb0QBQmYS8WuCjD34RXMxtAAR33NU87md
-O3dqDvsuj9QbvY/gOQAGrcSPaE0VJ4YzatePTB34MziUXQ/ch/G1c66ScHW2nlGUa0TBe6PySGe/8U3w
-Ol8w5UivxzmX13zSKI8Lc2k67AsujqbUtagBJKtlgktVhHS058d2qUUwwgrf9zGm2Kjn7DMdrlTPB1BN
-H+sT3uIpQ4h4E/y85aoOjqp5D4SEC2/PJ5+bTiyfMmXK7hw6ZhuFLfcFQDpE7Ah1LxhEVstabAIr6azN
-EgDAVbwMFaq4PhumCj/RXgV7QryQpHpw0nEZwu3DCOOddEYtux7rOYoZX79+fTFt1GfQptG2LyJRjyS5
-rNbp4K/VpgpnwJdwXsympG/ccccd5TBDITjVXe7ae1FOmjHUs8L5lG2/AaqtszommkO87n2XxNdxXD1W
-poSyytATfidSBGdtx3fCoJEPTOVzYQavrX9Kj9Ppv0AAUA/dC6BwlaXbf2LgWASvpS7Nuf5F+t8R/Cj+
-m5dccoltV0coWyZl7GtOOMXZ9JP30bxbExXGS1RL0ry/GWr3XrzbBTyhsJRe1e/AWqXEkiDalbUCZB/u
-R6QERImUHldJ58kauRVYq+hcaQAKt2mFOprf+tCDBECvcj7BGaKaVFxGrE08S26//fYHINyv8f4/IFUC
-T0hJmUFI28iRXXnrEaY5LK0eoshPfOITAfM0SswP6hhleSwH20vuhg0bWLTIHUR/VIDzMeC8gmc0zwjg
-6nINTcXUV7Kq69GZc3snT5S8ZIuZUCBPMwGhpikcZ4zr+0Fbgd9UOOjjFZI6m7Fam3FImYERV1raKNUk
-xqCxA8ZfwvsjRP+OuBcuuuiiYMur8vQXx67HStAzGHxYk4VzCW7CZGRf6vBAuKYJ7uYkx4suujVfUibG
-vYyyyZg2nnPGGWfUcL54mTqeSOPo1nI3DVflcPoewDj8d4499th6YK3xw40YeI8zckbREjQF6HVODKZK
-uyUavWu9VmvSUkm1t52gK2+55ZYcGOjLjM5K0lFn2z4BUv2zn/1sv89//vOvUo8ct0dBwNkfLybSiCGO
-lEBylvXNvK/gadHdeOONOpk5HE2mnLrKLjMMOMMQYvbwW3PLUp4y+q6MOG3C0ZZvXTyhD0oWagoiYcG7
-EZ762z0Qavgd0rC56zLw10BdAWNOcDwY/9/ke5qQv/L5+Icvv/zyHX68ebItuXaGw/vouwSpNCNNK4f4
-AsDCwKG0y53gaolrO/w6CQEgjckJWRfVlq+rxTVlfYf+XquEOX7qleqQNJ1t1CBPLpUTc6sjJUSWSF3B
-JdUAGiXhgrMlC+IlNaxhCuzpzmcwL0S8qnIgKAnP4amHIWaD1PditJvBaCzx7dqv9Ok6Z1cYD+74Gon3
-qpi/hU+sO1tAoyuznLDy+zSoqyrxpS99SSr2W/6joBYdd/FJk9B0Ql+k1Z0O0hzEuMX0bwmPhIK2opYp
-DUQavBOmyyyUvgxc6CqwV3mCegDvcX6fT55F3BO4gnSBI29EAhacttTeIF1fe3GX5YCTSnAQCADe3VK6
-mMppAOKfKp+vAry2hxNASRuXQUaj/x4BQGesZveR8kuqq8yUpIEAUuEYHSoB4NxSfutLsJpemCGQ34ob
-+sgjj4w8/vjj31U+ynHpe7Uv5pcQkCZw6623fh+CPxgki2k0Micn3em3UJ3QwCidR7+8h+x3twLC8OvH
-BYRAfZomD5At3Mu5XWcuoTQavUurIX8c28MWfupJyZEnr7KyMh8hmE/7dZOPPhWXj1+Iqm+Wax9QhD0W
-f+ddj0e+QMMS7ekhOGiL0vQH52//1YhexRp/AXQkHKhPXB+vBbc2FXzwwQfHglvtkEwLNcKttAbcmi1b
-tsiondQAiFgLwDVEjqIQhafiTFIw8umQQiMBACx9CyCwUPowZTnXhiAVbA1LpZDekAYiFtIizFv/wlTg
-KXByEh3ZYSL2O/gA7AyljP66JZau2jOSpoGboC7kd9mCMBcQ8k2zCAsJJyCUBiFhQKSVOBUdHLhpiBns
-QrCavhpho95HnbBRAvI3Tdevfs+YMcNGSfpnolYAfC1ShlzrC7wlDAbGnPDWeGihxB9Ygw5NAWFuBWCN
-Nu4BI3m7KBk1Kq8BsFnvVWgKwJTELLiM9hNcet5fg3B34EttlPpi6qyECxU+iHSS/KnCd2B7vA8Bm9pK
-exfSeSeBQk0BhIJM2+osvweislUD6wXURI2WRihZRogbddoSEuEqGJEqoCWhEWZ0P1O/Uu/DiGrpHdrR
-fpw49rMK4idrCi3W8dnQTbVfcVNA+Gs8cWX+AJEOffHpi1pp36v8eiT38cP4mg+shkkPJDLVTjcYVFT+
-yIcffrjqhBNOWIkl+q2hQ4e+TpiszmqIKlivi0O2bdum+axcerpLMk+P/q95t0Yx2vsgFf0KOC1D87HR
-LsOKy8KbYDQYiwCQhvVChnC6IpsTGCorLfrpisr19DKcdsXgMRH6OUACAN4R35jK59f/CdcO0k1hWqVp
-QjrTTBuMySNNbaVgMaB4UUkfrPf6DJGmAApPpwOjEKfyaIPKYXqRlALWIl862fyO3zaa4U9+4IEHxkrI
-qFyl72tuyJAhL9G+N5L91+HWOZVtmiD5I0A6Er/DFRgA0KUY2A+7TxmCX4xt/Qyza3/EOvjpFdVEW+rh
-owN8Xk1nIDVagi61fG0CgOlbIuofKxTst8XMYlb9SNFplIpj+dZSka1ZKx+FPAEc+fppUkxSDaeKT9SL
-k3p67wvOMTxMqrmVdvCpWengskU0yH4CrCNvuummIUqA4BwQAC1iqncG0rcRCfbFixfr9J82loUb4pj2
-GQSBbaVmBJ8MrU1004Rw4nbeDRZptsCzK/y0iSgbNKxECn6djScy3oloG9WiHcBxXxrt//LLL2tHoBj/
-VQh3LbBcVs1jGkpwxE12gX3MD7Qa8PGQGNcXCungsilK3EYPrSzYvu++JjibNrgf/jaBvnHjxuHw4BEa
-hKGb5MiJMMCgLJQ8zfTaVmT4vR+/91Y6hEJag4G0Cdy7p5xyynK9yJklVi+U+Tze2yqQiqRDtKbek3fS
-22+/bczNBg+dL3jKFwBOwBgxk+4QCQp/qpBWA8jbKxzM/xoVXeYjPOM6iw7UFeCxCCDvFSDfoGZTN372
-Sfypnf3FufV/+lnnPaZo4HCO31EZ7Rg8A/sP6fYvLS2V0VVHrFPqf+CIB2O+Fi4+F10RHEmIkIxBkTCv
-ErhKo7mfwdWjTV9QfKPFJF5NAJx66qlbeX9asIBp+ZXOtxcctmbNGm1JlUupAcmkvec/7damihfDAjCT
-2quThD9QJzxOFwz6K+4efia0W06P0mZSxkCebsWAqf+qAYPFof7ga/1NkH0XAXVdH1RdpjTso9HdGwel
-O1UXDYkWES7ajv13wXLO1H1HPFRiMaO34v5/e2cCt+lYNfDZkH2MbUKTtU0qJRINjVCRJUuWIjMU0mL9
-kravVGSpZGmxVFKSSCifpRhjkCREkWE0lox9V5j5/v/z3ud2v493eZb3nfd53ub6/e7nuu9rPedc55zr
-XOsTG4IyUV9+kVfzXiZ9YyXtTWz/FRmXM0ILgIyIvh6FMcF0qf0qeTr6NXfguSkInG9v1QKQGNJOuuFv
-z+68E3l2OP7441992mmnuYc/Jga1pkyHYhilMtA3rsOdy6rDAY9em0HB1OErIxOLhHyGMp/LTL9BNz36
-6KMzfSF8BdK9rehw61b4Flh0Rp77ucaycrm2G4ExNabCbM+amISmq8uRNnp3gFubWUr3l7ujaSbht+aQ
-grgoU6uAZz3TyLh4dSNinnZ24FjOAwDngCgAaWtboEzG0S77UO6Z0PQGFPWF7Dz8IhuPNkUprMazCAIz
-R5rqmy+Vge/tTLda2ArBl5aNzHLXFtP23ymEF154of9+tK4ygisFz3bH3UybPusL8auhFNxPY0TdbUq+
-THvzY489FjsAs7OKdb8CkLkw1h8xEx6H0RbGr65BWn+vzgqKyYt1AM7NDA/h30XwTQDsxgYBDmsDX2C2
-gmFPKO7fFzGCho0TTwXwbsdvuLCAWhVC8ntQxCO9uqWg2SQfwjTtZvJ+DTT9E8luQcG69/5u6o5NQwiS
-cIQiSaaTAUhTMpsJhtpJI+FLwQefDdkHcccee+zh3nWZpK3gbZVeuXcEudsI3BVsiww8kUFP7bmr8mYD
-VeZ4sdTud73ONpau8gnvU4tOt8weCiABueKKK2ZvsMEGNxE7nsSNSGVsWmE5cBmY3nPKNzEP8G/GLDeB
-1E6VooKJiX/76quvvhb+n3mGlXN7rAiB82yIfg/tuFLRsC3haSPi4hw95VqH31pVI6ljZZhoZRTDTjDN
-s9R3K8LzN9L8hfjrWN257oADDsh/bQr4VAo8HiWOU40pdC0B2UJmYQEfe/y5J5100orgcgTvq4LPLhYL
-HiLs67Bx4BNtAa470lEuiFUX7QqCL6L4RjOEnsn7DSI8YcKERdlIt6GC3Iir0o36ppm3QusRoQCSsDIB
-2xGvoJLNSEdwl/ZopELSboC2+nWhaaaBxAMw5/JYCB5fjT83gGEXo9fakbTDTgE4Sy+9QPUhvFQA2bBG
-Ne0U9iJz+EXjxhwBbabV4VDB47pvwwp4G7T/MGEPsLnkPhTCTcRdSTtMW2aZZe6iff5jeycwlk3vay/j
-JKPwBh4ZP1i+9Vo29ApYGNbsxOehY8eOfRNj36n77bff3UW83rBx4o2by8GeVUBqPd7FTRrEhC7xft/g
-7lpfOAcwHu8tjSoA8yJvngC8hzru8Lu6lBwKgLAYu8oQJJpuJQJUAGGeulzR022x5JJLqr1nb7LJJtMu
-u+yyG2DI9wIAxcUmo9BupN2UzQ9foR6vE8drL3O0LoR7SJTWFML3BHg/hNCZatCESdpFBcVfsEtkexIe
-mQk9MGZ5H+jt7PGuvD/FmvPtzB1MBbYrCPs7cbOh/xOkL9egNDlVZuIzWG1jHZQddu/JJ5+8Op3CFwD/
-wzBsLH8BT5wihC9drSqVlfh2ustzHbTBltBgVdpLmfNCGWf/xf9Z5PDcxJPw95N20ULGMrge3wlALfSr
-eeI0oQe4MmNwpx80dITBIHdQsTcEZZq6fYEDidfweEWW7zL+RZhxMpEF+p37Bl7LkcTN+XY1oITD7052
-4Bngc+7epdBWb1xuhhQCID09STYXoZoDM72oAoaBxtCuY1FMLjkdxPv5+NMxP39Kz3soVsIW7Dh8LcwW
-y1NFh8BnTERqDsbTDFC1eRRqrUTqXOCHP/zhFHjnYoaQuwHzKN6FV/65tzbfMPlO+tpOmxQraLGuD42j
-xyZ8BvJxqfi6/Rdve9rKTlnh7WIyI/t3zu25l+CazTff3P8CgKwvdbZVwYteClPDLYfTUQQWHWH91xEp
-nGiIymC0nTMPlf2a9/tgOoG3PLWRZ909LRgKIM3mzNPhfqzNFzgMugXQF61sbOJtY5VvtI8KAeGag+9K
-jUOGZWmbD3AG/eukuQCl8CsE8mcqBJ7NEVBvDAorIH3SteKCeVUulL0WZf6Uwk6GSVfRagFmGXy0cPJ+
-TysVtWteLJ+Qu/XXX99JvfWxFgU1lt6hhwpQWTkfBRmXdEAL062JfJmubmd7kXg0Mj2Hdo7JxMsvv7xb
-zx5SbokmVit7RRiTd9eiABTiRhRACDjl2MDrU8aqXP5xJ8OAuzkp6OGgnYs40+WuwA0vuuii1bnq+A7C
-chJIcDraodBSQz9UNFp+DzVewlGFJeYPYLi5xUrNaARxTRSCO9J2QiDtDGYxXLgNBppOG13pYSfnD5pE
-xLrlszErrLDCJ3g/hLpW0PyFTnK3gvECvOeVaO5t+Cffw85lhwd+76IfXA4L2WGQY/9Yr0dB/wdFfHYi
-Dmm2wzoaW1lNy6g+ffLNJZ8b9W6mrjtNvPHGG3fTIqGJspScHACOG9Eazhqn2Z5J+vRJH7sCyb8SCbfJ
-xHyfqUkHEwUDkMwxjreTrEnYhqYbTpuCciUAtB6qavekR7v4tEsqBPkgeiCtAxjyBXtjmHAcDPRmrIMd
-SXsMaa5wYs5hgjgU+X2tyyH4oXy4j3518n6DoYfC743BdjTC8CIKyNuQ7ZB+RJowgZ2HqKuCDkhkJ8sz
-5/zzz9ey2qLo7YMugg/NxX0qcnG730wSLsf7BoVF3k14je/LSVctbxTBDXTEM0xrWDVPNwWQkwPsNXZ2
-fipn+E3bSKWOETXvZY7NeKJ8tPnVlFO7MSa2OgLQphwRXsjxIOlLQljxMHAPgZOXo0iPtkenoL9tpmWo
-KRrzByhrecA/RvGev7Vps2AM1+z5rtulIJN/eTKNsefHxay3jIlCGI0C8o9D9+N2pY95LZkwGVd3JW2e
-MDtZcF0TUN8lDeCPko6FAjjbI/oFKu/Gf0vRkXST135QjXk3LlvV/L/ctE664nWjZbcCJbSJ3vGOd7hl
-8A8IrvlK4Pyow4UVQLq1Mf3fZXr2BLgk9ku1ES4ByJneLQpiDJvJwDTxaMwHoenjKoAK3r53ihNwmUY/
-Jubw3eaczMln444eyT3t9kbVzCMRhusI2BrBP8GI4Sb84mNHJ27g7tq/OOokRMzWo2y9VXu6aXSkezcd
-skeFFcZuBIsEvf/MwXIz/y3w4f+ZLDv4apZuCqBIFAJKpktokH8itLE0Uc3Uz7uXhGgFeCPQu4u0InkJ
-G1I09xzrW0dMSgHkkvQ0u5puuFkBMPpj4B0WQEGHTvbij0XAxyOq3a7vrhcp2j2SUoYKIOef5tgxEPZP
-yt0O4Z+mmcw3yYdPzy/iaTExP+ahuQ9p/lfciwos7iLmWf7uCx2oR383QSn4+TJZNbAPl1fLX4U18S9p
-2hM9X1YoiZx9HcmYwWHADY49esrYR8URBfPrb8ISxnhf+L6Zcv8gkvjlsMK5Acrf/Xe/+12MK5NI5ul0
-RwO77PJv8BOVtHw6Gi35A4um2T/tCBpQhuNfh4tBl8JCunfy5MmzCBvpUMHojiZUD8A79jcYWfg4Sm9p
-LWzwTPkaw9yL0Zets846cc0W729nGL668zK8v0xWTdyL844Ot+drrf2qlzQR3GOhKYQ0xgX22jTQy8YO
-fRVKpf4RiEk2RAje4gsz/Y9Qzq+YkfQz6hV5iYDmXxqAP25EEsn3TnU51qUBngbH58RzGLnnacNmVgFK
-IkCPpRX6QgGMKjoLVxxCGIYZvaLp4evgeTs68NvOQPCPzlbf+TboMB25uci46dOn+3d62xY0Mqgh51wC
-7nY2fU31JXnS96rrUQFkYoA5HwDu0QrAr1sjk9TGjjEISO1YbGQYgdY7B4G/FmSNj0k/0tqjCNP27Bpc
-zZcklu+d7BjjVS2ATkalCjsd1dN180I1Y76rAAohtxyHggp+nFLLNMPNR6YCJWRpT+RgRXSoVo6MH5N1
-dph8n8kyvPNlI6Dxm/E2L3RtqTyN68tRRpTr8ILnV7lkS3iP2XpUAJly6623fgDhvFTZL4DNqHp8J3XM
-9yH8uM1m4sSJD5LxFwWyYVVYLvEuV7yKevay4JwpraeSdkyTxGY8+wzvaQG0JDRthOfTMHE5hGsSrmUK
-pV8qAJSAvDEsHXwde1x+//vfO8zdpsA9aEhcLNXh3YQc/DQJAN98Ast4YegSNMrw/nzKcVXBvf9Pk/83
-pi861B75r0cFQOXCFSoDAHIN37J6LMSIHlwUQi+4CAzzsYxHK/2Y91sQ+KpVEVYAdW7LYaQ1isnAHmHL
-ctrcL+nHS84BtDnIdYP3zPjx44MP0lKsJyd0KJPBU84B+O3ZkxgK4FcVwEuJy1yd+8KQOoAH7w8xB7Y6
-k3rO/kUHiLDmzdqnbbnllo+akM1x6+JtQXo/G6VF/PsP9LyEZ4YF9OV6FbKcBwAIjyPeUIwp+irrZXFK
-gaYIZXyQGc21TeBcAN+nOfYXeYJE0KOPDhleQ9AOput0l/QDHw87dTo6wh8SCy5PsxmoaYTsjWBM/zsw
-aKJPmSqC2QWRuiKKj073XFYH5znsdVkNPHdVHnCJYwgr8nA7PfYZiSuy5iThUsiIdO5VRjN9jQ8po/hz
-mf1/Tnpbf02a8rPXwskUjazAAvjZmBPVHrssoJ8XlyLc4OGV1ntnWso6A0LMcG4hnUBLHOr6CHMBr+Z7
-TrFxIZN0pA8+T9LAwt4rrTsJMRUay1S9MlR/uLALcBxpav/X/kVoFBYA9OqviE6KH5lr7/D61sx9vQYz
-X9olL3iXg/icxF6ZwJ8lwrfz/X7pwNMoMVQoDr3vpNxrLbi/4XQCYtpaVx5qodH/wGrAMxRq+oaAEolC
-I23JZGCsCLguSZnfqyoAyvWUoP8x8DqYIQ4TFZsmUlvWwtcp30/WaP1OgbsbnLah/MjzdG506pagzg/y
-2xn4x6GRo+AN9/2HBZCWU53FtXUyOlHQGjnXDg1+39cJPQMKoN0JO5LhwD+QAw/MhYP3P4yiGE9aadKX
-fGaWqp97CS6ZNGnSbUYgQ30q6z4rSO3FUsL1lHVesVGhzwKr0PguEmgkAVsBa2C3jIcBzgH5GRKBsCwz
-Lgwh7gA04ZtMKxEzTyf64P9EYQHwOizWtp+hTRrqBGy3FGxooAXwMgUAb1TnADqxqWthLq82owM4AJN+
-NXBUHjJdHtM9jQ5xpoHMf/nXfNsxHPCzTOhHHc6dhP79nitP55u+p62/teX0qQBkWAtxKYFCz2Rp4jkq
-icmL2oL6+c7Tf9sxF7CWaT0piPcTJwNxyVAqgLkoi2URmkONgNlirdT3DnVPaQFUGr4j0aD9gyHBw90q
-2V4N40L+cTz+bbh5k8mfdMm04cLaOANyE7KFUG9C77+Hwo9LXn4RfEdjVP+DDvCcCho7sIN2RRSAEwV9
-ymYlT77OoUzfL+MWod/60l/vb5p+K0krAEAt9NKikuyxLaMep3nv9uAJJI5NEGZCl/wMpXIbZr9KJWZH
-DC9Mpe05CbWT3518YQiC46aZEBjeRaejHYJb7gJsRqkAGjLlzUGDpXhKC6AgxoNuOOtowlSAB7/Y819s
-5vks/L04CkCZye24HoAyxxlpqmPxepHOR7GWDe9XLk1Uce78U6G4Z/hUHoqPg3j9Mly/FQFUWAGYKe7j
-/zGV+PdhzVgBDgWEbIpLfQJPmd4D8F3DKTvLdGPIixDN22sO8jgkmsyrrvuF1TLb0KnY+m2INoS7BEke
-yA/eW+2pq0MAigsjYDYdy7BRANAqkMIc3wsefk+x7yXC4O24759O7jpwPz7piv9heN5hsrROy6gS3fer
-Ikl501ihuaBIWbZZXznrEqq0AjgfcC6VNHNbkDCkeb8S7/slUCuuuOIPUQL/V1gWCbT/Y+4GibdhPn3K
-tCiApgiT9QyVD71k7MRrqMAYyHqbUgA5Gw09/H8DD/tUYXrw/vvvDwXQyN6CagHt8u6QGRzn2KPDu58p
-JrrtBBRqx+kxJ8b7EQyDHxZul8ihx8eLYYJBjTiveovNdJRxvJ0lPiC8pLT7KqwuBWBhRaHOTJ5Ejx2V
-UnC3VuyrooxzgoMy9nRsZNiaa675H7Ti1xH4pyQOQVlm/iPO3lgB6xme4yrzdYqDbjJ2bHjpFJj7gbMc
-ApAu26qfLC9F09bjCqHQJI6eDn5wAjAUwEspO+9NGVEAhZz3/ZnL8pozv7VudbHrD3zPQPirF35+jt5/
-uSZ7/5hMpOzLkaFLrCQnXH3vz9WlACwkC2UIcCGfVxWN2CgDOBnoUt8iEOEwx0iWzWWFU/G+xzyDn+ni
-WijSenDkEIQ//lC004YCwO6UbqN0Shq0jU97ydQq77QAGjZTC2SWSgVgmT64+2lXFUJ++9pxLmWEHn1z
-8Jri0BaXMuY43Z7aPzk5kvjgCSyFXXnfxnmvJl0c+4XPTvI6P+UjaVlPeQlcv2ktVA1nJfgnygy4uvNX
-KogzAvT272YCsNwcRM9wHBrsb5j9mhvBDJZPmDsGPzhu3LidLaPTTEQUXsfPAVTaTiUQCqBo/2pUn+85
-jCTR4iY0P09IP/7dRZjfHaksU/AQ/iXBFk4jfQAALv9JREFU4Ut0ZgvA06WVQ1jcp4B/FL3/rfgu+61A
-koOwFMbg5zDBqHpdrPvDYpczz3BpvZmq6RoV4GgwBPJiNNZV9M42Ygprtdy+3hXwF4te4JMQzEsPtAJm
-Ue43HAehEXO2NJQB4dbzv6T1Ftlyg1JflbRLHPDGEKBd4BkAOGIIkL1dneVBhpFzvQIc39ugy57ed9p3
-luV06moPOJRr/qBxGJ3Y+kWPHvJC2IuegKX3n0rHd6q46qDFvgj/W7CqlaEcJkRcnT/xl9/Q7yeeI3D+
-oZHe3zoaUgAAHNtznbzg/cd2bviJZJ0wR7LRjo0cI/H1GQloKIdMfsH7uRCrqlg0cTwn4PbgY9hNuFgx
-0dEQ7FHrEPyg2VUAuRtyCCAY2Cph4BwC1F2wQq6jw1iCNly0q2OE+bpOrblV+rG6C2vPhMG/dFC7AN7+
-XUZfbG4z3MtVXaJ7BnyPYuUrcOVkoPNa+xVzYpG/QdSi90c2vK05Tv01s0OzYSHKSmi08wD+WgRT4GPi
-o0EEYlmQPJMxhd5rXicEQeabmDMPYUG5VprWhVsmnUDZFIS/aFoYKTdV+Nm2DjhDAbQtgHUAlgJsUto8
-LICc1a8jezl/RK+4BGUtluWpAKDPPfhxx2DyVj1ltkuanPXnoo83gdfX4NExdljAV1qxhInnz+g4Y4nO
-S3BREk78LVmkbVQB2KHYibqX5ud2yM30/tKwYQWgiWFlmOz+ndSpjtEFhrIaHbu53u/upTEwwBfo2ccK
-ELuYroGQ36bM6mlBhwIyn0k+kxuEGjRDzTvPHShWFUCjNJrn8PZVYWHxxcxWX+l6iyP/EsQtBk0iiQqA
-dr0XAXmitzztHA7s8e9GWqUMaY9io9vKKLlyDz942mmNpEO7Fdy/nrjQue0C7lspwPB5o8IvzWI1gfKv
-oZy4Q6Ayx5LV1OU3rAAsNSvjDxxPA5bf1qzh11VxkSgmBCHI+mjCckIQYh4DIc+pKTc2CEHQBUD6SEyo
-NYuJyaZwaATIVtKCi1orLaSGG7uVugcyb8Gnz8B0iUvdxae1QF4VQMwBmFkFgLuXv8AKC6CTJnjhe2U3
-NJlWKby6Wc1YPmb96bS8G+8QOsy7RBZlsZLf8IXH5M3fKE/EXgLk43myf90hBXKQ1oZVNOSaEh4Qj38R
-Ki4v/CpWwBMgZFmN9nAirykv0AcyhtrQF5B6DuY4BA15G4S13GQ6Jz0c+0wA+W+peW0ECWC+dnTAKY55
-/LWr62tHQPuBCTrb8zyLyalF05Qjb2kB2OapAPgfRa0KqmhUFpoCY6AyBbCFNfrpwjqNMPCQoUeBr3Ud
-jlXr0nk4lMVX4OnXwcdqkGb4Nnp/6Hc6SiUO/bSiOJsBIBDJSguT/YQC2QLNhjxvRHGSbxmQOsatv+Zm
-1+EMvg9ECTyHcpGSITwSza2VhG2KWfUF06IA2m4+IOkDk7vJ5V/g53hNPFRmjSpKsgy9g/alBdDMeB1a
-xCSgwk9Z8YDVfWJGGzYzjBwSouS4n5t7/GerI7FKF4QXy94cpe+BthHw7tk0+bEJJHNdnyD9Hgh/BjXq
-xz8HU65/O/9NM0PLpnt/8zetAGhA6u765x8Qdj//zWi2WLaz4EZcIdTejLouiH0t86o5qePrMgtOWK3T
-D6qc48f+xcxrOdFkwnZw0kc4+IOb62CO3dnzcBr+wzDGaJggcCC6YxRB0QbPoHgbtgBQFtGAtNkSjolp
-N4VljIoclzcB+d72TuF3FYqJvCUYun6H9pwA77vTM2XJcyx2Un8H30O1ZkWKIesGtPuXeZo1/S0m6Ih/
-9CTO+ysLuJasygTawht2Vg4Qo7g16H4yHwEhqhN3jZbnTL953CZczgdA5KOwEH5d7DlIgYm/kCbOrYPH
-kX6TwgpoCZ9GAa43/b777nslF4RORrltDWOcAM0ec2jDdzZoS41YLxwtpEu6N6UAKvUqNH6+CO4q8Ed4
-j1twmSfIOirJ2+tVXlf4//SnPy1A+x2PMtyE9qya8nEqDzl4DuE/GLm4Qwy0ajH9j4WHl8EvJwkbxC72
-EmA9/J5yTjXvQEyCD4TARMNxCukXwHSWpg+uGYaOngGiahoezi0q77QgNSjfB6IcblezElTOB6AYPFe9
-NGE/8iJFFZIa2nzt5FBO8U83e+6551Uogv1gpE1gkuOxCB5VIHjESzo2Q7d5giq0tZ5nOeGW9G+k3sCL
-3s9dcravZ0lUAA+hCOIikGaGFI0A0Gpa21D+AuaRjz322NHA/ZEaU972S3k6nPF5nsqzU/yG1i3KQjo0
-w5953Pcpyjpqww03fFI+t9NrFa8EuOlybMw0i9BuRzMT+i8YurqG30jZeS3Y0mjQb+d8AOucd1LIAWpW
-FIQETMRjQxGKYSUa5EcMB16vhm43JWBDJZ0kBkrgzzyfBMeJMNGJKLL7NY0LRaCAJX4mbyfXjAVQ7pID
-37E8gY8KAJo8SLuFBdBOSNbCotCnsLF3/4vA/SmUt8kU+tKKs/NDBs6BR4/JMki/L++TC+s202Z0v758
-wxN3BwLHT5GFi4RHPu83cx0JWlYA1iEwasj3ve991/H5fcOAOXs1P+t2MEZs+qFnfztllGunzgfw/RWQ
-ry3bo8PuJ3g9cSqBCe2oBCSAcNl4qaD22Wefv+69996fQAg2QxEchyL4J8psNAwk7ZQUGz8sLPMPpQMO
-q39GE7YROGwv3XHHHeclIGP99tHSw3/wox/96APGwz9tgaewVB0wgnpXG8BbnyTuS9ICRWb7pEA77vdm
-a8+yHJTjfoamG5D/fwmrTV+tos938pcXfaJ0jjTxQJj+WemAKAALy1lvgDwOJrkegbSBG25UsgRREQZN
-pykQfZ8ElpWBIyn/RM1mnOmifBrEXYWeMnQYcDLLg8u0qxKQmVIRqDRFhKHBX7EIPg2+H0CZHQH9ZoFL
-zBEU9AgLwrRD5WR6YHmWdo1JwGzveuHhH279q6vSAjAf5d0nPQqF2DCv1Ft3s+mSF81PT74r30ejuDzR
-qhJM2YnrvWi3e2m/j2600UZ3mV7rle9jsQqWQbk3O+6XJnl3whF0sDOFCb4ZMAsxkRDmlpwNKXDFvf9f
-QSA98GP5zTRsbPopeonDIeZEgaOOOSiGAyDoz1QwRViUT5ybinK78AnkWbRdlUDCXTSkDeoRzlF77bXX
-TSiCQ6Hje+lNvgKf3auyk47SlnwN9b5BoAH8gcReCd7QKkD2VrRZVQGM0SRGQEJYBhDEAStKegs7bi68
-tCUFn0RPvmBhAeU4/kWCHIY+QrqP0kH9UQBUaOD2DXh03ZrNQY3CF3+vR50XsMswdvwlPRstqLf0A6YA
-qhVsttlmv4GAxxQ9dTWqkffRCLva1UskT8ESWMvM3J/+b8L3gegXol1D6xheCIhrr/4H4Y6Y1cfccsst
-cYdAmtyma0Pnpio3M8UEpngwLLiV50sogIk0/ld47gafEdBCxlPhzXNFQBuEBdBC3YuAWwwBEA7/ENRD
-QHfaHu02AZi8ZJvY+QDuyfDU4vIj4JbCD3+7Mc3j8XszNr9UXHQoyf+lvSbLi9AthwldkfX/hnKhI7ib
-Ig575zvf+Sz1NHTWv56qBlQBAGjsELRi3r3l52JM2VZ6LrWrpv3qlPcjzLBVLRsl8ASE3xPiTEMJiEMK
-RAwLaCh7zY/fd99932l3S0B80mmxSMNUWCwf3sk+gi8RPxFl4I7L22C6kfY6hGkGzuuhwTPCKLzAqdev
-y23ACITLQzEEQKBUJo/xtJ0FAEyBmO3A2v0m+GdA7+Xhw6rwxwk/eNA/NNmfDu+XSQjG/QfwfpjflGUb
-1UcoM7zkyvIp41CUy01aiMBieQPqBlQBCFn2ZEzaPQ7AByOk9xQMm0LaEAKUEeN7yngrxDjlwgsvHG8B
-W2yxxb+I2x0T60aUgAKR5cdyIr2mPebeMNup7Twn0BMxCiGLoYHxDAv+yfNFlNrmKLfPw3i3gNsolEEO
-DWQMLYNBc9DasuMkYDOVICihAGhD97IrHI+DywzLanQ+oZn668lTCFlMvGJxbofSPYvOZyUFnfzZ8/s/
-lvGX5tDk8yz3/SDLJs8ehH3ToSv8Z5s0I1+2Y24j/hbK5eeWP1g0agZA4enTycD2YmouEh4iAWn07LX6
-zNtLZIzvYfqNeU685ppr3FMe/y0AY+2OkplRowQUjLmaYKTfkYb8GY3TtqsDveAcQwPiVGihDJgsvBtF
-8DVw3hJr4EAEyFnnmCOA8ZTQsCB6Ka/lYPg+FIDwUFhdCid3ASIQi8ACHgTSatGCeIAzAHEKsAv0lsFr
-qQDgKs1revE9Kex0aDuutucHVuVfBXYUndwRWSnW6TaEHe+wAFybnfSz3Lg1mLa9BJ7+iuWnYsq6BtIf
-FAUggHlisNBg35JouGbMoTKfAk2jbPvEE08c65lqI5h0vBGBmIwSuB9t7f6D0hIgWiUQE4OE/xIlEPsE
-eBeYZmGx2nnpokeCCeLQk4qV5cOZzBEcC96bgp9r0n9TiFB2KtlQBAMMoDDInLF3t5GJqNzhh/AvhnAI
-lrdDqwD+UcBYtzIZYJzK4qQp8IR5jSAfwrv3Uy5c2/MTPkqFi3Cewsa3Q7MAhpmToM3J0H8R4qrWQiap
-y6cMl7O1eGfxfqAbfvBLxVRXIQ0mGjQFALHK+QCI8lWIeRkarRXmDEahHG+WmUIDHQ6uhsWlojDYnvSK
-97PjqttwwHgIGkuEvP6KHYaxYxCBip7V+E5xKgGtK3sEGGMkiuBeFMF3YZp3oQz2hzZ/ge7SRxrYQw/0
-mLHhy0Bygg+4xgGzpPaGJ17n3u5HEebrkDiFv6DpGHp+19mPxISPCWjepaNOq8Uhl7x0hkq3GKZ5vHcd
-OrfTaIOlaxRGV876f/OYr9bDIVgXN9vOvA90G3aDaNAUgLXIsBLYSTsQ2Z9eehaEqgpoN2Dq+IjxPQpF
-s/cgevTPZZ5Jkyb9FmbauYfhgErCy0VjsxCCcg5a/j3CBkylkspyOsGvhX333Xd/mKHBt1kqmgR+n4YG
-10AfJwvzpJiSV5fJ3hv+0MqoUABp1veWthJerlnTNksr7IUl6KGPUACVtPP6NTZkKcjnn3/+Isyy2+vb
-+9vDK3Qh/MAcY37hRvBPIG6KF+MKLJOEr0WZ/QSe9kBQ0z1/Iu7cAe5Yhs5n+jJY437LTjeoCsBKJDBE
-HKVGg7iHoiWfh5gSt1nN5kGgvEPgcIT504kMw40rqGv7ysSgdQTjU7emlcuKK5LmTJTHLuZTmIQvy+gk
-X9iBt5wj2HXXXR9FERyH4G8FnT8GvlerCGBsB65KcEmPBvGM/3aEhk3fBgSNPbORqwf/QXBu87uR4YTp
-B8JBt2hveZMzJK/EMv0pfDEFvoode/JKUc+L0C7mk4D3q/DXJ12GNg6+WxVlq/C/3s6FoLQWiqwNed5x
-MZI2uwwFo2WrZZTKu6GCGk2ciDaar9H0IYRotjMg2nc1pXAyZFO9kg2kElArQ6hvI8yx7GKhNNJfCP8g
-jXIlpnDil/XE2QHqjwNENOKR1UtFKCu6OcvpIFfOEQi/FtfkyZMfZHjwQ+ikIvDQyh+hu/MnkCYUQUOT
-hZQT43bK+E8jdAGeSF6MscMCoCiVwIPsDPQE6Tx3wlIozrnwzcbQ43fQZVsED3AD4OSZWIdH8BV4l/q+
-iB8IkW8tkp4NH62Lko3hQQuIxE5CrWPr0Vou6KVSGXSXyA5qRTR4aWpDtC8hnL9jrJ49UrN1hxJwcYFe
-7nAa5aicGETR3EnD7Eg9F6KhraeqbNy84TrrAuQ7BEVyDpM4b5QphBM3T2jSLNJ95RN+ezV7OJkIa+Ah
-5gh+Cs3fg/DuBt5X479Ab2NvJU36VQSWaVoEQYXbkAJIWLkLQY0/zm9ljGcmDB9lzQszN+GQJmmR0ub7
-E34utHizQlykkSa6EEro9QSw7gU/facrOO7y34B3r6tbWz7ivWl+oew8Puzk6kE57hfGrG+w/aaBbxQw
-BQyER3FQ4imY6WOY6VfXTNg1WqQ9SVwZbu9GgxwEo5+IIogjp+4ToHF3pnHPINy0Nm40tPm0IIBjLlbC
-pjD2hUwA7SwAxLXlkeJGiCOtUxFA85FTpkx5EovgdGjxbspxA9VlhIu7qyZJl7SSulVFfH7/m/cQ2pzZ
-z4je/DTvaR8VQFgAKmxo7P0Iz5uvq1l6K2FgwsXRR5pg8a3ERPCP6fWPpQMYCy1CiI0vaouDPQj3A8C2
-Kz3/6QkF+d5H2Nnw2uqF2d+K/NgJBf9S5mcR/rOsZ14qROtrBQHzN+RANJQAZs49MMVkeoZbit6oaY1H
-mW7KmEODqQQmA5A7BpcXMJdRVlhhhcnEHY+Qx3o5wVlX4A4DaOpNIP7HWhHnnXfe4kUvUW7EsaxOdGnV
-aBHI4N69h+D9GFy24ntXLTHpUhkaKAyhJKv4QmM/veSiKQuAulQAS6FwnEj7P75PtEBh0vN9kJyCH2Np
-cJgLX3it/G/guQ/jx2Yd+aeoW7y9ysvTpXcinNvT85dn+uGNXcD/TGg1HhyaXucv6nLYJlx+fpV6jvPF
-dhJO3+eVS+TnVX3Rw9oo7KDyyqTJWAIzEVxN0hTMZmCJiRoaRiWwDeX+gsZe1YL8rwG0q2vlh9Loz1kX
-9UdjFwwYF41CeG95OQir5Fwmht5sQyhAmo0UEy3VDGDtkEc8xEd8i6HBMwwNfk4P+CHCtlYooVncN2ev
-BMy2RcmI5BWN54hrSgFAe8tc5amnnrqNavZ1aDLYzG754Bb8Js6Y/AcDgzv71obnEr/k/xdRhK7xjyRu
-Oum2wVKdhh8OXvJM/2lYkkvQmZhXnmjWSdeR0F4FdBy8+WULAtZBXe+3jp5cEqCnuEELg6FiUwubeDw9
-9XEYcDbET8Fstl65NDb+oMU3gqBn03BvsjCZH0K7a2t7GvA24sXb9NnbjYYx5xJnD7AJjP4bhgQfhXHi
-MBHpRshQ+p3spENaNwqFQwPOGvxGpQleWzERdjHC+qxDA+RB+oSgqAB4GrYAOIxlGbolyf8IZXK8Yd87
-pSVP0r4rxQD+ipvli69LdVxf/1P465u061h69xTghM2ZfnlvBO1/IjBuBa/cXIAzkp7/88S5w29B6JN5
-m4JWeMwIvV1q/AllHug35dvzDxo9rKM3l0ToLX7QwkE66pYoEHlHKjoFrbgYhGlpVtXydBDZZb87EOwp
-WBtTExEtA6KPgvgfxFcLVxvVBvIChtjrTfy5MMSR7DG41vwyljscsyGzzE71U6lVhfEHP/iBw4MpPJti
-DS3MEMnbjEegGP4Gnh+k9/67+ap5esOfMiDVyLknn3zyq2mH9VA2Mc7tLX2r4cLlGNo64Snngvbg/UB4
-YSWtQ+BRyFKRR1uj7Nwj8gDwHQafnJIw0NYLoziOAPdPUYb4V/kkkzXkWz809cLQ8+CxDzsfJk+plBsq
-aAATJzEGsMj6irKRMiUa9ywbSmGE4MLUtDaU6cgfG3/Q+qsjwOcweVNeMuoKAUpmF+o6jMcLLjTnrE94
-Ii/hsc8A5tgWxrgQZnK/wfI2lHDLaEU9ZOlcpxAXT4mPFgFCvjVYfQhG/QX+czCtFsDzKM2GhgBFG4+8
-5557Zg2m8Gd7iIt1OllH+5xD+38LQVsJAbc9bd/gd15d0RipJUjcFeT5QFX4+Zuv13Kk9yzw/ZSti+zL
-H62Y/VEMG7UU/svhub3aQfgFasgsACvX0RjQv0sZYHb/DwrgCNvKRiK6VQUV5h0CbVWn0OCf22CDDWb7
-oWNc+D4Y4RgE/fVFD1HV8jJMLNMAk5NXN/B9NMrqZ+bVDbX27oJi4H5tC2fuFSRL9d988Tbk+QyPPepu
-KId/KnCZhrC6XLWd68pQRyLL9B+Fswe94oorVkG5/w/hH6GtF0HYbMNS8H3XoRicfXfTz3EI+VcRxvJe
-QpT9dqT7JvlXLfILSaty4o2+o5n0vp76tqe+me3CO60iJnFadlWGogG+QQN9loYcMCWAkI+G8Arxn2j/
-z7C0c1UCjRJYBQXknzvsYBj1qgRUPEmbEIbCVDTuV/QIX/cQEu/CGEqKMiKdYcPAqQQcR4fmPPbYYxde
-YoklVuQAzP0HH3yw/w4sbRSsIXHQvJvgT58+fWFuK96Ddj6I3nUVrXUEvKrMg5eIH0U7jkCw76OMw+CD
-HyUClsEEoOP9AyjjFfBKzPRbV6Zp0g/hp+zb4ZEdsEBvoswhG/PX4tAqcrXlNf2dSkCfXvo7CGT8dTLE
-GghLIJiVxvfCUa9v+gINcRLARrg3B3F5yAF8H4CmXhYGqd0SKl7OFI8GLhWJPcaxKIufe0+bkWp0D74A
-/7BRBDJ/tYcVz6F0tfBA8wXHjRs3kfCDsdI201JzIhcYfdJ69H0O7RYTfbTZpaQ/FOH/U+JCp/N63o8m
-zftVHjzdlEema8J3i6+Xhd5J3t2xHqdRd9sIv/i0jQIQmBol8DUE7rMIa47BskFN2pSD+LHUhelnmafB
-DJ9zw1AWhjWwHnUeTJ3baTH0wkzuJ4hlHJSJ+9lPJ/0vUSi3ZznikZNRGdbJPnTrNjSY17jU0tOhycor
-r7wFdN+FZxsU+wIo5VqLMRQB8aMQwhH0wHfx/h3a/WTG+1ox4Zgv2AF+OALFvyppzKNrSS6oB5LFhN9o
-yvQMzBT44zrpiLP8rMf3IXUtIToYkKcSsGwm3g6BaF9D4MYgrC2tDlRgDYtCpkAzOyQ4iN7gioz3X18e
-fvhh7xQ8FFNwTepNBSStkl7BXPQYcXMLiuB2mOjXMOHJnBTLc+7DbtUgaTSvfNpGpR+z+tbJLr4xCPB2
-hH+Ez/cwqbYQApaCX7YP8cErmvu0jWePT0HwvkMP7EpGOJT9oij7z/NxAO24IMp+oEz+4A34ywm/qxmK
-7IGVeFvB1xFXgNAWXjJ0WwCTQNQogY8RfjQ98uI2Eu+tzsZaTbWRHub7WwjviVtuueWjRuroGVaE2T4J
-4+wLIy1e9DC19atMYkutVgVp7iH92bx/n17m71EQPw4PWD6UkYfN8CBxG2gfYY1hR3W5VWGFdlvxfJz4
-9RH8BRFsh2m2h0oi+ZjoriVg2k7FfTWfh6Pgf1uFk8lm9/P7L72TinS17VpN3si7fDWyUDz+gccU6na+
-oa3M/ipCSbhqWFu8V5UADbYDjf9dCLs8DT9QjRU9B4qFjmCU5r5r/W7L/F1VUAtmOYw074Nh7FGsX7pV
-hyTBiHQk2n5OJDrPcDp5Tif9X/MIqTh5SWaVuduC2EMPhJOOI6VNzugLkkd1Mf4mQdP9+FwP+sepRIQ2
-6E1Y8q+C59JezNFA8/tQDv712gmeriMuHKsEr6JtPkl5+9BWi9HmhodF2JWipd+wOoqh4y+Ae29m+734
-tG2FX2yTgC1hPliZIR5y1LVEyHDgPXx/H9PK5ZkBUwLAHgygcNODe3fBj2i8b02aNOmWxMthwSOPPDIF
-WD7JWPENDgt40pyThkFH8gYTkN//+3OIIfNdQPgl+FfRG5TDA8JK2lOuVVnef40Tf5cca+dKnI2Hbusj
-wBMhxlbQcW3pUyzb2VYlvXmPNiA+x/muWvycvN/EAvsr7+Gc5L333nudLziYTiTaD+VQW1Ymb8ZX+ei0
-Or6PYvm0Sr9dlvr6Qqhkwr4SDWVcCgqNN5feeB38UxHCtVhTVdiqzNAKmCUjFebb3dR7PIx0UnXCqNhF
-uAsV7YYiWkOmTMZMWPDJGptO4hIOyzMNTHEr+dxffjHlXlQtV8BzmMBrOeY1fBi5spfvyQLCzH8jArQl
-+G7M847FFltsSXvoopdO5dilKbsUrf+XF5OxKm7ynAftT0NR/65qRTDD/w7CDyF+W3p9lfxACj7FjvAP
-cLwI1PcjsCA/Jw90gvALcNsrAIHEBfNgJs5BCF+DAJ3GOPCdxaytzFE1xyNDkz+W5dVh0aDUM5Xna/Tc
-F1fLg1lXoZG35dkbJlzDHl9GRe5rLZMoj7yuP8cfPKK4nIF2xcDlqLNYxrpxnXXWeb5avszjd6cvK4Jf
-jOeXXXbZkZjDISFVPKHjctBwc57tCV8bOr5KhenEHnSXlvJn2baUp/DG6UXySPNn+Tyf8B+gMKb75xnG
-66ZOnbos8Z8h7uN0GEtbJq62fQxrxeU/A/0HeA5jkvFoC6POtjb7qwh3igIImJOwWAIrQPAfIKhbaI4X
-zDIQk4NJm2AUmZEew39kOQ1BP45hgct+pZOBiduFuMnAs5YrCxVGk3FL+pJOi8WLH2OcqsLAXHyK8OsJ
-P4+0V9MDzho/fvyDnmDMSohPIVCZaB0Y5XvbOWHVrHcsL3DVntjvK6+8cilOBC6HoK/J56Y87+NZCTrH
-Gj20DiUKjt1oRxoF37X8MZDPodUjfF/EcxJlXVNVLrSJs/tbEPd50ntzT0+bu4huyYVip709OvwodXiZ
-x6mWyHvHCL/wlgzqRye4JPCNN9646OzZs78MzJ9GEbgOPNDaPQQOhsvbYO+lbhXB2SiC2AWY9GJ5aizC
-vBvxOxG/vvMJMKnMkOamSZPWwTx8+y/AeUlmDiVcprqGx39Z/hvxd8Dc9/DezVFultUtPD8GSUnEBGfW
-0ZNPvd0Uk5dtIiSrYx6vgVC+mTzv4HEybwlw8HxB4F2Mx/2O3t5yjE/HPIAXnKpc/0X4b1H4pzCE8thu
-6WwDOoNNyasyfm8xGRdtkOWWiVt7sU1jRyHC/xdgP4BdoX+wSCzUhrdItwZK67n7ZKTWix+cEmCCUssy
-JNiJ7yNhtAk0CK/BOaXZOAAQBCci2LG8gwU/C4Y6h4Y/HSa8vlo+sCwNczo0cD/5JpieC9jTE+YTsBEn
-zfUS1vyOU3cyLnWNYGvr06TxWOqt+CqcGyjr70wuPch7Pa4U2GKybQS7+qK9XZKsLSDjDDeePOXtNMJa
-m76nbydL+c+GVaGNwr42CK5J3jXBaVWVotZaMWdi9m74F+UpXKEIUBhuuAolQbveSbBW0ln0tCrI0k2b
-Nm1xlO0OBOxMXe+xnsKSSOU7YDwOwFohcVIUHH8CXJ9j34cdQ9RRL51K4NvgZcCIM69xqWpblovWRGiO
-hdE2U9honIG2BkRPIXAnYZwroDe6n4Z3ffl7zBGU20pNeMEFFyxFurVkSD5dwnwd39HbV4Ys0r6qqELI
-lAoZCQGIoULR8yk47lj0ca/CLJLNwL+TdDNAdwYwPEw+8R5UJ3jgtzBwTQAnhX01wlaj7lWoeDmepXlf
-iTmahQmPuRFwDtoRJ85Ex5+MJO9lnJaWE6dpFTxF2otJew7+dUyuOW9SOib3luRjF57deN6Bso3hF3XG
-ST/hLBO3/hJtg3LxH6oeh8e++Pjjj5/gEKdTJvt6I8FAEqm3OgYtvGhkGWoOJuBiCMlhvB8Icw7GkCDx
-sGfxRNlo6rK3eYzv3/CcxBHS62sn9DCDl2GMux7xOwPvRvgrMGE1SkVA3ihLBHQ1TMtnKAPTjFGB2CNq
-HdiLFr2cN9Y68eV9fbMp4h88d/B+D8+TPE+RXkF62nfinkZgvZbd5c4X9ImXB/y77jHEjwGvhYBtMepa
-lLSLEbYY8Y6rvdNPYV+DsFcTZvgr+F4Y2EJZ8R2wVZScQZZfVXSGpdCr7MZgvUU+LBxhdhh0DuG/QYnM
-rE7smdH/hqS+bYj3lp6YdwF2zYmB2slnNaWj3Li7T/oD35+pd3/+BnyqCTpd+MWhoxWACOiqDVFsGjoK
-oXs1jCGj+dQyoNlacjIGzOAyVMzuwxxOFp6NoPwcAfgL48KXXXvNBNWriN+aip38WhvGd6OLm4vCPC7K
-FK5sl/QNC2VBmuhCqZqiRoVVoR8JsH6Mz8cw39Px7ocKw+GFqxEqBo/8LsoTgo6vIsCLrrqbbz3G+VhU
-MbTxXSd8vhMdBUQhvBoX5RjtC0on/mJLoaInVXBv4nFM/yuW8a6iZy0nQU3v3v811ljjDdS3MWV9jHxv
-sIpiOKHVIwGqtDLbQLhY4hN+6voxPHXoxIkTtfxGOqzCCg2cB6KioSpjMIg2JLj0MCT4Jibb+2USnoHc
-M1CLn0wQa/6OP4ve6CoY9DIY/Q/ETavOUmdmthq/E4aeyPebSPsm/DU1Y4WXnjeeQnCcPAihI43tVStY
-RHePVzjSVd8N8zvD0je/jy79Pt5r4TFpjzChMAJe64EW5Zie4ZPbr/0bMzfrXAfOv+9JYXIxx8qUMYlH
-wXfv/yu1LqQR30H3rBt/IF2UrclPez4KnF9i7uF4KqDamH+SWF0EG8hah6CslzhlCCofhCq99DIuiPB2
-XzT2/8A8h9CQ5WkxGnPArYECj2SK2JVGvSOYMHwQhpHRL8H/dXUnYOLuLrUHHnjAM+wrE6YyWB9/ffzx
-luGjy95WH1cd64eQEVa2JXllVNMNiKO8KIcys44o3Eqsq6jEidkYwyfcwiocCKx7AP7C+3TiFPjbsXzu
-Zlz/QC2A7gSEbpMoyn/bXQ9/LYZMMb4nXypysyUstUW08k2Vc+NKOIZC1vlH4D0IOK+0UKLKyedWKmmn
-vINBxCHHr2ZI8H4A+hINuq6A0YMMpslo7yoPhZTC5KO1CmAkmedeqr+WeP/v/UrGkQ/xXhVkwRvBn5ss
-QZ6lUAiv5XND0kzEfz2PZvpClOk4PXrxtBT0ETYF0fLKNqXOeKcMgsO99EJYJZ7XlONIlwIe+BR59Qyn
-OEc9o0M5AU749swMgyzT//1zbsKbl67heypC9Edg/NfSSy/9aO0cCWm8mcm/Dff6tg9S9gcIeg3K2wNA
-uZwa43vCS/jNN8DOOqK96PX9Q5CTwekoj4vznnTsRqQBrn9IihtMgg4JQllpdUhw7rnnjqUX2Y+4fTCz
-VyjW6BWWgdw8lFVXfRWBvWSsHBihoMBQM3guhOGv5PkHDHdfb8t7KjMmF1ckq0pgVcqKWXfyj+d7LPnH
-Ivzeub8QTwwdsucljXWVT204+YWPIsOFUuGtm0/55bdCbxldmxlHPMb7Y6T3cWPOTJ4ZhN1FnbejpG7b
-eOONnWt4mdD4D07Er0jaV5HnLaR5L/5GlL+wCkWFBp1i4xRx5X2FpBkMJ3yx+1MFBkwXg/Ph2esXfGSa
-l+ExGMDM6zKHrQKQkDBiN83N0tHaBB9E+E5MwHkhZDbsYA0Lsj2pMszlmCvI3lPhAoaHifoTCW/gcd3/
-bzD/rR4mycw9+Z6NJ/+rWA2YgL8SQhM+AuPuxMXJsxD+wsTFTD3fryiehfUJX0BLAoa355YO9txOEOo/
-RznxThnVcAX9PuJmUd8s8vtvz/9kpt7wPh1CvxLC7eTdGyhToX8rT8ziO0JQ6IWFR8G3rEFvE+pwuXWU
-Vhqdgge1vsW1Z6fkJGS1ExGg4eiGtQLIBoPhus3aMgG3A2GfhYHfKuM5tiSttJgX9OjWk6AM/PfeOD1o
-zwr/zwCOOxACTyNeB3zXsuHoLt7rcvau/PHmImzI0SJQCZQPBSxEfQuBb/jELajAEaaQ/5s6ywe5+DeK
-KL5RMs7K/xulVB6t7Q+YYiz/Nspcl3reRvo1fJjIG6uAu4yp4BNX0sNwPge9DajDvQIh+Chgcfwez3cZ
-lkl7YVD5VOc3DB6WbtCJ3U5Uq84NMO5008q+NPynEJSlNM2RhXkxLKglCfwW1oGCUG7+UTExd/AMYa7v
-u6R4J49r/DPg3TtQXjPZV/80jweJ/lO7756wQXXQcsEll1xyAYZUCwLm8sDkZiCHJ6tTsf6r+V6WZzkn
-8RR2TWzwksY6omLCZJ7xIDCp6P1jDv8bUpgu59MLQy4zXP7o6aSiccPVzTPitwsBYQJxlveCGVAEb4N5
-P0/Y1jDzSExBknSd6y/SzjPQu+QhekRh87qxUTnJVjBsKqnnSeu+Z28g+ge+cwoqhhkw9cPke558z9PL
-voB1oYIg+AX///oFhNGNQC8Q97zLk5q566677gLkcXLRPQFjiFuAcj14E9/k9Z+UR5N/EeInELc676vx
-nkK/FO+mXVB4fUgTQk9ee1RXAaS7PetQ8BwgxDbeOH9Brz8Teh4HLD/IY9nVzoHw/xo3FI3RFsSFIcol
-HYWAPxL9CGF7wfQbaJLDJMKpIIbCGCKgtQpCUeELry5m3fV90vkO/Lm7MHb9EacF4YafZ4h7BsF3449h
-EU4e/TE8rjA4E6+A+74IcYtgETkTH+/ELYpgLwx9oh7ShCM8vvVJn3HCLOxJu5cA7co26L/ADUgxuvAE
-ZizNYi1pTflvT174cptA2Pa1l5IMOnBtVME8b5g2wl1m7TY3wLh1HEyyFUyyG0zzbnuyYmhQCuEQwx8c
-DQzpC062YfjAHoqhXl8hqX0stDas+m08LmFIv6yftAlTV8p5+Avec1FEjt/DelKZY9W5H+N0nrPo8a8V
-nP92wc8mGbKGSgDaxC83EAmPR0sxXTfh9RMwzUbMEeSY0fGrNBvsGWqqaNzJ/LjajGVAxpnuZYkKoSWu
-NqpbgPGU0y2sNsMQfsccjrP6xZDpAWA9FZh/zk4+V1hepvSHENa2qLpdG3JIiGOvUL2YspjJ3gxg9uF5
-F3MEizh7DXM5pnUPwXz6QYShdgh5LB2qqJ1z4LlLoaf3P3mjjTa6K+H7bx3nJ/49+fMZuAeqqAgMxg/T
-3x6PpcMt8PeAsTZlKWtxJ+hRBDJe0jD9HkqcHzTAFEgLRt97GjR9nP/4K/45PP75x6yssxD8nJfI4Pm+
-xJtPhd4poCKonSDi7oH3Mom0E3K/LYpgiWJpKyfAUiHMp2vvZG0qBnoj113jG95pglHeDRgrDbSB16qd
-SdgvWcu/Oyvoqf0ybr7fRYH5jFoHJ8B30gke61o65HsUFoGbWybyvj3+epibcRBGExTLwPP2pm/LuYI6
-UG6nJPby9t6xnRqaxvgeEj9I2Pl8+1yH4N8r0P+Na/ni3axzCWi+64cCMJhMGOYmDOZynJNN1/kwYXgK
-zLgWgv8hhH5zwia4OYYwTdJk3qFa/wacznTQUmsqLlHFxPfyTVdkHiHMU4VnMtN/KRbArDxqreB7i/K8
-3hDVmdR9Cer5FsBLtKj7zd69p3/NdRmRuYH3w5xbY46+FatgVc1U5wsIyxWEoDmM3M6z6XXTYoASqih1
-kLbrwhNm8mOLtLsdCb+Z8D+wNHs2Pf2fI2XxU/T4mlu5VFuNnv/eDwXmK4B+CNRPdPxfgWlywjDTYxm8
-ESvAOwo3hjk3Yn/+Eu4pcIhAWDykleFl3hhf+Jr5h7mfFpVoalHF5iY3GbluL52c0CPu98T9Hnpdmjv2
-ki7QSqtK2qXyyKj5fgMU+G9huAZI0lzSFF6viqpOHHo1NibsW4l/M8y6LqWvjx9/JmJNvIcyUDHgtBJ0
-w23IkAKvHzN4Cru4Q5d4wN/di39E2K9hA9b1mPw3cvhoRlCDn5zQ85t084U+CdOiP18BtEjA3rLLsNU9
-BabDKngFvds4XleBiVUEGyEAHlFeiiGDiiKUgT0g1oMmrY/T3ySNpvKn3dusKuwh8MAcE3j27sXciNru
-KZ6ZPNN4rkQB3Ejc7Oo/NIu3Q63/tgM60GOeuXZnpnlGiMGqKJm4p7/5UkmwUWUcjL8OPd+7UAJvJ/3K
-wLIi9xUskstcCo0Wgj7xYSVYbkUpRG+aOBiX7wPpU1/0vJSfxfIaY/YMAIVR8X999vBupdYVx5y90vw+
-nlsoZxrprnrooYf+kWfvTZdu/rg+KTH4/qAwyuCD3Zk1FIIZNEcIepy08g9IEfS3IyCvI/2rSLc8zyt5
-Hw/Wr+Q0XywtqgxQGrkkFmb0vKIK8ITCUch9gDWE3V2SPI8T7zVa9/P8i3ePMt+F/xf25F/PH2l4AKmb
-I1309CrJ6vCpW6L5H4NCgfkKYFDIWlehsXst5wzMgZBkT1oW4HZkrs5WCfifessh9CqCCXyvzPNqhGdl
-v7UWeO+mCPzWpd/bO+VEOn/yPf1qWPWdYcqTfN9F2XeTdqYP367Fz0YhzGYMP5sxvGv13Rzpo7L+8O6W
-af7HoFHgpZYftCrmF9wABUIpOO71H3W5U8+18B4tBecT2AG3BJbA4vTCY6lDa2EZHv/MY3EELR7fDSM+
-vvX9Np53w70n4EnC4kHBOBkX7xmO75+YPolgP0m8cW6zfQCF9CRDkye5P+8Jwl6mvEgTG3P05/fuUqH9
-3P8DR3+iTg0rP3wAAAAASUVORK5CYIIBAAD//4FusxZmswAA
-`,
-	},
-
-	"/resources/SpartaHelmet32.png": {
-		name:    "SpartaHelmet32.png",
-		local:   "resources/SpartaHelmet32.png",
-		size:    3627,
-		modtime: 1497199857,
-		compressed: `
-H4sIAAAAAAAC/2xXezgTbvufUObYt0gqmRUmbE5LZpucRZiRHOYwDMNstskccmpOIccmCjnnVF+tSITk
-UJIcKiRGlAphCEl+l7fe3/tevd/7uu4/nvv+HJ7nuu9/nkSMpYmI4CFBAAAgcsbUEAsAAEA7KbAbAADQ
-QkNXAACeEKKBAeaMgQEIQyV7Ef0JAAAk/WKahZIhXrwr0iuhczSGv/QdWEgkx3q/zlluRuzuDyTaTK4k
-HFavc/6pscNwr/vJp3HtCm7HNnaZKSofJ5D1RkyHQoRf3LyBeLkc1kvrbaGJPmh6SJQ3awTl3K3p64s6
-idKouqqL0dc46uvzKiV5RBeNjhte2xp0uSPnNFdpQNza2h46IQmW4jU7wdwUHrwbGx/rxCLZ1A5U1Zco
-TgZ11CJnxOOXw1dyNKQEBQsE/Yxxrnub9387FKgEhJZyDkG7EyUC1Ws9w1eL5Ssul5TJ2zYz9kvpC6tF
-dQpc4gNOFJ/vBUivf86SvODZYdfx+F6Dr0rn+7o3sOsJPscUiwerNhrDeTBhW4/u6CurLK8go3WcEmLF
-Jo4LiazF2s9CXwW0LuVUOH33fqry9bLx5MbeSu+bX/chaTk+9bq61q8hWShEr7lO1kLrZtlU07x272az
-OKctBNpSDFP5RnajinRC3fwOvzVsvPCTl6fc2xob2BILAACAFFMHGgAg2r6TPFeVRA8DALsoRFt7ur3F
-WYQHmQTFe5LdCVAGiQLYCSQDwSBRSAQ6HsQg+QfQEAwU+F8IRAANsVOGgUH/gtD9UGB7CwzIgEwlgOBQ
-TagqGC0IAoGQVE8vBNbQ+Ded6umFAvvQ6RQEDBYcHAwN1oCSqd4wNW1tbZiqOkxdXYXq6aVCCwmg4xkq
-AbRjv0T+rWNIoHlQiRQ6kRwA2jnj3clBdBQY/BvzK34Z0Yle/3EKoP1+lgeZBNvpwNSgqrB/ojFIlH9m
-MfCUXyT0f1jIHSmEA5ZAI/sH7VwKrX0SCfuf4p8EAzKJQiXQaDs9+G/8f9f+xNv/k4H9PxswSBSEAZWA
-p5OptmSyP9rYP4QY4A2yIODpID0PMjUABIeqIWF/wv5QsCB7Er1CDPF0AlpdVU1LRfWkipqarao2Aq6K
-gGv9ov8X5t8jgv0xo18LAPu9AWhBJOz/twkt+JXHqhcAAL47Y6hnaw8Myib623ZJt85IN447j84KHb5z
-KSWw9R1pEHM/Slq1OkVMOdd2kd+M6aMtXFN0Qv+WjGNxgt1fX9IrMaLp4aZvK2+anuOhHfS4Qb0lqPn+
-70HKmdMpYq31Q43jY2vfN3XZrGivku3eppztiy+qy5q9hutqZFChtk5sLwscJvf6dRtjExPzIGfX9pkj
-vd6+uXY9bweyvnffnXcKr98leRHNiTVKOpAiMJZ+zCS5ru5kY3PzeMaC5J57MDFmDoFAUNMm+vqG8uOD
-Wt+WlJRUQLPNjcB/SSR1GA/PGtxcNNVwhpIzRmsw2AlomIwTa7VcQsmOLlSQiPygo+aCdQO7e3hM6SxG
-wlRUVErv3CELFOmclgyTZY523xV2UnTOvI+NGwhKLZsdCbe0srJaW1VfHO51U2Vg+CynVTjq+TagaMr7
-9kRc/ehW//SzTKXGhw93P27kE+bFM9H33WtN4ojoVzY2BZDB5A4VJX72sq2mI9OPi//CDhpLKkpis9mP
-xsbGCkKR+TLZLNa3o6corfdDlj9Cmm6o3T6tv15hU152OK4/LTNTg7ftuWpIlJJI9Y9swfjr3edrvoP1
-lGlsbP7TttjmM8szXU9mh5zSY2KONBYf8xETtoWMXpnMPc8b3TorLS09NOHEDt/gfmMqFwaLm/qbNv31
-zcHOzs7ayuqlGk4xF429dGmzmxM6ay67XoN14dz7rNtHanxDafTyCwi+Mrl0vAGb8eT2ysqer1nTB+Dq
-3KEsVFho9eYap0MpxGwwnFHEXZrqGppaYjgQCAQr/TJ5hxM5lqwvcnJyKZnyFo673wcqpxb2zEhISLTs
-e7xqa+QWunRBIjEgWDEs7ll+bAiLhIewPuVSQ5An5CIv312mShzdbC0lNiKvnyTVH0sfgj+h7B+o7O3t
-bcnOzm75vGhYBhWSEuAkssA/1FnxCoK6GMdDT69jGT2SUlsg4KT1ceyupYS7U9YWSV10lV6XcIbP8JcH
-gRrToibiG0sXPvUXl0wMlC4Uuh4Gx+2NiO2L2J4bYQeBab03tC2BFSwWS6rfZwURYzKi6+Xn6Gqk+sA5
-DV1RyRVzbQxRfqHJ+dQV6f84NTlSNvpZBMEIvDQdoxgX+bOJ511z+Ot4GReel1ICYy/BIlL3eyiXX4LF
-pa/saZukSAeuUSiifAXSqS0LmCWN8PnOlz/ijuG4elTjJt38puo8npSBszYbQkJXPy9v4ZiDF+n6cwf4
-1iOizo/1SCpa6374fPCWlqam53Ltz8+D5Vat7Fsf5HO7DGS/S1/jW5tXDYy7dOUcYibBpDmqmud9VXV/
-v4/xT/Enlstar+fGGs9/IqS5Fme4tm+Zc80qfU1DBZcfCklCS7682tw37VnkoOQ2Ah3S7TQ9szZ9b6R6
-2SODEFc4eQq3D+upvdfLws+NZFJcx8qavstmZ+nAV7X0OThXsu/LtwvdV5XkPD9V5+FjMGNlMPHk5OSm
-winWzXt2ogateRLmLCRS/YBHR6GMTukTvd15PcsfIRK4MttzsdjSpKt2tpA3Xeo/Krrz0n82aKEeWTNR
-AaSruMDOpamuvE3mUcolEeKLMflJ96xrAlvyuYbrE+jalgxnePDtQkenqTr82ohZas7k3xUQlXPOFbb9
-VWb9y3my+cDYrAubdQGra1UYPD5w8x6RMzHH8V31Lb1yNKhPIFouKLX64PwWz7It17L3o0dGruAKTyC1
-MM9hFeoy50OenuG8MW6b93I5TZyN9KlqWD4umR8bpTqhfZiwMuSisdvsQHx1UZHmecj08eyVA8Jt0R7H
-4HB4bUDXLJaf6tQMFuTrM4UeaoAS7KQe32QYbPZ/eI46AW/Qq6rA2oHcqngqnxdtuQFV7TL6b5Umwfvt
-j2silXeHLyxOPqlo8x667Qws5H4W9hRxg8yRrEE23gZm7dpZY2qa8cNnjtwnDQIvfvMvFRPJmY4y6hy8
-OMbs07z52tTSNmhaoOYdq2ElK3zyWIrxxztSMXcST2SIP4uqJsiHV5ESpAlzTT+cCjGfbst+iPvyANZz
-kRMrZNKAyxr9oI7FYo8nashKVLnfRJnvm/w2YBodD/l4qew8dgl9miKhvgJgC18/iBCk33YO0wpbGCcX
-vlvYitjufPxYr4FVtfG1FWumb1F0mYqXH/fnRbwdHS1dNRM7GMmvp+WVRkoSpms1Qbim4g+emc/o2Mtt
-YIQT4Itdte/ffvS66OQwsndtvSA+Pl6LC+5+D7+XvJF6+KS/z74fknscFl4d3AQWtj0PwatZ8fvwHoVr
-ar6Sd9QKeeTyjJfY0ZEltPpRWCEi2qn+Rk6eqOZXftdXnEuvnSFBouurP7c2JQX5Zx1aA6BHFIClvN0/
-X1edl461LECjhLQbTp9sau9LQqAmkDCFEPcXu4ZSgl7r0xcn9BSASUlJ0krDX+LdTosDFIzB362bkg/T
-FQo3E/tUY1rdnJ2d74TfjDIIWxhHKYhPXGXP85cN7kFMvi4aevOmjr3OfA6J2oeNOPcO58D1z/hYS+ag
-44F2JqcO8zODb6x3WaPP+neEoe4+U85+EeTwXMeg7jau4X1GPoU7/Uy/2fzUGrfzbBdv5FTN+qvAv6Qe
-icEot82sjQMeSXNlYR4n2pba2RdR2toOOAUHqF0ORD5mD5+MUOapTLM0M+pDTgy/EFPGymS5XF2jeZTD
-keFfE+PKlpYHwlw9xg3mqMOZR65FS9YBd73VyR9+EDgFcmy5VFSgV730LTjQf5+I9VPrKNqSW3Zfnm4k
-WnHpXvlWs5Cl7Gj0u3e4hvUvtT+PsqGR6CMwtxFYCDMiPT3dujNatZJRxlRJNoUOvalxSt2fK8eYszhR
-9JeReKni0bSBrOl7t0SJs+9R5SNJFHTH6qENbLdSY+ws48ejSc8HqtnXCnSCA+R7YAP17IT5ch+Vow+f
-/GhuNz9odSReVDojYlFIWPdvwnNWasmhazCh3hwNby6GsmfGZl1TzV9iPFPyQmKTVqdMKTAuUURAErQo
-zuewV5M2Dtw/nv32TKiRgYWFhXeMV5ty3KkRRZhf8i1+V3WXJszpmGxkCHe6helnFBQU5KTP6lPwxb2Z
-2sY9Hc55wIU9mBtlGjmu6uIh4xPzn3Ej9cOzxpiEOLD+dGSx5jX36vzoq5ZjbBfBVGF7nNujK2utW4lt
-hWEuPCOmINxD+jPz8sr4z1d6es52bDNF32zzyCXvw/IMjNhzbWm5Ci/hA4A0REHKa+2+hLbGiqixDBPg
-+I3U1Blk2rDFNDM8M4HbmqZbPst4KGezyZ2xNauRSZt1YpQAiAOuUpfVZ3m3PfgWDP6GoAKuVStc2a6K
-AjWt1ztmCIopf/DRVpWBTISpx9gjQqgFC37V1wUeb7S3uPcWbF6HPd3ftQ2Q3bYIvLEVob7zkzhjZGl4
-W98t5v8CAAD//90BJ0YrDgAA
-`,
-	},
-
-	"/resources/SpartaHelmet64.png": {
-		name:    "SpartaHelmet64.png",
-		local:   "resources/SpartaHelmet64.png",
-		size:    8422,
-		modtime: 1497199839,
-		compressed: `
-H4sIAAAAAAAC/2xydVQU3rb/DCFDDyEdQ0sOoShDd0tJdzcDDJ0DEpIiPSAhKdIh3dJS0iElMIBISUn/
-lu973++9dd/da+0/zt6fOOfsHaP5UpEQjwYPAAAQKivJaQMAAKm/CXoEAAA+OXqsAgBAf0dZWU1lWVmI
-pifcztHFFgB48i44SZ1bzpJ8MNTuzcByOHbxd2Z8QpQWmaja7+TIR9uuiJ0symfQJlH9IQXDhXEr4aGo
-Pg4LlisMFU4eVlu49KLSvD/BWP572MRp4DhivAtB1Nze6siu0gZB1VVOTiKFxYXK0yQ1ZYQYnBxmEuIX
-JSUkohYu76bNqtmMf32Sdby7e5jnomSmxlThirghmK6LjI40TnfVqZ0qbyri3PDurxXbIY8+DTpDCVHj
-4eXhOSuYmIM7yS5oPLhx+YrXaPhGYh57CNbaBJ0XspfFFpWwv+r0I6OWIRBADoBeY+GuF+qPA+j/7KVS
-+tj06/X3NLQ48Q78aJyDZr9xYOEsnC6/agsCagbedVTL8PCenomFiRq/iSReZ8UnvIw02Oebces+QZUZ
-X9sP8R7GKmxcgT/Z5x+SiiFQDk2SklqzT1LFYeOqoqlH3Tclm+0HIuM3neRrvf58XYVQ3gu4hSfhAJ+F
-M+2SXJvPPSaw1F5L26MrEgAA4LorGSIAAKK+vwlM4yaiBQAw3B1fGXgZqKvBrOGufJY2cCtbPj9Xd8Df
-EPOD+bm6u9p6WUL8XF3cEDA/ceb/QsDcELC/ZSgz5L8gXs7izAbqmhBZuKct5BnfUz5+Zgk8CAQi5mlj
-B9OWU/gX3dPGTpzZwcvLHQaF+vr68vkK8cE97aECIiIiUH5BqKAgr6eNHS/C383L0o/XDcHyj8h/68jZ
-Iqw9Hd29HOFukL9nSyu4t5c4M/O/MP/EP0Zejnb/4+SG+NezrOGu0L8dqAAfP/Q/0fxc3f8zy8/S/R+S
-xP+wxP5KwQy1bRFwF++/l5IQERaD/p/ivxNk4a7unrYIxN/es3/h/3ft3/EG/8nA4D8b+Lm6w2Q9bS29
-4J6v4HAXCQUXf0c3e4i6raUXRNoa7ukGecYnIAb9d9i/KajDbRzt/OUsvWwlBPkFnvPyC/MKCLziF4E9
-44cJifxD/1+Y/x4R9N9m9M8CQP+1ARJ4YtD/v00SeE5FieEAAF2Gspz0Kz9gZTZukG5/x+CEiO/XMRlS
-GZCxtlT+G+bKfL3KJDE5cl6K66KDwUYNLelvreeGbWIqNZ95q4N1TcCawHIfkrBGbRITQD9eddFEZT6I
-RNMwEsL0fHhl/OaefccEDsElgeWpoUdHQ+9P1+xFdpbmup7o6uuLZpj6GFr0vSGJcUkt168e12AlxXRZ
-PjxqdZMc9HhZLBYZAB2R6C4ZZkzy1Qp9ez+25xUgOvF5w93PWVdXN+XtW+G2khL2F0F/jlk3TbvubFRu
-bB45uxjHSz7cnj4G38DB/kCI/TsceaUPmkqyFTaCqOFPOK971PTEGd4aj42NCTA8Z0kiTIWmzJv4bUQG
-cqovNXtY75ozvSGjQDbU2o1lAWhUYplnzYNO+lU5ObQ6+GkSLsitHdb4U1WKp0ByS1EcKWf5Hyhomnb1
-F/Eeoah7lCDq6urLQNPhTEnmN7M0pRGR2Nzc3DocTiQcrMjS3AAu9/7H+m8M/jyL1yhoFwCDTJKys7mt
-+puxrFkiACUu3+wz5eqtGnD/2F3brhYwRQcQUZBSTJPGV8jF68SK4NMBz2fn51UXuLt9H3a/Ferzzxls
-JDHJ/lJOYS9+9cCzJ4Rvsdru/6FMp5RGMnQjkpIPicrb3tqKT22kpaEp2sDqU3AQCkdu3PEfsx/AtUNA
-J4xC7HzMlr1/BJaa2CDXiF3u4vLwW+yfpT1etVhUcf2sBLi8yXl5R/hX1Oulfz8BPulmO10SYK9dig3Y
-oE4xb3QxJJH73o+19ljAQX4uqftXQl7o72EOq2v/31/jl4lNSLbBI9IN54XkKSJm6eA+AxZ/YvcjnU7M
-76KpikMd/lUFwV6WlpaUhpQyadtb5OTkBoqJdI5FR11xcXFHeoCLCMi+UG/xL35vFYAaxN6bqc+0xXNb
-JHP3tqCgQIiIUYIH4SJ3o4WSiIdj2gGN/LPrwsUS798ZyDJEcHse5XcSmiwP5yUR4z8/wN5i/mnE+bKH
-nGuynR7uVlV4T3rAPXyDFMn2FAzfZdK3yFXg4cjKyChtmZ+SjPxTI8/X6jjN3tTaakSxaAmrAkSMXlbq
-luuZfmUlzj8HJWcwRpNEvIb8dMjDlq7WSfxwx2Whglzs/6VKssA12o4uiOPT7vhy9srb+0XoQs1vd7dD
-w8xZg+OE6J6q5oz0EewZc9tcrpEj770pttHvnoNP58DST3AyAwVPbyO124F2GPA2hM8TpAv65JJxI84X
-PyX9ydPU/au7I8tDy0xyLUmpZabr7Wxi8IzZb33cvHAnvQ6ttLS5pZERL/unDYffFPFpAi87I5AeZOHN
-AGk6q9y8cHM9H1EZqv3+aCq+zOWFJxp1VdRCdqMY4hCQoUFb+mQVoA6leFo/uXsZ+T7GWmynYMUuaU3l
-HoS233x9R8Qg1hwvciSDWe3syfZr0tBjXcoBHtwRfF4gj1JP2EOsrHPjH0ETj8QM3qsVF/CQGXFlkamr
-n3vjEDP8Gd98+5yKdbWLMh1a7fllg5ptpTXBqorGoSWlCqHZt2KWH6+Flnmb6kUr06yfzo9Aq7WJWzZx
-ApD0oYCsB9zkvJgttjH2W+jg6fk+6tbEZL2qtRWG2vFWe2L++L146P3t+nEWTXljFZ+444lVvZi4/Lrk
-TOGt2vOteIItNBHRtsIN7J0SnIPuEL219SLs+0eyeAWdMIezN2R4B5E9voYa5qrYr505JH4P2W3oBVhu
-j3CbDqLiBk61Rp4+bc8WYewnbP3xSAAruVw3shlBP+InN3KWcUBBkHcPVwUIY9JdiWd1vVNmkMqK8s8c
-E/HanVSICs0IUqw262A/XPrsDHS+b/DYHBhmHtX77DNXcVbSH7fyM9216llpRYHk2a0o9/22mp9d4ubW
-k08lpvMq73RL9mowpb/GRFS6W1JylxrHpC13tD0esjb/RleN0Cmzn3439D3mdKfa+uNnz72pw5fSPTIO
-VDiExPyX00+hvWzX1zLHwvpkJL2CrpmJPoobIgqAe6NY9Oez9jeMy/xUr69MMteQ/itqN+JsbGw7B2td
-D4+SJh4zhzq3LjTSN4YrbJ4GnNO7LB6OVW2/HPmcQzqWjlws2u+td76dYpGK9NFN/1qlGaLwRwUbUVjm
-kLfqk3F/V3DF4x9NS0PaAAVd7goEf4eGfNielVH2ZYrYA0ljNFM6+9Gp7iq6x9cKcrI/sXs/8bNmeEd9
-83ZGBdCZatFJYDUnEAIoYcT8EHRvuntNfCWqLYHDqQHYy4sYYuQgozzt4uX+6owXFbpudFH5wqYzk93Z
-NN1iYd6QKE0P0TTzS4MW6D7t8jalzKu5uabW4hjCbWSURdmfmti3mf3r/XvOjImcm0/o6FXH4uJi4sPl
-Jjju2QpOjmrtd9LM5w2y++XBNxf04u7r3ehfCyW1dXIp+RPjgu/MC0+AcvMK8nqZkgST539mQ70Pls7p
-fm72tyfpktk+q6Ixsbr/9vs3SULPELf/y/Wu8MYcRtXSFH44DwaddF1jaCWq9JuLsQ0wRA9z9JEn88sf
-Fqar7y6d6Kni6DdctMgTiJSfC+d+sCwAGqG7bxtyxQNDCDPhSOs6YbVYbP41te+w0JuLg9nw+Xup9p+G
-yliooteExMovPTOsL9aYJ6XDHrvufe78mq+auV630uarwb4gnhIMKovy9wwwq/5dzT59keIg3iSRo1Kg
-oZ6K+vJl9qqXLIqeqPT3yz37xUQy7CZdyrg7q17rt+KscsXZwsPeNZ43TA5HiaKvNolg2mvDTs/wgVia
-mVIPZKqGsTX3Ek4zT77ZEHkMYEhZNIB0rrxuX+2Luo4ZhDGXBbWXBV4erq3V20+4ZJp/xP2cKFTGXV8d
-ddXSwdUpE+zY14j4FkCHfhYTEdCLG5QeN1DYETCAGGnF+R0u3cbEEEqKDmUt0jD1kM//1Apr8Tux+oLT
-MBZxJfnlT3Bz7wMJTq70jXS4JYurdITVhcCAleFexJdb62HBCM+F5nQ2+JOSPAvZwjddZHJdOG4MwCPX
-l+ZNjkkVxVlj8s+W0hYnqj++IDRiJJYVUAWOGlv25LwiPY2N3HAXXGpizqGMidinpV2LL5nWifRdTeVk
-CvWfjS0GlPo0BD/2UDXeXdPMSWBzNoyfyo0qFjAYnscq/UqblhikVNpmnxfciH/OqaR8UFUBCDlM1yDB
-ivPLVfMJI76RMD9AacEc7zQ6N8lj/QeitkU5srdE+RR/OEzUod//KGhnqTVAjHiUJNoiItBfdHYm4N6n
-rAwNpOqZ/BtgWY56lZzE3+NRaMMnult9/WR77HfTPTEdiZtgcXWp/NNsrHadmPe4VEQstefdB1b23pgh
-T/U7WSyZXIVOnpRPGUx8N9vOiKYL/PWOooea1yE8RWUqZGQ6RL4lhbY3P3mP2ty4wSHY29YWPJa9efYN
-wWPe+R3LGNvBJ5a7Jnyvuz3q8a8keWtqvrD2ZAN31MAIXvxReXhDZ9UKGakQJWVqnNQxoanK5KVQj1/v
-VICdvqGzGhh4SKNmvoLGkCN+iC1i4y9VvBzonZpyCJaVVW9vOWHYoNc2WqoK+/CtoULK3eBQQ/Nj+B+H
-P36vMjiLml9W/lB9bBj2jZlUJG7gtGFFXpPJCjkXK95tohwCWntQWrrMGEaZuQc2/0LcJfVnNIynxJOf
-uU8pdzISsHvenMWwLkAXy2YIHIO9vT83HEHLXF8WBso4NC3mu7Iiv+JVAY8JfyyGVIgUbJMUJ5jVWsmb
-M/FjAHa1RyVkqO1xybvxrhUgBOKSEKmLKS61nyJBzZZzhEXRsHTjTwc8ZdbAIRWDXsGWficjsN2Dviia
-kYvPhY+Hm5Aja7OcL/RHTBdeEWm/3evGc4KOqqair3iHbNe+heq+ZOV9xGFmwE6c3mbNTXrlOM9Uyluv
-znTOhxwg2Rsc2uNSdYwtufOyvdmPkVsLefjSNYqztRjHKD2UnMd7zoWFplSsZgq6XE0sWSutjBiVm43U
-A4O9V065S4/PRabo9QHhpm0+lWGy+1DSrxIFyhJdFCERy1r8EWsqSzzR2d9DUmW9199FKGXvqlnj8rhe
-kDklXX3tOqtoWP4k0KvU5sMU7fosmItuNmigZW9bsDpyA1RlqO1iaLvC02as32jwxybZb0lK0g5X3m6H
-Qx4rrrXZNOAChGA5gxlTIXp50K84Z3KUhexGkRrzdLtI+3IrXe2HTKExt0ziJ1Xvovad3q58EiewUwVL
-ZWVurLM8w+oAEiSlzoGmzDnP3udMdnwcd3OyNOP7BtK50voqcffKDQPHyM2Q+OsPm9IDXqfPv6iWXvhN
-1vkeNlRUfk5v8zWPqwHbvfQ/sogo5l6drDHyxfgd7OpnpDETQphrIuzNrCZjjGve9OHOTaxLmv9XGT75
-lNR36xof5VKnHDkuA2O8wIbV8Kt3SvuYt1v00nW4TsWWZJgv9gtvXsNGkg2a/MAIQde64AEwnXPRtt6r
-7qf71TbFaoIssao85e29nhNUA4fFq3VNXfCWatcajMDLxZZ++T1WCv8/qToD40ZNVfqzGV3t+PgEyaK8
-ESMjKkKEBA+Y6G9RxfVf9kE7VkrqTKmAcCqQrV5oXXIDVOIC82nmtXHIzDywV4rubsLHp2/DWFtVlO6Y
-1OWhbCEjvva7VuECh9WF4JEdiu6H4GpypFxvStuWHK/vAiWvczfk62r9shU3vYNK27CS/1VS71j25/Q1
-CPoD4RTsUiX+oBblsDX07mbxzrW4QN/zq5eilCCtePrdvWcY0Knoh6YTpXxqXQDmbUVYX0ZirpemuipB
-DeacqNvUCqM7RvGeTfI0NDTD3K5lJ7ilHzur5Vuy7pPrprT2bvsKhZBhvNSfyxOwKZki+pKGxZhxlNDO
-quN0SYcqJN3QdwSvLu0R9KjnC3VqNDlIubfwbhwgMP+6uKc9vODAuq6iAPmiJkH7JkRrbQSDi7q8c9/n
-l9/a55znz7pVurt3VTIlu9hqivOFHAJmlj2SIQ24qqWmo5M/HpUMv2S/lMqIK6OQ/trPgHE1+c1nht2N
-51HImHdnuufJFL3YUj9DgQ3XBWXjivpXiw9YUoXg/o/R52qczjWNLoueoxFPm8uNsWxbXq5T45ct2b8/
-XNDVrZp9A9yrG5Qe4j2RdoBlgLECdVsieXTKtTrSedC0nFj8xdlb1ruOOC1xtFQG7rNAMmDeT3WGUFKp
-qma+EkxKYQyHfIEie88OcH29tofaD4kRA3kL+4PkgSr5QBlrvoYVzReu6cIC35YcEqnX9DBlmrwxIhzp
-TS+lvyhOsoQRmDW7W2oZW8OcTvWhx7EZYLeqNkwWQIv4hPwPIz2IIyhj/A8vsWZ+1MUUqwW1vbm9IaMR
-2QAU95xz8OgEKvBg+GwtgJTeoAhgZXkt+/a9oF7xIca06uTc+mC1tH0U5THK/kSN6M2VrBmAvETsp8vB
-GEO+FGFQUn5BJSuZkN0mkchdx6u4doOHxIQpNS5nrWNtvVv+DM5UV3ZDiHLW0gHQIVpvV9y39TgDjp4v
-f24mXiQXbdadlam4zITdo8HqobHRMrMZYOfJgpR+/Yha58hBj4NimZY9vB6tIHZgP4CcqDD1wl50x0Zx
-S2XXwtw9493ZyLE5JLOvOi7edgAqMYOpryJIm1uTNo9tsLrra/1GHkXu3TV/B8ADDdQKQd9o5zuzxjIK
-cpdVY7yuE76cn8Mn1zDvR8Qsw3uP+bqcLUrLqkUXMI2xRykhiP4dJapHvPyCyoUgesMwSZkOJK1DXOCQ
-3XHW+VeA7w9Ga2nX7NnYOqgs0VTJVevQA2fxFkx+2RWM4NsvLNJRyVmJ+5ytnc2kOq/OUBevN1tVJJEG
-2jM9JCi3MGm8sX4aTCvBPe5XRkbQPSrNTvtW+Fz4EpG6MtQaMB/EfmoyigDaMhwHsHXmnFiZRX+4At+l
-/xxFndjPiiplrU0SVEmvr0h3xPGQ3F7vMkst38Q2fmYgdSV+7UIsP6T321HCc2KesD7E2KdsO6Y1nYb+
-fUh3eZ0qygFMViupovJz2+txHeqKo1gyr7s+JXgt+HIP/x1dRMn8XjS8/UTBdIdvCGOEP212Dl3YhfXz
-E7ECDqABCxZQQGd6lqXKuNSEfdWEzRwpl1c/9LTInz4a+qOgSHJwwyhUhERTNCbFyRsJqiQzeI8/stPD
-Bhwqms58aslcMja6dnsy6rzK+EnCdoQ8WHR9Neh0bCmSK7TAh83U5/ru1dtaGcyZAck8u5fNspat4BJz
-nArt/NHHSeAnTSqAaut7Rf6xIA0wC7Il73XS7bFYrrMoCc6ZgIz2oJ06YJzminp4puPP74MWxI7wOt7+
-3gNHivN8mZ+1xwdHAYmh4GB2tAN3uTWvOy4WZP+NH8ZU0ibbcil1rkJgQ1BfPvQNxYlCC9n37NcmyPbB
-uwd2tSMX5cinqd8jNBsehabscMBiMWncvcJ7LouDB7iXEC3ZMxuFUMSt9rxbA1y+3sjcloR296HU/pCU
-YSU2m5fNnVL89vjLvLtXB+7CHYjrFH29638H419kVKu1rRVGQonZLqM9KE2EtyVrDjsGbFDHcK8REzIv
-tJZkzi1Hw7NE/JRVmV/VV/es9GnxK4MRdVxhwy6LSxzT/HlYpelfgXQ9XYn+4yVw3t5FpDcPK7FNBXe9
-vrIpPzdb/HAKR/3VL5Pe5FGFcMpSlkxFStFQ9jx8uEy+TnHqcSzXo6QR8xaY9sp7M8Ak6soWm2DUvg4W
-lDd1ekK66ZX4gsdOuXPtU0F+9sAUKYglLO04ay0GzBLlzuH/+M6EueY5lE+vhuxdCnIlPE4pbvC7Wlll
-5Va25GYpSWBDteYxPfepfScYwRhVrUvj/EROZ9+vKgxsDNecHGoBRKFMzANWxqO1/Ftpj4NNMJdV7knI
-B2ioKVnwRymRknIIpzJdYT2cdwGFLGOZ6zgMlVyeOAfro3qp9saMIh87CvvUNTX5VBcpGaoeXSj/Ke8m
-vVY9XrJGnm7XWg928oqhx8Cje69LbQk0jWWeyxVVTzd2B3J7xdFcXx7BAWfftaxkgnk+IDlqCrGTqLlK
-PgLcTCIpJRO7FgXiZT4K2wUIVaoaflLIR524itngdDH6n0J7MKouBkYe285qOFesMtmsEgDPjBtqskHU
-G+qxfPLndULyy0KQ1mzjNLwOpWNzsQYB/xJcsrk6u8yTzNGzzyf5DqV2hsiYXiwB+sDzT1rV+xd+1qxj
-PpGcVDxPWQ/ImsYH1qpff8P50EHHlovL6HGAqMFw/RgPcHJ2nkaPgclw+GOId/3iAWwm10s6JZ444agE
-1Y8HIXcpYOTRJOkCCNjbky6E/WK0tIom8H3qUB2gXCNP4mp9clHua0Z/+RXRLPRyhkGuJj6b+Et0ZqjA
-VfQvFfnoZBM3vnbhJ5MqBlEkzO0DexFnkCOgLwtWMA7VzuxvQ4JrUpDb0bfCQT9hhWmspihjZnbHDEhj
-9RJWRPvHct7SuRufzxy9SibQRgNkWd8m7ME5l/6Tb2oNxeBbyckXcUjxeI9rPydS1SBsI7jMTcDmOTtU
-nRKXMhC/XksAI4mVVdoqbO4+T3kztXHOZUqN4fnRSpsvNU4KjnnAXvHpYzBJFq4VxjFZBBU75/YXSUn3
-9W4FE06ldZKKZhcd8DplrB2PVJtUChXHQ8Y5Ff7x8lu+sNpaotNVjOc5RUdU+d7Cmy5nLxIyWH6wMXMD
-fGAUdDr5RWN9qHhOMkvCk3acINDA/PpzJgwFTKNXEa2UP+xIaBstZfYzd1+haGXw45A8BYJGdnvXZAc7
-AidpLp/M0lRtcWUC4ZKTMVIfHDDmMmu41me5KwPQz3wx+h5jVktIKp9e02lUD3woI8W+I0HP89vh8l/q
-4D2NYtuXrV476TyGOn3cOg1IU6EGKjHn2pCnullG+1EVnLpHKoBDGQbsJ+9dBxwSqk8ikaJDfUJ+Ea9w
-fxBYgi9FO5c+2ji2Y/R0htvxYrO4O8Zj5PEpPpVTXI3HBnezIsXnzQIPpL2v2avs2Qx5jG1dC8mwOt45
-BD5j3ceh8A1HCOP9ih3Ohva/UV52bJPdDGI4r5iJdUc1AhaYO3ELjBvEpOXIx/YRKFVk3qOCas0Bx7Wt
-NswJ1PyUzKcIwGindPjk6fm8+cPTvkQZMJmHsqMyxYddmnDDMbLN32zWwNGVBInC4IGmpfuR31uNWZTy
-ajaLZvhmwf0+z652Jq/lrQxZeoXzxsOduZbW2Fb7WPyEhiMu1RJwHvqZq2pAW18m7yuVhJHkKZC5QR2M
-LixpscTACpbd72+60X9Ui9IpSDggXamNr6ItPkN1BrwGN2mW1t5aEsX5vRthJ7o1whhjH1peosEvHsMY
-B6msgX6cTl/JuNBj3DGY+spuOtyapZOnLVti+UqtcX1Lcr3uLfSKByT5GHUz575NdHvdox20qxKRBPAg
-Nb+lsMVN1lvOsbW1JZYZ6uf3JZNZ4CxKbmgsvN8p64RYcJ1mYxbU3J5AYb+6bwlyhRQtNgAPnk+bZSda
-syjVO5ELqJ+nGdGoG9U/t7LrwaTcWV84gr5opWYSq3406Kle35jvZaIMH1CL187W9gE4O57c36ALki2a
-sZjioz1Qpsn2rumNUluV4N/+egmBNgNFjKeOI8H8LkQUTuANmKINvlz48esMC3BA0sTyNRl3owrw/vvP
-ry9N3FILZkVFIMHEY05NImkt3oG3AALfjMVpivPMvGh09byf+YfF5tsdH2wIIA+sAbomulRh14qYs7e/
-/q1iMiEFqpbu48XKPTnbn55ZS6JcVxjkseyUimCkBAUd9+BcK3GXwPy8lQPVGkIPW3Ye8Q8Snq3IAQi/
-3FojoaxvFSFN3DVTdhLJ7FQ/TU5og8z4mN63vhNc0rrbnfkAwPqFLz3RFxEIWddzzE7RxZziuM80d76l
-xwhlezSUwvSDubINtG34MzT/SLdBi5suR7n3tTEhs18C1r0KFV2ZuNiBhtBlCn9R9VzImy5ibbWClVs9
-qVLx69A3iw/ap5OrTKfhIlUArFReLFBb7PBRPw1eDNBOfJCmN+t9+/tOye5mvRh8qmvd0c8mweBTvivX
-NP4LCf5oTn/irKlAWM41Hsmr0GscIbcnvQeKXW7FjZNNBFSmTl7Y6OUKhkI5SpWJ+9mR6KcryPyM8mqW
-ev2uPBgbAGjP0kcaJTPjAipLtoNoh3HIuUyu5IUINuVeI/iPKQh+yveBQZJxjcCt3PgE7flPUrMOKnPM
-TJw+DnuBGZ0PxeSnXsUTRUek6OlBunSoXwvmQeuWCV5FCKQ2pdVw6Vli42bJS3ypTJBmYjorlGY/OlmH
-yQ3WuaYqKDWtADaIYMIwnzEzKq1OAJeYh++lpubP3AzyW4/5ZLWOA2E2e1J9imF6uBOlOomQvEchq6ml
-RR7WHga6VPjvqlWlJKX3Q7HGDT6lw5YSYy5+wrmD2YF/QHWmGExnUQauSWeyZWb8bDLyyKouGTwVZr10
-mUkyvGDkhwxyvCDCVLygFrF90oKInSoHvQwstH4pKQ+/J2eIom3nCdl9ZYN4ePfOaW1hS+JECa5YzksO
-VoAl5Qb55Cyppbh0UPRFTMNbKWf0oSlBvri8JS52Jwb62pQPwh72HA3tUVZCjnilYduH8HIZNPDiv3Pm
-fYRWDuFxbByyMsl+XlGvwz7xR28vAnvd+iTjWWhKr1HCFMuO7FZx93viE9mPlEO+aX1Fgx+pnsthbipR
-hWuVFXXHwOe/FcPTzaQnQ20NV6QcB954HPDeqqdTe2E8vDQC3L9nBj1Mu3HXo39evMlBdb64wTjIw9HT
-uicfOZz5PMvLvs9ftJDPqHW44U/rOnIE6Xs+V21unl250ogGXkRMP2A+n5sr1hLJUwMAAABl+ZdyVTIW
-4f8vAAD//19v/2DmIAAA
+H4sIAAAAAAAC/7RUwZLiNhQ8r76iaw/JboowSQ45zJ6ELUBVHotI8rAcPbYApYxFSWKm+PuUBMPM7qZy
+ywkVeq9fd7+W9d4Eg60bY8CL8QadN200PZ7OiHuDmd2Zwb3gJyzdcDABW3cae39GOJrObm3XDsMZW+dT
+NVk4HL3723RxCmUM9jEew/3d3dPgdtOdG9pxN3V+d7dzv15GpsbexNYOYUqI3pszWm8w2M6MwfQ4jb3J
+0AjtwcAdzYjgTr671aAN+d6bEOG2+bxw5Erj54DgtvGl9eaekMIdz97u9hGfus/447ff//xRHx+76RR0
+GJArQwI2/tn0U0JKG6K3T6do3XiZZUP2DjZg556NH9+c27phcC923L0ynYJvcXYn9A6ji6TdeWMQ3QXm
+WjSBHbvh1Ke+hNLb0A2tPRg/ufahf2Vh4DwOrrfbM7lRmRIiTf+eaDv2OAUDe7Mu/fNkx9bnzR3CBC82
+7hNa+nWnSDKq7doEMMkrORp/sDEl4+jds+1Nj7hv43dSOzf2NjWF3HQw8Z6QD7/gW0ohmXfl0rne4HAK
+ET7lYMyA7ZN7Tlev6xpdtJ2ZkA94Neuy7Pfjxv47Lm/WTf+Vgx3fm/DK4ehdf+rM/0EDV3W9604HM8bs
+bgJrx/7Oebi4Nx6HNhpv2yG8GZ23c03DTcBFVG1s7kq3Y34iWyyc2w0mJxmje7vLttsYEt8LjPMBh/aM
+J5NonNKLiw5m7J0POV1H7w4uGlxciQG98fbZ9Nh6d7iY8Pq+btn5gNvHAUdvU6p8Ss54yVAIF/IlV0VF
++QOT99BLrqDEXK+pZOAKKykeeclKzDbQS4ZCrDaSL5YaS1GVTCrQukQhai35rNFCKvKRKnD1MV/QegP2
+dSWZUhAS/GFVcVZiTaWkteZMTcDrompKXi8mmDUatdCo+APXrIQWE5KG/tgGMccDk8WS1prOeMX1Js+b
+c12nWXMhQbGiUvOiqajEqpEroRhJsm6Cyyl4jVqAPbJaQy1pVX2nUqxrJhP19xIxY6g4nVWM5EH1BiWX
+rNBJzdup4CWrNa0mUCtW8HRgX9nDqqJyM7liKvZXw2rNaUVK+kAXTOHTfzuSVlI0kj0kymIO1cyU5rrR
+DAshyuQzUUw+8oKpL6iEymY1ik1QUk3z4JUUc67Vl3SeNYpnz3itmZTNSnNRf8ZSrNkjk6SgjWJlNlfU
+WapeMiE3CbTiV+8nWC+ZXjKZ/MxO0WSB0pIX+l0ZERJaSP1OI2q2qPiC1QVLbERCWXPFPoNKrlIBv4xd
+0w1EkyWnFTWKETH/NrCTvEjwOWj5yBPta/FKKMWvMcmWFUtc7J6SfwYAP1HN8HkHAAA=
 `,
 	},
 
@@ -2115,16 +3084,24 @@ uicfOZz5PMvLvs9ftJDPqHW44U/rOnIE6Xs+V21unl250ogGXkRMP2A+n5sr1hLJUwMAAABl+ZdyVTIW
 		local: `./resources`,
 		isDir: true,
 	},
+
+	"/resources/fonts": {
+		name:  "fonts",
+		local: `resources/fonts`,
+		isDir: true,
+	},
 }
 
 var _escDirs = map[string][]os.FileInfo{
 
 	"./resources": {
 		_escData["/resources/SpartaHelmet.png"],
-		_escData["/resources/SpartaHelmet128.png"],
-		_escData["/resources/SpartaHelmet16.png"],
-		_escData["/resources/SpartaHelmet256.png"],
-		_escData["/resources/SpartaHelmet32.png"],
-		_escData["/resources/SpartaHelmet64.png"],
+		_escData["/resources/fonts"],
+	},
+
+	"resources/fonts": {
+		_escData["/resources/fonts/Go-Bold.ttf"],
+		_escData["/resources/fonts/Go-Regular.ttf"],
+		_escData["/resources/fonts/README"],
 	},
 }
@@ -0,0 +1,490 @@
+package main
+
+import (
+	"fmt"
+	"image"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mweagle/SpartaImager/transforms"
+	gocf "github.com/mweagle/go-cloudformation"
+)
+
+// envPrefix is the prefix shared by every SpartaImager configuration
+// variable. Variables set at provision time are forwarded to the
+// transform lambda.
+const envPrefix = "SPARTA_IMAGER_"
+
+const (
+	envOutputFormat   = "SPARTA_IMAGER_FORMAT"
+	envJPEGQuality    = "SPARTA_IMAGER_JPEG_QUALITY"
+	envPNGCompression = "SPARTA_IMAGER_PNG_COMPRESSION"
+	envPlacement      = "SPARTA_IMAGER_PLACEMENT"
+	envAnchor         = "SPARTA_IMAGER_ANCHOR"
+	envAnchors        = "SPARTA_IMAGER_ANCHORS"
+	envMargin         = "SPARTA_IMAGER_MARGIN"
+	envTileSpacing    = "SPARTA_IMAGER_TILE_SPACING"
+	envOpacity        = "SPARTA_IMAGER_OPACITY"
+	envBlendMode      = "SPARTA_IMAGER_BLEND_MODE"
+	envContrast       = "SPARTA_IMAGER_CONTRAST"
+	envWatermarkSize  = "SPARTA_IMAGER_WATERMARK_SIZE"
+	envWatermarkEdge  = "SPARTA_IMAGER_WATERMARK_EDGE"
+	envWatermarkMin   = "SPARTA_IMAGER_WATERMARK_MIN"
+	envWatermarkMax   = "SPARTA_IMAGER_WATERMARK_MAX"
+	envWatermarkSrc   = "SPARTA_IMAGER_WATERMARK_SOURCE"
+	envWatermarkName  = "SPARTA_IMAGER_WATERMARK_NAME"
+	envWatermarkTTL   = "SPARTA_IMAGER_WATERMARK_REFRESH"
+	envText           = "SPARTA_IMAGER_TEXT"
+	envTextFont       = "SPARTA_IMAGER_TEXT_FONT"
+	envTextSize       = "SPARTA_IMAGER_TEXT_SIZE"
+	envTextColor      = "SPARTA_IMAGER_TEXT_COLOR"
+	envTextShadow     = "SPARTA_IMAGER_TEXT_SHADOW"
+	envTextOutline    = "SPARTA_IMAGER_TEXT_OUTLINE"
+	envTextRotation   = "SPARTA_IMAGER_TEXT_ROTATION"
+	envMetadata       = "SPARTA_IMAGER_METADATA"
+	envMetadataAllow  = "SPARTA_IMAGER_METADATA_ALLOW"
+	envMaxWidth       = "SPARTA_IMAGER_MAX_WIDTH"
+	envMaxHeight      = "SPARTA_IMAGER_MAX_HEIGHT"
+	envMaxPixels      = "SPARTA_IMAGER_MAX_PIXELS"
+	envMaxBytes       = "SPARTA_IMAGER_MAX_BYTES"
+	envMaxFrames      = "SPARTA_IMAGER_MAX_FRAMES"
+	envMaxTotalPixels = "SPARTA_IMAGER_MAX_TOTAL_PIXELS"
+	envMemorySize     = "SPARTA_IMAGER_MEMORY_SIZE"
+	envForce          = "SPARTA_IMAGER_FORCE"
+)
+
+// forceMetadataKey is the S3 user metadata key (x-amz-meta-force) that
+// reprocesses a single upload whose derivatives are up to date
+const forceMetadataKey = "force"
+
+// userMetadata returns the S3 user metadata value for name. S3 returns
+// the keys with whatever case they were written with, so they're compared
+// case insensitively.
+func userMetadata(metadata map[string]*string, name string) (string, bool) {
+	for eachKey, eachValue := range metadata {
+		if strings.EqualFold(eachKey, name) && eachValue != nil {
+			return *eachValue, true
+		}
+	}
+	return "", false
+}
+
+// imagerOptions is the deployment configuration shared by every derivative
+type imagerOptions struct {
+	Encode transforms.EncodeOptions
+	Stamp  transforms.StampOptions
+	Scale  transforms.WatermarkScale
+	// Watermark is the name of the master image loaded from the
+	// WatermarkSource. Every derivative shares the WatermarkProvider cache.
+	Watermark         string
+	WatermarkSource   *watermarkSource
+	WatermarkProvider transforms.WatermarkProvider
+	// Text replaces the helmet with a text watermark iff non-nil
+	Text     *transforms.TextOptions
+	Metadata transforms.MetadataPolicy
+	// Limits reject uploads that would exhaust the lambda's memory
+	Limits transforms.DecodeLimits
+	// Output routes derivatives away from the uploads
+	Output outputRoute
+	// Force reprocesses uploads whose derivatives are up to date
+	Force bool
+}
+
+func envValue(keyName string, defaultValue string) string {
+	value := os.Getenv(keyName)
+	if "" == value {
+		value = defaultValue
+	}
+	return value
+}
+
+// imagerEnvironment returns the SPARTA_IMAGER_* variables defined at
+// provision time s.t. the deployed function uses the same configuration
+func imagerEnvironment() map[string]*gocf.StringExpr {
+	environment := make(map[string]*gocf.StringExpr)
+	for _, eachVariable := range os.Environ() {
+		parts := strings.SplitN(eachVariable, "=", 2)
+		if len(parts) == 2 && strings.HasPrefix(parts[0], envPrefix) {
+			environment[parts[0]] = gocf.String(parts[1])
+		}
+	}
+	return environment
+}
+
+// loadOptions returns the deployment configuration
+func loadOptions() (*imagerOptions, error) {
+	encode, encodeErr := encodeOptions()
+	if encodeErr != nil {
+		return nil, encodeErr
+	}
+	stamp, stampErr := stampOptions()
+	if stampErr != nil {
+		return nil, stampErr
+	}
+	scale, scaleErr := watermarkScale()
+	if scaleErr != nil {
+		return nil, scaleErr
+	}
+	source, sourceErr := parseWatermarkSource(envValue(envWatermarkSrc, "embedded"))
+	if sourceErr != nil {
+		return nil, sourceErr
+	}
+	refreshInterval, refreshIntervalErr := time.ParseDuration(envValue(envWatermarkTTL, "5m"))
+	if refreshIntervalErr != nil {
+		return nil, fmt.Errorf("invalid %s: %s", envWatermarkTTL, refreshIntervalErr)
+	}
+	source.RefreshInterval = refreshInterval
+	text, textErr := textOptions()
+	if textErr != nil {
+		return nil, textErr
+	}
+	metadata, metadataErr := metadataPolicy()
+	if metadataErr != nil {
+		return nil, metadataErr
+	}
+	limits, limitsErr := decodeLimits()
+	if limitsErr != nil {
+		return nil, limitsErr
+	}
+	output, outputErr := parseOutputRoute()
+	if outputErr != nil {
+		return nil, outputErr
+	}
+	force, forceErr := strconv.ParseBool(envValue(envForce, "false"))
+	if forceErr != nil {
+		return nil, fmt.Errorf("invalid %s: %s", envForce, forceErr)
+	}
+	return &imagerOptions{
+		Encode:            encode,
+		Stamp:             stamp,
+		Scale:             scale,
+		Watermark:         envValue(envWatermarkName, transforms.DefaultWatermark),
+		WatermarkSource:   source,
+		WatermarkProvider: source.Provider(),
+		Text:              text,
+		Metadata:          metadata,
+		Limits:            limits,
+		Output:            output,
+		Force:             force,
+	}, nil
+}
+
+// encodeOptions returns the output encoder settings
+func encodeOptions() (transforms.EncodeOptions, error) {
+	options := transforms.EncodeOptions{}
+	format, formatErr := transforms.ParseFormat(envValue(envOutputFormat, "auto"))
+	if formatErr != nil {
+		return options, formatErr
+	}
+	options.Format = format
+
+	quality, qualityErr := strconv.Atoi(envValue(envJPEGQuality, "85"))
+	if qualityErr != nil || quality < 1 || quality > 100 {
+		return options, fmt.Errorf("invalid %s: must be an integer in [1, 100]", envJPEGQuality)
+	}
+	options.JPEGQuality = quality
+
+	compression, compressionErr := transforms.ParsePNGCompression(envValue(envPNGCompression, "default"))
+	if compressionErr != nil {
+		return options, compressionErr
+	}
+	options.PNGCompression = compression
+	return options, nil
+}
+
+// stampOptions returns the watermark placement settings
+func stampOptions() (transforms.StampOptions, error) {
+	options := transforms.DefaultStampOptions()
+	mode, modeErr := transforms.ParsePlacementMode(envValue(envPlacement, "anchored"))
+	if modeErr != nil {
+		return options, modeErr
+	}
+	options.Mode = mode
+
+	anchor, anchorErr := transforms.ParseAnchor(envValue(envAnchor, "bottom-right"))
+	if anchorErr != nil {
+		return options, anchorErr
+	}
+	options.Anchor = anchor
+
+	anchors, anchorsErr := transforms.ParseAnchors(os.Getenv(envAnchors))
+	if anchorsErr != nil {
+		return options, anchorsErr
+	}
+	options.Anchors = anchors
+
+	margin, marginErr := transforms.ParseMargin(envValue(envMargin, "0"))
+	if marginErr != nil {
+		return options, marginErr
+	}
+	options.Margin = margin
+
+	tileSpacing, tileSpacingErr := transforms.ParseMargin(envValue(envTileSpacing, "50%"))
+	if tileSpacingErr != nil {
+		return options, tileSpacingErr
+	}
+	options.TileSpacing = tileSpacing
+
+	opacity, opacityErr := transforms.ParseOpacity(envValue(envOpacity, "100"))
+	if opacityErr != nil {
+		return options, opacityErr
+	}
+	options.Opacity = opacity
+
+	blend, blendErr := transforms.ParseBlendMode(envValue(envBlendMode, "normal"))
+	if blendErr != nil {
+		return options, blendErr
+	}
+	options.Blend = blend
+
+	contrast, contrastErr := transforms.ParseContrastMode(envValue(envContrast, "off"))
+	if contrastErr != nil {
+		return options, contrastErr
+	}
+	options.Contrast = contrast
+	return options, nil
+}
+
+// watermarkScale returns the helmet watermark size settings
+func watermarkScale() (transforms.WatermarkScale, error) {
+	scale := transforms.DefaultWatermarkScale()
+	size, sizeErr := transforms.ParseMargin(envValue(envWatermarkSize, scale.Size.String()))
+	if sizeErr != nil {
+		return scale, sizeErr
+	}
+	scale.Size = size
+
+	edge, edgeErr := transforms.ParseScaleEdge(envValue(envWatermarkEdge, scale.Edge.String()))
+	if edgeErr != nil {
+		return scale, edgeErr
+	}
+	scale.Edge = edge
+
+	minSize, minSizeErr := strconv.Atoi(envValue(envWatermarkMin, strconv.Itoa(scale.MinSize)))
+	if minSizeErr != nil || minSize < 0 {
+		return scale, fmt.Errorf("invalid %s: must be a non-negative integer", envWatermarkMin)
+	}
+	scale.MinSize = minSize
+
+	maxSize, maxSizeErr := strconv.Atoi(envValue(envWatermarkMax, strconv.Itoa(scale.MaxSize)))
+	if maxSizeErr != nil || maxSize < 0 {
+		return scale, fmt.Errorf("invalid %s: must be a non-negative integer", envWatermarkMax)
+	}
+	if maxSize > 0 && maxSize < minSize {
+		return scale, fmt.Errorf("%s must not be less than %s", envWatermarkMax, envWatermarkMin)
+	}
+	scale.MaxSize = maxSize
+	return scale, nil
+}
+
+// watermarkSource is where the helmet watermark is loaded from
+type watermarkSource struct {
+	// Bucket and Prefix are set for an S3 source
+	Bucket string
+	Prefix string
+	// Directory is set for a local directory source
+	Directory       string
+	RefreshInterval time.Duration
+}
+
+// parseWatermarkSource parses "embedded", "file:///DIRECTORY" or
+// "s3://BUCKET/PREFIX"
+func parseWatermarkSource(value string) (*watermarkSource, error) {
+	if value == "embedded" {
+		return &watermarkSource{}, nil
+	}
+	parsed, parsedErr := url.Parse(value)
+	if parsedErr != nil {
+		return nil, fmt.Errorf("invalid %s: %s", envWatermarkSrc, parsedErr)
+	}
+	switch parsed.Scheme {
+	case "file":
+		if parsed.Path == "" {
+			return nil, fmt.Errorf("invalid %s: missing directory", envWatermarkSrc)
+		}
+		return &watermarkSource{Directory: parsed.Path}, nil
+	case "s3":
+		if parsed.Host == "" {
+			return nil, fmt.Errorf("invalid %s: missing bucket", envWatermarkSrc)
+		}
+		prefix := strings.TrimPrefix(parsed.Path, "/")
+		if prefix != "" && !strings.HasSuffix(prefix, "/") {
+			prefix += "/"
+		}
+		return &watermarkSource{
+			Bucket: parsed.Host,
+			Prefix: prefix,
+		}, nil
+	default:
+		return nil, fmt.Errorf("invalid %s: expected embedded, file:///DIRECTORY or s3://BUCKET/PREFIX", envWatermarkSrc)
+	}
+}
+
+// Provider returns the WatermarkProvider for the source
+func (source *watermarkSource) Provider() transforms.WatermarkProvider {
+	switch {
+	case source.Bucket != "":
+		return transforms.NewS3Provider(source.Bucket, source.Prefix, nil, source.RefreshInterval)
+	case source.Directory != "":
+		return transforms.NewDirectoryProvider(source.Directory, source.RefreshInterval)
+	default:
+		return transforms.NewEmbeddedProvider()
+	}
+}
+
+// textOptions returns the text watermark settings, or nil if no text
+// watermark is configured
+func textOptions() (*transforms.TextOptions, error) {
+	text := os.Getenv(envText)
+	if text == "" {
+		return nil, nil
+	}
+	options := transforms.DefaultTextOptions()
+	options.Text = text
+	templateErr := options.ParseTemplate()
+	if templateErr != nil {
+		return nil, fmt.Errorf("invalid %s: %s", envText, templateErr)
+	}
+	options.Font = envValue(envTextFont, transforms.DefaultFont)
+	_, fontErr := transforms.LoadFont(options.Font)
+	if fontErr != nil {
+		return nil, fontErr
+	}
+
+	size, sizeErr := transforms.ParseMargin(envValue(envTextSize, options.Size.String()))
+	if sizeErr != nil {
+		return nil, sizeErr
+	}
+	options.Size = size
+
+	textColor, textColorErr := transforms.ParseColor(envValue(envTextColor, "#FFFFFFCC"))
+	if textColorErr != nil {
+		return nil, textColorErr
+	}
+	options.Color = textColor
+
+	// Shadow is "COLOR" or "COLOR OFFSET", eg "#00000080 2"
+	shadowParts := strings.Fields(envValue(envTextShadow, "#00000080 2"))
+	if len(shadowParts) != 0 && shadowParts[0] != "none" {
+		shadowColor, shadowColorErr := transforms.ParseColor(shadowParts[0])
+		if shadowColorErr != nil {
+			return nil, shadowColorErr
+		}
+		options.ShadowColor = shadowColor
+		if len(shadowParts) > 1 {
+			offset, offsetErr := strconv.Atoi(shadowParts[1])
+			if offsetErr != nil {
+				return nil, fmt.Errorf("invalid %s offset: %s", envTextShadow, shadowParts[1])
+			}
+			options.ShadowOffset = image.Pt(offset, offset)
+		}
+	} else {
+		options.ShadowColor = nil
+	}
+
+	// Outline is "COLOR WIDTH", eg "#000000 2"
+	outlineParts := strings.Fields(os.Getenv(envTextOutline))
+	if len(outlineParts) != 0 && outlineParts[0] != "none" {
+		outlineColor, outlineColorErr := transforms.ParseColor(outlineParts[0])
+		if outlineColorErr != nil {
+			return nil, outlineColorErr
+		}
+		options.OutlineColor = outlineColor
+		options.OutlineWidth = 1
+		if len(outlineParts) > 1 {
+			width, widthErr := strconv.Atoi(outlineParts[1])
+			if widthErr != nil || width < 0 {
+				return nil, fmt.Errorf("invalid %s width: %s", envTextOutline, outlineParts[1])
+			}
+			options.OutlineWidth = width
+		}
+	}
+
+	rotation, rotationErr := strconv.ParseFloat(envValue(envTextRotation, "0"), 64)
+	if rotationErr != nil {
+		return nil, fmt.Errorf("invalid %s: %s", envTextRotation, rotationErr)
+	}
+	options.Rotation = rotation
+	return &options, nil
+}
+
+// metadataPolicy returns the policy for carrying source metadata into the
+// derivatives
+func metadataPolicy() (transforms.MetadataPolicy, error) {
+	policy := transforms.MetadataPolicy{}
+	mode, modeErr := transforms.ParseMetadataMode(envValue(envMetadata, "strip"))
+	if modeErr != nil {
+		return policy, modeErr
+	}
+	policy.Mode = mode
+
+	allow, allowErr := transforms.ParseMetadataAllowlist(os.Getenv(envMetadataAllow))
+	if allowErr != nil {
+		return policy, allowErr
+	}
+	if mode == transforms.MetadataAllowlist && len(allow) == 0 {
+		return policy, fmt.Errorf("%s requires %s", mode, envMetadataAllow)
+	}
+	policy.Allow = allow
+	return policy, nil
+}
+
+// defaultMemorySize is the transform lambda's memory in MB
+const defaultMemorySize = 512
+
+// memorySize returns the transform lambda's memory in MB. Inside the lambda
+// the size it was actually provisioned with takes precedence.
+func memorySize() (int, error) {
+	value := envValue("AWS_LAMBDA_FUNCTION_MEMORY_SIZE",
+		envValue(envMemorySize, strconv.Itoa(defaultMemorySize)))
+	memory, memoryErr := strconv.Atoi(value)
+	if memoryErr != nil || memory < 128 {
+		return 0, fmt.Errorf("invalid %s: must be at least 128", envMemorySize)
+	}
+	return memory, nil
+}
+
+// decodeLimits returns the resource limits applied to every upload. The
+// defaults are derived from the lambda's memory. A limit of 0 disables the
+// check.
+func decodeLimits() (transforms.DecodeLimits, error) {
+	memory, memoryErr := memorySize()
+	if memoryErr != nil {
+		return transforms.DecodeLimits{}, memoryErr
+	}
+	limits := transforms.DecodeLimitsForMemory(memory)
+	for _, eachLimit := range []struct {
+		keyName string
+		value   *int64
+	}{
+		{envMaxPixels, &limits.MaxPixels},
+		{envMaxTotalPixels, &limits.MaxTotalPixels},
+		{envMaxBytes, &limits.MaxBytes},
+	} {
+		value, valueErr := strconv.ParseInt(envValue(eachLimit.keyName,
+			strconv.FormatInt(*eachLimit.value, 10)), 10, 64)
+		if valueErr != nil || value < 0 {
+			return limits, fmt.Errorf("invalid %s: must be a non-negative integer", eachLimit.keyName)
+		}
+		*eachLimit.value = value
+	}
+	for _, eachLimit := range []struct {
+		keyName string
+		value   *int
+	}{
+		{envMaxWidth, &limits.MaxWidth},
+		{envMaxHeight, &limits.MaxHeight},
+		{envMaxFrames, &limits.MaxFrames},
+	} {
+		value, valueErr := strconv.Atoi(envValue(eachLimit.keyName,
+			strconv.Itoa(*eachLimit.value)))
+		if valueErr != nil || value < 0 {
+			return limits, fmt.Errorf("invalid %s: must be a non-negative integer", eachLimit.keyName)
+		}
+		*eachLimit.value = value
+	}
+	return limits, nil
+}
@@ -0,0 +1,21 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestTextOptionsParsesTemplate(t *testing.T) {
+	t.Setenv(envText, "© {{.Uploader}} {{.Date.Year}}")
+	options, err := textOptions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if options.Template == nil {
+		t.Error("textOptions() didn't parse the template")
+	}
+
+	t.Setenv(envText, "© {{.Uploader")
+	if _, err := textOptions(); err == nil {
+		t.Error("textOptions() accepted an invalid template")
+	}
+}
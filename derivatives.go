@@ -0,0 +1,156 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mweagle/SpartaImager/transforms"
+)
+
+// envDerivatives is the environment variable that configures the set of
+// renditions produced for every upload. The value is a comma separated list
+// of VARIANT:MAX_EDGE pairs, where a MAX_EDGE of 0 keeps the original size.
+const envDerivatives = "SPARTA_IMAGER_DERIVATIVES"
+
+const defaultDerivatives = "original:0,large:1280,medium:640,thumb:160"
+
+// derivative is a single rendition produced from an uploaded image
+type derivative struct {
+	Variant string
+	MaxEdge int
+	// Output routes the derivative keys
+	Output   *outputRoute
+	Encoder  *transforms.FormatEncoder
+	Pipeline *transforms.Pipeline
+	// Recipe is the hash of the settings that produce the derivative
+	Recipe string
+}
+
+// Key returns the S3 key the derivative of the decoded source is written
+// to. The key reflects the derivative's dimensions and output format.
+func (deriv *derivative) Key(sourceKey string, source *transforms.Source) string {
+	bounds := source.Image.Bounds()
+	width, height := transforms.FitDimensions(bounds.Dx(),
+		bounds.Dy(),
+		deriv.MaxEdge,
+		deriv.MaxEdge)
+	return deriv.Output.Key(&keyFields{
+		SourceKey: sourceKey,
+		Variant:   deriv.Variant,
+		Width:     width,
+		Height:    height,
+		Ext:       deriv.Encoder.OutputFormat(source.Format).Extension(),
+		Hash:      source.Digest,
+	})
+}
+
+func newDerivative(variant string, maxEdge int, options *imagerOptions) (*derivative, error) {
+	recipe, recipeErr := recipeHash(variant, maxEdge, options)
+	if recipeErr != nil {
+		return nil, recipeErr
+	}
+	pipeline := transforms.NewPipeline()
+	encoder := &transforms.FormatEncoder{Options: options.Encode}
+	pipeline.Encoder = encoder
+	pipeline.Metadata = &options.Metadata
+	if maxEdge > 0 {
+		pipeline.Then(&transforms.Resize{
+			Width:  maxEdge,
+			Height: maxEdge,
+			Filter: transforms.Lanczos3,
+		})
+	}
+	stampOptions := options.Stamp
+	if options.Text != nil {
+		pipeline.Then(&transforms.TextStamp{
+			Text:    options.Text,
+			Options: &stampOptions,
+		})
+	} else {
+		pipeline.Then(&transforms.Stamp{
+			Options:   &stampOptions,
+			Scale:     &options.Scale,
+			Provider:  options.WatermarkProvider,
+			Watermark: options.Watermark,
+		})
+	}
+	return &derivative{
+		Variant:  variant,
+		MaxEdge:  maxEdge,
+		Output:   &options.Output,
+		Encoder:  encoder,
+		Pipeline: pipeline,
+		Recipe:   recipe,
+	}, nil
+}
+
+// derivativeEntry is a single VARIANT:MAX_EDGE pair
+type derivativeEntry struct {
+	Variant string
+	MaxEdge int
+}
+
+// parseDerivativeEntries parses the VARIANT:MAX_EDGE list
+func parseDerivativeEntries(value string) ([]derivativeEntry, error) {
+	var entries []derivativeEntry
+	for _, eachEntry := range strings.Split(value, ",") {
+		eachEntry = strings.TrimSpace(eachEntry)
+		if eachEntry == "" {
+			continue
+		}
+		parts := strings.SplitN(eachEntry, ":", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid derivative %q: expected VARIANT:MAX_EDGE", eachEntry)
+		}
+		maxEdge, maxEdgeErr := strconv.Atoi(parts[1])
+		if maxEdgeErr != nil || maxEdge < 0 {
+			return nil, fmt.Errorf("invalid derivative %q: MAX_EDGE must be a non-negative integer", eachEntry)
+		}
+		entries = append(entries, derivativeEntry{Variant: parts[0], MaxEdge: maxEdge})
+	}
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("no derivatives defined in %q", value)
+	}
+	return entries, nil
+}
+
+// parseDerivatives parses the VARIANT:MAX_EDGE list. Every derivative
+// shares the same deployment options.
+func parseDerivatives(value string, options *imagerOptions) ([]*derivative, error) {
+	entries, entriesErr := parseDerivativeEntries(value)
+	if entriesErr != nil {
+		return nil, entriesErr
+	}
+	var derivatives []*derivative
+	for _, eachEntry := range entries {
+		eachDerivative, derivativeErr := newDerivative(eachEntry.Variant, eachEntry.MaxEdge, options)
+		if derivativeErr != nil {
+			return nil, derivativeErr
+		}
+		derivatives = append(derivatives, eachDerivative)
+	}
+	return derivatives, nil
+}
+
+// imagerConfig is the deployment configuration, loaded at cold start
+var imagerConfig = mustLoadOptions()
+
+// imageDerivatives is the set of renditions produced for every upload
+var imageDerivatives = mustParseDerivatives(imagerConfig)
+
+func mustLoadOptions() *imagerOptions {
+	options, optionsErr := loadOptions()
+	if optionsErr != nil {
+		panic(optionsErr)
+	}
+	return options
+}
+
+func mustParseDerivatives(options *imagerOptions) []*derivative {
+	derivatives, err := parseDerivatives(envValue(envDerivatives, defaultDerivatives), options)
+	if err != nil {
+		panic(err)
+	}
+	return derivatives
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"strings"
+)
+
+// s3EventAction is the processing path for an S3 event notification
+type s3EventAction int
+
+const (
+	// s3EventUnsupported events are logged and skipped
+	s3EventUnsupported s3EventAction = iota
+	// s3EventCreated events stamp the new object
+	s3EventCreated
+	// s3EventRemoved events delete the object's derivatives
+	s3EventRemoved
+)
+
+// s3EventPrefix prefixes event names in bucket notification configurations.
+// Event records carry the bare name, eg "ObjectCreated:Put".
+const s3EventPrefix = "s3:"
+
+// s3EventActions maps every bare object creation and removal event name to
+// its processing path. See
+// https://docs.aws.amazon.com/AmazonS3/latest/dev/NotificationHowTo.html
+var s3EventActions = map[string]s3EventAction{
+	"ObjectCreated:Put":                     s3EventCreated,
+	"ObjectCreated:Post":                    s3EventCreated,
+	"ObjectCreated:Copy":                    s3EventCreated,
+	"ObjectCreated:CompleteMultipartUpload": s3EventCreated,
+	"ObjectRemoved:Delete":                  s3EventRemoved,
+	"ObjectRemoved:DeleteMarkerCreated":     s3EventRemoved,
+}
+
+// s3EventActionFor returns the processing path for an event name, with or
+// without the "s3:" prefix
+func s3EventActionFor(eventName string) s3EventAction {
+	name := strings.TrimPrefix(strings.TrimSpace(eventName), s3EventPrefix)
+	return s3EventActions[name]
+}
@@ -0,0 +1,408 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	awsLambdaEvents "github.com/aws/aws-lambda-go/events"
+	awsLambdaContext "github.com/aws/aws-lambda-go/lambdacontext"
+	"github.com/aws/aws-sdk-go/service/s3"
+	sparta "github.com/mweagle/Sparta"
+	"github.com/rs/zerolog"
+)
+
+// readS3Event returns the recorded S3 event in the testdata fixture
+func readS3Event(t *testing.T, fixture string) awsLambdaEvents.S3Event {
+	body, err := ioutil.ReadFile(filepath.Join("testdata", fixture))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var event awsLambdaEvents.S3Event
+	if err := json.Unmarshal(body, &event); err != nil {
+		t.Fatal(err)
+	}
+	if len(event.Records) != 1 {
+		t.Fatalf("%s has %d records", fixture, len(event.Records))
+	}
+	return event
+}
+
+func TestS3EventFixtures(t *testing.T) {
+	tests := []struct {
+		fixture   string
+		eventName string
+		want      s3EventAction
+		key       string
+	}{
+		{"s3-put.json", "ObjectCreated:Put", s3EventCreated, "uploads/helmet.jpg"},
+		{"s3-post.json", "ObjectCreated:Post", s3EventCreated, "uploads/form upload.png"},
+		{"s3-copy.json", "ObjectCreated:Copy", s3EventCreated, "uploads/copied/helmet(1).jpg"},
+		{"s3-complete-multipart-upload.json",
+			"ObjectCreated:CompleteMultipartUpload",
+			s3EventCreated,
+			"uploads/panorama.jpg"},
+		{"s3-delete.json", "ObjectRemoved:Delete", s3EventRemoved, "uploads/helmet.jpg"},
+		{"s3-delete-marker-created.json",
+			"ObjectRemoved:DeleteMarkerCreated",
+			s3EventRemoved,
+			"uploads/helmet.jpg"},
+	}
+	for _, eachTest := range tests {
+		t.Run(eachTest.eventName, func(t *testing.T) {
+			record := readS3Event(t, eachTest.fixture).Records[0]
+			if record.EventName != eachTest.eventName {
+				t.Errorf("EventName = %s, want %s", record.EventName, eachTest.eventName)
+			}
+			if action := s3EventActionFor(record.EventName); action != eachTest.want {
+				t.Errorf("s3EventActionFor(%s) = %d, want %d", record.EventName, action, eachTest.want)
+			}
+			// Notification configurations name the same events with a prefix
+			if action := s3EventActionFor(s3EventPrefix + record.EventName); action != eachTest.want {
+				t.Errorf("s3EventActionFor(%s%s) = %d, want %d",
+					s3EventPrefix,
+					record.EventName,
+					action,
+					eachTest.want)
+			}
+			key, err := url.QueryUnescape(record.S3.Object.Key)
+			if err != nil || key != eachTest.key {
+				t.Errorf("Key = %s (%v), want %s", key, err, eachTest.key)
+			}
+		})
+	}
+}
+
+func TestS3EventActionUnsupported(t *testing.T) {
+	for _, eachName := range []string{
+		"",
+		"ObjectCreated:*",
+		"s3:ObjectRemoved:*",
+		"ObjectRestore:Completed",
+		"objectcreated:put",
+	} {
+		if action := s3EventActionFor(eachName); action != s3EventUnsupported {
+			t.Errorf("s3EventActionFor(%q) = %d, want unsupported", eachName, action)
+		}
+	}
+}
+
+// storedObject is an object held by objectStore
+type storedObject struct {
+	body     []byte
+	metadata http.Header
+	etag     string
+}
+
+// objectStore is a path style S3 endpoint that keeps objects in memory and
+// records every request as "METHOD bucket/key", with a "?tagging" suffix
+// for tagging requests
+type objectStore struct {
+	mutex   sync.Mutex
+	objects map[string]*storedObject
+	calls   []string
+	version int
+}
+
+func newObjectStore() *objectStore {
+	return &objectStore{objects: make(map[string]*storedObject)}
+}
+
+func (store *objectStore) put(name string, body []byte) {
+	store.version++
+	store.objects[name] = &storedObject{
+		body:     body,
+		metadata: make(http.Header),
+		etag:     fmt.Sprintf(`"v%d"`, store.version),
+	}
+}
+
+func (store *objectStore) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	name := strings.TrimPrefix(request.URL.Path, "/")
+	call := request.Method + " " + name
+	if _, isTagging := request.URL.Query()["tagging"]; isTagging {
+		call += "?tagging"
+	}
+	store.calls = append(store.calls, call)
+
+	object, exists := store.objects[name]
+	switch {
+	case strings.HasSuffix(call, "?tagging"):
+		if !exists {
+			writer.WriteHeader(http.StatusNotFound)
+		}
+	case request.Method == http.MethodHead || request.Method == http.MethodGet:
+		if !exists {
+			writer.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(writer, `<Error><Code>NoSuchKey</Code></Error>`)
+			return
+		}
+		for eachKey, eachValues := range object.metadata {
+			writer.Header()[eachKey] = eachValues
+		}
+		writer.Header().Set("ETag", object.etag)
+		if request.Method == http.MethodGet {
+			writer.Write(object.body)
+		}
+	case request.Method == http.MethodPut:
+		if (request.Header.Get("If-None-Match") == "*" && exists) ||
+			(request.Header.Get("If-Match") != "" &&
+				(!exists || request.Header.Get("If-Match") != object.etag)) {
+			writer.WriteHeader(http.StatusPreconditionFailed)
+			fmt.Fprint(writer, `<Error><Code>PreconditionFailed</Code></Error>`)
+			return
+		}
+		body, _ := ioutil.ReadAll(request.Body)
+		store.put(name, body)
+		for eachKey, eachValues := range request.Header {
+			if strings.HasPrefix(strings.ToLower(eachKey), "x-amz-meta-") {
+				store.objects[name].metadata[eachKey] = eachValues
+			}
+		}
+		writer.Header().Set("ETag", store.objects[name].etag)
+	case request.Method == http.MethodDelete:
+		delete(store.objects, name)
+		writer.WriteHeader(http.StatusNoContent)
+	default:
+		writer.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// encodeTestImage returns a small image encoded in the format of the key's
+// extension
+func encodeTestImage(t *testing.T, key string) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, 64, 48))
+	for y := 0; y < 48; y++ {
+		for x := 0; x < 64; x++ {
+			img.Set(x, y, color.RGBA{R: uint8(x * 4), G: uint8(y * 5), B: 0x80, A: 0xff})
+		}
+	}
+	var encoded bytes.Buffer
+	var err error
+	if path.Ext(key) == ".png" {
+		err = png.Encode(&encoded, img)
+	} else {
+		err = jpeg.Encode(&encoded, img, nil)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return encoded.Bytes()
+}
+
+// transformImageCalls runs transformImage against the store and returns
+// the S3 requests it made
+func transformImageCalls(t *testing.T,
+	store *objectStore,
+	event awsLambdaEvents.S3Event) ([]string, error) {
+	svc := testS3(t, store)
+	defaultS3Client := newS3Client
+	newS3Client = func(logger *zerolog.Logger) *s3.S3 {
+		return svc
+	}
+	defer func() {
+		newS3Client = defaultS3Client
+	}()
+	logger := zerolog.Nop()
+	ctx := context.WithValue(context.Background(), sparta.ContextKeyLogger, &logger)
+	ctx = awsLambdaContext.NewContext(ctx, &awsLambdaContext.LambdaContext{AwsRequestID: "test"})
+	_, err := transformImage(ctx, event)
+	return store.calls, err
+}
+
+func TestTransformImageDispatchesFixtures(t *testing.T) {
+	const bucket = "sparta-imager-uploads"
+	route := &imagerConfig.Output
+	lineageName := func(key string) string {
+		return bucket + "/" + lineageKey(route, bucket, key)
+	}
+	derivativeName := func(variant string, key string) string {
+		ext := strings.TrimPrefix(path.Ext(key), ".")
+		return bucket + "/" + route.Key(&keyFields{SourceKey: key, Variant: variant, Ext: ext})
+	}
+	createdCalls := func(key string) []string {
+		calls := []string{
+			"HEAD " + bucket + "/" + key,
+			"GET " + lineageName(key),
+			"GET " + bucket + "/" + key,
+		}
+		for _, eachDerivative := range imageDerivatives {
+			calls = append(calls, "PUT "+derivativeName(eachDerivative.Variant, key))
+		}
+		return append(calls, "GET "+lineageName(key), "PUT "+lineageName(key))
+	}
+	removedCalls := func(key string) []string {
+		return []string{
+			"GET " + lineageName(key),
+			"DELETE " + derivativeName("thumb", key),
+			"DELETE " + derivativeName("large", key),
+			"DELETE " + lineageName(key),
+		}
+	}
+
+	tests := []struct {
+		fixture string
+		want    func(key string) []string
+	}{
+		{"s3-put.json", createdCalls},
+		{"s3-post.json", createdCalls},
+		{"s3-copy.json", createdCalls},
+		{"s3-complete-multipart-upload.json", createdCalls},
+		{"s3-delete.json", removedCalls},
+		{"s3-delete-marker-created.json", removedCalls},
+	}
+	for _, eachTest := range tests {
+		t.Run(eachTest.fixture, func(t *testing.T) {
+			event := readS3Event(t, eachTest.fixture)
+			key, _ := url.QueryUnescape(event.Records[0].S3.Object.Key)
+			store := newObjectStore()
+			if s3EventActionFor(event.Records[0].EventName) == s3EventCreated {
+				store.put(bucket+"/"+key, encodeTestImage(t, key))
+			} else {
+				manifest, _ := json.Marshal(&lineage{
+					Bucket: bucket,
+					Key:    key,
+					Derivatives: []lineageDerivative{
+						{Bucket: bucket, Key: route.Key(&keyFields{SourceKey: key, Variant: "thumb", Ext: "jpg"}), Variant: "thumb"},
+						{Bucket: bucket, Key: route.Key(&keyFields{SourceKey: key, Variant: "large", Ext: "jpg"}), Variant: "large"},
+					},
+				})
+				store.put(lineageName(key), manifest)
+			}
+			calls, err := transformImageCalls(t, store, event)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := eachTest.want(key); !reflect.DeepEqual(calls, want) {
+				t.Errorf("transformImage made\n%s\nwant\n%s",
+					strings.Join(calls, "\n"),
+					strings.Join(want, "\n"))
+			}
+		})
+	}
+}
+
+func TestTransformImageSkipsDerivatives(t *testing.T) {
+	route := &imagerConfig.Output
+	for _, eachFixture := range []string{"s3-put.json", "s3-delete.json"} {
+		event := readS3Event(t, eachFixture)
+		event.Records[0].S3.Object.Key = route.Key(&keyFields{
+			SourceKey: "uploads/helmet.jpg",
+			Variant:   "thumb",
+			Ext:       "jpg",
+		})
+		calls, err := transformImageCalls(t, newObjectStore(), event)
+		if err != nil || len(calls) != 0 {
+			t.Errorf("%s: transformImage made %v, %v for a derivative", eachFixture, calls, err)
+		}
+	}
+}
+
+func TestTransformImageQuarantinesUnsupportedUploads(t *testing.T) {
+	const bucket = "sparta-imager-uploads"
+	event := readS3Event(t, "s3-put.json")
+	store := newObjectStore()
+	store.put(bucket+"/uploads/helmet.jpg", []byte("not an image"))
+	calls, err := transformImageCalls(t, store, event)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"HEAD " + bucket + "/uploads/helmet.jpg",
+		"GET " + bucket + "/" + lineageKey(&imagerConfig.Output, bucket, "uploads/helmet.jpg"),
+		"GET " + bucket + "/uploads/helmet.jpg",
+		"PUT " + bucket + "/uploads/helmet.jpg?tagging",
+	}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("transformImage made %v, want %v", calls, want)
+	}
+}
+
+func TestTransformImageSkipsRedeliveredEvents(t *testing.T) {
+	const bucket = "sparta-imager-uploads"
+	route := &imagerConfig.Output
+	event := readS3Event(t, "s3-put.json")
+	store := newObjectStore()
+	store.put(bucket+"/uploads/helmet.jpg", encodeTestImage(t, "uploads/helmet.jpg"))
+	if _, err := transformImageCalls(t, store, event); err != nil {
+		t.Fatal(err)
+	}
+	store.calls = nil
+	calls, err := transformImageCalls(t, store, event)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"HEAD " + bucket + "/uploads/helmet.jpg",
+		"GET " + bucket + "/" + lineageKey(route, bucket, "uploads/helmet.jpg"),
+	}
+	for _, eachDerivative := range imageDerivatives {
+		want = append(want, "HEAD "+bucket+"/"+route.Key(&keyFields{
+			SourceKey: "uploads/helmet.jpg",
+			Variant:   eachDerivative.Variant,
+			Ext:       "jpg",
+		}))
+	}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("transformImage made %v, want %v", calls, want)
+	}
+}
+
+func TestTransformImageHonorsForceMetadata(t *testing.T) {
+	const bucket = "sparta-imager-uploads"
+	event := readS3Event(t, "s3-put.json")
+	tests := []struct {
+		force string
+		want  bool
+	}{
+		{"true", true},
+		{"1", true},
+		{"false", false},
+		{"later", false},
+	}
+	for _, eachTest := range tests {
+		t.Run(eachTest.force, func(t *testing.T) {
+			store := newObjectStore()
+			store.put(bucket+"/uploads/helmet.jpg", encodeTestImage(t, "uploads/helmet.jpg"))
+			if _, err := transformImageCalls(t, store, event); err != nil {
+				t.Fatal(err)
+			}
+			// S3 returns user metadata keys as they were written
+			store.objects[bucket+"/uploads/helmet.jpg"].metadata.Set("X-Amz-Meta-Force", eachTest.force)
+			store.calls = nil
+			calls, err := transformImageCalls(t, store, event)
+			if err != nil {
+				t.Fatal(err)
+			}
+			downloaded := false
+			for _, eachCall := range calls {
+				if eachCall == "GET "+bucket+"/uploads/helmet.jpg" {
+					downloaded = true
+				}
+			}
+			if downloaded != eachTest.want {
+				t.Errorf("x-amz-meta-force %s reprocessed = %t, want %t: %v",
+					eachTest.force,
+					downloaded,
+					eachTest.want,
+					calls)
+			}
+		})
+	}
+}
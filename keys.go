@@ -0,0 +1,240 @@
+package main
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// envKeyTemplate is the environment variable that lays out derivative keys
+// below the output prefix, eg "{dir}/derived/{name}-{variant}-{width}w.{ext}"
+const envKeyTemplate = "SPARTA_IMAGER_KEY_TEMPLATE"
+
+// defaultKeyTemplate matches the keys produced before templates were
+// configurable, except that the extension is the derivative format's
+const defaultKeyTemplate = "{variant}_{dir}/{name}.{ext}"
+
+// keyPlaceholders maps every placeholder to the pattern its value matches
+// when a key is parsed
+var keyPlaceholders = map[string]string{
+	// key is the full source key
+	"key": `.+`,
+	// dir is the source key's directory, without a trailing slash
+	"dir": `.+`,
+	// name is the source key's base name, without its extension
+	"name": `[^/]+`,
+	// srcext is the source key's extension, without the leading period
+	"srcext": `[^/.]*`,
+	// ext is the derivative format's extension, eg "jpg"
+	"ext": `[a-z0-9]+`,
+	// variant is the derivative variant, eg "thumb". Templates parsed with
+	// the configured variant names only match those, see variantPattern.
+	"variant": `[^/]+?`,
+	// width and height are the derivative dimensions in pixels
+	"width":  `[0-9]+`,
+	"height": `[0-9]+`,
+	// hash is the hex SHA-256 of the source content. "{hash:N}" keeps the
+	// first N digits.
+	"hash": `[0-9a-f]+`,
+}
+
+// keyFields are the values a key template is expanded with
+type keyFields struct {
+	SourceKey string
+	Variant   string
+	Width     int
+	Height    int
+	// Ext is the derivative format's extension
+	Ext string
+	// Hash is the hex SHA-256 of the source content
+	Hash string
+}
+
+// keySegment is either literal text or a placeholder
+type keySegment struct {
+	literal     string
+	placeholder string
+	// length truncates hash placeholders. Zero keeps the full value.
+	length int
+	// dirSlash is set for a dir placeholder followed by a slash. The slash
+	// is dropped along with an empty dir s.t. root level keys don't get a
+	// leading slash.
+	dirSlash bool
+}
+
+// keyTemplate lays out derivative keys. Templates are reversible: Parse
+// recovers the placeholder values from a key the template produced.
+type keyTemplate struct {
+	source   string
+	segments []keySegment
+	pattern  *regexp.Regexp
+}
+
+// variantPattern returns the pattern that matches exactly the variant
+// names, s.t. a source key that happens to start with another word and an
+// underscore isn't mistaken for a derivative
+func variantPattern(variants []string) string {
+	if len(variants) == 0 {
+		return keyPlaceholders["variant"]
+	}
+	quoted := make([]string, len(variants))
+	for index, eachVariant := range variants {
+		quoted[index] = regexp.QuoteMeta(eachVariant)
+	}
+	// Prefer the longest name when one is a prefix of another
+	sort.Slice(quoted, func(lhs int, rhs int) bool {
+		return len(quoted[lhs]) > len(quoted[rhs])
+	})
+	return strings.Join(quoted, "|")
+}
+
+// parseKeyTemplate compiles a template of literal text and {placeholder}
+// references. Parse only matches the listed variant names, or any variant
+// if the list is empty.
+func parseKeyTemplate(value string, variants []string) (*keyTemplate, error) {
+	template := &keyTemplate{source: value}
+	remainder := value
+	for remainder != "" {
+		start := strings.IndexByte(remainder, '{')
+		if start < 0 {
+			template.segments = append(template.segments, keySegment{literal: remainder})
+			break
+		}
+		if start > 0 {
+			template.segments = append(template.segments, keySegment{literal: remainder[:start]})
+		}
+		end := strings.IndexByte(remainder[start:], '}')
+		if end < 0 {
+			return nil, fmt.Errorf("unterminated placeholder in key template: %s", value)
+		}
+		segment, segmentErr := parseKeyPlaceholder(remainder[start+1 : start+end])
+		if segmentErr != nil {
+			return nil, fmt.Errorf("invalid key template %s: %s", value, segmentErr)
+		}
+		remainder = remainder[start+end+1:]
+		if segment.placeholder == "dir" && strings.HasPrefix(remainder, "/") {
+			segment.dirSlash = true
+			remainder = remainder[1:]
+		}
+		template.segments = append(template.segments, segment)
+	}
+	if len(template.segments) == 0 {
+		return nil, fmt.Errorf("empty key template")
+	}
+
+	expression := "^"
+	for _, eachSegment := range template.segments {
+		switch {
+		case eachSegment.placeholder == "":
+			expression += regexp.QuoteMeta(eachSegment.literal)
+		case eachSegment.dirSlash:
+			expression += fmt.Sprintf("(?:(?P<dir>%s)/)?", keyPlaceholders["dir"])
+		case eachSegment.placeholder == "variant":
+			expression += fmt.Sprintf("(?P<variant>%s)", variantPattern(variants))
+		default:
+			expression += fmt.Sprintf("(?P<%s>%s)",
+				eachSegment.placeholder,
+				keyPlaceholders[eachSegment.placeholder])
+		}
+	}
+	pattern, patternErr := regexp.Compile(expression + "$")
+	if patternErr != nil {
+		return nil, fmt.Errorf("invalid key template %s: %s", value, patternErr)
+	}
+	template.pattern = pattern
+	return template, nil
+}
+
+func parseKeyPlaceholder(value string) (keySegment, error) {
+	parts := strings.SplitN(value, ":", 2)
+	segment := keySegment{placeholder: parts[0]}
+	if _, exists := keyPlaceholders[segment.placeholder]; !exists {
+		return segment, fmt.Errorf("unknown placeholder {%s}", value)
+	}
+	if len(parts) == 2 {
+		length, lengthErr := strconv.Atoi(parts[1])
+		if segment.placeholder != "hash" || lengthErr != nil || length < 1 || length > 64 {
+			return segment, fmt.Errorf("invalid placeholder {%s}", value)
+		}
+		segment.length = length
+	}
+	return segment, nil
+}
+
+// HasLiteral returns true if the template adds text of its own, which
+// distinguishes the keys it produces from source keys
+func (template *keyTemplate) HasLiteral() bool {
+	for _, eachSegment := range template.segments {
+		if strings.Trim(eachSegment.literal, "/.") != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// LiteralPrefix returns the literal text that every key the template
+// produces starts with
+func (template *keyTemplate) LiteralPrefix() string {
+	return template.segments[0].literal
+}
+
+// Expand returns the key for the fields
+func (template *keyTemplate) Expand(fields *keyFields) string {
+	dir := path.Dir(fields.SourceKey)
+	if dir == "." || dir == "/" {
+		dir = ""
+	}
+	base := path.Base(fields.SourceKey)
+	srcext := path.Ext(base)
+	values := map[string]string{
+		"key":     fields.SourceKey,
+		"dir":     dir,
+		"name":    strings.TrimSuffix(base, srcext),
+		"srcext":  strings.TrimPrefix(srcext, "."),
+		"ext":     fields.Ext,
+		"variant": fields.Variant,
+		"width":   strconv.Itoa(fields.Width),
+		"height":  strconv.Itoa(fields.Height),
+		"hash":    fields.Hash,
+	}
+	var key strings.Builder
+	for _, eachSegment := range template.segments {
+		if eachSegment.placeholder == "" {
+			key.WriteString(eachSegment.literal)
+			continue
+		}
+		value := values[eachSegment.placeholder]
+		if eachSegment.length > 0 && len(value) > eachSegment.length {
+			value = value[:eachSegment.length]
+		}
+		key.WriteString(value)
+		if eachSegment.dirSlash && value != "" {
+			key.WriteString("/")
+		}
+	}
+	return key.String()
+}
+
+// Parse returns the placeholder values of a key produced by the template.
+// The boolean result is false if the template couldn't have produced key.
+func (template *keyTemplate) Parse(key string) (map[string]string, bool) {
+	match := template.pattern.FindStringSubmatch(key)
+	if match == nil {
+		return nil, false
+	}
+	values := make(map[string]string)
+	for index, eachName := range template.pattern.SubexpNames() {
+		if eachName != "" {
+			values[eachName] = match[index]
+		}
+	}
+	return values, true
+}
+
+// String returns the template source
+func (template *keyTemplate) String() string {
+	return template.source
+}
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+// testVariants are the variant names key templates are parsed with
+var testVariants = []string{"original", "large", "thumb", "thumb_2x"}
+
+func mustKeyTemplate(t *testing.T, template string) *keyTemplate {
+	parsed, err := parseKeyTemplate(template, testVariants)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return parsed
+}
+
+func TestKeyTemplateRoundTrip(t *testing.T) {
+	tests := []struct {
+		template string
+		fields   keyFields
+		key      string
+		values   map[string]string
+	}{
+		{
+			defaultKeyTemplate,
+			keyFields{SourceKey: "uploads/ben.jpg", Variant: "thumb", Ext: "jpg"},
+			"thumb_uploads/ben.jpg",
+			map[string]string{"variant": "thumb", "dir": "uploads", "name": "ben", "ext": "jpg"},
+		},
+		{
+			defaultKeyTemplate,
+			keyFields{SourceKey: "cat.png", Variant: "large", Ext: "jpg"},
+			"large_cat.jpg",
+			map[string]string{"variant": "large", "dir": "", "name": "cat", "ext": "jpg"},
+		},
+		{
+			defaultKeyTemplate,
+			keyFields{SourceKey: "a/b/my_photo.v2.JPEG", Variant: "thumb_2x", Ext: "jpg"},
+			"thumb_2x_a/b/my_photo.v2.jpg",
+			map[string]string{"variant": "thumb_2x", "dir": "a/b", "name": "my_photo.v2", "ext": "jpg"},
+		},
+		{
+			"{variant}_{key}",
+			keyFields{SourceKey: "photos/cat.png", Variant: "original", Ext: "png"},
+			"original_photos/cat.png",
+			map[string]string{"variant": "original", "key": "photos/cat.png"},
+		},
+		{
+			"{dir}/derived/{name}-{variant}-{width}w.{ext}",
+			keyFields{SourceKey: "photos/cat.png", Variant: "thumb", Width: 160, Height: 90, Ext: "png"},
+			"photos/derived/cat-thumb-160w.png",
+			map[string]string{"dir": "photos", "name": "cat", "variant": "thumb", "width": "160", "ext": "png"},
+		},
+		{
+			"{dir}/derived/{name}-{variant}-{width}w.{ext}",
+			keyFields{SourceKey: "cat.png", Variant: "thumb", Width: 160, Height: 90, Ext: "gif"},
+			"derived/cat-thumb-160w.gif",
+			map[string]string{"dir": "", "name": "cat", "variant": "thumb", "width": "160", "ext": "gif"},
+		},
+		{
+			"derived/{hash:12}/{variant}-{width}x{height}.{srcext}.{ext}",
+			keyFields{
+				SourceKey: "in/cat.webp",
+				Variant:   "large",
+				Width:     1280,
+				Height:    720,
+				Ext:       "jpg",
+				Hash:      "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+			},
+			"derived/0123456789ab/large-1280x720.webp.jpg",
+			map[string]string{
+				"hash":    "0123456789ab",
+				"variant": "large",
+				"width":   "1280",
+				"height":  "720",
+				"srcext":  "webp",
+				"ext":     "jpg",
+			},
+		},
+	}
+	for _, eachTest := range tests {
+		t.Run(eachTest.key, func(t *testing.T) {
+			template := mustKeyTemplate(t, eachTest.template)
+			key := template.Expand(&eachTest.fields)
+			if key != eachTest.key {
+				t.Fatalf("Expand() = %s, want %s", key, eachTest.key)
+			}
+			values, parsed := template.Parse(key)
+			if !parsed {
+				t.Fatalf("Parse(%s) didn't match %s", key, eachTest.template)
+			}
+			if !reflect.DeepEqual(values, eachTest.values) {
+				t.Errorf("Parse(%s) = %v, want %v", key, values, eachTest.values)
+			}
+		})
+	}
+}
+
+func TestKeyTemplateParseRejectsSources(t *testing.T) {
+	tests := []struct {
+		template string
+		key      string
+	}{
+		// Only the configured variants are derivatives
+		{defaultKeyTemplate, "my_photo.jpg"},
+		{defaultKeyTemplate, "uploads/xthumb_ben.jpg"},
+		{"{variant}_{key}", "my_photo.jpg"},
+		{"{variant}_{key}", "thumbnail_photo.jpg"},
+		// The extension must be present
+		{defaultKeyTemplate, "thumb_README"},
+		{"{dir}/derived/{name}-{variant}-{width}w.{ext}", "photos/derived/cat-thumb-wide.png"},
+		{"{dir}/derived/{name}-{variant}-{width}w.{ext}", "photos/cat-thumb-160w.png"},
+		{"derived/{hash:12}/{variant}.{ext}", "derived/not-a-hash/large.jpg"},
+	}
+	for _, eachTest := range tests {
+		template := mustKeyTemplate(t, eachTest.template)
+		if values, parsed := template.Parse(eachTest.key); parsed {
+			t.Errorf("%s parsed %s as %v", eachTest.template, eachTest.key, values)
+		}
+	}
+}
+
+func TestKeyTemplateAnyVariant(t *testing.T) {
+	template, err := parseKeyTemplate(defaultKeyTemplate, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	values, parsed := template.Parse("custom_ben.jpg")
+	if !parsed || values["variant"] != "custom" {
+		t.Errorf("Parse() = %v, %t, want the custom variant", values, parsed)
+	}
+}
+
+func TestParseKeyTemplateErrors(t *testing.T) {
+	for _, eachTemplate := range []string{
+		"",
+		"{variant",
+		"{unknown}_{key}",
+		"{hash:0}",
+		"{hash:65}",
+		"{width:4}",
+	} {
+		if _, err := parseKeyTemplate(eachTemplate, testVariants); err == nil {
+			t.Errorf("parseKeyTemplate(%q) succeeded", eachTemplate)
+		}
+	}
+}
+
+func TestKeyTemplateLiteralPrefix(t *testing.T) {
+	tests := []struct {
+		template string
+		want     string
+	}{
+		{defaultKeyTemplate, ""},
+		{"derived/{key}", "derived/"},
+		{"{dir}/derived/{name}.{ext}", ""},
+		{"static", "static"},
+	}
+	for _, eachTest := range tests {
+		if prefix := mustKeyTemplate(t, eachTest.template).LiteralPrefix(); prefix != eachTest.want {
+			t.Errorf("%s LiteralPrefix() = %q, want %q", eachTest.template, prefix, eachTest.want)
+		}
+	}
+}
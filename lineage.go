@@ -0,0 +1,200 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/rs/zerolog"
+)
+
+// lineageDerivative is a single object produced from an original
+type lineageDerivative struct {
+	Bucket  string `json:"bucket"`
+	Key     string `json:"key"`
+	Variant string `json:"variant"`
+}
+
+// lineage records every derivative produced from an original s.t. they can
+// all be deleted along with it, even after the derivative configuration
+// changes
+type lineage struct {
+	Bucket      string              `json:"bucket"`
+	Key         string              `json:"key"`
+	Derivatives []lineageDerivative `json:"derivatives"`
+	// ETag of the stored manifest, empty if it hasn't been written
+	ETag string `json:"-"`
+}
+
+// maxLineageAttempts bounds the number of times recordLineage rereads and
+// merges the manifest after another invocation updated it
+const maxLineageAttempts = 5
+
+// lineageKey returns the key of the lineage manifest for an original. The
+// manifest is routed like a derivative s.t. it's never stamped itself, and
+// is qualified by the source bucket s.t. originals with the same key in
+// different buckets don't share a manifest in the output bucket.
+func lineageKey(route *outputRoute, sourceBucket string, sourceKey string) string {
+	return fmt.Sprintf("%slineage_%s/%s.json", route.Prefix, sourceBucket, sourceKey)
+}
+
+// readLineage returns the lineage manifest of an original, or nil if none
+// has been recorded
+func readLineage(ctx context.Context,
+	svc *s3.S3,
+	route *outputRoute,
+	bucket string,
+	key string) (*lineage, error) {
+	result, err := svc.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(route.OutputBucket(bucket)),
+		Key:    aws.String(lineageKey(route, bucket, key)),
+	})
+	if err != nil {
+		if requestFailure, ok := err.(awserr.RequestFailure); ok &&
+			requestFailure.StatusCode() == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer result.Body.Close()
+	manifest := &lineage{}
+	err = json.NewDecoder(result.Body).Decode(manifest)
+	if err != nil {
+		return nil, fmt.Errorf("invalid lineage manifest for %s: %w", key, err)
+	}
+	manifest.ETag = aws.StringValue(result.ETag)
+	return manifest, nil
+}
+
+// writeLineage stores the manifest only if it's unchanged since it was read,
+// or still doesn't exist if it's new. Otherwise S3 rejects the write, see
+// isLineageConflict.
+func writeLineage(ctx context.Context,
+	svc *s3.S3,
+	route *outputRoute,
+	manifest *lineage) error {
+	body, err := json.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+	request, _ := svc.PutObjectRequest(&s3.PutObjectInput{
+		Body:        bytes.NewReader(body),
+		Bucket:      aws.String(route.OutputBucket(manifest.Bucket)),
+		Key:         aws.String(lineageKey(route, manifest.Bucket, manifest.Key)),
+		ContentType: aws.String("application/json"),
+	})
+	if manifest.ETag != "" {
+		request.HTTPRequest.Header.Set("If-Match", manifest.ETag)
+	} else {
+		request.HTTPRequest.Header.Set("If-None-Match", "*")
+	}
+	request.SetContext(ctx)
+	return request.Send()
+}
+
+// isLineageConflict returns true if a conditional manifest write failed
+// because another invocation wrote the manifest first
+func isLineageConflict(err error) bool {
+	requestFailure, ok := err.(awserr.RequestFailure)
+	return ok && (requestFailure.StatusCode() == http.StatusPreconditionFailed ||
+		requestFailure.StatusCode() == http.StatusConflict)
+}
+
+// recordLineage adds the derivatives to the original's lineage manifest.
+// Previously recorded derivatives are kept s.t. objects produced under an
+// earlier configuration are still cleaned up. Concurrent invocations for
+// the same original are merged rather than overwriting each other.
+func recordLineage(ctx context.Context,
+	svc *s3.S3,
+	route *outputRoute,
+	bucket string,
+	key string,
+	derivatives []lineageDerivative,
+	logger *zerolog.Logger) error {
+	for attempt := 1; ; attempt++ {
+		manifest, err := readLineage(ctx, svc, route, bucket, key)
+		if err != nil {
+			return err
+		}
+		if manifest == nil {
+			manifest = &lineage{Bucket: bucket, Key: key}
+		}
+		for _, eachDerivative := range derivatives {
+			recorded := false
+			for _, eachRecorded := range manifest.Derivatives {
+				if eachRecorded.Bucket == eachDerivative.Bucket &&
+					eachRecorded.Key == eachDerivative.Key {
+					recorded = true
+					break
+				}
+			}
+			if !recorded {
+				manifest.Derivatives = append(manifest.Derivatives, eachDerivative)
+			}
+		}
+		err = writeLineage(ctx, svc, route, manifest)
+		if isLineageConflict(err) && attempt < maxLineageAttempts {
+			logger.Warn().
+				Str("Key", lineageKey(route, bucket, key)).
+				Int("Attempt", attempt).
+				Msg("Lineage updated concurrently. Merging again")
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		logger.Info().
+			Str("Bucket", route.OutputBucket(bucket)).
+			Str("Key", lineageKey(route, bucket, key)).
+			Int("DerivativeCount", len(manifest.Derivatives)).
+			Msg("Recorded lineage")
+		return nil
+	}
+}
+
+// deleteDerivatives deletes every derivative recorded in the lineage of a
+// removed original, followed by the manifest itself
+func deleteDerivatives(ctx context.Context,
+	route *outputRoute,
+	bucket string,
+	key string,
+	logger *zerolog.Logger) error {
+	svc := newS3Client(logger)
+	manifest, err := readLineage(ctx, svc, route, bucket, key)
+	if err != nil {
+		return err
+	}
+	if manifest == nil {
+		// Without a manifest the derivative keys, and whether this function
+		// may delete them, are unknown
+		logger.Warn().
+			Str("Bucket", bucket).
+			Str("Key", key).
+			Msg("No lineage recorded. Leaving derivatives in place")
+		return nil
+	}
+	for _, eachDerivative := range manifest.Derivatives {
+		_, err = svc.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+			Bucket: aws.String(eachDerivative.Bucket),
+			Key:    aws.String(eachDerivative.Key),
+		})
+		if err != nil {
+			return err
+		}
+		logger.Info().
+			Str("Bucket", eachDerivative.Bucket).
+			Str("Key", eachDerivative.Key).
+			Str("Variant", eachDerivative.Variant).
+			Msg("Deleted derivative")
+	}
+	_, err = svc.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(route.OutputBucket(bucket)),
+		Key:    aws.String(lineageKey(route, bucket, key)),
+	})
+	return err
+}
@@ -0,0 +1,205 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/rs/zerolog"
+)
+
+// conditionalStore is an S3 endpoint for a single object that honors
+// If-Match and If-None-Match on PUT. Before each write is applied,
+// beforeWrite can replace the object to simulate a concurrent invocation.
+type conditionalStore struct {
+	mutex       sync.Mutex
+	body        []byte
+	version     int
+	puts        int
+	beforeWrite func(store *conditionalStore)
+}
+
+func (store *conditionalStore) etag() string {
+	return fmt.Sprintf(`"v%d"`, store.version)
+}
+
+func (store *conditionalStore) set(body []byte) {
+	store.body = body
+	store.version++
+}
+
+func (store *conditionalStore) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	switch request.Method {
+	case http.MethodGet:
+		if store.body == nil {
+			writer.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(writer, `<Error><Code>NoSuchKey</Code></Error>`)
+			return
+		}
+		writer.Header().Set("ETag", store.etag())
+		writer.Write(store.body)
+	case http.MethodPut:
+		body, _ := ioutil.ReadAll(request.Body)
+		store.puts++
+		if store.beforeWrite != nil {
+			store.beforeWrite(store)
+		}
+		ifMatch := request.Header.Get("If-Match")
+		ifNoneMatch := request.Header.Get("If-None-Match")
+		if (ifMatch != "" && (store.body == nil || ifMatch != store.etag())) ||
+			(ifNoneMatch == "*" && store.body != nil) {
+			writer.WriteHeader(http.StatusPreconditionFailed)
+			fmt.Fprint(writer, `<Error><Code>PreconditionFailed</Code></Error>`)
+			return
+		}
+		store.set(body)
+		writer.Header().Set("ETag", store.etag())
+	default:
+		writer.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func testS3(t *testing.T, handler http.Handler) *s3.S3 {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	awsSession := session.Must(session.NewSession(&aws.Config{
+		Endpoint:         aws.String(server.URL),
+		Region:           aws.String("us-west-2"),
+		Credentials:      credentials.NewStaticCredentials("id", "secret", ""),
+		S3ForcePathStyle: aws.Bool(true),
+		MaxRetries:       aws.Int(0),
+	}))
+	return s3.New(awsSession)
+}
+
+func TestRecordLineageMergesConcurrentWrites(t *testing.T) {
+	logger := zerolog.Nop()
+	route := &outputRoute{Bucket: "derivatives", Template: mustKeyTemplate(t, defaultKeyTemplate)}
+	concurrent := lineageDerivative{Bucket: "derivatives", Key: "thumb_photo.jpg", Variant: "thumb"}
+	tests := []struct {
+		name     string
+		existing []lineageDerivative
+	}{
+		{"new manifest", nil},
+		{"existing manifest", []lineageDerivative{
+			{Bucket: "derivatives", Key: "old_photo.jpg", Variant: "old"},
+		}},
+	}
+	for _, eachTest := range tests {
+		t.Run(eachTest.name, func(t *testing.T) {
+			store := &conditionalStore{}
+			if eachTest.existing != nil {
+				body, _ := json.Marshal(&lineage{
+					Bucket:      "uploads",
+					Key:         "photo.jpg",
+					Derivatives: eachTest.existing,
+				})
+				store.set(body)
+			}
+			// Another invocation records its derivative between this one's
+			// read and write
+			store.beforeWrite = func(store *conditionalStore) {
+				store.beforeWrite = nil
+				manifest := &lineage{Bucket: "uploads", Key: "photo.jpg"}
+				if store.body != nil {
+					json.Unmarshal(store.body, manifest)
+				}
+				manifest.Derivatives = append(manifest.Derivatives, concurrent)
+				body, _ := json.Marshal(manifest)
+				store.set(body)
+			}
+			svc := testS3(t, store)
+			recorded := lineageDerivative{Bucket: "derivatives", Key: "large_photo.jpg", Variant: "large"}
+			err := recordLineage(aws.BackgroundContext(),
+				svc,
+				route,
+				"uploads",
+				"photo.jpg",
+				[]lineageDerivative{recorded},
+				&logger)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if store.puts != 2 {
+				t.Errorf("recordLineage made %d writes, want 2", store.puts)
+			}
+			manifest := &lineage{}
+			if err := json.Unmarshal(store.body, manifest); err != nil {
+				t.Fatal(err)
+			}
+			want := append(append([]lineageDerivative{}, eachTest.existing...), concurrent, recorded)
+			if fmt.Sprint(manifest.Derivatives) != fmt.Sprint(want) {
+				t.Errorf("Derivatives = %v, want %v", manifest.Derivatives, want)
+			}
+		})
+	}
+}
+
+func TestRecordLineageGivesUp(t *testing.T) {
+	logger := zerolog.Nop()
+	route := &outputRoute{Bucket: "derivatives", Template: mustKeyTemplate(t, defaultKeyTemplate)}
+	store := &conditionalStore{}
+	var beforeWrite func(store *conditionalStore)
+	beforeWrite = func(store *conditionalStore) {
+		store.set([]byte(`{"bucket":"uploads","key":"photo.jpg"}`))
+		store.beforeWrite = beforeWrite
+	}
+	store.beforeWrite = beforeWrite
+	err := recordLineage(aws.BackgroundContext(),
+		testS3(t, store),
+		route,
+		"uploads",
+		"photo.jpg",
+		[]lineageDerivative{{Bucket: "derivatives", Key: "large_photo.jpg", Variant: "large"}},
+		&logger)
+	if !isLineageConflict(err) {
+		t.Errorf("recordLineage() = %v, want a conflict", err)
+	}
+	if store.puts != maxLineageAttempts {
+		t.Errorf("recordLineage made %d writes, want %d", store.puts, maxLineageAttempts)
+	}
+}
+
+func TestLineageKeepsSourceBucketsApart(t *testing.T) {
+	logger := zerolog.Nop()
+	route := &outputRoute{Bucket: "derivatives", Template: mustKeyTemplate(t, defaultKeyTemplate)}
+	svc := testS3(t, newObjectStore())
+	sourceBuckets := []string{"uploads-east", "uploads-west"}
+	for _, eachBucket := range sourceBuckets {
+		err := recordLineage(aws.BackgroundContext(),
+			svc,
+			route,
+			eachBucket,
+			"photo.jpg",
+			[]lineageDerivative{{Bucket: "derivatives", Key: eachBucket + "_photo.jpg", Variant: "large"}},
+			&logger)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !route.IsOutput("derivatives", lineageKey(route, eachBucket, "photo.jpg")) {
+			t.Errorf("lineage manifest of %s isn't an output", eachBucket)
+		}
+	}
+	for _, eachBucket := range sourceBuckets {
+		manifest, err := readLineage(aws.BackgroundContext(), svc, route, eachBucket, "photo.jpg")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if manifest == nil ||
+			manifest.Bucket != eachBucket ||
+			len(manifest.Derivatives) != 1 ||
+			manifest.Derivatives[0].Key != eachBucket+"_photo.jpg" {
+			t.Errorf("lineage of %s/photo.jpg = %+v", eachBucket, manifest)
+		}
+	}
+}
@@ -6,10 +6,13 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -17,6 +20,7 @@ import (
 	awsLambdaContext "github.com/aws/aws-lambda-go/lambdacontext"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	sparta "github.com/mweagle/Sparta"
 	spartaAWS "github.com/mweagle/Sparta/aws"
 	spartaAPIGateway "github.com/mweagle/Sparta/aws/apigateway"
@@ -53,35 +57,234 @@ func s3ARNParamValue(keyName string, defaultValue string) string {
 var s3EventBroadcasterBucket = s3ARNParamValue("SPARTA_S3_TEST_BUCKET",
 	"arn:aws:s3:::PublicS3Bucket")
 
+// transformPrefix is the default output prefix for derivatives written to
+// the upload's bucket
 const transformPrefix = "xformed_"
 
-func stampImage(bucket string, key string, logger *zerolog.Logger) error {
+// newS3Client returns the S3 client that events are handled with. Tests
+// replace it to serve S3 locally.
+var newS3Client = func(logger *zerolog.Logger) *s3.S3 {
+	return s3.New(spartaAWS.NewSession(logger))
+}
+
+// quarantineTagKey is the S3 object tag applied to uploads that are
+// rejected before decoding
+const quarantineTagKey = "SpartaImagerQuarantine"
+
+// quarantineReason returns the quarantine tag value for err, or the empty
+// string if the upload should not be quarantined
+func quarantineReason(err error) string {
+	switch {
+	case errors.Is(err, transforms.ErrImageTooLarge):
+		return "image-too-large"
+	case errors.Is(err, transforms.ErrUnsupportedFormat):
+		return "unsupported-format"
+	default:
+		return ""
+	}
+}
+
+// quarantineImage tags the rejected upload s.t. it can be found and
+// reviewed without the lambda failing and retrying the event
+func quarantineImage(bucket string, key string, reason string, logger *zerolog.Logger) error {
+	svc := newS3Client(logger)
+	_, err := svc.PutObjectTagging(&s3.PutObjectTaggingInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+		Tagging: &s3.Tagging{
+			TagSet: []*s3.Tag{
+				{
+					Key:   aws.String(quarantineTagKey),
+					Value: aws.String(reason),
+				},
+			},
+		},
+	})
+	return err
+}
 
-	// Only transform if the key doesn't have the _xformed part
-	if !strings.Contains(key, transformPrefix) {
-		awsSession := spartaAWS.NewSession(logger)
-		svc := s3.New(awsSession)
-		result, err := svc.GetObject(&s3.GetObjectInput{
+// deadlineMargin is reserved from the Lambda deadline s.t. an image that
+// can't be stamped in time is abandoned while there's still time to abort
+// its uploads and log the failure
+const deadlineMargin = 3 * time.Second
+
+// errStampTimeout is returned when an image can't be stamped before the
+// Lambda deadline
+var errStampTimeout = errors.New("timed out stamping image")
+
+// uploadConcurrency bounds the number of multipart upload parts buffered
+// in memory at once for each derivative
+const uploadConcurrency = 2
+
+// uploadRendering encodes the rendering into a multipart upload. Encoding
+// runs concurrently with the upload through a pipe, so the encoded image is
+// never held in memory in full.
+func uploadRendering(ctx context.Context,
+	uploader *s3manager.Uploader,
+	rendering *transforms.Rendering,
+	bucket string,
+	key string,
+	metadata map[string]string) error {
+	objectMetadata := make(map[string]string, len(rendering.Annotations)+len(metadata))
+	for eachKey, eachValue := range rendering.Annotations {
+		objectMetadata[eachKey] = eachValue
+	}
+	for eachKey, eachValue := range metadata {
+		objectMetadata[eachKey] = eachValue
+	}
+	reader, writer := io.Pipe()
+	encodeErrs := make(chan error, 1)
+	go func() {
+		_, err := rendering.WriteTo(writer)
+		writer.CloseWithError(err)
+		encodeErrs <- err
+	}()
+	_, uploadErr := uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+		Body:        reader,
+		Bucket:      aws.String(bucket),
+		Key:         aws.String(key),
+		ContentType: aws.String(rendering.Format.ContentType()),
+		Metadata:    aws.StringMap(objectMetadata),
+	})
+	// Unblock the encoder if the upload stopped reading early
+	reader.CloseWithError(uploadErr)
+	encodeErr := <-encodeErrs
+	if encodeErr != nil {
+		return encodeErr
+	}
+	return uploadErr
+}
+
+// forcedUpload returns true if the upload's x-amz-meta-force metadata asks
+// for it to be reprocessed even though its derivatives are up to date.
+// Invalid values are logged and ignored.
+func forcedUpload(metadata map[string]*string, logger *zerolog.Logger) bool {
+	value, exists := userMetadata(metadata, forceMetadataKey)
+	if !exists {
+		return false
+	}
+	force, forceErr := strconv.ParseBool(value)
+	if forceErr != nil {
+		logger.Warn().
+			Str("Force", value).
+			Msg("Ignoring invalid x-amz-meta-force metadata")
+		return false
+	}
+	return force
+}
+
+func stampImage(ctx context.Context, bucket string, key string, logger *zerolog.Logger) error {
+
+	// Only transform uploads, not derivatives written back to the bucket
+	route := &imagerConfig.Output
+	if !route.IsOutput(bucket, key) {
+		svc := newS3Client(logger)
+		head, err := svc.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
 			Bucket: aws.String(bucket),
 			Key:    aws.String(key),
 		})
 		if nil != err {
 			return err
 		}
+		revision := &sourceRevision{
+			ETag:      strings.Trim(aws.StringValue(head.ETag), `"`),
+			VersionID: aws.StringValue(head.VersionId),
+		}
+
+		imageTenant := imageTenants.Resolve(bucket, key, head.Metadata)
+		// Derivatives record the watermark content, s.t. replacing the
+		// watermark in place reprocesses them
+		watermarkHash, err := watermarkDigest(ctx, imageTenant.Options, logger)
+		if err != nil {
+			return err
+		}
+		force := imageTenant.Options.Force || forcedUpload(head.Metadata, logger)
+		logger.Info().
+			Str("Tenant", imageTenant.Name).
+			Str("Watermark", imageTenant.Options.Watermark).
+			Str("WatermarkDigest", watermarkHash).
+			Str("ETag", revision.ETag).
+			Str("VersionID", revision.VersionID).
+			Bool("Force", force).
+			Msg("Resolved tenant")
+
+		// S3 delivers events at least once. Skip the download entirely if
+		// a redelivered event's derivatives are already up to date.
+		pending := imageTenant.Derivatives
+		if !force {
+			pending, err = staleDerivatives(ctx,
+				svc,
+				route,
+				bucket,
+				key,
+				revision,
+				watermarkHash,
+				imageTenant.Derivatives,
+				logger)
+			if err != nil {
+				return err
+			}
+			if len(pending) == 0 {
+				logger.Info().Msg("Derivatives up to date")
+				return nil
+			}
+		}
+
+		// Pin the revision the derivatives are recorded against
+		result, err := svc.GetObjectWithContext(ctx, &s3.GetObjectInput{
+			Bucket:  aws.String(bucket),
+			Key:     aws.String(key),
+			IfMatch: head.ETag,
+		})
+		if nil != err {
+			return err
+		}
 		defer result.Body.Close()
 
-		transformed, transformedErr := transforms.StampImage(result.Body, logger)
-		if transformedErr != nil {
-			return transformedErr
+		// Decode once and share the source with every derivative pipeline
+		source, decodeErr := transforms.DecodeWithLimits(ctx,
+			result.Body,
+			&imageTenant.Options.Limits,
+			&imageTenant.Options.Metadata,
+			logger)
+		if decodeErr != nil {
+			return decodeErr
 		}
-		// Put the encoded image to a byte buffer, then wrap a reader around it.
-		_, uploadResultErr := svc.PutObject(&s3.PutObjectInput{
-			Body:   transformed,
-			Bucket: aws.String(bucket),
-			Key:    aws.String(fmt.Sprintf("%s%s", transformPrefix, key)),
+		uploader := s3manager.NewUploaderWithClient(svc, func(uploader *s3manager.Uploader) {
+			uploader.Concurrency = uploadConcurrency
 		})
-		if uploadResultErr != nil {
-			return uploadResultErr
+		derivatives := make([]lineageDerivative, 0, len(pending))
+		for _, eachDerivative := range pending {
+			rendering, renderingErr := eachDerivative.Pipeline.Render(ctx, source, logger)
+			if renderingErr != nil {
+				return renderingErr
+			}
+			derivativeKey := eachDerivative.Key(key, source)
+			uploadResultErr := uploadRendering(ctx,
+				uploader,
+				rendering,
+				route.OutputBucket(bucket),
+				derivativeKey,
+				eachDerivative.Metadata(revision, watermarkHash))
+			if uploadResultErr != nil {
+				return uploadResultErr
+			}
+			logger.Info().
+				Str("Variant", eachDerivative.Variant).
+				Int("MaxEdge", eachDerivative.MaxEdge).
+				Str("Bucket", route.OutputBucket(bucket)).
+				Str("Key", derivativeKey).
+				Str("Format", string(rendering.Format)).
+				Msg("Derivative uploaded")
+			derivatives = append(derivatives, lineageDerivative{
+				Bucket:  route.OutputBucket(bucket),
+				Key:     derivativeKey,
+				Variant: eachDerivative.Variant,
+			})
+		}
+		lineageErr := recordLineage(ctx, svc, route, bucket, key, derivatives, logger)
+		if lineageErr != nil {
+			return lineageErr
 		}
 	} else {
 		logger.Info().Msg("File already transformed")
@@ -99,17 +302,56 @@ func transformImage(ctx context.Context,
 		Int("RecordCount", len(event.Records)).
 		Msg("Request received 👍")
 
+	// Leave enough time to clean up before Lambda stops the invocation
+	stampCtx := ctx
+	if deadline, hasDeadline := ctx.Deadline(); hasDeadline {
+		var cancel context.CancelFunc
+		stampCtx, cancel = context.WithDeadline(ctx, deadline.Add(-deadlineMargin))
+		defer cancel()
+	}
+
 	responses := make([]transformedResponse, 0)
 
 	for _, eachRecord := range event.Records {
 		// What happened?
-		switch eachRecord.EventName {
-		case "ObjectCreated:Put":
+		switch s3EventActionFor(eachRecord.EventName) {
+		case s3EventCreated:
 			{
 				// Make sure the Name and Key are URL decoded. Spaces are + encoded
-				unescapedKeyName, _ := url.QueryUnescape(eachRecord.S3.Bucket.Name)
-				unescapedBucketName, _ := url.QueryUnescape(eachRecord.S3.Object.Key)
-				stampErr := stampImage(unescapedKeyName, unescapedBucketName, logger)
+				unescapedBucketName, _ := url.QueryUnescape(eachRecord.S3.Bucket.Name)
+				unescapedKeyName, _ := url.QueryUnescape(eachRecord.S3.Object.Key)
+				textCtx := transforms.ContextWithTextData(stampCtx, &transforms.TextData{
+					Date:     eachRecord.EventTime,
+					Uploader: eachRecord.PrincipalID.PrincipalID,
+					Bucket:   unescapedBucketName,
+					Key:      unescapedKeyName,
+				})
+				stampErr := stampImage(textCtx, unescapedBucketName, unescapedKeyName, logger)
+				if reason := quarantineReason(stampErr); reason != "" {
+					logger.Warn().
+						Err(stampErr).
+						Str("Bucket", unescapedBucketName).
+						Str("Key", unescapedKeyName).
+						Str("Reason", reason).
+						Msg("Quarantining image")
+					quarantineErr := quarantineImage(unescapedBucketName, unescapedKeyName, reason, logger)
+					if quarantineErr != nil {
+						logger.Error().
+							Err(quarantineErr).
+							Msg("Failed to quarantine image")
+					}
+					continue
+				}
+				if stampErr != nil && stampCtx.Err() == context.DeadlineExceeded {
+					logger.Error().
+						Err(stampErr).
+						Str("Bucket", unescapedBucketName).
+						Str("Key", unescapedKeyName).
+						Dur("Margin", deadlineMargin).
+						Msg("Timed out stamping image")
+					timeoutErr := fmt.Errorf("%w: %s", errStampTimeout, unescapedKeyName)
+					return nil, spartaAPIGateway.NewErrorResponse(http.StatusGatewayTimeout, timeoutErr)
+				}
 				if stampErr != nil {
 					return nil, spartaAPIGateway.NewErrorResponse(http.StatusInternalServerError, stampErr)
 				}
@@ -118,26 +360,34 @@ func transformImage(ctx context.Context,
 					Interface("Item", eachRecord.S3.Object.Key).
 					Msg("Image stamped")
 				responses = append(responses, transformedResponse{
-					Bucket: unescapedKeyName,
+					Bucket: unescapedBucketName,
 					Key:    unescapedKeyName,
 				})
 			}
-		case "s3:ObjectRemoved:Delete":
+		case s3EventRemoved:
 			{
-				deleteKey := fmt.Sprintf("%s%s", transformPrefix, eachRecord.S3.Object.Key)
-				awsSession := spartaAWS.NewSession(logger)
-				svc := s3.New(awsSession)
-
-				params := &s3.DeleteObjectInput{
-					Bucket: aws.String(eachRecord.S3.Bucket.Name),
-					Key:    aws.String(deleteKey),
-				}
-				deleteObj, deleteObjErr := svc.DeleteObject(params)
-				if deleteObjErr != nil {
+				unescapedBucketName, _ := url.QueryUnescape(eachRecord.S3.Bucket.Name)
+				unescapedKeyName, _ := url.QueryUnescape(eachRecord.S3.Object.Key)
+				// Removing a derivative doesn't cascade
+				if imagerConfig.Output.IsOutput(unescapedBucketName, unescapedKeyName) {
 					logger.Info().
-						Interface("Response", deleteObj).
-						Msg("Deleted object")
+						Str("Key", unescapedKeyName).
+						Msg("Derivative removed")
+					continue
 				}
+				deleteErr := deleteDerivatives(stampCtx,
+					&imagerConfig.Output,
+					unescapedBucketName,
+					unescapedKeyName,
+					logger)
+				if deleteErr != nil {
+					return nil, spartaAPIGateway.NewErrorResponse(http.StatusInternalServerError, deleteErr)
+				}
+				logger.Info().
+					Str("Bucket", unescapedBucketName).
+					Str("Key", unescapedKeyName).
+					Str("Event", eachRecord.EventName).
+					Msg("Derivatives deleted")
 			}
 		default:
 			{
@@ -165,8 +415,7 @@ func s3ItemInfo(ctx context.Context,
 		Key:    aws.String(apigRequest.QueryParams["keyName"]),
 	}
 
-	awsSession := spartaAWS.NewSession(logger)
-	svc := s3.New(awsSession)
+	svc := newS3Client(logger)
 	result, err := svc.GetObject(getObjectInput)
 	if nil != err {
 		return spartaAPIGateway.NewResponse(http.StatusNotFound, map[string]string{
@@ -195,22 +444,63 @@ func imagerFunctions(api *sparta.API) ([]*sparta.LambdaAWSInfo, error) {
 	// Provision an IAM::Role as part of this application
 	var iamRole = sparta.IAMRoleDefinition{}
 
+	// Derivatives must not land where they'd trigger the lambda again
+	route := &imagerConfig.Output
+	sourceBucket := strings.TrimPrefix(s3EventBroadcasterBucket, "arn:aws:s3:::")
+	routeErr := route.Validate(sourceBucket)
+	if routeErr != nil {
+		return nil, routeErr
+	}
+	outputBucketArn := fmt.Sprintf("arn:aws:s3:::%s", route.OutputBucket(sourceBucket))
+
 	// Setup the ARN that includes all child keys
 	resourceArn := fmt.Sprintf("%s/*", s3EventBroadcasterBucket)
 	iamRole.Privileges = append(iamRole.Privileges, sparta.IAMRolePrivilege{
 		Actions: []string{"s3:GetObject",
-			"s3:PutObject",
+			"s3:PutObjectTagging",
 		},
 		Resource: resourceArn,
 	})
+	// Derivatives and lineage manifests are only written under the output
+	// prefix
+	iamRole.Privileges = append(iamRole.Privileges, sparta.IAMRolePrivilege{
+		Actions: []string{"s3:GetObject",
+			"s3:PutObject",
+			"s3:AbortMultipartUpload",
+			"s3:DeleteObject",
+		},
+		Resource: fmt.Sprintf("%s/%s*", outputBucketArn, route.Prefix),
+	})
+	// Missing lineage manifests are only reported as 404s, rather than
+	// 403s, with list access
+	iamRole.Privileges = append(iamRole.Privileges, sparta.IAMRolePrivilege{
+		Actions:  []string{"s3:ListBucket"},
+		Resource: outputBucketArn,
+	})
+	// Grant read access to externally hosted watermarks
+	for _, eachSource := range imageTenants.WatermarkSources() {
+		if eachSource.Bucket != "" {
+			iamRole.Privileges = append(iamRole.Privileges, sparta.IAMRolePrivilege{
+				Actions: []string{"s3:GetObject"},
+				Resource: fmt.Sprintf("arn:aws:s3:::%s/%s*",
+					eachSource.Bucket,
+					eachSource.Prefix),
+			})
+		}
+	}
 	var lambdaFunctions []*sparta.LambdaAWSInfo
 
 	// The default timeout is 3 seconds - increase that to 30 seconds s.t. the
 	// transform lambda doesn't fail early.
+	transformMemory, transformMemoryErr := memorySize()
+	if transformMemoryErr != nil {
+		return nil, transformMemoryErr
+	}
 	transformOptions := &sparta.LambdaFunctionOptions{
 		Description: "Stamp assets in S3",
-		MemorySize:  512,
+		MemorySize:  int64(transformMemory),
 		Timeout:     20,
+		Environment: imagerEnvironment(),
 	}
 	lambdaFn, _ := sparta.NewAWSLambda(sparta.LambdaName(transformImage),
 		transformImage,
@@ -225,6 +515,7 @@ func imagerFunctions(api *sparta.API) ([]*sparta.LambdaAWSInfo, error) {
 			SourceArn: s3EventBroadcasterBucket,
 		},
 		Events: []string{"s3:ObjectCreated:*", "s3:ObjectRemoved:*"},
+		Filter: route.NotificationFilter(),
 	})
 	lambdaFunctions = append(lambdaFunctions, lambdaFn)
 
@@ -236,6 +527,12 @@ func imagerFunctions(api *sparta.API) ([]*sparta.LambdaAWSInfo, error) {
 		Actions:  []string{"s3:GetObject"},
 		Resource: resourceArn,
 	})
+	if route.OutputBucket(sourceBucket) != sourceBucket {
+		iamDynamicRole.Privileges = append(iamDynamicRole.Privileges, sparta.IAMRolePrivilege{
+			Actions:  []string{"s3:GetObject"},
+			Resource: fmt.Sprintf("%s/*", outputBucketArn),
+		})
+	}
 
 	s3ItemInfoLambdaFn, _ := sparta.NewAWSLambda(sparta.LambdaName(s3ItemInfo),
 		s3ItemInfo,
@@ -261,17 +558,28 @@ func imagerFunctions(api *sparta.API) ([]*sparta.LambdaAWSInfo, error) {
 }
 
 func main() {
+	// Warm the watermark caches at cold start rather than on the first
+	// upload. Provisioning doesn't need them.
+	if os.Getenv("AWS_LAMBDA_FUNCTION_NAME") != "" {
+		logger := zerolog.New(os.Stderr).With().Timestamp().Logger()
+		imageTenants.PreloadWatermarks(context.Background(), &logger)
+	}
 	apiStage := sparta.NewStage("v1")
 	apiGateway := sparta.NewAPIGateway("SpartaImagerAPI", apiStage)
 	apiGateway.CORSEnabled = true
 	funcs, err := imagerFunctions(apiGateway)
 	stackName := spartaCF.UserScopedStackName("SpartaImager")
 
-	if err == nil {
-		sparta.Main(stackName,
-			"This is a sample Sparta application",
-			funcs,
-			apiGateway,
-			nil)
+	if err != nil {
+		logger := zerolog.New(os.Stderr).With().Timestamp().Logger()
+		logger.Error().
+			Err(err).
+			Msg("Invalid configuration")
+		os.Exit(1)
 	}
+	sparta.Main(stackName,
+		"This is a sample Sparta application",
+		funcs,
+		apiGateway,
+		nil)
 }
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+const (
+	// envInputPrefix scopes the S3 notifications to uploads under the prefix
+	envInputPrefix = "SPARTA_IMAGER_INPUT_PREFIX"
+	// defaultInputPrefix scopes the notifications when derivatives are
+	// written to the upload's bucket and envInputPrefix isn't set
+	defaultInputPrefix = "incoming/"
+	// envOutputBucket is the bucket derivatives are written to. Defaults to
+	// the bucket of the upload.
+	envOutputBucket = "SPARTA_IMAGER_OUTPUT_BUCKET"
+	// envOutputPrefix prefixes every derivative key. Defaults to
+	// transformPrefix for derivatives written to the upload's bucket.
+	envOutputPrefix = "SPARTA_IMAGER_OUTPUT_PREFIX"
+)
+
+// outputRoute determines where the derivatives of an upload are written
+type outputRoute struct {
+	// InputPrefix limits the S3 notifications to keys with the prefix
+	InputPrefix string
+	// Bucket is the derivative bucket. Empty writes derivatives to the
+	// upload's bucket.
+	Bucket string
+	// Prefix is prepended to every derivative and lineage manifest key
+	Prefix string
+	// Template lays out derivative keys below the Prefix
+	Template *keyTemplate
+}
+
+// parseOutputRoute returns the derivative routing configuration
+func parseOutputRoute() (outputRoute, error) {
+	route := outputRoute{
+		Bucket: strings.TrimPrefix(os.Getenv(envOutputBucket), "arn:aws:s3:::"),
+	}
+	inputPrefix, inputPrefixExists := os.LookupEnv(envInputPrefix)
+	if !inputPrefixExists && route.Bucket == "" {
+		// Keep derivatives written to the upload's bucket out of the
+		// notifications
+		inputPrefix = defaultInputPrefix
+	}
+	route.InputPrefix = inputPrefix
+	prefix, prefixExists := os.LookupEnv(envOutputPrefix)
+	if !prefixExists {
+		// Derivatives in a dedicated bucket don't need to be distinguished
+		// from uploads
+		if route.Bucket == "" {
+			prefix = transformPrefix
+		}
+	}
+	route.Prefix = prefix
+	entries, entriesErr := parseDerivativeEntries(envValue(envDerivatives, defaultDerivatives))
+	if entriesErr != nil {
+		return route, entriesErr
+	}
+	variants := make([]string, len(entries))
+	for index, eachEntry := range entries {
+		variants[index] = eachEntry.Variant
+	}
+	template, templateErr := parseKeyTemplate(envValue(envKeyTemplate, defaultKeyTemplate), variants)
+	if templateErr != nil {
+		return route, templateErr
+	}
+	route.Template = template
+	if route.Bucket == "" && route.Prefix == "" && !template.HasLiteral() {
+		return route, fmt.Errorf("%s is required unless %s is set",
+			envOutputPrefix,
+			envOutputBucket)
+	}
+	return route, nil
+}
+
+// OutputBucket returns the bucket the derivatives of an upload in
+// sourceBucket are written to
+func (route *outputRoute) OutputBucket(sourceBucket string) string {
+	if route.Bucket == "" {
+		return sourceBucket
+	}
+	return route.Bucket
+}
+
+// IsOutput returns true if the object was written by this route rather than
+// uploaded, and so must not be stamped
+func (route *outputRoute) IsOutput(bucket string, key string) bool {
+	if route.OutputBucket(bucket) != bucket || !strings.HasPrefix(key, route.Prefix) {
+		return false
+	}
+	relativeKey := strings.TrimPrefix(key, route.Prefix)
+	if strings.HasPrefix(relativeKey, "lineage_") && strings.HasSuffix(relativeKey, ".json") {
+		return true
+	}
+	_, isDerivative := route.Template.Parse(relativeKey)
+	return isDerivative
+}
+
+// Key returns the key of a derivative with the fields
+func (route *outputRoute) Key(fields *keyFields) string {
+	return route.Prefix + route.Template.Expand(fields)
+}
+
+// Validate ensures derivatives written to the notifying sourceBucket can't
+// trigger further notifications. Derivatives in the same bucket require an
+// InputPrefix that no derivative key can start with, s.t. IsOutput is never
+// the only safeguard. Derivative keys start with the Prefix followed by the
+// template's literal prefix.
+func (route *outputRoute) Validate(sourceBucket string) error {
+	if route.OutputBucket(sourceBucket) != sourceBucket {
+		return nil
+	}
+	if route.InputPrefix == "" {
+		return fmt.Errorf("%s or %s is required when derivatives are written to %s",
+			envOutputBucket,
+			envInputPrefix,
+			sourceBucket)
+	}
+	// Two prefixes match a common key iff one is a prefix of the other
+	outputPrefix := route.Prefix + route.Template.LiteralPrefix()
+	if strings.HasPrefix(outputPrefix, route.InputPrefix) ||
+		strings.HasPrefix(route.InputPrefix, outputPrefix) {
+		return fmt.Errorf("derivative keys starting %q must not overlap %s %q",
+			outputPrefix,
+			envInputPrefix,
+			route.InputPrefix)
+	}
+	return nil
+}
+
+// NotificationFilter returns the S3 notification filter that limits events
+// to the InputPrefix
+func (route *outputRoute) NotificationFilter() s3.NotificationConfigurationFilter {
+	if route.InputPrefix == "" {
+		return s3.NotificationConfigurationFilter{}
+	}
+	return s3.NotificationConfigurationFilter{
+		Key: &s3.KeyFilter{
+			FilterRules: []*s3.FilterRule{
+				{
+					Name:  aws.String("prefix"),
+					Value: aws.String(route.InputPrefix),
+				},
+			},
+		},
+	}
+}
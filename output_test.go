@@ -0,0 +1,40 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestOutputRouteValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		route    outputRoute
+		template string
+		valid    bool
+	}{
+		{"output bucket", outputRoute{Bucket: "derivatives"}, "", true},
+		{"same bucket without input prefix", outputRoute{Prefix: transformPrefix}, "", false},
+		{"same bucket named explicitly", outputRoute{Bucket: "uploads", Prefix: transformPrefix}, "", false},
+		{"disjoint prefixes", outputRoute{InputPrefix: "uploads/", Prefix: transformPrefix}, "", true},
+		{"output within input", outputRoute{InputPrefix: "uploads/", Prefix: "uploads/derivatives/"}, "", false},
+		{"input within output", outputRoute{InputPrefix: "xformed_uploads/", Prefix: transformPrefix}, "", false},
+		{"equal prefixes", outputRoute{InputPrefix: "photos/", Prefix: "photos/"}, "", false},
+		{"empty output prefix", outputRoute{InputPrefix: "uploads/"}, "", false},
+		{"template literal", outputRoute{InputPrefix: "uploads/"}, "derived/{key}", true},
+		{"template literal within input", outputRoute{InputPrefix: "uploads/"}, "uploads/derived/{key}", false},
+		{"template literal after prefix", outputRoute{InputPrefix: "xformed_uploads/", Prefix: transformPrefix}, "thumbs/{key}", true},
+	}
+	for _, eachTest := range tests {
+		t.Run(eachTest.name, func(t *testing.T) {
+			route := eachTest.route
+			template := eachTest.template
+			if template == "" {
+				template = defaultKeyTemplate
+			}
+			route.Template = mustKeyTemplate(t, template)
+			err := route.Validate("uploads")
+			if (err == nil) != eachTest.valid {
+				t.Errorf("Validate() = %v, want valid %t", err, eachTest.valid)
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/mweagle/SpartaImager/transforms"
+	"github.com/rs/zerolog"
+)
+
+// recipeVersion is bumped whenever a transform change alters the output of
+// an unchanged configuration, s.t. existing derivatives are reprocessed
+const recipeVersion = 1
+
+// Derivative metadata keys that record what the derivative was produced
+// from. S3 stores them as x-amz-meta-* headers.
+const (
+	metadataSourceETag    = "source-etag"
+	metadataSourceVersion = "source-version-id"
+	metadataRecipe        = "recipe"
+)
+
+// derivativeRecipe is everything other than the source that determines a
+// derivative's content and key
+type derivativeRecipe struct {
+	Version         int
+	Variant         string
+	MaxEdge         int
+	Encode          transforms.EncodeOptions
+	Stamp           transforms.StampOptions
+	Scale           transforms.WatermarkScale
+	Watermark       string
+	WatermarkSource string
+	Text            *transforms.TextOptions
+	Metadata        transforms.MetadataPolicy
+	OutputBucket    string
+	OutputPrefix    string
+	KeyTemplate     string
+}
+
+// recipeHash returns the hex SHA-256 of the derivative recipe. The
+// watermark is named rather than hashed here, as its content can change
+// while the lambda is warm, see watermarkDigest.
+func recipeHash(variant string, maxEdge int, options *imagerOptions) (string, error) {
+	recipe := derivativeRecipe{
+		Version:   recipeVersion,
+		Variant:   variant,
+		MaxEdge:   maxEdge,
+		Encode:    options.Encode,
+		Stamp:     options.Stamp,
+		Scale:     options.Scale,
+		Watermark: options.Watermark,
+		WatermarkSource: fmt.Sprintf("%s/%s/%s",
+			options.WatermarkSource.Bucket,
+			options.WatermarkSource.Prefix,
+			options.WatermarkSource.Directory),
+		Text:         options.Text,
+		Metadata:     options.Metadata,
+		OutputBucket: options.Output.Bucket,
+		OutputPrefix: options.Output.Prefix,
+		KeyTemplate:  options.Output.Template.String(),
+	}
+	body, err := json.Marshal(recipe)
+	if err != nil {
+		return "", fmt.Errorf("invalid %s recipe: %s", variant, err)
+	}
+	return fmt.Sprintf("%x", sha256.Sum256(body)), nil
+}
+
+// watermarkDigest returns the hex SHA-256 of the watermark the options
+// currently stamp with, or an empty string for text watermarks and
+// providers that can't identify their content
+func watermarkDigest(ctx context.Context,
+	options *imagerOptions,
+	logger *zerolog.Logger) (string, error) {
+	if options.Text != nil {
+		return "", nil
+	}
+	digester, ok := options.WatermarkProvider.(transforms.WatermarkDigester)
+	if !ok {
+		return "", nil
+	}
+	return digester.WatermarkDigest(ctx, options.Watermark, logger)
+}
+
+// recipe returns the recipe recorded on the derivative when it's stamped
+// with the watermark with the digest
+func (deriv *derivative) recipe(watermarkDigest string) string {
+	if watermarkDigest == "" {
+		return deriv.Recipe
+	}
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(deriv.Recipe+":"+watermarkDigest)))
+}
+
+// sourceRevision identifies the revision of an upload that derivatives are
+// produced from
+type sourceRevision struct {
+	ETag string
+	// VersionID is empty for buckets without versioning
+	VersionID string
+}
+
+// Metadata returns the S3 user metadata that records the derivative was
+// produced from the revision and stamped with the watermark with the digest
+func (deriv *derivative) Metadata(revision *sourceRevision, watermarkDigest string) map[string]string {
+	metadata := map[string]string{
+		metadataSourceETag: revision.ETag,
+		metadataRecipe:     deriv.recipe(watermarkDigest),
+	}
+	if revision.VersionID != "" {
+		metadata[metadataSourceVersion] = revision.VersionID
+	}
+	return metadata
+}
+
+// IsCurrent returns true if the object metadata records that it was
+// produced from the revision with the derivative's current recipe and
+// watermark
+func (deriv *derivative) IsCurrent(metadata map[string]*string,
+	revision *sourceRevision,
+	watermarkDigest string) bool {
+	return objectMetadata(metadata, metadataSourceETag) == revision.ETag &&
+		objectMetadata(metadata, metadataSourceVersion) == revision.VersionID &&
+		objectMetadata(metadata, metadataRecipe) == deriv.recipe(watermarkDigest)
+}
+
+// objectMetadata returns a user metadata value. The SDK canonicalizes the
+// keys it returns, eg "Source-Etag", so the lookup is case insensitive.
+func objectMetadata(metadata map[string]*string, key string) string {
+	for eachKey, eachValue := range metadata {
+		if strings.EqualFold(eachKey, key) {
+			return aws.StringValue(eachValue)
+		}
+	}
+	return ""
+}
+
+// staleDerivatives returns the derivatives that must be produced for the
+// revision of an upload. The derivative keys can depend on the decoded
+// source, so the outputs are found through the lineage manifest rather than
+// computed.
+func staleDerivatives(ctx context.Context,
+	svc *s3.S3,
+	route *outputRoute,
+	bucket string,
+	key string,
+	revision *sourceRevision,
+	watermarkDigest string,
+	derivatives []*derivative,
+	logger *zerolog.Logger) ([]*derivative, error) {
+	manifest, err := readLineage(ctx, svc, route, bucket, key)
+	if err != nil || manifest == nil {
+		return derivatives, err
+	}
+	var stale []*derivative
+	for _, eachDerivative := range derivatives {
+		current := false
+		for _, eachRecorded := range manifest.Derivatives {
+			if eachRecorded.Variant != eachDerivative.Variant {
+				continue
+			}
+			result, headErr := svc.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+				Bucket: aws.String(eachRecorded.Bucket),
+				Key:    aws.String(eachRecorded.Key),
+			})
+			if headErr != nil {
+				if requestFailure, ok := headErr.(awserr.RequestFailure); ok &&
+					requestFailure.StatusCode() == http.StatusNotFound {
+					continue
+				}
+				return nil, headErr
+			}
+			if eachDerivative.IsCurrent(result.Metadata, revision, watermarkDigest) {
+				current = true
+				logger.Debug().
+					Str("Variant", eachRecorded.Variant).
+					Str("Bucket", eachRecorded.Bucket).
+					Str("Key", eachRecorded.Key).
+					Msg("Derivative up to date")
+				break
+			}
+		}
+		if !current {
+			stale = append(stale, eachDerivative)
+		}
+	}
+	return stale, nil
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/mweagle/SpartaImager/transforms"
+	"github.com/rs/zerolog"
+)
+
+// headMetadata returns metadata as the SDK returns it from a HEAD request,
+// with canonicalized keys
+func headMetadata(metadata map[string]string) map[string]*string {
+	head := make(map[string]*string)
+	for eachKey, eachValue := range metadata {
+		head[http.CanonicalHeaderKey(eachKey)] = aws.String(eachValue)
+	}
+	return head
+}
+
+func TestDerivativeIsCurrent(t *testing.T) {
+	deriv, err := newDerivative("thumb", 160, imagerConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	revision := &sourceRevision{ETag: "b21b84d653bb07b05b1e6b33684dc11b", VersionID: "v1"}
+	const watermark = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+	metadata := headMetadata(deriv.Metadata(revision, watermark))
+
+	tests := []struct {
+		name      string
+		revision  *sourceRevision
+		watermark string
+		want      bool
+	}{
+		{"unchanged", revision, watermark, true},
+		{"replaced upload", &sourceRevision{ETag: "0cc175b9c0f1b6a831c399e269772661", VersionID: "v2"}, watermark, false},
+		{"new version", &sourceRevision{ETag: revision.ETag, VersionID: "v2"}, watermark, false},
+		{"replaced watermark", revision, "60303ae22b998861bce3b28f33eec1be758a213c86c93c076dbe9f558c11c752", false},
+		{"no watermark digest", revision, "", false},
+	}
+	for _, eachTest := range tests {
+		t.Run(eachTest.name, func(t *testing.T) {
+			if current := deriv.IsCurrent(metadata, eachTest.revision, eachTest.watermark); current != eachTest.want {
+				t.Errorf("IsCurrent() = %t, want %t", current, eachTest.want)
+			}
+		})
+	}
+}
+
+func TestWatermarkDigest(t *testing.T) {
+	logger := zerolog.Nop()
+	options := *imagerConfig
+	options.WatermarkProvider = transforms.NewEmbeddedProvider()
+	options.Watermark = transforms.DefaultWatermark
+	digest, err := watermarkDigest(context.Background(), &options, &logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(digest) != 64 {
+		t.Errorf("watermarkDigest() = %q, want a hex SHA-256", digest)
+	}
+
+	// Text watermarks don't use the provider
+	text := transforms.DefaultTextOptions()
+	options.Text = &text
+	digest, err = watermarkDigest(context.Background(), &options, &logger)
+	if err != nil || digest != "" {
+		t.Errorf("watermarkDigest() = %q, %v for a text watermark", digest, err)
+	}
+}
@@ -0,0 +1,292 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/mweagle/SpartaImager/transforms"
+	"github.com/rs/zerolog"
+)
+
+// envTenants is the environment variable that maps tenants to their
+// watermark settings. The value is a JSON object keyed by tenant name, eg:
+//
+//	{"acme": {"watermark": "acme.png", "placement": "tiled", "opacity": "40"}}
+const envTenants = "SPARTA_IMAGER_TENANTS"
+
+// envTenantFrom selects how the tenant of an upload is determined
+const envTenantFrom = "SPARTA_IMAGER_TENANT_FROM"
+
+// tenantMetadataKey is the S3 user metadata key (x-amz-meta-tenant) read
+// by tenantFromMetadata
+const tenantMetadataKey = "tenant"
+
+// tenantSource determines the tenant of an upload
+type tenantSource int
+
+const (
+	// tenantFromPrefix uses the first path segment of the key below the
+	// input prefix, eg "acme" for "incoming/acme/photos/ben.jpg"
+	tenantFromPrefix tenantSource = iota
+	// tenantFromBucket uses the bucket name
+	tenantFromBucket
+	// tenantFromMetadata uses the x-amz-meta-tenant object metadata
+	tenantFromMetadata
+)
+
+func parseTenantSource(name string) (tenantSource, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "", "prefix":
+		return tenantFromPrefix, nil
+	case "bucket":
+		return tenantFromBucket, nil
+	case "metadata":
+		return tenantFromMetadata, nil
+	default:
+		return tenantFromPrefix, fmt.Errorf("invalid %s: expected prefix, bucket or metadata", envTenantFrom)
+	}
+}
+
+// tenantConfig overrides the deployment watermark settings for a single
+// tenant. Empty fields inherit the deployment value and use the same
+// syntax as the corresponding SPARTA_IMAGER_* variable.
+type tenantConfig struct {
+	Source    string `json:"source"`
+	Watermark string `json:"watermark"`
+	Text      string `json:"text"`
+	Size      string `json:"size"`
+	Placement string `json:"placement"`
+	Anchor    string `json:"anchor"`
+	Margin    string `json:"margin"`
+	Opacity   string `json:"opacity"`
+	Blend     string `json:"blend"`
+	Contrast  string `json:"contrast"`
+}
+
+// options returns a copy of the deployment options with the tenant
+// overrides applied
+func (config *tenantConfig) options(base *imagerOptions) (*imagerOptions, error) {
+	options := *base
+	if config.Source != "" {
+		source, sourceErr := parseWatermarkSource(config.Source)
+		if sourceErr != nil {
+			return nil, sourceErr
+		}
+		source.RefreshInterval = base.WatermarkSource.RefreshInterval
+		options.WatermarkSource = source
+		options.WatermarkProvider = source.Provider()
+	}
+	if config.Watermark != "" {
+		options.Watermark = config.Watermark
+		options.Text = nil
+	}
+	if config.Text != "" {
+		text := transforms.DefaultTextOptions()
+		if base.Text != nil {
+			text = *base.Text
+		}
+		text.Text = config.Text
+		templateErr := text.ParseTemplate()
+		if templateErr != nil {
+			return nil, templateErr
+		}
+		options.Text = &text
+	}
+	if config.Size != "" {
+		size, sizeErr := transforms.ParseMargin(config.Size)
+		if sizeErr != nil {
+			return nil, sizeErr
+		}
+		options.Scale.Size = size
+	}
+	if config.Placement != "" {
+		mode, modeErr := transforms.ParsePlacementMode(config.Placement)
+		if modeErr != nil {
+			return nil, modeErr
+		}
+		options.Stamp.Mode = mode
+	}
+	if config.Anchor != "" {
+		anchor, anchorErr := transforms.ParseAnchor(config.Anchor)
+		if anchorErr != nil {
+			return nil, anchorErr
+		}
+		options.Stamp.Anchor = anchor
+	}
+	if config.Margin != "" {
+		margin, marginErr := transforms.ParseMargin(config.Margin)
+		if marginErr != nil {
+			return nil, marginErr
+		}
+		options.Stamp.Margin = margin
+	}
+	if config.Opacity != "" {
+		opacity, opacityErr := transforms.ParseOpacity(config.Opacity)
+		if opacityErr != nil {
+			return nil, opacityErr
+		}
+		options.Stamp.Opacity = opacity
+	}
+	if config.Blend != "" {
+		blend, blendErr := transforms.ParseBlendMode(config.Blend)
+		if blendErr != nil {
+			return nil, blendErr
+		}
+		options.Stamp.Blend = blend
+	}
+	if config.Contrast != "" {
+		contrast, contrastErr := transforms.ParseContrastMode(config.Contrast)
+		if contrastErr != nil {
+			return nil, contrastErr
+		}
+		options.Stamp.Contrast = contrast
+	}
+	return &options, nil
+}
+
+// tenant is a resolved tenant with its own derivative pipelines
+type tenant struct {
+	Name        string
+	Options     *imagerOptions
+	Derivatives []*derivative
+}
+
+// tenantRegistry maps tenants to their derivative pipelines. Uploads from
+// unknown tenants use the deployment defaults.
+type tenantRegistry struct {
+	From tenantSource
+	// InputPrefix is stripped from keys before resolving prefix tenants
+	InputPrefix   string
+	DefaultTenant *tenant
+	Tenants       map[string]*tenant
+}
+
+// Resolve returns the tenant for an upload. The metadata is the S3 user
+// metadata of the uploaded object.
+func (registry *tenantRegistry) Resolve(bucket string,
+	key string,
+	metadata map[string]*string) *tenant {
+	name := ""
+	switch registry.From {
+	case tenantFromBucket:
+		name = bucket
+	case tenantFromMetadata:
+		name, _ = userMetadata(metadata, tenantMetadataKey)
+	default:
+		relativeKey := strings.TrimPrefix(strings.TrimPrefix(key, registry.InputPrefix), "/")
+		parts := strings.SplitN(relativeKey, "/", 2)
+		if len(parts) == 2 {
+			name = parts[0]
+		}
+	}
+	if resolved, exists := registry.Tenants[name]; exists {
+		return resolved
+	}
+	return registry.DefaultTenant
+}
+
+// WatermarkSources returns the distinct watermark sources used by every
+// tenant, including the deployment default
+func (registry *tenantRegistry) WatermarkSources() []*watermarkSource {
+	sources := []*watermarkSource{registry.DefaultTenant.Options.WatermarkSource}
+	for _, eachTenant := range registry.Tenants {
+		if eachTenant.Options.WatermarkSource != registry.DefaultTenant.Options.WatermarkSource {
+			sources = append(sources, eachTenant.Options.WatermarkSource)
+		}
+	}
+	return sources
+}
+
+// PreloadWatermarks loads every tenant's watermark into its provider cache
+// and pre-scales it for each derivative at the common photo aspect ratios.
+// Failures are logged and retried by the first invocation that needs them.
+func (registry *tenantRegistry) PreloadWatermarks(ctx context.Context, logger *zerolog.Logger) {
+	tenants := []*tenant{registry.DefaultTenant}
+	for _, eachTenant := range registry.Tenants {
+		tenants = append(tenants, eachTenant)
+	}
+	for _, eachTenant := range tenants {
+		if eachTenant.Options.Text != nil {
+			continue
+		}
+		var sizes []int
+		for _, eachDerivative := range eachTenant.Derivatives {
+			sizes = append(sizes, eachTenant.Options.Scale.CommonSizes(eachDerivative.MaxEdge)...)
+		}
+		preloadErr := transforms.PreloadWatermark(ctx,
+			eachTenant.Options.WatermarkProvider,
+			eachTenant.Options.Watermark,
+			sizes,
+			logger)
+		if preloadErr != nil {
+			logger.Warn().
+				Err(preloadErr).
+				Str("Tenant", eachTenant.Name).
+				Msg("Failed to preload watermark")
+		}
+	}
+}
+
+// parseTenants parses the tenant JSON. Every tenant produces the same set
+// of derivative variants s.t. the derivative keys don't depend on the
+// tenant.
+func parseTenants(value string,
+	from tenantSource,
+	base *imagerOptions,
+	derivatives []*derivative) (*tenantRegistry, error) {
+	registry := &tenantRegistry{
+		From:        from,
+		InputPrefix: base.Output.InputPrefix,
+		DefaultTenant: &tenant{
+			Name:        "default",
+			Options:     base,
+			Derivatives: derivatives,
+		},
+		Tenants: make(map[string]*tenant),
+	}
+	if strings.TrimSpace(value) == "" {
+		return registry, nil
+	}
+	var configs map[string]*tenantConfig
+	unmarshalErr := json.Unmarshal([]byte(value), &configs)
+	if unmarshalErr != nil {
+		return nil, fmt.Errorf("invalid %s: %s", envTenants, unmarshalErr)
+	}
+	derivativesValue := envValue(envDerivatives, defaultDerivatives)
+	for eachName, eachConfig := range configs {
+		if eachName == "" || eachConfig == nil {
+			return nil, fmt.Errorf("invalid %s: empty tenant", envTenants)
+		}
+		options, optionsErr := eachConfig.options(base)
+		if optionsErr != nil {
+			return nil, fmt.Errorf("invalid %s tenant %s: %s", envTenants, eachName, optionsErr)
+		}
+		tenantDerivatives, derivativesErr := parseDerivatives(derivativesValue, options)
+		if derivativesErr != nil {
+			return nil, derivativesErr
+		}
+		registry.Tenants[eachName] = &tenant{
+			Name:        eachName,
+			Options:     options,
+			Derivatives: tenantDerivatives,
+		}
+	}
+	return registry, nil
+}
+
+// imageTenants is the tenant registry consulted for every upload
+var imageTenants = mustParseTenants(imagerConfig, imageDerivatives)
+
+func mustParseTenants(options *imagerOptions, derivatives []*derivative) *tenantRegistry {
+	from, fromErr := parseTenantSource(envValue(envTenantFrom, "prefix"))
+	if fromErr != nil {
+		panic(fromErr)
+	}
+	registry, err := parseTenants(envValue(envTenants, ""), from, options, derivatives)
+	if err != nil {
+		panic(err)
+	}
+	return registry
+}
@@ -0,0 +1,226 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/mweagle/SpartaImager/transforms"
+)
+
+func TestResolvePrefixTenantBelowInputPrefix(t *testing.T) {
+	base := *imagerConfig
+	base.Output = outputRoute{
+		InputPrefix: "incoming/",
+		Prefix:      transformPrefix,
+		Template:    mustKeyTemplate(t, defaultKeyTemplate),
+	}
+	if err := base.Output.Validate("uploads"); err != nil {
+		t.Fatal(err)
+	}
+	registry, err := parseTenants(`{"acme": {"opacity": "40"}}`, tenantFromPrefix, &base, imageDerivatives)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{"incoming/acme/photos/ben.jpg", "acme"},
+		{"incoming/acme/ben.jpg", "acme"},
+		{"incoming/ben.jpg", "default"},
+		{"incoming/other/ben.jpg", "default"},
+	}
+	for _, eachTest := range tests {
+		if resolved := registry.Resolve("uploads", eachTest.key, nil); resolved.Name != eachTest.want {
+			t.Errorf("Resolve(%s) = %s, want %s", eachTest.key, resolved.Name, eachTest.want)
+		}
+	}
+}
+
+func TestParseTenantsParsesTextTemplates(t *testing.T) {
+	base := *imagerConfig
+	registry, err := parseTenants(`{"acme": {"text": "© Acme {{.Date.Year}}"}}`, tenantFromPrefix, &base, imageDerivatives)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if text := registry.Tenants["acme"].Options.Text; text == nil || text.Template == nil {
+		t.Errorf("acme text is %v, want a parsed template", text)
+	}
+	_, err = parseTenants(`{"acme": {"text": "© Acme {{.Date"}}`, tenantFromPrefix, &base, imageDerivatives)
+	if err == nil {
+		t.Error("parseTenants() accepted an invalid text template")
+	}
+}
+
+func TestParseTenantSource(t *testing.T) {
+	tests := []struct {
+		name string
+		want tenantSource
+	}{
+		{"", tenantFromPrefix},
+		{"prefix", tenantFromPrefix},
+		{" Bucket ", tenantFromBucket},
+		{"METADATA", tenantFromMetadata},
+	}
+	for _, eachTest := range tests {
+		if got, err := parseTenantSource(eachTest.name); err != nil || got != eachTest.want {
+			t.Errorf("parseTenantSource(%q) = %v, %v, want %v", eachTest.name, got, err, eachTest.want)
+		}
+	}
+	if _, err := parseTenantSource("header"); err == nil {
+		t.Error("parseTenantSource(header) succeeded")
+	}
+}
+
+func TestResolveTenants(t *testing.T) {
+	base := *imagerConfig
+	tenantsJSON := `{"acme": {"opacity": "40"}, "uploads": {"opacity": "60"}}`
+	tests := []struct {
+		name     string
+		from     tenantSource
+		bucket   string
+		key      string
+		metadata map[string]*string
+		want     string
+	}{
+		{"bucket", tenantFromBucket, "uploads", "acme/ben.jpg", nil, "uploads"},
+		{"unknown bucket", tenantFromBucket, "photos", "acme/ben.jpg", nil, "default"},
+		{"metadata", tenantFromMetadata, "uploads", "ben.jpg",
+			map[string]*string{"Tenant": aws.String("acme")}, "acme"},
+		{"metadata lowercase key", tenantFromMetadata, "uploads", "ben.jpg",
+			map[string]*string{"tenant": aws.String("acme")}, "acme"},
+		{"metadata nil value", tenantFromMetadata, "uploads", "ben.jpg",
+			map[string]*string{"Tenant": nil}, "default"},
+		{"unknown metadata", tenantFromMetadata, "uploads", "acme/ben.jpg",
+			map[string]*string{"Tenant": aws.String("other")}, "default"},
+		{"missing metadata", tenantFromMetadata, "acme", "acme/ben.jpg", nil, "default"},
+		{"prefix", tenantFromPrefix, "uploads", "acme/photos/ben.jpg", nil, "acme"},
+		{"prefix without folder", tenantFromPrefix, "uploads", "acme", nil, "default"},
+		{"unknown prefix", tenantFromPrefix, "acme", "other/ben.jpg", nil, "default"},
+	}
+	for _, eachTest := range tests {
+		t.Run(eachTest.name, func(t *testing.T) {
+			registry, err := parseTenants(tenantsJSON, eachTest.from, &base, imageDerivatives)
+			if err != nil {
+				t.Fatal(err)
+			}
+			registry.InputPrefix = ""
+			resolved := registry.Resolve(eachTest.bucket, eachTest.key, eachTest.metadata)
+			if resolved.Name != eachTest.want {
+				t.Errorf("Resolve() = %s, want %s", resolved.Name, eachTest.want)
+			}
+			if eachTest.want == "default" && resolved != registry.DefaultTenant {
+				t.Error("Resolve() didn't return the default tenant")
+			}
+		})
+	}
+}
+
+func TestTenantWatermarkSources(t *testing.T) {
+	base := *imagerConfig
+	registry, err := parseTenants(`{
+		"acme": {"source": "s3://acme-marks/watermarks"},
+		"globex": {"source": "file:///opt/watermarks"},
+		"initech": {"opacity": "40"}
+	}`, tenantFromPrefix, &base, imageDerivatives)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sources := registry.WatermarkSources()
+	if len(sources) != 3 {
+		t.Fatalf("WatermarkSources() returned %d sources, want 3", len(sources))
+	}
+	if sources[0] != base.WatermarkSource {
+		t.Errorf("first source is %+v, want the deployment source", sources[0])
+	}
+	var buckets, directories int
+	for _, eachSource := range sources[1:] {
+		switch {
+		case eachSource.Bucket == "acme-marks" && eachSource.Prefix == "watermarks/":
+			buckets++
+		case eachSource.Directory == "/opt/watermarks":
+			directories++
+		}
+		if eachSource.RefreshInterval != base.WatermarkSource.RefreshInterval {
+			t.Errorf("source %+v doesn't inherit the refresh interval", eachSource)
+		}
+	}
+	if buckets != 1 || directories != 1 {
+		t.Errorf("WatermarkSources() = %+v, want the acme bucket and globex directory", sources)
+	}
+}
+
+func TestTenantOptions(t *testing.T) {
+	base := *imagerConfig
+	text := transforms.DefaultTextOptions()
+	text.Text = "© Sparta"
+	if err := text.ParseTemplate(); err != nil {
+		t.Fatal(err)
+	}
+	base.Text = &text
+	registry, err := parseTenants(`{"acme": {
+		"watermark": "acme.png",
+		"size": "20%",
+		"placement": "tiled",
+		"anchor": "top-left",
+		"margin": "12px",
+		"opacity": "40%",
+		"blend": "multiply",
+		"contrast": "halo"
+	}, "initech": {}}`, tenantFromPrefix, &base, imageDerivatives)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	acme := registry.Tenants["acme"].Options
+	if acme.Watermark != "acme.png" || acme.Text != nil {
+		t.Errorf("acme watermark = %q, text = %v, want acme.png without text", acme.Watermark, acme.Text)
+	}
+	if acme.Scale.Size.String() != "20%" {
+		t.Errorf("acme size = %s, want 20%%", acme.Scale.Size)
+	}
+	if acme.Stamp.Mode != transforms.PlacementTiled ||
+		acme.Stamp.Anchor != transforms.AnchorTopLeft ||
+		acme.Stamp.Margin.String() != "12px" ||
+		acme.Stamp.Opacity != 0.4 ||
+		acme.Stamp.Blend != transforms.BlendMultiply ||
+		acme.Stamp.Contrast != transforms.ContrastHalo {
+		t.Errorf("acme stamp = %+v", acme.Stamp)
+	}
+	if base.Stamp.Opacity == 0.4 || base.Text == nil {
+		t.Error("tenant overrides modified the deployment options")
+	}
+	if len(registry.Tenants["acme"].Derivatives) != len(imageDerivatives) {
+		t.Errorf("acme has %d derivatives, want %d",
+			len(registry.Tenants["acme"].Derivatives),
+			len(imageDerivatives))
+	}
+
+	// Empty tenants inherit everything
+	initech := registry.Tenants["initech"].Options
+	if initech.Stamp.Opacity != base.Stamp.Opacity ||
+		initech.Text != base.Text ||
+		initech.WatermarkSource != base.WatermarkSource {
+		t.Errorf("initech options = %+v, want the deployment options", initech)
+	}
+
+	for _, eachInvalid := range []string{
+		`[]`,
+		`{"": {}}`,
+		`{"acme": null}`,
+		`{"acme": {"source": "ftp://marks"}}`,
+		`{"acme": {"size": "big"}}`,
+		`{"acme": {"placement": "scattered"}}`,
+		`{"acme": {"anchor": "middle"}}`,
+		`{"acme": {"margin": "-"}}`,
+		`{"acme": {"opacity": "140"}}`,
+		`{"acme": {"blend": "dodge"}}`,
+		`{"acme": {"contrast": "loud"}}`,
+	} {
+		_, err := parseTenants(eachInvalid, tenantFromPrefix, &base, imageDerivatives)
+		if err == nil || !strings.Contains(err.Error(), envTenants) {
+			t.Errorf("parseTenants(%s) = %v, want an %s error", eachInvalid, err, envTenants)
+		}
+	}
+}
@@ -0,0 +1,267 @@
+package transforms
+
+import (
+	"context"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/gif"
+	"sort"
+
+	"github.com/rs/zerolog"
+)
+
+// maxPaletteSize is the largest palette a GIF frame can have
+const maxPaletteSize = 256
+
+// applyAnimation renders every frame of the animation onto a full canvas,
+// applies the pipeline transforms to it and quantizes the result back to a
+// paletted frame. Delays and the loop count are preserved, and smart
+// placements chosen for the first frame are kept for the rest. Only the region
+// that changed since the previous frame is kept, so the output holds little
+// more than the frames it was decoded from.
+func (pipeline *Pipeline) applyAnimation(ctx context.Context,
+	animation *gif.GIF,
+	logger *zerolog.Logger) (*gif.GIF, error) {
+
+	canvasRect := image.Rect(0, 0, animation.Config.Width, animation.Config.Height)
+	if canvasRect.Empty() {
+		for _, eachFrame := range animation.Image {
+			canvasRect = canvasRect.Union(eachFrame.Bounds())
+		}
+	}
+	canvas := image.NewRGBA(canvasRect)
+	transformed := &gif.GIF{
+		Image:           make([]*image.Paletted, 0, len(animation.Image)),
+		Delay:           animation.Delay,
+		LoopCount:       animation.LoopCount,
+		Disposal:        make([]byte, 0, len(animation.Image)),
+		BackgroundIndex: animation.BackgroundIndex,
+	}
+	// shown is the transformed canvas as displayed after the last output
+	// frame
+	var shown *image.RGBA
+	// Smart placements are chosen from the first frame
+	ctx = contextWithSmartAnchors(ctx)
+
+	for index, eachFrame := range animation.Image {
+		disposal := byte(0)
+		if index < len(animation.Disposal) {
+			disposal = animation.Disposal[index]
+		}
+		var previous *image.RGBA
+		if disposal == gif.DisposalPrevious {
+			previous = image.NewRGBA(canvasRect)
+			copy(previous.Pix, canvas.Pix)
+		}
+		draw.Draw(canvas, eachFrame.Bounds(), eachFrame, eachFrame.Bounds().Min, draw.Over)
+
+		// Transforms may retain their input, so hand each one a snapshot
+		snapshot := image.NewRGBA(canvasRect)
+		copy(snapshot.Pix, canvas.Pix)
+		frameImage, err := pipeline.Apply(ctx, snapshot, logger)
+		if err != nil {
+			return nil, err
+		}
+		frameRGBA, err := toRGBA(ctx, frameImage)
+		if err != nil {
+			return nil, err
+		}
+
+		changed := frameRGBA.Bounds()
+		if shown != nil && shown.Bounds() == frameRGBA.Bounds() {
+			var clears bool
+			changed, clears = changedRect(shown, frameRGBA)
+			if clears {
+				// Pixels can only be made transparent again by disposing of
+				// the previous frame, so redraw it in full and dispose of it
+				last := len(transformed.Image) - 1
+				transformed.Image[last] = quantize(shown,
+					framePalette(transformed.Image[last].Palette, shown))
+				transformed.Disposal[last] = gif.DisposalBackground
+				changed = opaqueRect(frameRGBA)
+			}
+		}
+		if changed.Empty() {
+			// Keep the frame, and its delay, without drawing anything new
+			changed = image.Rect(0, 0, 1, 1)
+		}
+		region := frameRGBA.SubImage(changed)
+		transformed.Image = append(transformed.Image,
+			quantize(region, framePalette(eachFrame.Palette, region)))
+		transformed.Disposal = append(transformed.Disposal, gif.DisposalNone)
+		shown = frameRGBA
+
+		switch disposal {
+		case gif.DisposalBackground:
+			draw.Draw(canvas, eachFrame.Bounds(), image.Transparent, image.Point{}, draw.Src)
+		case gif.DisposalPrevious:
+			canvas = previous
+		}
+	}
+	if shown != nil {
+		transformed.Config = image.Config{
+			ColorModel: transformed.Image[0].Palette,
+			Width:      shown.Bounds().Dx(),
+			Height:     shown.Bounds().Dy(),
+		}
+	}
+	logger.Info().
+		Int("FrameCount", len(transformed.Image)).
+		Int("LoopCount", transformed.LoopCount).
+		Interface("Bounds", transformed.Config).
+		Msg("Transformed animation")
+	return transformed, nil
+}
+
+// isTransparent returns true for pixels that quantize to the transparent
+// palette entry
+func isTransparent(pix []uint8) bool {
+	return pix[3] < 0x80
+}
+
+// changedRect returns the bounding rectangle of the pixels that differ
+// between the displayed and next frames. Pixels that are transparent in
+// both are equal. clears is true if a displayed pixel becomes transparent,
+// which drawing the next frame over the displayed one can't do.
+func changedRect(displayed *image.RGBA, next *image.RGBA) (changed image.Rectangle, clears bool) {
+	bounds := next.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			offset := next.PixOffset(x, y)
+			before := displayed.Pix[offset : offset+4 : offset+4]
+			after := next.Pix[offset : offset+4 : offset+4]
+			if isTransparent(after) {
+				if isTransparent(before) {
+					continue
+				}
+				clears = true
+			} else if before[0] == after[0] &&
+				before[1] == after[1] &&
+				before[2] == after[2] &&
+				before[3] == after[3] {
+				continue
+			}
+			changed = changed.Union(image.Rect(x, y, x+1, y+1))
+		}
+	}
+	return changed, clears
+}
+
+// opaqueRect returns the bounding rectangle of the pixels that aren't
+// transparent
+func opaqueRect(img *image.RGBA) image.Rectangle {
+	var opaque image.Rectangle
+	bounds := img.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			offset := img.PixOffset(x, y)
+			if !isTransparent(img.Pix[offset : offset+4 : offset+4]) {
+				opaque = opaque.Union(image.Rect(x, y, x+1, y+1))
+			}
+		}
+	}
+	return opaque
+}
+
+// framePalette extends the frame's original palette with the most popular
+// colors in img that it can't represent, such as those introduced by the
+// watermark, up to the 256 color limit
+func framePalette(original color.Palette, img image.Image) color.Palette {
+	palette := make(color.Palette, 0, maxPaletteSize)
+	palette = append(palette, original...)
+	hasTransparent := false
+	for _, eachColor := range palette {
+		if _, _, _, alpha := eachColor.RGBA(); alpha == 0 {
+			hasTransparent = true
+			break
+		}
+	}
+	if !hasTransparent && len(palette) < maxPaletteSize {
+		palette = append(palette, color.RGBA{})
+	}
+	if len(palette) >= maxPaletteSize {
+		return palette[:maxPaletteSize]
+	}
+
+	// Popularity of 5 bit per channel buckets that aren't already close to a
+	// palette entry
+	type bucket struct {
+		count   int
+		r, g, b uint64
+	}
+	buckets := make(map[uint16]*bucket)
+	bounds := img.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			pixel := color.NRGBAModel.Convert(img.At(x, y)).(color.NRGBA)
+			if pixel.A < 0x80 {
+				continue
+			}
+			key := uint16(pixel.R>>3)<<10 | uint16(pixel.G>>3)<<5 | uint16(pixel.B>>3)
+			entry, exists := buckets[key]
+			if !exists {
+				entry = &bucket{}
+				buckets[key] = entry
+			}
+			entry.count++
+			entry.r += uint64(pixel.R)
+			entry.g += uint64(pixel.G)
+			entry.b += uint64(pixel.B)
+		}
+	}
+	candidates := make([]*bucket, 0, len(buckets))
+	for _, eachBucket := range buckets {
+		candidates = append(candidates, eachBucket)
+	}
+	sort.Slice(candidates, func(lhs int, rhs int) bool {
+		return candidates[lhs].count > candidates[rhs].count
+	})
+	for _, eachCandidate := range candidates {
+		if len(palette) >= maxPaletteSize {
+			break
+		}
+		count := uint64(eachCandidate.count)
+		average := color.RGBA{
+			R: uint8(eachCandidate.r / count),
+			G: uint8(eachCandidate.g / count),
+			B: uint8(eachCandidate.b / count),
+			A: 0xff,
+		}
+		if paletteDistance(palette, average) > 3*16*16 {
+			palette = append(palette, average)
+		}
+	}
+	return palette
+}
+
+// paletteDistance returns the squared RGB distance, in 8 bit units, to the
+// closest opaque palette entry
+func paletteDistance(palette color.Palette, target color.RGBA) int {
+	closest := -1
+	for _, eachColor := range palette {
+		r, g, b, a := eachColor.RGBA()
+		if a == 0 {
+			continue
+		}
+		dr := int(r>>8) - int(target.R)
+		dg := int(g>>8) - int(target.G)
+		db := int(b>>8) - int(target.B)
+		distance := dr*dr + dg*dg + db*db
+		if closest < 0 || distance < closest {
+			closest = distance
+		}
+	}
+	if closest < 0 {
+		return 1 << 30
+	}
+	return closest
+}
+
+// quantize dithers img onto the palette. The paletted image keeps the bounds
+// of img, which positions the frame on the GIF canvas.
+func quantize(img image.Image, palette color.Palette) *image.Paletted {
+	paletted := image.NewPaletted(img.Bounds(), palette)
+	draw.FloydSteinberg.Draw(paletted, paletted.Bounds(), img, img.Bounds().Min)
+	return paletted
+}
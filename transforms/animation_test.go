@@ -0,0 +1,154 @@
+package transforms
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/gif"
+	"testing"
+
+	"github.com/rs/zerolog"
+)
+
+var testAnimationPalette = color.Palette{
+	color.RGBA{},
+	color.RGBA{R: 0xff, A: 0xff},
+	color.RGBA{G: 0xff, A: 0xff},
+	color.RGBA{B: 0xff, A: 0xff},
+	color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+}
+
+func testFrame(rect image.Rectangle, index uint8) *image.Paletted {
+	frame := image.NewPaletted(rect, testAnimationPalette)
+	for offset := range frame.Pix {
+		frame.Pix[offset] = index
+	}
+	return frame
+}
+
+// testAnimation has partial frames and every disposal method
+func testAnimation() *gif.GIF {
+	return &gif.GIF{
+		Image: []*image.Paletted{
+			testFrame(image.Rect(0, 0, 40, 30), 4),
+			testFrame(image.Rect(5, 5, 15, 15), 1),
+			testFrame(image.Rect(20, 10, 30, 20), 2),
+			testFrame(image.Rect(0, 0, 40, 30), 0),
+			testFrame(image.Rect(10, 10, 20, 20), 3),
+			testFrame(image.Rect(12, 12, 14, 14), 1),
+		},
+		Delay: []int{10, 20, 30, 40, 50, 60},
+		Disposal: []byte{
+			gif.DisposalNone,
+			gif.DisposalNone,
+			gif.DisposalPrevious,
+			gif.DisposalNone,
+			gif.DisposalBackground,
+			gif.DisposalNone,
+		},
+		Config: image.Config{Width: 40, Height: 30},
+	}
+}
+
+// displayFrames returns the canvas as displayed for every frame
+func displayFrames(animation *gif.GIF) []*image.RGBA {
+	canvasRect := image.Rect(0, 0, animation.Config.Width, animation.Config.Height)
+	canvas := image.NewRGBA(canvasRect)
+	var displayed []*image.RGBA
+	for index, eachFrame := range animation.Image {
+		previous := image.NewRGBA(canvasRect)
+		copy(previous.Pix, canvas.Pix)
+		draw.Draw(canvas, eachFrame.Bounds(), eachFrame, eachFrame.Bounds().Min, draw.Over)
+		snapshot := image.NewRGBA(canvasRect)
+		copy(snapshot.Pix, canvas.Pix)
+		displayed = append(displayed, snapshot)
+		switch animation.Disposal[index] {
+		case gif.DisposalBackground:
+			draw.Draw(canvas, eachFrame.Bounds(), image.Transparent, image.Point{}, draw.Src)
+		case gif.DisposalPrevious:
+			canvas = previous
+		}
+	}
+	return displayed
+}
+
+func TestApplyAnimationPreservesDisplay(t *testing.T) {
+	logger := zerolog.Nop()
+	animation := testAnimation()
+	transformed, err := NewPipeline().applyAnimation(context.Background(), animation, &logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Round trip the encoder, which validates frame bounds
+	var encoded bytes.Buffer
+	if err = gif.EncodeAll(&encoded, transformed); err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := gif.DecodeAll(&encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(decoded.Image) != len(animation.Image) {
+		t.Fatalf("frame count = %d, want %d", len(decoded.Image), len(animation.Image))
+	}
+	for index, eachDelay := range animation.Delay {
+		if decoded.Delay[index] != eachDelay {
+			t.Errorf("frame %d delay = %d, want %d", index, decoded.Delay[index], eachDelay)
+		}
+	}
+	want := displayFrames(animation)
+	got := displayFrames(decoded)
+	for index := range want {
+		if !bytes.Equal(got[index].Pix, want[index].Pix) {
+			t.Errorf("frame %d is displayed differently", index)
+		}
+	}
+	// Unchanged regions aren't repeated
+	outputPixels := 0
+	for _, eachFrame := range transformed.Image {
+		outputPixels += eachFrame.Bounds().Dx() * eachFrame.Bounds().Dy()
+	}
+	if fullPixels := len(animation.Image) * 40 * 30; outputPixels >= fullPixels {
+		t.Errorf("output frames hold %d pixels, want fewer than %d", outputPixels, fullPixels)
+	}
+}
+
+func testEncodeGIF(t *testing.T, animation *gif.GIF) []byte {
+	t.Helper()
+	var encoded bytes.Buffer
+	if err := gif.EncodeAll(&encoded, animation); err != nil {
+		t.Fatal(err)
+	}
+	return encoded.Bytes()
+}
+
+func TestGIFFrameCount(t *testing.T) {
+	data := testEncodeGIF(t, testAnimation())
+	frames, err := gifFrameCount(data)
+	if err != nil || frames != 6 {
+		t.Errorf("gifFrameCount() = %d, %v, want 6", frames, err)
+	}
+	if _, err = gifFrameCount(data[:len(data)/2]); err == nil {
+		t.Error("truncated stream counted without error")
+	}
+}
+
+func TestDecodeRejectsAnimationBomb(t *testing.T) {
+	const frameCount = 2000
+	animation := &gif.GIF{Config: image.Config{Width: 6000, Height: 6000}}
+	for index := 0; index < frameCount; index++ {
+		animation.Image = append(animation.Image, testFrame(image.Rect(0, 0, 1, 1), 1))
+		animation.Delay = append(animation.Delay, 1)
+	}
+	animation.Config.ColorModel = testAnimationPalette
+	data := testEncodeGIF(t, animation)
+	logger := zerolog.Nop()
+	limits := DefaultDecodeLimits()
+	_, err := DecodeWithLimits(context.Background(), bytes.NewReader(data), &limits, nil, &logger)
+	if !errors.Is(err, ErrImageTooLarge) {
+		t.Errorf("DecodeWithLimits() = %v, want ErrImageTooLarge", err)
+	}
+}
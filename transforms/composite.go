@@ -0,0 +1,216 @@
+package transforms
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+	"strings"
+)
+
+// BlendMode is the separable blend function used to mix the watermark with
+// the pixels beneath it. See https://www.w3.org/TR/compositing-1/#blending
+type BlendMode int
+
+const (
+	// BlendNormal draws the watermark color as is
+	BlendNormal BlendMode = iota
+	// BlendMultiply darkens the backdrop by the watermark color
+	BlendMultiply
+	// BlendScreen lightens the backdrop by the watermark color
+	BlendScreen
+	// BlendOverlay multiplies or screens depending on the backdrop color
+	BlendOverlay
+	// BlendSoftLight darkens or lightens depending on the watermark color,
+	// with a softer result than BlendOverlay
+	BlendSoftLight
+)
+
+var blendModeNames = []string{
+	"normal",
+	"multiply",
+	"screen",
+	"overlay",
+	"soft-light",
+}
+
+// String returns the hyphenated blend mode name, eg "soft-light"
+func (mode BlendMode) String() string {
+	if mode < BlendNormal || mode > BlendSoftLight {
+		return fmt.Sprintf("BlendMode(%d)", int(mode))
+	}
+	return blendModeNames[mode]
+}
+
+// ParseBlendMode returns the BlendMode for a name such as "multiply"
+func ParseBlendMode(name string) (BlendMode, error) {
+	normalized := strings.ToLower(strings.TrimSpace(name))
+	if normalized == "" {
+		return BlendNormal, nil
+	}
+	for index, eachName := range blendModeNames {
+		if eachName == normalized {
+			return BlendMode(index), nil
+		}
+	}
+	return BlendNormal, fmt.Errorf("unsupported blend mode: %s", name)
+}
+
+// blend returns the blended color for a backdrop cb and source cs, both
+// non-premultiplied and in [0, 1]
+func (mode BlendMode) blend(cb float64, cs float64) float64 {
+	switch mode {
+	case BlendMultiply:
+		return cb * cs
+	case BlendScreen:
+		return cb + cs - cb*cs
+	case BlendOverlay:
+		if cb <= 0.5 {
+			return cs * 2 * cb
+		}
+		cb2 := 2*cb - 1
+		return cs + cb2 - cs*cb2
+	case BlendSoftLight:
+		if cs <= 0.5 {
+			return cb - (1-2*cs)*cb*(1-cb)
+		}
+		var d float64
+		if cb <= 0.25 {
+			d = ((16*cb-12)*cb + 4) * cb
+		} else {
+			d = math.Sqrt(cb)
+		}
+		return cb + (2*cs-1)*(d-cb)
+	default:
+		return cs
+	}
+}
+
+// Composite draws src, aligned so that sp maps to rect.Min, over the dst
+// pixels in rect using the blend mode. Opacity scales the source alpha and
+// is clamped to [0, 1]. Compositing stops with ctx.Err() once ctx is done.
+func Composite(ctx context.Context,
+	dst *image.RGBA,
+	rect image.Rectangle,
+	src image.Image,
+	sp image.Point,
+	mode BlendMode,
+	opacity float64) error {
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	opacity = math.Max(0, math.Min(1, opacity))
+	if opacity == 0 {
+		return nil
+	}
+	rgbaSrc, isRGBA := src.(*image.RGBA)
+	if mode == BlendNormal && (opacity == 1 || !isRGBA) {
+		if opacity == 1 {
+			draw.Draw(dst, rect, src, sp, draw.Over)
+		} else {
+			mask := image.NewUniform(color.Alpha16{A: uint16(opacity * 0xffff)})
+			draw.DrawMask(dst, rect, src, sp, mask, image.Point{}, draw.Over)
+		}
+		return nil
+	}
+
+	// Clip the rectangle to both images, moving the source point along with
+	// any clipped top or left edge
+	clipped := rect.Intersect(dst.Bounds())
+	sp = sp.Add(clipped.Min.Sub(rect.Min))
+	rect = clipped
+	srcRect := rect.Sub(rect.Min).Add(sp).Intersect(src.Bounds())
+	rect = srcRect.Sub(sp).Add(rect.Min)
+	if rect.Empty() {
+		return nil
+	}
+	delta := sp.Sub(rect.Min)
+	return parallelRows(ctx, rect, func(minY int, maxY int) {
+		for y := minY; y < maxY; y++ {
+			for x := rect.Min.X; x < rect.Max.X; x++ {
+				var sr, sg, sb, sa uint32
+				if isRGBA {
+					// Widen the 8-bit premultiplied channels as color.RGBA does
+					srcOffset := rgbaSrc.PixOffset(x+delta.X, y+delta.Y)
+					srcPix := rgbaSrc.Pix[srcOffset : srcOffset+4 : srcOffset+4]
+					sr = uint32(srcPix[0]) * 0x101
+					sg = uint32(srcPix[1]) * 0x101
+					sb = uint32(srcPix[2]) * 0x101
+					sa = uint32(srcPix[3]) * 0x101
+				} else {
+					sr, sg, sb, sa = src.At(x+delta.X, y+delta.Y).RGBA()
+				}
+				if sa == 0 {
+					continue
+				}
+				offset := dst.PixOffset(x, y)
+				pix := dst.Pix[offset : offset+4 : offset+4]
+				if mode == BlendNormal {
+					overPixel(pix, sr, sg, sb, sa, opacity)
+				} else {
+					blendPixel(pix, sr, sg, sb, sa, mode, opacity)
+				}
+			}
+		}
+	})
+}
+
+// overPixel applies the Porter-Duff source-over operator to a single
+// premultiplied RGBA pixel, with the source scaled by opacity. The source
+// channels are 16-bit premultiplied values as returned by color.RGBA().
+func overPixel(pix []uint8,
+	sr uint32,
+	sg uint32,
+	sb uint32,
+	sa uint32,
+	opacity float64) {
+
+	scale := uint32(opacity * 0xffff)
+	sr = sr * scale / 0xffff
+	sg = sg * scale / 0xffff
+	sb = sb * scale / 0xffff
+	sa = sa * scale / 0xffff
+	inverse := 0xffff - sa
+	pix[0] = uint8((uint32(pix[0])*0x101*inverse/0xffff + sr) >> 8)
+	pix[1] = uint8((uint32(pix[1])*0x101*inverse/0xffff + sg) >> 8)
+	pix[2] = uint8((uint32(pix[2])*0x101*inverse/0xffff + sb) >> 8)
+	pix[3] = uint8((uint32(pix[3])*0x101*inverse/0xffff + sa) >> 8)
+}
+
+// blendPixel applies the W3C source-over compositing formula with a
+// separable blend function to a single premultiplied RGBA pixel. The source
+// channels are 16-bit premultiplied values as returned by color.RGBA().
+func blendPixel(pix []uint8,
+	sr uint32,
+	sg uint32,
+	sb uint32,
+	sa uint32,
+	mode BlendMode,
+	opacity float64) {
+
+	alphaS := float64(sa) / 0xffff
+	alphaB := float64(pix[3]) / 0xff
+	srcColor := [3]float64{
+		float64(sr) / float64(sa),
+		float64(sg) / float64(sa),
+		float64(sb) / float64(sa),
+	}
+	alphaS *= opacity
+	alphaO := alphaS + alphaB*(1-alphaS)
+
+	for channel := 0; channel < 3; channel++ {
+		cs := srcColor[channel]
+		var cb float64
+		if pix[3] != 0 {
+			cb = float64(pix[channel]) / float64(pix[3])
+		}
+		mixed := (1-alphaB)*cs + alphaB*mode.blend(cb, cs)
+		// Premultiplied result
+		result := alphaS*mixed + (1-alphaS)*alphaB*cb
+		pix[channel] = uint8(math.Round(math.Max(0, math.Min(1, result)) * 0xff))
+	}
+	pix[3] = uint8(math.Round(alphaO * 0xff))
+}
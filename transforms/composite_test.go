@@ -0,0 +1,73 @@
+package transforms
+
+import (
+	"context"
+	"image"
+	"image/color"
+	"image/draw"
+	"testing"
+)
+
+// gradientWatermark returns an opaque watermark whose pixels encode their
+// own coordinates
+func gradientWatermark(width int, height int) *image.RGBA {
+	watermark := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			watermark.SetRGBA(x, y, color.RGBA{R: uint8(x), G: uint8(y), A: 0xff})
+		}
+	}
+	return watermark
+}
+
+func TestCompositeClipsSourceWithDestination(t *testing.T) {
+	watermark := gradientWatermark(64, 64)
+	offsets := []image.Point{
+		image.Pt(-20, -20),
+		image.Pt(-30, 5),
+		image.Pt(5, -40),
+		image.Pt(70, 70),
+	}
+	// Each backdrop leaves the watermark color unchanged by the blend mode
+	backdrops := map[BlendMode]color.RGBA{
+		BlendNormal:   {R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+		BlendMultiply: {R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+		BlendScreen:   {A: 0xff},
+	}
+	for eachMode, eachBackdrop := range backdrops {
+		for _, eachOffset := range offsets {
+			dst := image.NewRGBA(image.Rect(0, 0, 100, 100))
+			draw.Draw(dst, dst.Bounds(), image.NewUniform(eachBackdrop), image.Point{}, draw.Src)
+			rect := watermark.Bounds().Add(eachOffset)
+			if err := Composite(context.Background(), dst, rect, watermark, image.Point{}, eachMode, 0.999); err != nil {
+				t.Fatal(err)
+			}
+			visible := rect.Intersect(dst.Bounds())
+			for y := visible.Min.Y; y < visible.Max.Y; y++ {
+				for x := visible.Min.X; x < visible.Max.X; x++ {
+					got := dst.RGBAAt(x, y)
+					wantR := uint8(x - eachOffset.X)
+					wantG := uint8(y - eachOffset.Y)
+					if absDiff(got.R, wantR) > 1 || absDiff(got.G, wantG) > 1 {
+						t.Fatalf("%s at %v: pixel (%d, %d) = %d,%d, want %d,%d",
+							eachMode,
+							eachOffset,
+							x,
+							y,
+							got.R,
+							got.G,
+							wantR,
+							wantG)
+					}
+				}
+			}
+		}
+	}
+}
+
+func absDiff(a uint8, b uint8) uint8 {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
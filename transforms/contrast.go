@@ -0,0 +1,290 @@
+package transforms
+
+import (
+	"fmt"
+	"image"
+	"image/draw"
+	"math"
+	"strings"
+)
+
+// ContrastMode determines how the watermark adapts to the background it's
+// drawn over
+type ContrastMode int
+
+const (
+	// ContrastOff draws the watermark as is
+	ContrastOff ContrastMode = iota
+	// ContrastVariant swaps in a lightened or darkened variant of the
+	// watermark when its luminance is too close to the background
+	ContrastVariant
+	// ContrastHalo always draws a soft contrasting halo behind the watermark
+	ContrastHalo
+	// ContrastAuto swaps variants as needed and adds a halo over busy
+	// backgrounds
+	ContrastAuto
+)
+
+var contrastModeNames = []string{
+	"off",
+	"variant",
+	"halo",
+	"auto",
+}
+
+// String returns the mode name accepted by ParseContrastMode
+func (mode ContrastMode) String() string {
+	if mode < ContrastOff || mode > ContrastAuto {
+		return fmt.Sprintf("ContrastMode(%d)", int(mode))
+	}
+	return contrastModeNames[mode]
+}
+
+// ParseContrastMode returns the ContrastMode for one of "off", "variant",
+// "halo" or "auto"
+func ParseContrastMode(name string) (ContrastMode, error) {
+	normalized := strings.ToLower(strings.TrimSpace(name))
+	if normalized == "" {
+		return ContrastOff, nil
+	}
+	for index, eachName := range contrastModeNames {
+		if eachName == normalized {
+			return ContrastMode(index), nil
+		}
+	}
+	return ContrastOff, fmt.Errorf("unsupported contrast mode: %s", name)
+}
+
+const (
+	// minimumContrast is the smallest acceptable difference between the
+	// watermark and background luminance, both in [0, 1]
+	minimumContrast = 0.3
+	// busyDeviation is the background luminance standard deviation above
+	// which ContrastAuto adds a halo
+	busyDeviation = 0.18
+	// variantStrength is how far a variant is mixed towards white or black
+	variantStrength = 0.7
+	// haloOpacity scales the halo relative to the watermark opacity
+	haloOpacity = 0.5
+	// luminanceSamples bounds the number of samples along each axis when
+	// measuring a region
+	luminanceSamples = 64
+)
+
+// luma returns the Rec. 709 luma in [0, 1] of a non-premultiplied color
+func luma(r float64, g float64, b float64) float64 {
+	return 0.2126*r + 0.7152*g + 0.0722*b
+}
+
+// regionLuminance returns the mean and variance of the luminance of img
+// within rect. Large regions are sampled on a sparse grid.
+func regionLuminance(img image.Image, rect image.Rectangle) (float64, float64) {
+	rect = rect.Intersect(img.Bounds())
+	if rect.Empty() {
+		return 0, 0
+	}
+	stepX := maxInt(1, rect.Dx()/luminanceSamples)
+	stepY := maxInt(1, rect.Dy()/luminanceSamples)
+	var sum, sumSquares float64
+	count := 0
+	for y := rect.Min.Y; y < rect.Max.Y; y += stepY {
+		for x := rect.Min.X; x < rect.Max.X; x += stepX {
+			r, g, b, _ := img.At(x, y).RGBA()
+			value := luma(float64(r)/0xffff, float64(g)/0xffff, float64(b)/0xffff)
+			sum += value
+			sumSquares += value * value
+			count++
+		}
+	}
+	mean := sum / float64(count)
+	return mean, math.Max(0, sumSquares/float64(count)-mean*mean)
+}
+
+// watermarkLuminance returns the alpha weighted mean luminance of the
+// watermark's visible pixels
+func watermarkLuminance(img image.Image) float64 {
+	bounds := img.Bounds()
+	var sum, weight float64
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			r, g, b, a := img.At(x, y).RGBA()
+			if a == 0 {
+				continue
+			}
+			// Premultiplied channels divided by alpha
+			alpha := float64(a)
+			sum += luma(float64(r)/alpha, float64(g)/alpha, float64(b)/alpha) * alpha
+			weight += alpha
+		}
+	}
+	if weight == 0 {
+		return 0
+	}
+	return sum / weight
+}
+
+// mixWatermark returns a copy of src with every color mixed towards target
+// by strength, preserving the alpha channel
+func mixWatermark(src image.Image, target uint8, strength float64) *image.RGBA {
+	bounds := src.Bounds()
+	mixed := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(mixed, mixed.Bounds(), src, bounds.Min, draw.Src)
+	for offset := 0; offset < len(mixed.Pix); offset += 4 {
+		alpha := float64(mixed.Pix[offset+3])
+		// Premultiplied target color
+		premultiplied := float64(target) * alpha / 0xff
+		for channel := 0; channel < 3; channel++ {
+			value := float64(mixed.Pix[offset+channel])
+			mixed.Pix[offset+channel] = clampUint8(float32(value + (premultiplied-value)*strength))
+		}
+	}
+	return mixed
+}
+
+// haloImage returns a soft silhouette of src in the given gray level,
+// dilated and blurred by radius. The result is radius pixels larger than
+// src on every side.
+func haloImage(src image.Image, radius int, gray uint8) *image.RGBA {
+	bounds := src.Bounds()
+	width := bounds.Dx() + 2*radius
+	height := bounds.Dy() + 2*radius
+	alpha := make([]float32, width*height)
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			_, _, _, a := src.At(x, y).RGBA()
+			alpha[(y-bounds.Min.Y+radius)*width+x-bounds.Min.X+radius] = float32(a) / 0xffff
+		}
+	}
+	// Separable box blur, applied twice to approximate a gaussian. The
+	// result is doubled below s.t. the halo spreads past the watermark edges
+	// rather than fading out at them.
+	scratch := make([]float32, len(alpha))
+	for pass := 0; pass < 2; pass++ {
+		boxBlur(alpha, scratch, width, height, radius, 1, width)
+		boxBlur(scratch, alpha, height, width, radius, width, 1)
+	}
+	halo := image.NewRGBA(image.Rect(0, 0, width, height))
+	for index, eachAlpha := range alpha {
+		value := math.Min(1, float64(eachAlpha)*2)
+		offset := index * 4
+		halo.Pix[offset] = uint8(float64(gray) * value)
+		halo.Pix[offset+1] = uint8(float64(gray) * value)
+		halo.Pix[offset+2] = uint8(float64(gray) * value)
+		halo.Pix[offset+3] = uint8(0xff * value)
+	}
+	return halo
+}
+
+// boxBlur averages src over a window of 2*radius+1 samples along one axis
+// and writes the result to dst. Each of the lineCount lines has length
+// samples, step apart, and consecutive lines start stride apart.
+func boxBlur(src []float32,
+	dst []float32,
+	length int,
+	lineCount int,
+	radius int,
+	step int,
+	stride int) {
+	window := float32(2*radius + 1)
+	for line := 0; line < lineCount; line++ {
+		base := line * stride
+		var sum float32
+		for index := -radius; index <= radius; index++ {
+			if index >= 0 && index < length {
+				sum += src[base+index*step]
+			}
+		}
+		for index := 0; index < length; index++ {
+			dst[base+index*step] = sum / window
+			if outgoing := index - radius; outgoing >= 0 {
+				sum -= src[base+outgoing*step]
+			}
+			if incoming := index + radius + 1; incoming < length {
+				sum += src[base+incoming*step]
+			}
+		}
+	}
+}
+
+// contrastWatermark chooses the watermark variant drawn over each
+// placement. Variants and halos are only built once they're needed.
+type contrastWatermark struct {
+	mode      ContrastMode
+	base      image.Image
+	luminance float64
+	radius    int
+	variants  map[uint8]*image.RGBA
+	halos     map[uint8]*image.RGBA
+}
+
+func newContrastWatermark(watermark image.Image, mode ContrastMode) *contrastWatermark {
+	size := watermark.Bounds().Size()
+	adaptive := &contrastWatermark{
+		mode:     mode,
+		base:     watermark,
+		radius:   maxInt(2, maxInt(size.X, size.Y)/32),
+		variants: make(map[uint8]*image.RGBA),
+		halos:    make(map[uint8]*image.RGBA),
+	}
+	if mode != ContrastOff {
+		adaptive.luminance = watermarkLuminance(watermark)
+	}
+	return adaptive
+}
+
+// contrastChoice is the watermark, and optional halo, drawn over a single
+// placement
+type contrastChoice struct {
+	watermark image.Image
+	halo      image.Image
+	// variant is "base", "light" or "dark"
+	variant            string
+	backgroundLuma     float64
+	backgroundVariance float64
+}
+
+// choose samples the background under rect and returns the watermark to
+// draw there
+func (adaptive *contrastWatermark) choose(background image.Image, rect image.Rectangle) *contrastChoice {
+	choice := &contrastChoice{
+		watermark: adaptive.base,
+		variant:   "base",
+	}
+	if adaptive.mode == ContrastOff {
+		return choice
+	}
+	mean, variance := regionLuminance(background, rect)
+	choice.backgroundLuma = mean
+	choice.backgroundVariance = variance
+	luminance := adaptive.luminance
+	if adaptive.mode != ContrastHalo &&
+		math.Abs(mean-adaptive.luminance) < minimumContrast {
+		target, variant := uint8(0xff), "light"
+		if mean >= 0.5 {
+			target, variant = 0, "dark"
+		}
+		if _, exists := adaptive.variants[target]; !exists {
+			adaptive.variants[target] = mixWatermark(adaptive.base, target, variantStrength)
+		}
+		choice.watermark = adaptive.variants[target]
+		choice.variant = variant
+		luminance = float64(target)/0xff*variantStrength + adaptive.luminance*(1-variantStrength)
+	}
+	if adaptive.mode == ContrastHalo ||
+		(adaptive.mode == ContrastAuto && math.Sqrt(variance) > busyDeviation) {
+		gray := uint8(0)
+		if luminance < 0.5 {
+			gray = 0xff
+		}
+		if _, exists := adaptive.halos[gray]; !exists {
+			adaptive.halos[gray] = haloImage(adaptive.base, adaptive.radius, gray)
+		}
+		choice.halo = adaptive.halos[gray]
+	}
+	return choice
+}
+
+// haloRect returns the destination of the halo for a watermark drawn at rect
+func (adaptive *contrastWatermark) haloRect(rect image.Rectangle) image.Rectangle {
+	return rect.Inset(-adaptive.radius)
+}
@@ -0,0 +1,140 @@
+package transforms
+
+import (
+	"context"
+	"image"
+	"image/color"
+	"image/draw"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// parallelPixelThreshold is the smallest area, in pixels, that is split
+// into row bands. Smaller images aren't worth the scheduling overhead.
+const parallelPixelThreshold = 256 * 1024
+
+// bandsPerWorker splits large images into more bands than there are
+// workers s.t. cancellation is noticed promptly even with a single CPU
+const bandsPerWorker = 4
+
+// parallelRows calls fn with disjoint [minY, maxY) bands that together
+// cover rect. Bands run concurrently when rect is large enough, and no new
+// band is started once ctx is done, in which case ctx.Err() is returned.
+func parallelRows(ctx context.Context,
+	rect image.Rectangle,
+	fn func(minY int, maxY int)) error {
+	height := rect.Dy()
+	if height <= 0 {
+		return ctx.Err()
+	}
+	workers := runtime.GOMAXPROCS(0)
+	bandCount := 1
+	if rect.Dx()*height >= parallelPixelThreshold {
+		bandCount = minInt(height, workers*bandsPerWorker)
+	}
+	workers = minInt(workers, bandCount)
+	bandHeight := (height + bandCount - 1) / bandCount
+
+	var nextBand int64
+	work := func() {
+		for ctx.Err() == nil {
+			minY := rect.Min.Y + int(atomic.AddInt64(&nextBand, 1)-1)*bandHeight
+			if minY >= rect.Max.Y {
+				return
+			}
+			fn(minY, minInt(minY+bandHeight, rect.Max.Y))
+		}
+	}
+	if workers <= 1 {
+		work()
+		return ctx.Err()
+	}
+	var waitGroup sync.WaitGroup
+	for worker := 0; worker < workers; worker++ {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			work()
+		}()
+	}
+	waitGroup.Wait()
+	return ctx.Err()
+}
+
+// cloneRGBA returns a copy of img as an *image.RGBA with the same bounds.
+// Decoded JPEG, PNG and grayscale images are converted directly from their
+// pixel buffers, in parallel row bands for large images.
+func cloneRGBA(ctx context.Context, img image.Image) (*image.RGBA, error) {
+	bounds := img.Bounds()
+	dst := image.NewRGBA(bounds)
+	err := parallelRows(ctx, bounds, func(minY int, maxY int) {
+		convertRows(dst, img, minY, maxY)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dst, nil
+}
+
+// convertRows converts the rows [minY, maxY) of img into dst, which has
+// the same bounds
+func convertRows(dst *image.RGBA, img image.Image, minY int, maxY int) {
+	bounds := img.Bounds()
+	width := bounds.Dx()
+	switch src := img.(type) {
+	case *image.RGBA:
+		for y := minY; y < maxY; y++ {
+			srcOffset := src.PixOffset(bounds.Min.X, y)
+			dstOffset := dst.PixOffset(bounds.Min.X, y)
+			copy(dst.Pix[dstOffset:dstOffset+width*4], src.Pix[srcOffset:srcOffset+width*4])
+		}
+	case *image.NRGBA:
+		for y := minY; y < maxY; y++ {
+			srcOffset := src.PixOffset(bounds.Min.X, y)
+			dstOffset := dst.PixOffset(bounds.Min.X, y)
+			for x := 0; x < width; x, srcOffset, dstOffset = x+1, srcOffset+4, dstOffset+4 {
+				alpha := uint32(src.Pix[srcOffset+3])
+				dstPix := dst.Pix[dstOffset : dstOffset+4 : dstOffset+4]
+				if alpha == 0xff {
+					copy(dstPix, src.Pix[srcOffset:srcOffset+4])
+					continue
+				}
+				dstPix[0] = uint8((uint32(src.Pix[srcOffset]) * alpha) / 0xff)
+				dstPix[1] = uint8((uint32(src.Pix[srcOffset+1]) * alpha) / 0xff)
+				dstPix[2] = uint8((uint32(src.Pix[srcOffset+2]) * alpha) / 0xff)
+				dstPix[3] = uint8(alpha)
+			}
+		}
+	case *image.YCbCr:
+		for y := minY; y < maxY; y++ {
+			dstOffset := dst.PixOffset(bounds.Min.X, y)
+			for x := bounds.Min.X; x < bounds.Max.X; x, dstOffset = x+1, dstOffset+4 {
+				yOffset := src.YOffset(x, y)
+				cOffset := src.COffset(x, y)
+				r, g, b := color.YCbCrToRGB(src.Y[yOffset], src.Cb[cOffset], src.Cr[cOffset])
+				dstPix := dst.Pix[dstOffset : dstOffset+4 : dstOffset+4]
+				dstPix[0] = r
+				dstPix[1] = g
+				dstPix[2] = b
+				dstPix[3] = 0xff
+			}
+		}
+	case *image.Gray:
+		for y := minY; y < maxY; y++ {
+			srcOffset := src.PixOffset(bounds.Min.X, y)
+			dstOffset := dst.PixOffset(bounds.Min.X, y)
+			for x := 0; x < width; x, srcOffset, dstOffset = x+1, srcOffset+1, dstOffset+4 {
+				gray := src.Pix[srcOffset]
+				dstPix := dst.Pix[dstOffset : dstOffset+4 : dstOffset+4]
+				dstPix[0] = gray
+				dstPix[1] = gray
+				dstPix[2] = gray
+				dstPix[3] = 0xff
+			}
+		}
+	default:
+		band := image.Rect(bounds.Min.X, minY, bounds.Max.X, maxY)
+		draw.Draw(dst, band, img, band.Min, draw.Src)
+	}
+}
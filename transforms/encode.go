@@ -0,0 +1,158 @@
+package transforms
+
+import (
+	"fmt"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"strings"
+)
+
+// Format identifies an image encoding
+type Format string
+
+const (
+	// FormatAuto encodes the output in the same format as the source
+	FormatAuto Format = ""
+	// FormatJPEG encodes the output as a JPEG
+	FormatJPEG Format = "jpeg"
+	// FormatPNG encodes the output as a PNG
+	FormatPNG Format = "png"
+	// FormatGIF encodes the output as a GIF
+	FormatGIF Format = "gif"
+)
+
+// ParseFormat returns the Format for a user supplied name. The empty string
+// and "auto" both map to FormatAuto.
+func ParseFormat(name string) (Format, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "", "auto":
+		return FormatAuto, nil
+	case "jpeg", "jpg":
+		return FormatJPEG, nil
+	case "png":
+		return FormatPNG, nil
+	case "gif":
+		return FormatGIF, nil
+	default:
+		return FormatAuto, fmt.Errorf("unsupported output format: %s", name)
+	}
+}
+
+// ContentType returns the MIME type for the format
+func (format Format) ContentType() string {
+	switch format {
+	case FormatJPEG:
+		return "image/jpeg"
+	case FormatGIF:
+		return "image/gif"
+	default:
+		return "image/png"
+	}
+}
+
+// Extension returns the conventional file extension, without the leading
+// period, for the format
+func (format Format) Extension() string {
+	switch format {
+	case FormatJPEG:
+		return "jpg"
+	case FormatGIF:
+		return "gif"
+	default:
+		return "png"
+	}
+}
+
+// Encoder is the final stage of a Pipeline that serializes the transformed
+// image. The sourceFormat is the format name reported by the decoder.
+// Encode returns the format that was actually written.
+type Encoder interface {
+	Encode(writer io.Writer, img image.Image, sourceFormat string) (Format, error)
+}
+
+// AnimationEncoder is an Encoder that can also write every frame of an
+// animated GIF source
+type AnimationEncoder interface {
+	Encoder
+	// OutputFormat returns the format written for sourceFormat
+	OutputFormat(sourceFormat string) Format
+	EncodeAnimation(writer io.Writer, animation *gif.GIF) error
+}
+
+// EncodeOptions control the output format and encoder quality settings
+type EncodeOptions struct {
+	// Format forces the output format. FormatAuto preserves the source format,
+	// falling back to PNG for formats that cannot be encoded.
+	Format Format
+	// JPEGQuality is in the range [1, 100]. Zero uses jpeg.DefaultQuality.
+	JPEGQuality int
+	// PNGCompression is the zlib compression level for PNG output
+	PNGCompression png.CompressionLevel
+}
+
+// FormatEncoder encodes the output according to its EncodeOptions
+type FormatEncoder struct {
+	Options EncodeOptions
+}
+
+// OutputFormat returns the format that will be written for an image decoded
+// from sourceFormat
+func (encoder *FormatEncoder) OutputFormat(sourceFormat string) Format {
+	if encoder.Options.Format != FormatAuto {
+		return encoder.Options.Format
+	}
+	format, err := ParseFormat(sourceFormat)
+	if err != nil || format == FormatAuto {
+		return FormatPNG
+	}
+	return format
+}
+
+// Encode writes the image in the selected format
+func (encoder *FormatEncoder) Encode(writer io.Writer,
+	img image.Image,
+	sourceFormat string) (Format, error) {
+	format := encoder.OutputFormat(sourceFormat)
+	var err error
+	switch format {
+	case FormatJPEG:
+		quality := encoder.Options.JPEGQuality
+		if quality <= 0 {
+			quality = jpeg.DefaultQuality
+		}
+		err = jpeg.Encode(writer, img, &jpeg.Options{Quality: quality})
+	case FormatGIF:
+		err = gif.Encode(writer, img, nil)
+	default:
+		pngEncoder := &png.Encoder{
+			CompressionLevel: encoder.Options.PNGCompression,
+		}
+		err = pngEncoder.Encode(writer, img)
+	}
+	return format, err
+}
+
+// ParsePNGCompression returns the png.CompressionLevel for a user supplied
+// name
+func ParsePNGCompression(name string) (png.CompressionLevel, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "", "default":
+		return png.DefaultCompression, nil
+	case "none":
+		return png.NoCompression, nil
+	case "speed":
+		return png.BestSpeed, nil
+	case "best":
+		return png.BestCompression, nil
+	default:
+		return png.DefaultCompression, fmt.Errorf("unsupported PNG compression level: %s", name)
+	}
+}
+
+// EncodeAnimation writes every frame of the animation as a GIF
+func (encoder *FormatEncoder) EncodeAnimation(writer io.Writer, animation *gif.GIF) error {
+	return gif.EncodeAll(writer, animation)
+}
@@ -0,0 +1,170 @@
+package transforms
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"image"
+)
+
+// exifHeader prefixes the TIFF structure in a JPEG APP1 segment
+var exifHeader = []byte("Exif\x00\x00")
+
+const (
+	markerSOI  = 0xd8
+	markerSOS  = 0xda
+	markerAPP1 = 0xe1
+
+	exifTagOrientation = 0x0112
+	exifTypeShort      = 3
+)
+
+// jpegSegment is a single marker segment in a JPEG stream. Data excludes the
+// marker and length bytes.
+type jpegSegment struct {
+	marker byte
+	data   []byte
+}
+
+// jpegSegments returns the marker segments that precede the entropy coded
+// image data. It returns nil if data is not a JPEG.
+func jpegSegments(data []byte) []jpegSegment {
+	if len(data) < 4 || data[0] != 0xff || data[1] != markerSOI {
+		return nil
+	}
+	var segments []jpegSegment
+	offset := 2
+	for offset+4 <= len(data) {
+		if data[offset] != 0xff {
+			break
+		}
+		marker := data[offset+1]
+		// Fill bytes
+		if marker == 0xff {
+			offset++
+			continue
+		}
+		length := int(binary.BigEndian.Uint16(data[offset+2:]))
+		if length < 2 || offset+2+length > len(data) {
+			break
+		}
+		segments = append(segments, jpegSegment{
+			marker: marker,
+			data:   data[offset+4 : offset+2+length],
+		})
+		if marker == markerSOS {
+			break
+		}
+		offset += 2 + length
+	}
+	return segments
+}
+
+// jpegExif returns the TIFF structure from the JPEG's EXIF APP1 segment, or
+// nil if there isn't one
+func jpegExif(data []byte) []byte {
+	for _, eachSegment := range jpegSegments(data) {
+		if eachSegment.marker == markerAPP1 && bytes.HasPrefix(eachSegment.data, exifHeader) {
+			return eachSegment.data[len(exifHeader):]
+		}
+	}
+	return nil
+}
+
+// exifOrientationOffset returns the byte order and the offset of the
+// Orientation value within the TIFF structure, or -1 if the tag is absent
+func exifOrientationOffset(tiff []byte) (binary.ByteOrder, int) {
+	if len(tiff) < 8 {
+		return nil, -1
+	}
+	var byteOrder binary.ByteOrder
+	switch string(tiff[0:2]) {
+	case "II":
+		byteOrder = binary.LittleEndian
+	case "MM":
+		byteOrder = binary.BigEndian
+	default:
+		return nil, -1
+	}
+	ifdOffset := int(byteOrder.Uint32(tiff[4:]))
+	if ifdOffset < 8 || ifdOffset+2 > len(tiff) {
+		return nil, -1
+	}
+	entryCount := int(byteOrder.Uint16(tiff[ifdOffset:]))
+	for index := 0; index < entryCount; index++ {
+		entry := ifdOffset + 2 + index*12
+		if entry+12 > len(tiff) {
+			break
+		}
+		if byteOrder.Uint16(tiff[entry:]) == exifTagOrientation &&
+			byteOrder.Uint16(tiff[entry+2:]) == exifTypeShort {
+			return byteOrder, entry + 8
+		}
+	}
+	return nil, -1
+}
+
+// exifOrientation returns the EXIF Orientation value in [1, 8], defaulting
+// to 1 (upright) if the tag is absent or invalid
+func exifOrientation(tiff []byte) int {
+	byteOrder, offset := exifOrientationOffset(tiff)
+	if offset < 0 {
+		return 1
+	}
+	orientation := int(byteOrder.Uint16(tiff[offset:]))
+	if orientation < 1 || orientation > 8 {
+		return 1
+	}
+	return orientation
+}
+
+// Orient physically rotates and flips img so that it is upright given the
+// EXIF orientation value. The result has a zero origin. Processing stops
+// with ctx.Err() once ctx is done.
+func Orient(ctx context.Context, img image.Image, orientation int) (image.Image, error) {
+	if orientation <= 1 || orientation > 8 {
+		return img, nil
+	}
+	src, err := toRGBA(ctx, img)
+	if err != nil {
+		return nil, err
+	}
+	width := src.Bounds().Dx()
+	height := src.Bounds().Dy()
+	dstWidth, dstHeight := width, height
+	// Orientations 5-8 swap the axes
+	if orientation >= 5 {
+		dstWidth, dstHeight = height, width
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, dstWidth, dstHeight))
+	err = parallelRows(ctx, dst.Rect, func(minY int, maxY int) {
+		for y := minY; y < maxY; y++ {
+			for x := 0; x < dstWidth; x++ {
+				var sx, sy int
+				switch orientation {
+				case 2:
+					sx, sy = width-1-x, y
+				case 3:
+					sx, sy = width-1-x, height-1-y
+				case 4:
+					sx, sy = x, height-1-y
+				case 5:
+					sx, sy = y, x
+				case 6:
+					sx, sy = y, height-1-x
+				case 7:
+					sx, sy = width-1-y, height-1-x
+				case 8:
+					sx, sy = width-1-y, x
+				}
+				srcOffset := src.PixOffset(sx, sy)
+				dstOffset := dst.PixOffset(x, y)
+				copy(dst.Pix[dstOffset:dstOffset+4], src.Pix[srcOffset:srcOffset+4])
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dst, nil
+}
@@ -0,0 +1,190 @@
+package transforms
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"testing"
+
+	"github.com/rs/zerolog"
+)
+
+// testOrientationTIFF returns a TIFF structure holding only the entries
+func testOrientationTIFF(byteOrder binary.ByteOrder, entries ...tiffEntry) []byte {
+	writer := &tiffWriter{byteOrder: byteOrder, buf: make([]byte, 8)}
+	if byteOrder == binary.LittleEndian {
+		copy(writer.buf, "II\x2a\x00")
+	} else {
+		copy(writer.buf, "MM\x00\x2a")
+	}
+	ifdOffset := writer.writeIFD(entries)
+	byteOrder.PutUint32(writer.buf[4:], ifdOffset)
+	return writer.buf
+}
+
+func TestExifOrientation(t *testing.T) {
+	for _, eachByteOrder := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		for orientation := 1; orientation <= 8; orientation++ {
+			tiff := testOrientationTIFF(eachByteOrder,
+				testASCIIEntry(testTagMake, "Acme Optical"),
+				testShortEntry(eachByteOrder, exifTagOrientation, uint16(orientation)))
+			if got := exifOrientation(tiff); got != orientation {
+				t.Errorf("%v exifOrientation() = %d, want %d", eachByteOrder, got, orientation)
+			}
+		}
+	}
+
+	upsideDown := testOrientationTIFF(binary.BigEndian,
+		testShortEntry(binary.BigEndian, exifTagOrientation, 3))
+	badByteOrder := append([]byte("XX"), upsideDown[2:]...)
+	badIFDOffset := append([]byte{}, upsideDown...)
+	binary.BigEndian.PutUint32(badIFDOffset[4:], uint32(len(badIFDOffset)))
+	longOrientation := testOrientationTIFF(binary.BigEndian, tiffEntry{
+		tag:      exifTagOrientation,
+		dataType: exifTypeLong,
+		count:    1,
+		value:    []byte{0, 0, 0, 3},
+	})
+	tests := []struct {
+		name string
+		tiff []byte
+	}{
+		{"missing", nil},
+		{"no orientation tag", testOrientationTIFF(binary.LittleEndian,
+			testASCIIEntry(testTagMake, "Acme Optical"))},
+		{"zero", testOrientationTIFF(binary.LittleEndian,
+			testShortEntry(binary.LittleEndian, exifTagOrientation, 0))},
+		{"out of range", testOrientationTIFF(binary.LittleEndian,
+			testShortEntry(binary.LittleEndian, exifTagOrientation, 9))},
+		{"not a short", longOrientation},
+		{"bad byte order", badByteOrder},
+		{"bad IFD offset", badIFDOffset},
+		{"truncated header", upsideDown[:6]},
+		{"truncated entry", upsideDown[:len(upsideDown)-8]},
+	}
+	for _, eachTest := range tests {
+		if got := exifOrientation(eachTest.tiff); got != 1 {
+			t.Errorf("%s exifOrientation() = %d, want 1", eachTest.name, got)
+		}
+	}
+}
+
+// testLabelledImage is a 3x2 image whose pixels are labelled by their
+// position, s.t. every rotation and flip is distinguishable:
+//
+//	a b c
+//	d e f
+func testLabelledImage(origin image.Point) *image.RGBA {
+	img := image.NewRGBA(image.Rectangle{Min: origin, Max: origin.Add(image.Pt(3, 2))})
+	for y := 0; y < 2; y++ {
+		for x := 0; x < 3; x++ {
+			img.SetRGBA(origin.X+x, origin.Y+y, color.RGBA{R: byte('a' + y*3 + x), A: 0xff})
+		}
+	}
+	return img
+}
+
+// imageLabels returns the labels of a testLabelledImage, one string per row
+func imageLabels(img image.Image) []string {
+	bounds := img.Bounds()
+	var rows []string
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		var row []byte
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			r, _, _, _ := img.At(x, y).RGBA()
+			row = append(row, byte(r>>8))
+		}
+		rows = append(rows, string(row))
+	}
+	return rows
+}
+
+func TestOrient(t *testing.T) {
+	tests := []struct {
+		orientation int
+		want        []string
+	}{
+		{1, []string{"abc", "def"}},
+		{2, []string{"cba", "fed"}},
+		{3, []string{"fed", "cba"}},
+		{4, []string{"def", "abc"}},
+		{5, []string{"ad", "be", "cf"}},
+		{6, []string{"da", "eb", "fc"}},
+		{7, []string{"fc", "eb", "da"}},
+		{8, []string{"cf", "be", "ad"}},
+		// Invalid orientations leave the image as is
+		{0, []string{"abc", "def"}},
+		{9, []string{"abc", "def"}},
+	}
+	for _, eachTest := range tests {
+		source := testLabelledImage(image.Pt(5, 7))
+		oriented, err := Orient(context.Background(), source, eachTest.orientation)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := imageLabels(oriented)
+		if len(got) != len(eachTest.want) {
+			t.Errorf("Orient(%d) = %q, want %q", eachTest.orientation, got, eachTest.want)
+			continue
+		}
+		for index := range got {
+			if got[index] != eachTest.want[index] {
+				t.Errorf("Orient(%d) = %q, want %q", eachTest.orientation, got, eachTest.want)
+				break
+			}
+		}
+		if oriented != image.Image(source) && oriented.Bounds().Min != (image.Point{}) {
+			t.Errorf("Orient(%d) has origin %v, want zero", eachTest.orientation, oriented.Bounds().Min)
+		}
+	}
+}
+
+// testJPEGWithAPP1 encodes a JPEG with an APP1 segment holding data
+// following the SOI marker
+func testJPEGWithAPP1(t *testing.T, width int, height int, data []byte) []byte {
+	t.Helper()
+	var encoded bytes.Buffer
+	if err := jpeg.Encode(&encoded, testYCbCr(width, height), nil); err != nil {
+		t.Fatal(err)
+	}
+	segment := []byte{0xff, markerAPP1, 0, 0}
+	binary.BigEndian.PutUint16(segment[2:], uint16(2+len(data)))
+	segment = append(segment, data...)
+	stream := encoded.Bytes()
+	return append(append(append([]byte{}, stream[:2]...), segment...), stream[2:]...)
+}
+
+func TestDecodeOrientsJPEG(t *testing.T) {
+	logger := zerolog.Nop()
+	rotated := testOrientationTIFF(binary.BigEndian,
+		testShortEntry(binary.BigEndian, exifTagOrientation, 6))
+	tests := []struct {
+		name        string
+		app1        []byte
+		orientation int
+		size        image.Point
+	}{
+		{"rotated", append(append([]byte{}, exifHeader...), rotated...), 6, image.Pt(32, 48)},
+		{"malformed", append(append([]byte{}, exifHeader...), "MM\x00\x2a\xff\xff"...), 1, image.Pt(48, 32)},
+		{"truncated", append(append([]byte{}, exifHeader...), rotated[:12]...), 1, image.Pt(48, 32)},
+		{"not EXIF", []byte("http://ns.adobe.com/xap/1.0/\x00"), 1, image.Pt(48, 32)},
+	}
+	for _, eachTest := range tests {
+		t.Run(eachTest.name, func(t *testing.T) {
+			data := testJPEGWithAPP1(t, 48, 32, eachTest.app1)
+			source, err := Decode(context.Background(), bytes.NewReader(data), &logger)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if source.Orientation != eachTest.orientation {
+				t.Errorf("Orientation = %d, want %d", source.Orientation, eachTest.orientation)
+			}
+			if size := source.Image.Bounds().Size(); size != eachTest.size {
+				t.Errorf("decoded %v, want %v", size, eachTest.size)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package transforms
+
+import (
+	"context"
+	"image"
+	"image/draw"
+
+	"github.com/rs/zerolog"
+)
+
+// Crop trims the image to Rect, which is relative to the image origin
+type Crop struct {
+	Rect image.Rectangle
+}
+
+// Apply returns the cropped image
+func (crop *Crop) Apply(ctx context.Context,
+	img image.Image,
+	logger *zerolog.Logger) (image.Image, error) {
+	cropRect := crop.Rect.Add(img.Bounds().Min).Intersect(img.Bounds())
+	cropped := image.NewRGBA(image.Rect(0, 0, cropRect.Dx(), cropRect.Dy()))
+	draw.Draw(cropped, cropped.Bounds(), img, cropRect.Min, draw.Src)
+	return cropped, nil
+}
+
+// Grayscale converts the image to 8-bit grayscale
+type Grayscale struct {
+}
+
+// Apply returns the grayscale image
+func (grayscale *Grayscale) Apply(ctx context.Context,
+	img image.Image,
+	logger *zerolog.Logger) (image.Image, error) {
+	gray := image.NewGray(img.Bounds())
+	draw.Draw(gray, gray.Bounds(), img, img.Bounds().Min, draw.Src)
+	return gray, nil
+}
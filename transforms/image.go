@@ -1,89 +1,317 @@
 package transforms
 
 import (
-	"bytes"
+	"context"
 	"fmt"
 	"image"
+	"image/color"
 	"image/draw"
 
-	"github.com/mweagle/SpartaImager/assets"
 	"github.com/rs/zerolog"
 
 	// Ensure the JPEG decoder is registered
 	_ "image/jpeg"
-	"image/png"
 	"io"
-	"math"
+	"strconv"
 )
 
-func watermarkName(suffix int) string {
-	return fmt.Sprintf("/resources/SpartaHelmet%d.png", suffix)
-}
+// defaultWatermarkProvider serves the watermarks embedded in the binary
+var defaultWatermarkProvider = NewEmbeddedProvider()
 
 // StampImage handles stamping the user uploaded image with the appropriately
-// sized watermark
-func StampImage(reader io.Reader, logger *zerolog.Logger) (io.ReadSeeker, error) {
-
-	target, imageType, err := image.Decode(reader)
+// sized watermark. Processing stops with ctx.Err() once ctx is done.
+func StampImage(ctx context.Context, reader io.Reader, logger *zerolog.Logger) (io.ReadSeeker, error) {
+	output, err := NewPipeline(&Stamp{}).Run(ctx, reader, logger)
 	if err != nil {
-		logger.Error().
-			Err(err).
-			Msg("Failed to decode image")
 		return nil, err
 	}
+	return output.Body, nil
+}
 
-	// Pick the longer edge and a reasonably sized stamp
-	maxEdge := math.Max(float64(target.Bounds().Max.X), float64(target.Bounds().Max.Y))
-	edgeLog := int(math.Floor(math.Log2(maxEdge))) - 1
+// Stamp is a Transform that composites the appropriately sized watermark
+// into the image
+type Stamp struct {
+	// Options defaults to DefaultStampOptions
+	Options *StampOptions
+	// Scale defaults to DefaultWatermarkScale
+	Scale *WatermarkScale
+	// Provider defaults to the embedded watermarks
+	Provider WatermarkProvider
+	// Watermark is the name of the master image passed to the Provider.
+	// Defaults to DefaultWatermark.
+	Watermark string
+}
 
-	logger.Error().
-		Str("ImageType", imageType).
-		Float64("MaxEdge", maxEdge).
-		Int("EdgeLog", edgeLog).
-		Interface("TargetBounds", target.Bounds()).
-		Msg("Target Dimensions")
+// Apply stamps the target image
+func (stamp *Stamp) Apply(ctx context.Context,
+	target image.Image,
+	logger *zerolog.Logger) (image.Image, error) {
 
-	watermarkSuffix := int(math.Max(32, math.Pow(2, math.Min(float64(8), float64(edgeLog)))))
-	resourceName := watermarkName(watermarkSuffix)
-	logger.Info().
-		Str("Resource", resourceName).
-		Msg("Watermark resource")
+	scale := stamp.Scale
+	if scale == nil {
+		defaultScale := DefaultWatermarkScale()
+		scale = &defaultScale
+	}
+	size := scale.Pixels(target.Bounds())
 
-	byteSource, err := assets.FSByte(false, resourceName)
+	provider := stamp.Provider
+	if provider == nil {
+		provider = defaultWatermarkProvider
+	}
+	name := stamp.Watermark
+	if name == "" {
+		name = DefaultWatermark
+	}
+	master, err := provider.Watermark(ctx, name, logger)
+	if err != nil {
+		return nil, err
+	}
+	watermark, err := scaleWatermark(ctx, master, size)
 	if err != nil {
+		return nil, err
+	}
+	masterBounds := master.Bounds()
+	if masterEdge := maxInt(masterBounds.Dx(), masterBounds.Dy()); bucketWatermarkSize(size) > masterEdge {
 		logger.Warn().
-			Err(err).
-			Str("Name", resourceName).
-			Interface("TargetBounds", target.Bounds()).
-			Msg("Failed to load computed watermark. Falling to default")
-		byteSource = assets.FSMustByte(false, watermarkName(16))
-	}
-	stampReader := bytes.NewReader(byteSource)
-	stamp, _, err := image.Decode(stampReader)
+			Str("Watermark", name).
+			Int("Pixels", size).
+			Int("MasterPixels", masterEdge).
+			Msg("Watermark capped at its native size")
+	}
+
+	logger.Info().
+		Interface("TargetBounds", target.Bounds()).
+		Str("Watermark", name).
+		Str("Size", scale.Size.String()).
+		Str("Edge", scale.Edge.String()).
+		Int("Pixels", size).
+		Interface("StampBounds", watermark.Bounds()).
+		Msg("Scaled watermark")
+
+	stamped, err := compositeWatermark(ctx, target, watermark, stamp.Options, logger)
 	if err != nil {
-		logger.Info().
-			Err(err).
-			Msg("Failed to load stamp image")
 		return nil, err
 	}
+	return stamped, nil
+}
 
-	// Save it...
-	compositedImage := image.NewRGBA(image.Rect(0, 0, target.Bounds().Max.X, target.Bounds().Max.Y))
-	draw.Draw(compositedImage, compositedImage.Bounds(), target, image.Point{0, 0}, draw.Src)
+// compositeWatermark returns target with the watermark drawn at every
+// placement selected by options. The placement is recorded as an annotation
+// on the output. Target is only drawn into when the pipeline owns it,
+// otherwise only the region under the placements is copied, see
+// patchedImage.
+func compositeWatermark(ctx context.Context,
+	target image.Image,
+	watermark image.Image,
+	options *StampOptions,
+	logger *zerolog.Logger) (image.Image, error) {
 
-	// Bottom right corner
-	targetRect := target.Bounds()
-	targetRect.Min.X = (targetRect.Max.X - stamp.Bounds().Max.X)
-	targetRect.Min.Y = (targetRect.Max.Y - stamp.Bounds().Max.Y)
+	if options == nil {
+		defaultOptions := DefaultStampOptions()
+		options = &defaultOptions
+	}
+	anchor := options.Anchor
+	var placements []image.Rectangle
+	if options.Mode == PlacementSmart {
+		rect, smartAnchor, score, reused := options.pinnedSmartPlacement(ctx, target, watermark.Bounds().Size())
+		anchor = smartAnchor
+		placements = []image.Rectangle{rect}
+		if reused {
+			logger.Debug().
+				Str("Anchor", anchor.String()).
+				Msg("Reused placement of the first frame")
+		} else {
+			logger.Info().
+				Str("Anchor", anchor.String()).
+				Float64("EdgeDensity", score).
+				Msg("Chose least busy placement")
+		}
+	} else {
+		placements = options.Placements(target.Bounds(), watermark.Bounds().Size())
+	}
+	adaptive := newContrastWatermark(watermark, options.Contrast)
+
+	compositedImage, owned := ctx.Value(ownedImageKey).(*image.RGBA)
+	var patched *patchedImage
+	if !owned || compositedImage != target {
+		var damaged image.Rectangle
+		for _, eachRect := range placements {
+			if options.Contrast != ContrastOff {
+				eachRect = adaptive.haloRect(eachRect)
+			}
+			damaged = damaged.Union(eachRect)
+		}
+		var err error
+		patched, err = newPatchedImage(ctx, target, damaged)
+		if err != nil {
+			return nil, err
+		}
+		compositedImage = patched.patch
+	}
+
+	Annotate(ctx, "watermark-placement", options.Mode.String())
+	if len(placements) == 1 {
+		// Encoded outputs have a zero origin
+		rect := placements[0].Sub(target.Bounds().Min)
+		Annotate(ctx, "watermark-anchor", anchor.String())
+		Annotate(ctx, "watermark-rect", fmt.Sprintf("%d,%d,%d,%d",
+			rect.Min.X,
+			rect.Min.Y,
+			rect.Max.X,
+			rect.Max.Y))
+	} else {
+		Annotate(ctx, "watermark-count", strconv.Itoa(len(placements)))
+	}
 
 	logger.Info().
 		Interface("TargetBounds", target.Bounds()).
-		Interface("StampBounds", stamp.Bounds()).
-		Interface("TargetRect", targetRect).
+		Interface("StampBounds", watermark.Bounds()).
+		Str("Anchor", anchor.String()).
+		Int("PlacementCount", len(placements)).
+		Float64("Opacity", options.Opacity).
+		Str("Blend", options.Blend.String()).
+		Str("Contrast", options.Contrast.String()).
 		Msg("Drawing")
 
-	draw.Draw(compositedImage, targetRect, stamp, image.Point{0, 0}, draw.Over)
-	buf := new(bytes.Buffer)
-	err = png.Encode(buf, compositedImage)
-	return bytes.NewReader(buf.Bytes()), nil
+	for _, eachRect := range placements {
+		choice := adaptive.choose(compositedImage, eachRect)
+		if options.Contrast != ContrastOff {
+			logger.Debug().
+				Interface("Placement", eachRect).
+				Float64("BackgroundLuma", choice.backgroundLuma).
+				Float64("BackgroundVariance", choice.backgroundVariance).
+				Str("Variant", choice.variant).
+				Bool("Halo", choice.halo != nil).
+				Msg("Adapted watermark contrast")
+		}
+		if choice.halo != nil {
+			err := Composite(ctx,
+				compositedImage,
+				adaptive.haloRect(eachRect),
+				choice.halo,
+				choice.halo.Bounds().Min,
+				BlendNormal,
+				options.Opacity*haloOpacity)
+			if err != nil {
+				return nil, err
+			}
+		}
+		err := Composite(ctx,
+			compositedImage,
+			eachRect,
+			choice.watermark,
+			choice.watermark.Bounds().Min,
+			options.Blend,
+			options.Opacity)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if patched != nil && patched.patch.Bounds() != target.Bounds() {
+		return patched.flatten(ctx)
+	}
+	return compositedImage, nil
+}
+
+// patchedImage is a copy-on-write view of an image the pipeline doesn't
+// own, eg the decoded source that every derivative is rendered from.
+// Watermarks are drawn into patch, a copy of the region they cover, and
+// every other pixel is read from the underlying image.
+type patchedImage struct {
+	image.Image
+	patch *image.RGBA
+}
+
+// newPatchedImage returns a view of img whose patch is a copy of the
+// pixels in rect
+func newPatchedImage(ctx context.Context,
+	img image.Image,
+	rect image.Rectangle) (*patchedImage, error) {
+	region := img
+	rect = rect.Intersect(img.Bounds())
+	if subImager, ok := img.(interface {
+		SubImage(image.Rectangle) image.Image
+	}); ok && rect != img.Bounds() {
+		region = subImager.SubImage(rect)
+	}
+	patch, err := cloneRGBA(ctx, region)
+	if err != nil {
+		return nil, err
+	}
+	return &patchedImage{Image: img, patch: patch}, nil
+}
+
+// ColorModel returns the model of the pixels returned by At
+func (img *patchedImage) ColorModel() color.Model {
+	return color.RGBAModel
+}
+
+// At returns the watermarked pixel within the patch, or the underlying
+// pixel elsewhere
+func (img *patchedImage) At(x int, y int) color.Color {
+	if image.Pt(x, y).In(img.patch.Rect) {
+		return img.patch.RGBAAt(x, y)
+	}
+	return color.RGBAModel.Convert(img.Image.At(x, y))
+}
+
+// flatten returns the patched pixels as an image the encoders have a fast
+// path for. A YCbCr image, ie a decoded JPEG, stays YCbCr s.t. it's copied
+// at its subsampled size rather than expanded to RGBA.
+func (img *patchedImage) flatten(ctx context.Context) (image.Image, error) {
+	ycbcr, isYCbCr := img.Image.(*image.YCbCr)
+	if !isYCbCr {
+		flattened, err := cloneRGBA(ctx, img.Image)
+		if err != nil {
+			return nil, err
+		}
+		draw.Draw(flattened, img.patch.Rect, img.patch, img.patch.Rect.Min, draw.Src)
+		return flattened, nil
+	}
+	flattened := *ycbcr
+	flattened.Y = append([]uint8(nil), ycbcr.Y...)
+	flattened.Cb = append([]uint8(nil), ycbcr.Cb...)
+	flattened.Cr = append([]uint8(nil), ycbcr.Cr...)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// Subsampled chroma is shared by neighboring pixels, so each chroma
+	// sample the patch touches is the average of every pixel it covers
+	type chromaSum struct {
+		r, g, b, count uint32
+	}
+	sums := make(map[int]*chromaSum)
+	rect := img.patch.Rect
+	for y := rect.Min.Y; y < rect.Max.Y; y++ {
+		for x := rect.Min.X; x < rect.Max.X; x++ {
+			pixel := img.patch.RGBAAt(x, y)
+			luma, _, _ := color.RGBToYCbCr(pixel.R, pixel.G, pixel.B)
+			flattened.Y[flattened.YOffset(x, y)] = luma
+			sums[flattened.COffset(x, y)] = &chromaSum{}
+		}
+	}
+	// Chroma samples cover at most 4x2 pixels
+	covered := rect.Inset(-4).Intersect(flattened.Rect)
+	for y := covered.Min.Y; y < covered.Max.Y; y++ {
+		for x := covered.Min.X; x < covered.Max.X; x++ {
+			sum, touched := sums[flattened.COffset(x, y)]
+			if !touched {
+				continue
+			}
+			pixel := img.At(x, y).(color.RGBA)
+			sum.r += uint32(pixel.R)
+			sum.g += uint32(pixel.G)
+			sum.b += uint32(pixel.B)
+			sum.count++
+		}
+	}
+	for eachOffset, eachSum := range sums {
+		_, cb, cr := color.RGBToYCbCr(uint8(eachSum.r/eachSum.count),
+			uint8(eachSum.g/eachSum.count),
+			uint8(eachSum.b/eachSum.count))
+		flattened.Cb[eachOffset] = cb
+		flattened.Cr[eachOffset] = cr
+	}
+	return &flattened, nil
 }
@@ -0,0 +1,132 @@
+package transforms
+
+import (
+	"context"
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/rs/zerolog"
+)
+
+// testYCbCr returns a 4:2:0 image with a pattern in every channel. The
+// colors stay within the RGB gamut, s.t. they survive a round trip through
+// RGB.
+func testYCbCr(width int, height int) *image.YCbCr {
+	img := image.NewYCbCr(image.Rect(0, 0, width, height), image.YCbCrSubsampleRatio420)
+	for index := range img.Y {
+		img.Y[index] = uint8(64 + index*7%128)
+	}
+	for index := range img.Cb {
+		img.Cb[index] = uint8(112 + index*3%32)
+		img.Cr[index] = uint8(144 - index*5%32)
+	}
+	return img
+}
+
+// ycbcrOf returns the YCbCr components of an RGBA pixel
+func ycbcrOf(pixel color.RGBA) (uint8, uint8, uint8) {
+	return color.RGBToYCbCr(pixel.R, pixel.G, pixel.B)
+}
+
+func TestCompositeWatermarkCopiesOnlyPlacements(t *testing.T) {
+	logger := zerolog.Nop()
+	watermark := gradientWatermark(40, 30)
+	tests := []struct {
+		name    string
+		options StampOptions
+	}{
+		{"anchored", StampOptions{Mode: PlacementAnchored, Anchor: AnchorBottomRight, Opacity: 0.7}},
+		{"smart", StampOptions{Mode: PlacementSmart, Anchor: AnchorTopLeft, Opacity: 1}},
+		{"halo", StampOptions{
+			Mode:     PlacementAnchored,
+			Anchor:   AnchorTopRight,
+			Opacity:  1,
+			Blend:    BlendMultiply,
+			Contrast: ContrastHalo,
+		}},
+	}
+	for _, eachTest := range tests {
+		t.Run(eachTest.name, func(t *testing.T) {
+			source := testYCbCr(320, 240)
+			original := testYCbCr(320, 240)
+
+			// The pipeline owns a copy, so it's drawn into directly
+			owned, err := cloneRGBA(context.Background(), source)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ownedCtx := context.WithValue(context.Background(), ownedImageKey, owned)
+			want, err := compositeWatermark(ownedCtx, owned, watermark, &eachTest.options, &logger)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := compositeWatermark(context.Background(), source, watermark, &eachTest.options, &logger)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// A JPEG source is flattened back into YCbCr rather than
+			// expanded to RGBA
+			flattened, isYCbCr := got.(*image.YCbCr)
+			if !isYCbCr {
+				t.Fatalf("compositeWatermark returned %T, want *image.YCbCr", got)
+			}
+			if flattened.SubsampleRatio != source.SubsampleRatio || flattened.Rect != source.Rect {
+				t.Fatalf("flattened to %v %v", flattened.SubsampleRatio, flattened.Rect)
+			}
+			wantRGBA := want.(*image.RGBA)
+			for y := 0; y < 240; y++ {
+				for x := 0; x < 320; x++ {
+					wantY, _, _ := ycbcrOf(wantRGBA.RGBAAt(x, y))
+					if gotY := flattened.Y[flattened.YOffset(x, y)]; absDiff(gotY, wantY) > 1 {
+						t.Fatalf("pixel (%d, %d) luma is %d, want %d", x, y, gotY, wantY)
+					}
+				}
+			}
+			// Each 4:2:0 chroma sample is the average of its 2x2 block
+			for y := 0; y < 240; y += 2 {
+				for x := 0; x < 320; x += 2 {
+					var r, g, b int
+					for _, eachPoint := range []image.Point{{x, y}, {x + 1, y}, {x, y + 1}, {x + 1, y + 1}} {
+						pixel := wantRGBA.RGBAAt(eachPoint.X, eachPoint.Y)
+						r, g, b = r+int(pixel.R), g+int(pixel.G), b+int(pixel.B)
+					}
+					_, wantCb, wantCr := color.RGBToYCbCr(uint8(r/4), uint8(g/4), uint8(b/4))
+					offset := flattened.COffset(x, y)
+					if absDiff(flattened.Cb[offset], wantCb) > 2 || absDiff(flattened.Cr[offset], wantCr) > 2 {
+						t.Fatalf("block (%d, %d) chroma is %d,%d, want %d,%d",
+							x,
+							y,
+							flattened.Cb[offset],
+							flattened.Cr[offset],
+							wantCb,
+							wantCr)
+					}
+				}
+			}
+			for index := range source.Y {
+				if source.Y[index] != original.Y[index] {
+					t.Fatal("compositeWatermark modified the unowned source")
+				}
+			}
+		})
+	}
+}
+
+func TestPatchedImageColorModel(t *testing.T) {
+	source := testYCbCr(64, 48)
+	patched, err := newPatchedImage(context.Background(), source, image.Rect(40, 30, 80, 60))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if patched.patch.Bounds() != image.Rect(40, 30, 64, 48) {
+		t.Errorf("patch is %v, want it limited to the image", patched.patch.Bounds())
+	}
+	for _, eachPoint := range []image.Point{{0, 0}, {63, 47}, {40, 30}, {39, 47}} {
+		pixel := patched.At(eachPoint.X, eachPoint.Y)
+		if pixel != patched.ColorModel().Convert(pixel) {
+			t.Errorf("At%v is a %T outside the ColorModel", eachPoint, pixel)
+		}
+	}
+}
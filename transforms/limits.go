@@ -0,0 +1,224 @@
+package transforms
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"io/ioutil"
+	"math"
+)
+
+var (
+	// ErrImageTooLarge is returned by DecodeWithLimits when the upload
+	// exceeds one of the DecodeLimits
+	ErrImageTooLarge = errors.New("image too large")
+	// ErrUnsupportedFormat is returned by DecodeWithLimits when the upload
+	// isn't in a format that has a registered decoder
+	ErrUnsupportedFormat = errors.New("unsupported image format")
+)
+
+// DecodeLimits bound the resources a single upload may consume. The
+// decoded image is held as RGBA, so every pixel costs at least four bytes
+// per copy. A zero value disables the corresponding check.
+type DecodeLimits struct {
+	MaxWidth  int
+	MaxHeight int
+	// MaxPixels bounds Width*Height, per frame for animated GIFs
+	MaxPixels int64
+	// MaxFrames bounds the number of frames in an animated GIF
+	MaxFrames int
+	// MaxTotalPixels bounds the canvas pixels transformed across every frame
+	// of an animated GIF. Each frame is transformed on the full canvas, so a
+	// long animation of tiny frames costs its canvas size times its frames.
+	MaxTotalPixels int64
+	// MaxBytes bounds the size of the encoded upload
+	MaxBytes int64
+}
+
+const (
+	// decodeBytesPerPixel is the peak memory held for each pixel of a still
+	// image: the decoded image (up to 4 bytes), the two RGBA copies made by
+	// Orient (8) and the derivative being rendered (4)
+	decodeBytesPerPixel = 16
+	// animationBytesPerPixel is the memory held for each canvas pixel of
+	// every frame of an animation: the decoded paletted frame and up to two
+	// paletted output frames
+	animationBytesPerPixel = 3
+	// heapHeadroom allows for the garbage collector growing the heap past
+	// the live set before it runs
+	heapHeadroom = 1.5
+	// runtimeReserve is the memory used by the runtime, the lambda handler
+	// and the watermarks
+	runtimeReserve = 64 << 20
+	// maxUploadBytes is the largest MaxBytes for any memory size
+	maxUploadBytes = 50 << 20
+	// maxAnimationFrames is the MaxFrames for any memory size
+	maxAnimationFrames = 1000
+)
+
+// DecodeLimitsForMemory returns limits that keep stamping a single upload
+// within memoryMB of lambda memory. The raw upload is held alongside the
+// decoded pixels, so MaxBytes comes out of the same budget.
+func DecodeLimitsForMemory(memoryMB int) DecodeLimits {
+	memory := int64(memoryMB) << 20
+	maxBytes := memory / 10
+	if maxBytes > maxUploadBytes {
+		maxBytes = maxUploadBytes
+	}
+	// A zero limit is unlimited, so even the smallest lambda accepts a pixel
+	usable := math.Max(float64(memory-runtimeReserve-maxBytes)/heapHeadroom,
+		decodeBytesPerPixel*animationBytesPerPixel*2)
+	return DecodeLimits{
+		MaxWidth:  16384,
+		MaxHeight: 16384,
+		MaxPixels: int64(usable / decodeBytesPerPixel),
+		MaxFrames: maxAnimationFrames,
+		// Animations leave half of the budget to the frame being transformed
+		MaxTotalPixels: int64(usable / 2 / animationBytesPerPixel),
+		MaxBytes:       maxBytes,
+	}
+}
+
+// DefaultDecodeLimits returns limits suitable for a 512MB lambda
+func DefaultDecodeLimits() DecodeLimits {
+	return DecodeLimitsForMemory(512)
+}
+
+// errTruncatedGIF is returned by gifFrameCount for a stream that ends
+// before its trailer
+var errTruncatedGIF = errors.New("gif: truncated stream")
+
+// gifFrameCount counts the frames in a GIF stream by walking its blocks,
+// without decompressing any pixels
+func gifFrameCount(data []byte) (int, error) {
+	// Header and logical screen descriptor
+	offset := 13
+	if len(data) < offset {
+		return 0, errTruncatedGIF
+	}
+	if packed := data[10]; packed&0x80 != 0 {
+		offset += 3 << (uint(packed&0x07) + 1)
+	}
+	// skipSubBlocks returns the offset following a sequence of data sub
+	// blocks
+	skipSubBlocks := func(offset int) (int, error) {
+		for offset < len(data) {
+			length := int(data[offset])
+			offset++
+			if length == 0 {
+				return offset, nil
+			}
+			offset += length
+		}
+		return 0, errTruncatedGIF
+	}
+	frames := 0
+	for offset < len(data) {
+		var err error
+		switch data[offset] {
+		case 0x21:
+			// Extension introducer, label and sub blocks
+			offset, err = skipSubBlocks(offset + 2)
+		case 0x2c:
+			// Image descriptor, optional local color table, LZW code size and
+			// sub blocks
+			if offset+10 > len(data) {
+				return frames, errTruncatedGIF
+			}
+			frames++
+			packed := data[offset+9]
+			offset += 10
+			if packed&0x80 != 0 {
+				offset += 3 << (uint(packed&0x07) + 1)
+			}
+			offset, err = skipSubBlocks(offset + 1)
+		case 0x3b:
+			return frames, nil
+		default:
+			return frames, fmt.Errorf("gif: unknown block type 0x%02x", data[offset])
+		}
+		if err != nil {
+			return frames, err
+		}
+	}
+	return frames, errTruncatedGIF
+}
+
+// checkAnimation verifies that the frames of a GIF, and the cost of
+// transforming each of them on the full canvas, are within the limits
+func (limits *DecodeLimits) checkAnimation(data []byte, config image.Config) error {
+	frames, err := gifFrameCount(data)
+	if err != nil {
+		return err
+	}
+	if limits.MaxFrames > 0 && frames > limits.MaxFrames {
+		return fmt.Errorf("%w: %d frames exceeds %d",
+			ErrImageTooLarge,
+			frames,
+			limits.MaxFrames)
+	}
+	pixels := int64(frames) * int64(config.Width) * int64(config.Height)
+	if limits.MaxTotalPixels > 0 && pixels > limits.MaxTotalPixels {
+		return fmt.Errorf("%w: %d frames of %dx%d exceed %d pixels",
+			ErrImageTooLarge,
+			frames,
+			config.Width,
+			config.Height,
+			limits.MaxTotalPixels)
+	}
+	return nil
+}
+
+// readLimited reads all of reader, failing with ErrImageTooLarge as soon as
+// more than maxBytes have been read
+func readLimited(reader io.Reader, maxBytes int64) ([]byte, error) {
+	if maxBytes <= 0 {
+		return ioutil.ReadAll(reader)
+	}
+	data, err := ioutil.ReadAll(io.LimitReader(reader, maxBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > maxBytes {
+		return nil, fmt.Errorf("%w: exceeds %d bytes", ErrImageTooLarge, maxBytes)
+	}
+	return data, nil
+}
+
+// Check reads only the image header and verifies that the declared
+// dimensions are within the limits. GIF frames are counted, but not
+// decoded, to bound the cost of transforming an animation.
+func (limits *DecodeLimits) Check(data []byte) (image.Config, string, error) {
+	config, imageType, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		if errors.Is(err, image.ErrFormat) {
+			return config, imageType, fmt.Errorf("%w: %s", ErrUnsupportedFormat, err)
+		}
+		return config, imageType, err
+	}
+	if limits.MaxWidth > 0 && config.Width > limits.MaxWidth {
+		return config, imageType, fmt.Errorf("%w: width %d exceeds %d",
+			ErrImageTooLarge,
+			config.Width,
+			limits.MaxWidth)
+	}
+	if limits.MaxHeight > 0 && config.Height > limits.MaxHeight {
+		return config, imageType, fmt.Errorf("%w: height %d exceeds %d",
+			ErrImageTooLarge,
+			config.Height,
+			limits.MaxHeight)
+	}
+	pixels := int64(config.Width) * int64(config.Height)
+	if limits.MaxPixels > 0 && pixels > limits.MaxPixels {
+		return config, imageType, fmt.Errorf("%w: %d pixels exceeds %d",
+			ErrImageTooLarge,
+			pixels,
+			limits.MaxPixels)
+	}
+	if imageType == "gif" {
+		return config, imageType, limits.checkAnimation(data, config)
+	}
+	return config, imageType, nil
+}
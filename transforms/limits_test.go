@@ -0,0 +1,139 @@
+package transforms
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"io/ioutil"
+	"math"
+	"runtime"
+	"runtime/debug"
+	"testing"
+
+	"github.com/rs/zerolog"
+)
+
+// testOrientedJPEG returns a JPEG with close to pixels pixels, tagged with
+// EXIF orientation 6 s.t. decoding rotates it
+func testOrientedJPEG(t *testing.T, pixels int64) []byte {
+	t.Helper()
+	width := int(math.Sqrt(float64(pixels) * 3 / 2))
+	height := int(pixels / int64(width))
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			offset := img.PixOffset(x, y)
+			img.Pix[offset] = uint8(x*7 ^ y*13)
+			img.Pix[offset+1] = uint8(x ^ y)
+			img.Pix[offset+2] = uint8((x * y) >> 3)
+			img.Pix[offset+3] = 0xff
+		}
+	}
+	var encoded bytes.Buffer
+	if err := jpeg.Encode(&encoded, img, &jpeg.Options{Quality: 95}); err != nil {
+		t.Fatal(err)
+	}
+	orientation := []byte{
+		'M', 'M', 0, 42, 0, 0, 0, 8,
+		0, 1,
+		0x01, 0x12, 0, exifTypeShort, 0, 0, 0, 1, 0, 6, 0, 0,
+		0, 0, 0, 0,
+	}
+	data, err := InjectMetadata(encoded.Bytes(), FormatJPEG, &Metadata{EXIF: orientation})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestDefaultDecodeLimitsFitMemory(t *testing.T) {
+	if testing.Short() {
+		t.Skip("encodes a maximum size JPEG")
+	}
+	const memoryMB = 512
+	limits := DecodeLimitsForMemory(memoryMB)
+	data := testOrientedJPEG(t, limits.MaxPixels)
+	runtime.GC()
+	debug.FreeOSMemory()
+
+	logger := zerolog.Nop()
+	source, err := DecodeWithLimits(context.Background(), bytes.NewReader(data), &limits, nil, &logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if source.Orientation != 6 {
+		t.Fatalf("orientation = %d, want 6", source.Orientation)
+	}
+	// The original size derivative is the most expensive
+	for _, eachEdge := range []int{0, 1280} {
+		pipeline := NewPipeline()
+		if eachEdge > 0 {
+			pipeline.Then(&Resize{Width: eachEdge, Height: eachEdge, Filter: Lanczos3})
+		}
+		pipeline.Then(&Stamp{})
+		rendering, err := pipeline.Render(context.Background(), source, &logger)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = rendering.WriteTo(ioutil.Discard); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Sys is all of the memory obtained from the OS, which includes the
+	// largest heap reached. The largest upload is held on top of it.
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+	peak := int64(stats.Sys) + limits.MaxBytes - int64(len(data))
+	if peak > memoryMB<<20 {
+		t.Errorf("peak memory %d MB exceeds %d MB", peak>>20, memoryMB)
+	}
+	t.Logf("%d pixels peaked at %d MB", limits.MaxPixels, peak>>20)
+}
+
+func TestDecodeLimitsForMemory(t *testing.T) {
+	previous := DecodeLimitsForMemory(128)
+	for _, eachMemory := range []int{256, 512, 1024, 3008} {
+		limits := DecodeLimitsForMemory(eachMemory)
+		if limits.MaxPixels <= previous.MaxPixels ||
+			limits.MaxTotalPixels <= previous.MaxTotalPixels ||
+			limits.MaxBytes < previous.MaxBytes {
+			t.Errorf("%d MB limits %+v don't grow from %+v", eachMemory, limits, previous)
+		}
+		previous = limits
+	}
+	// Zero limits would disable the checks
+	if limits := DecodeLimitsForMemory(32); limits.MaxPixels <= 0 || limits.MaxTotalPixels <= 0 {
+		t.Errorf("32 MB limits = %+v", limits)
+	}
+}
+
+func TestAnimationLimits(t *testing.T) {
+	animation := testAnimation()
+	data := testEncodeGIF(t, animation)
+	tests := []struct {
+		name   string
+		limits DecodeLimits
+		want   error
+	}{
+		{"within limits", DecodeLimits{MaxFrames: 6, MaxTotalPixels: 6 * 40 * 30}, nil},
+		{"too many frames", DecodeLimits{MaxFrames: 5}, ErrImageTooLarge},
+		{"too many pixels", DecodeLimits{MaxTotalPixels: 6*40*30 - 1}, ErrImageTooLarge},
+		{"unlimited", DecodeLimits{}, nil},
+	}
+	for _, eachTest := range tests {
+		t.Run(eachTest.name, func(t *testing.T) {
+			_, _, err := eachTest.limits.Check(data)
+			if !errors.Is(err, eachTest.want) {
+				t.Errorf("Check() = %v, want %v", err, eachTest.want)
+			}
+		})
+	}
+	// gif.DecodeAll agrees with the frame count
+	decoded, err := gif.DecodeAll(bytes.NewReader(data))
+	if err != nil || len(decoded.Image) != len(animation.Image) {
+		t.Errorf("DecodeAll() = %d frames, %v", len(decoded.Image), err)
+	}
+}
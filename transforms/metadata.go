@@ -0,0 +1,684 @@
+package transforms
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"image"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// MetadataMode selects which source metadata is carried into the output
+type MetadataMode int
+
+const (
+	// MetadataStrip drops all EXIF, XMP and ICC metadata
+	MetadataStrip MetadataMode = iota
+	// MetadataPreserve keeps all EXIF, XMP and ICC metadata
+	MetadataPreserve
+	// MetadataPreserveExceptGPS keeps all metadata except location data
+	MetadataPreserveExceptGPS
+	// MetadataAllowlist keeps only the tags named in MetadataPolicy.Allow
+	MetadataAllowlist
+)
+
+var metadataModeNames = []string{
+	"strip",
+	"preserve",
+	"preserve-except-gps",
+	"allowlist",
+}
+
+// String returns the hyphenated mode name, eg "preserve-except-gps"
+func (mode MetadataMode) String() string {
+	if mode < MetadataStrip || mode > MetadataAllowlist {
+		return fmt.Sprintf("MetadataMode(%d)", int(mode))
+	}
+	return metadataModeNames[mode]
+}
+
+// ParseMetadataMode returns the MetadataMode for a name such as "preserve"
+func ParseMetadataMode(name string) (MetadataMode, error) {
+	normalized := strings.ToLower(strings.TrimSpace(name))
+	if normalized == "" {
+		return MetadataStrip, nil
+	}
+	for index, eachName := range metadataModeNames {
+		if eachName == normalized {
+			return MetadataMode(index), nil
+		}
+	}
+	return MetadataStrip, fmt.Errorf("unsupported metadata mode: %s", name)
+}
+
+// Pseudo tag names that select entire metadata blocks in MetadataAllowlist
+// mode
+const (
+	MetadataAllowGPS = "GPS"
+	MetadataAllowICC = "ICC"
+	MetadataAllowXMP = "XMP"
+)
+
+// exifTagNames maps the commonly allowlisted EXIF tag names to their IDs.
+// Other tags can be allowlisted by hex ID, eg "0x9286".
+var exifTagNames = map[string]uint16{
+	"ImageDescription":      0x010e,
+	"Make":                  0x010f,
+	"Model":                 0x0110,
+	"Orientation":           0x0112,
+	"XResolution":           0x011a,
+	"YResolution":           0x011b,
+	"ResolutionUnit":        0x0128,
+	"Software":              0x0131,
+	"DateTime":              0x0132,
+	"Artist":                0x013b,
+	"Copyright":             0x8298,
+	"ExposureTime":          0x829a,
+	"FNumber":               0x829d,
+	"ExposureProgram":       0x8822,
+	"ISOSpeedRatings":       0x8827,
+	"ExifVersion":           0x9000,
+	"DateTimeOriginal":      0x9003,
+	"DateTimeDigitized":     0x9004,
+	"ShutterSpeedValue":     0x9201,
+	"ApertureValue":         0x9202,
+	"ExposureBiasValue":     0x9204,
+	"MeteringMode":          0x9207,
+	"Flash":                 0x9209,
+	"FocalLength":           0x920a,
+	"MakerNote":             0x927c,
+	"UserComment":           0x9286,
+	"ColorSpace":            0xa001,
+	"WhiteBalance":          0xa403,
+	"FocalLengthIn35mmFilm": 0xa405,
+	"LensMake":              0xa433,
+	"LensModel":             0xa434,
+}
+
+// IFD pointer tags
+const (
+	exifTagExifIFD    = 0x8769
+	exifTagGPSIFD     = 0x8825
+	exifTagInteropIFD = 0xa005
+)
+
+// Exif IFD tags that don't carry over to a re-encoded image as is, see
+// filterIFD
+const (
+	exifTagMakerNote       = 0x927c
+	exifTagPixelXDimension = 0xa002
+	exifTagPixelYDimension = 0xa003
+	exifTypeLong           = 4
+)
+
+// MetadataPolicy controls which source metadata is re-injected into the
+// encoded output. The zero value strips everything.
+type MetadataPolicy struct {
+	Mode MetadataMode
+	// Allow lists the EXIF tag names (see exifTagNames) or hex IDs, plus the
+	// GPS, ICC and XMP pseudo tags, kept in MetadataAllowlist mode
+	Allow []string
+}
+
+// exifTagID returns the tag ID for a tag name or hex ID such as "0x9286"
+func exifTagID(name string) (uint16, bool) {
+	if id, exists := exifTagNames[name]; exists {
+		return id, true
+	}
+	if strings.HasPrefix(name, "0x") {
+		id, err := strconv.ParseUint(name[2:], 16, 16)
+		return uint16(id), err == nil
+	}
+	return 0, false
+}
+
+// ParseMetadataAllowlist parses a comma separated list of tag names
+func ParseMetadataAllowlist(value string) ([]string, error) {
+	var allow []string
+	for _, eachName := range strings.Split(value, ",") {
+		eachName = strings.TrimSpace(eachName)
+		switch eachName {
+		case "":
+			continue
+		case MetadataAllowGPS, MetadataAllowICC, MetadataAllowXMP:
+		default:
+			if _, isTag := exifTagID(eachName); !isTag {
+				return nil, fmt.Errorf("unsupported metadata tag: %s", eachName)
+			}
+		}
+		allow = append(allow, eachName)
+	}
+	return allow, nil
+}
+
+func (policy *MetadataPolicy) allows(name string) bool {
+	for _, eachName := range policy.Allow {
+		if eachName == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (policy *MetadataPolicy) allowsTag(tag uint16) bool {
+	switch policy.Mode {
+	case MetadataPreserve:
+		return true
+	case MetadataPreserveExceptGPS:
+		return tag != exifTagGPSIFD
+	case MetadataAllowlist:
+		if tag == exifTagGPSIFD {
+			return policy.allows(MetadataAllowGPS)
+		}
+		// Pointers are kept iff the referenced IFD has allowed entries
+		if tag == exifTagExifIFD || tag == exifTagInteropIFD {
+			return true
+		}
+		for _, eachName := range policy.Allow {
+			if id, isTag := exifTagID(eachName); isTag && id == tag {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
+
+// Metadata is the set of metadata blocks read from a source image
+type Metadata struct {
+	// EXIF is the TIFF structure, without the JPEG "Exif\0\0" header
+	EXIF []byte
+	// XMP is the serialized XMP packet
+	XMP []byte
+	// ICC is the uncompressed ICC profile
+	ICC []byte
+}
+
+// Empty returns true if there are no metadata blocks
+func (metadata *Metadata) Empty() bool {
+	return metadata == nil ||
+		(len(metadata.EXIF) == 0 && len(metadata.XMP) == 0 && len(metadata.ICC) == 0)
+}
+
+var (
+	xmpHeader = []byte("http://ns.adobe.com/xap/1.0/\x00")
+	iccHeader = []byte("ICC_PROFILE\x00")
+	pngHeader = []byte("\x89PNG\r\n\x1a\n")
+)
+
+const (
+	markerAPP2 = 0xe2
+	// maxICCProfileSize bounds the inflated size of a PNG iCCP chunk. Real
+	// profiles are a few KB, so anything larger is a decompression bomb.
+	maxICCProfileSize = 4 << 20
+	// Maximum payload of a JPEG marker segment
+	jpegMaxSegment = 0xffff - 2
+	xmpKeyword     = "XML:com.adobe.xmp"
+)
+
+// ReadMetadata extracts the EXIF, XMP and ICC blocks from JPEG or PNG data
+func ReadMetadata(data []byte) *Metadata {
+	metadata := &Metadata{}
+	if bytes.HasPrefix(data, pngHeader) {
+		for _, eachChunk := range pngChunks(data) {
+			switch eachChunk.chunkType {
+			case "eXIf":
+				metadata.EXIF = eachChunk.data
+			case "iCCP":
+				metadata.ICC = pngICCProfile(eachChunk.data)
+			case "iTXt":
+				if bytes.HasPrefix(eachChunk.data, []byte(xmpKeyword+"\x00")) {
+					metadata.XMP = pngITXtText(eachChunk.data)
+				}
+			}
+		}
+		return metadata
+	}
+
+	iccChunks := make(map[int][]byte)
+	for _, eachSegment := range jpegSegments(data) {
+		switch {
+		case eachSegment.marker == markerAPP1 && bytes.HasPrefix(eachSegment.data, exifHeader):
+			metadata.EXIF = eachSegment.data[len(exifHeader):]
+		case eachSegment.marker == markerAPP1 && bytes.HasPrefix(eachSegment.data, xmpHeader):
+			metadata.XMP = eachSegment.data[len(xmpHeader):]
+		case eachSegment.marker == markerAPP2 && bytes.HasPrefix(eachSegment.data, iccHeader):
+			// ICC profiles larger than a segment are split into numbered chunks
+			chunk := eachSegment.data[len(iccHeader):]
+			if len(chunk) >= 2 {
+				iccChunks[int(chunk[0])] = chunk[2:]
+			}
+		}
+	}
+	if len(iccChunks) != 0 {
+		sequence := make([]int, 0, len(iccChunks))
+		for eachIndex := range iccChunks {
+			sequence = append(sequence, eachIndex)
+		}
+		sort.Ints(sequence)
+		for _, eachIndex := range sequence {
+			metadata.ICC = append(metadata.ICC, iccChunks[eachIndex]...)
+		}
+	}
+	return metadata
+}
+
+// Filter returns the metadata permitted by the policy for an output of the
+// given size. Preserved EXIF is rewritten with an upright orientation, since
+// Decode has already rotated the pixels, and the output's pixel dimensions.
+// The embedded thumbnail, which would show the unstamped image, and the
+// MakerNote, whose internal offsets don't survive the rewrite, are dropped.
+func (metadata *Metadata) Filter(policy *MetadataPolicy, size image.Point) *Metadata {
+	if metadata.Empty() || policy == nil || policy.Mode == MetadataStrip {
+		return &Metadata{}
+	}
+	filtered := &Metadata{}
+	if len(metadata.EXIF) != 0 {
+		filtered.EXIF = filterEXIF(metadata.EXIF, policy, size)
+	}
+	switch policy.Mode {
+	case MetadataAllowlist:
+		if policy.allows(MetadataAllowICC) {
+			filtered.ICC = metadata.ICC
+		}
+		if policy.allows(MetadataAllowXMP) {
+			filtered.XMP = metadata.XMP
+			if !policy.allows(MetadataAllowGPS) {
+				filtered.XMP = scrubXMPLocation(filtered.XMP)
+			}
+		}
+	case MetadataPreserveExceptGPS:
+		filtered.ICC = metadata.ICC
+		filtered.XMP = scrubXMPLocation(metadata.XMP)
+	default:
+		filtered.ICC = metadata.ICC
+		filtered.XMP = metadata.XMP
+	}
+	return filtered
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// XMP
+//
+
+// xmpLocationPattern matches the exif:GPS* properties in both the attribute
+// and element XMP serializations
+var xmpLocationPattern = regexp.MustCompile(
+	`(?s)\s+exif:GPS\w+="[^"]*"|<exif:GPS\w+[^>]*/>|<exif:GPS\w+[^>]*>.*?</exif:GPS\w+>`)
+
+func scrubXMPLocation(xmp []byte) []byte {
+	if len(xmp) == 0 {
+		return xmp
+	}
+	return xmpLocationPattern.ReplaceAll(xmp, nil)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// EXIF
+//
+
+// tiffTypeSizes is the byte size of each TIFF field type
+var tiffTypeSizes = map[uint16]int{
+	1: 1, 2: 1, 3: 2, 4: 4, 5: 8, 6: 1, 7: 1, 8: 2, 9: 4, 10: 8, 11: 4, 12: 8, 13: 4,
+}
+
+type tiffEntry struct {
+	tag      uint16
+	dataType uint16
+	count    uint32
+	// value is the raw value in the source byte order
+	value []byte
+	// ifd is the parsed sub IFD for pointer tags
+	ifd []tiffEntry
+}
+
+func isIFDPointer(tag uint16) bool {
+	return tag == exifTagExifIFD || tag == exifTagGPSIFD || tag == exifTagInteropIFD
+}
+
+func parseIFD(tiff []byte, byteOrder binary.ByteOrder, offset int, depth int) []tiffEntry {
+	if depth > 4 || offset < 8 || offset+2 > len(tiff) {
+		return nil
+	}
+	entryCount := int(byteOrder.Uint16(tiff[offset:]))
+	entries := make([]tiffEntry, 0, entryCount)
+	for index := 0; index < entryCount; index++ {
+		position := offset + 2 + index*12
+		if position+12 > len(tiff) {
+			break
+		}
+		entry := tiffEntry{
+			tag:      byteOrder.Uint16(tiff[position:]),
+			dataType: byteOrder.Uint16(tiff[position+2:]),
+			count:    byteOrder.Uint32(tiff[position+4:]),
+		}
+		typeSize, knownType := tiffTypeSizes[entry.dataType]
+		if !knownType {
+			continue
+		}
+		size := typeSize * int(entry.count)
+		if size < 0 || size > len(tiff) {
+			continue
+		}
+		valueOffset := position + 8
+		if size > 4 {
+			valueOffset = int(byteOrder.Uint32(tiff[position+8:]))
+		}
+		if valueOffset < 0 || valueOffset+size > len(tiff) {
+			continue
+		}
+		entry.value = tiff[valueOffset : valueOffset+size]
+		if isIFDPointer(entry.tag) {
+			if size < 4 {
+				continue
+			}
+			entry.ifd = parseIFD(tiff, byteOrder, int(byteOrder.Uint32(entry.value)), depth+1)
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func filterIFD(entries []tiffEntry,
+	policy *MetadataPolicy,
+	byteOrder binary.ByteOrder,
+	size image.Point) []tiffEntry {
+	filtered := make([]tiffEntry, 0, len(entries))
+	for _, eachEntry := range entries {
+		if eachEntry.tag == exifTagMakerNote || !policy.allowsTag(eachEntry.tag) {
+			continue
+		}
+		if isIFDPointer(eachEntry.tag) {
+			// The GPS IFD is kept or dropped as a whole
+			if eachEntry.tag != exifTagGPSIFD {
+				eachEntry.ifd = filterIFD(eachEntry.ifd, policy, byteOrder, size)
+			}
+			if len(eachEntry.ifd) == 0 {
+				continue
+			}
+		}
+		switch eachEntry.tag {
+		case exifTagOrientation:
+			if eachEntry.dataType == exifTypeShort {
+				upright := make([]byte, 2)
+				byteOrder.PutUint16(upright, 1)
+				eachEntry.value = upright
+			}
+		case exifTagPixelXDimension, exifTagPixelYDimension:
+			dimension := size.X
+			if eachEntry.tag == exifTagPixelYDimension {
+				dimension = size.Y
+			}
+			eachEntry.dataType = exifTypeLong
+			eachEntry.count = 1
+			eachEntry.value = make([]byte, 4)
+			byteOrder.PutUint32(eachEntry.value, uint32(dimension))
+		}
+		filtered = append(filtered, eachEntry)
+	}
+	return filtered
+}
+
+type tiffWriter struct {
+	byteOrder binary.ByteOrder
+	buf       []byte
+}
+
+// writeIFD appends the IFD, its out of line values and any sub IFDs, and
+// returns the offset of the IFD
+func (writer *tiffWriter) writeIFD(entries []tiffEntry) uint32 {
+	sort.Slice(entries, func(lhs int, rhs int) bool {
+		return entries[lhs].tag < entries[rhs].tag
+	})
+	if len(writer.buf)%2 != 0 {
+		writer.buf = append(writer.buf, 0)
+	}
+	start := len(writer.buf)
+	writer.buf = append(writer.buf, make([]byte, 2+12*len(entries)+4)...)
+	writer.byteOrder.PutUint16(writer.buf[start:], uint16(len(entries)))
+	for index, eachEntry := range entries {
+		position := start + 2 + index*12
+		writer.byteOrder.PutUint16(writer.buf[position:], eachEntry.tag)
+		writer.byteOrder.PutUint16(writer.buf[position+2:], eachEntry.dataType)
+		writer.byteOrder.PutUint32(writer.buf[position+4:], eachEntry.count)
+		switch {
+		case isIFDPointer(eachEntry.tag):
+			ifdOffset := writer.writeIFD(eachEntry.ifd)
+			writer.byteOrder.PutUint32(writer.buf[position+8:], ifdOffset)
+		case len(eachEntry.value) <= 4:
+			copy(writer.buf[position+8:position+12], eachEntry.value)
+		default:
+			if len(writer.buf)%2 != 0 {
+				writer.buf = append(writer.buf, 0)
+			}
+			valueOffset := len(writer.buf)
+			writer.buf = append(writer.buf, eachEntry.value...)
+			writer.byteOrder.PutUint32(writer.buf[position+8:], uint32(valueOffset))
+		}
+	}
+	return uint32(start)
+}
+
+// filterEXIF rewrites the TIFF structure keeping only the IFD0 entries,
+// and sub IFDs, allowed by the policy. IFD1 (the thumbnail) is dropped.
+func filterEXIF(tiff []byte, policy *MetadataPolicy, size image.Point) []byte {
+	if len(tiff) < 8 {
+		return nil
+	}
+	var byteOrder binary.ByteOrder
+	switch string(tiff[0:2]) {
+	case "II":
+		byteOrder = binary.LittleEndian
+	case "MM":
+		byteOrder = binary.BigEndian
+	default:
+		return nil
+	}
+	ifd0 := parseIFD(tiff, byteOrder, int(byteOrder.Uint32(tiff[4:])), 0)
+	ifd0 = filterIFD(ifd0, policy, byteOrder, size)
+	if len(ifd0) == 0 {
+		return nil
+	}
+	writer := &tiffWriter{
+		byteOrder: byteOrder,
+		buf:       make([]byte, 8, len(tiff)),
+	}
+	copy(writer.buf, tiff[0:4])
+	ifdOffset := writer.writeIFD(ifd0)
+	byteOrder.PutUint32(writer.buf[4:], ifdOffset)
+	return writer.buf
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// PNG
+//
+
+type pngChunk struct {
+	chunkType string
+	data      []byte
+}
+
+// pngChunks returns every chunk in the PNG stream
+func pngChunks(data []byte) []pngChunk {
+	var chunks []pngChunk
+	offset := len(pngHeader)
+	for offset+12 <= len(data) {
+		length := int(binary.BigEndian.Uint32(data[offset:]))
+		if length < 0 || offset+12+length > len(data) {
+			break
+		}
+		chunks = append(chunks, pngChunk{
+			chunkType: string(data[offset+4 : offset+8]),
+			data:      data[offset+8 : offset+8+length],
+		})
+		offset += 12 + length
+	}
+	return chunks
+}
+
+func pngICCProfile(data []byte) []byte {
+	// Profile name, NUL, compression method, zlib data
+	separator := bytes.IndexByte(data, 0)
+	if separator < 0 || separator+2 > len(data) {
+		return nil
+	}
+	reader, err := zlib.NewReader(bytes.NewReader(data[separator+2:]))
+	if err != nil {
+		return nil
+	}
+	defer reader.Close()
+	profile, err := ioutil.ReadAll(io.LimitReader(reader, maxICCProfileSize+1))
+	if err != nil || len(profile) > maxICCProfileSize {
+		return nil
+	}
+	return profile
+}
+
+func pngITXtText(data []byte) []byte {
+	// Keyword NUL, compression flag, compression method, language NUL,
+	// translated keyword NUL, text
+	fields := bytes.SplitN(data, []byte{0}, 2)
+	if len(fields) != 2 || len(fields[1]) < 2 || fields[1][0] != 0 {
+		return nil
+	}
+	remainder := fields[1][2:]
+	for index := 0; index < 2; index++ {
+		separator := bytes.IndexByte(remainder, 0)
+		if separator < 0 {
+			return nil
+		}
+		remainder = remainder[separator+1:]
+	}
+	return remainder
+}
+
+func appendPNGChunk(buf []byte, chunkType string, data []byte) []byte {
+	header := make([]byte, 8)
+	binary.BigEndian.PutUint32(header, uint32(len(data)))
+	copy(header[4:], chunkType)
+	buf = append(buf, header...)
+	buf = append(buf, data...)
+	crc := crc32.NewIEEE()
+	crc.Write(header[4:])
+	crc.Write(data)
+	checksum := make([]byte, 4)
+	binary.BigEndian.PutUint32(checksum, crc.Sum32())
+	return append(buf, checksum...)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Injection
+//
+
+// InjectMetadata returns the encoded image with the metadata blocks added.
+// Only JPEG and PNG outputs carry metadata.
+func InjectMetadata(encoded []byte, format Format, metadata *Metadata) ([]byte, error) {
+	if metadata.Empty() {
+		return encoded, nil
+	}
+	switch format {
+	case FormatJPEG:
+		return injectJPEGMetadata(encoded, metadata)
+	case FormatPNG:
+		return injectPNGMetadata(encoded, metadata)
+	default:
+		return encoded, nil
+	}
+}
+
+func appendJPEGSegment(buf []byte, marker byte, payload ...[]byte) []byte {
+	length := 2
+	for _, eachPart := range payload {
+		length += len(eachPart)
+	}
+	buf = append(buf, 0xff, marker, byte(length>>8), byte(length))
+	for _, eachPart := range payload {
+		buf = append(buf, eachPart...)
+	}
+	return buf
+}
+
+func injectJPEGMetadata(encoded []byte, metadata *Metadata) ([]byte, error) {
+	if len(encoded) < 2 || encoded[0] != 0xff || encoded[1] != markerSOI {
+		return nil, fmt.Errorf("invalid JPEG stream")
+	}
+	output := make([]byte, 0, len(encoded)+len(metadata.EXIF)+len(metadata.XMP)+len(metadata.ICC)+64)
+	output = append(output, encoded[0:2]...)
+	if len(metadata.EXIF) != 0 {
+		if len(exifHeader)+len(metadata.EXIF) > jpegMaxSegment {
+			return nil, fmt.Errorf("EXIF block too large: %d bytes", len(metadata.EXIF))
+		}
+		output = appendJPEGSegment(output, markerAPP1, exifHeader, metadata.EXIF)
+	}
+	// Extended XMP isn't supported, so large packets are dropped
+	if len(metadata.XMP) != 0 && len(xmpHeader)+len(metadata.XMP) <= jpegMaxSegment {
+		output = appendJPEGSegment(output, markerAPP1, xmpHeader, metadata.XMP)
+	}
+	if len(metadata.ICC) != 0 {
+		chunkSize := jpegMaxSegment - len(iccHeader) - 2
+		chunkCount := (len(metadata.ICC) + chunkSize - 1) / chunkSize
+		if chunkCount > 255 {
+			return nil, fmt.Errorf("ICC profile too large: %d bytes", len(metadata.ICC))
+		}
+		for index := 0; index < chunkCount; index++ {
+			end := (index + 1) * chunkSize
+			if end > len(metadata.ICC) {
+				end = len(metadata.ICC)
+			}
+			output = appendJPEGSegment(output,
+				markerAPP2,
+				iccHeader,
+				[]byte{byte(index + 1), byte(chunkCount)},
+				metadata.ICC[index*chunkSize:end])
+		}
+	}
+	return append(output, encoded[2:]...), nil
+}
+
+func injectPNGMetadata(encoded []byte, metadata *Metadata) ([]byte, error) {
+	chunks := pngChunks(encoded)
+	if len(chunks) == 0 || chunks[0].chunkType != "IHDR" {
+		return nil, fmt.Errorf("invalid PNG stream")
+	}
+	output := make([]byte, 0, len(encoded)+len(metadata.EXIF)+len(metadata.XMP)+len(metadata.ICC)+64)
+	output = append(output, pngHeader...)
+	output = appendPNGChunk(output, chunks[0].chunkType, chunks[0].data)
+
+	// Ancillary chunks that must precede PLTE and IDAT
+	if len(metadata.ICC) != 0 {
+		var compressed bytes.Buffer
+		compressed.WriteString("ICC Profile\x00\x00")
+		zlibWriter := zlib.NewWriter(&compressed)
+		_, err := zlibWriter.Write(metadata.ICC)
+		if err == nil {
+			err = zlibWriter.Close()
+		}
+		if err != nil {
+			return nil, err
+		}
+		output = appendPNGChunk(output, "iCCP", compressed.Bytes())
+	}
+	if len(metadata.EXIF) != 0 {
+		output = appendPNGChunk(output, "eXIf", metadata.EXIF)
+	}
+	if len(metadata.XMP) != 0 {
+		var text bytes.Buffer
+		text.WriteString(xmpKeyword)
+		// NUL, uncompressed, method, empty language and translated keyword
+		text.Write([]byte{0, 0, 0, 0, 0})
+		text.Write(metadata.XMP)
+		output = appendPNGChunk(output, "iTXt", text.Bytes())
+	}
+	for _, eachChunk := range chunks[1:] {
+		output = appendPNGChunk(output, eachChunk.chunkType, eachChunk.data)
+	}
+	return output, nil
+}
@@ -0,0 +1,275 @@
+package transforms
+
+import (
+	"bytes"
+	"compress/zlib"
+	"context"
+	"encoding/binary"
+	"image"
+	"image/png"
+	"sort"
+	"testing"
+
+	"github.com/rs/zerolog"
+)
+
+// Tags used by the EXIF fixture
+const (
+	testTagMake             = 0x010f
+	testTagCopyright        = 0x8298
+	testTagDateTimeOriginal = 0x9003
+	testTagGPSLatitudeRef   = 0x0001
+)
+
+func testShortEntry(byteOrder binary.ByteOrder, tag uint16, value uint16) tiffEntry {
+	buf := make([]byte, 2)
+	byteOrder.PutUint16(buf, value)
+	return tiffEntry{tag: tag, dataType: exifTypeShort, count: 1, value: buf}
+}
+
+func testASCIIEntry(tag uint16, value string) tiffEntry {
+	buf := append([]byte(value), 0)
+	return tiffEntry{tag: tag, dataType: 2, count: uint32(len(buf)), value: buf}
+}
+
+// testEXIF returns a TIFF structure with IFD0, Exif and GPS entries
+func testEXIF(byteOrder binary.ByteOrder) []byte {
+	pointer := func(tag uint16, ifd []tiffEntry) tiffEntry {
+		return tiffEntry{tag: tag, dataType: exifTypeLong, count: 1, value: make([]byte, 4), ifd: ifd}
+	}
+	ifd0 := []tiffEntry{
+		testShortEntry(byteOrder, exifTagOrientation, 6),
+		testASCIIEntry(testTagMake, "Acme Optical"),
+		testASCIIEntry(testTagCopyright, "Acme 2020"),
+		pointer(exifTagExifIFD, []tiffEntry{
+			testShortEntry(byteOrder, exifTagPixelXDimension, 4000),
+			testShortEntry(byteOrder, exifTagPixelYDimension, 3000),
+			testASCIIEntry(testTagDateTimeOriginal, "2020:01:02 03:04:05"),
+			{tag: exifTagMakerNote, dataType: 7, count: 12, value: []byte("Acme\x00\x00\x00\x08\x00\x00\x00\x01")},
+		}),
+		pointer(exifTagGPSIFD, []tiffEntry{
+			testASCIIEntry(testTagGPSLatitudeRef, "N"),
+		}),
+	}
+	writer := &tiffWriter{byteOrder: byteOrder, buf: make([]byte, 8)}
+	if byteOrder == binary.LittleEndian {
+		copy(writer.buf, "II\x2a\x00")
+	} else {
+		copy(writer.buf, "MM\x00\x2a")
+	}
+	ifdOffset := writer.writeIFD(ifd0)
+	byteOrder.PutUint32(writer.buf[4:], ifdOffset)
+	return writer.buf
+}
+
+// testEXIFTags flattens the parsed IFDs into their tags, keyed by IFD
+func testEXIFTags(t *testing.T, tiff []byte) map[string][]int {
+	t.Helper()
+	tags := make(map[string][]int)
+	if len(tiff) == 0 {
+		return tags
+	}
+	byteOrder := binary.ByteOrder(binary.BigEndian)
+	if string(tiff[0:2]) == "II" {
+		byteOrder = binary.LittleEndian
+	}
+	var walk func(name string, entries []tiffEntry)
+	walk = func(name string, entries []tiffEntry) {
+		for _, eachEntry := range entries {
+			tags[name] = append(tags[name], int(eachEntry.tag))
+			switch eachEntry.tag {
+			case exifTagExifIFD:
+				walk("Exif", eachEntry.ifd)
+			case exifTagGPSIFD:
+				walk("GPS", eachEntry.ifd)
+			}
+		}
+		sort.Ints(tags[name])
+	}
+	walk("IFD0", parseIFD(tiff, byteOrder, int(byteOrder.Uint32(tiff[4:])), 0))
+	return tags
+}
+
+func testEXIFValue(tiff []byte, ifdTag uint16, tag uint16) []byte {
+	byteOrder := binary.ByteOrder(binary.BigEndian)
+	if string(tiff[0:2]) == "II" {
+		byteOrder = binary.LittleEndian
+	}
+	entries := parseIFD(tiff, byteOrder, int(byteOrder.Uint32(tiff[4:])), 0)
+	for _, eachEntry := range entries {
+		if eachEntry.tag == ifdTag {
+			entries = eachEntry.ifd
+		}
+	}
+	for _, eachEntry := range entries {
+		if eachEntry.tag == tag {
+			return eachEntry.value
+		}
+	}
+	return nil
+}
+
+func TestFilterEXIF(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy MetadataPolicy
+		want   map[string][]int
+	}{
+		{
+			name:   "strip",
+			policy: MetadataPolicy{Mode: MetadataStrip},
+			want:   map[string][]int{},
+		},
+		{
+			name:   "preserve",
+			policy: MetadataPolicy{Mode: MetadataPreserve},
+			want: map[string][]int{
+				"IFD0": {testTagMake, exifTagOrientation, testTagCopyright, exifTagExifIFD, exifTagGPSIFD},
+				"Exif": {testTagDateTimeOriginal, exifTagPixelXDimension, exifTagPixelYDimension},
+				"GPS":  {testTagGPSLatitudeRef},
+			},
+		},
+		{
+			name:   "preserve except GPS",
+			policy: MetadataPolicy{Mode: MetadataPreserveExceptGPS},
+			want: map[string][]int{
+				"IFD0": {testTagMake, exifTagOrientation, testTagCopyright, exifTagExifIFD},
+				"Exif": {testTagDateTimeOriginal, exifTagPixelXDimension, exifTagPixelYDimension},
+			},
+		},
+		{
+			name:   "allowlist IFD0",
+			policy: MetadataPolicy{Mode: MetadataAllowlist, Allow: []string{"Copyright"}},
+			want: map[string][]int{
+				"IFD0": {testTagCopyright},
+			},
+		},
+		{
+			name: "allowlist sub IFDs",
+			policy: MetadataPolicy{
+				Mode:  MetadataAllowlist,
+				Allow: []string{"DateTimeOriginal", "MakerNote", MetadataAllowGPS},
+			},
+			want: map[string][]int{
+				"IFD0": {exifTagExifIFD, exifTagGPSIFD},
+				"Exif": {testTagDateTimeOriginal},
+				"GPS":  {testTagGPSLatitudeRef},
+			},
+		},
+	}
+	for _, eachByteOrder := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		for _, eachTest := range tests {
+			t.Run(eachByteOrder.String()+"/"+eachTest.name, func(t *testing.T) {
+				var filtered []byte
+				if eachTest.policy.Mode != MetadataStrip {
+					filtered = filterEXIF(testEXIF(eachByteOrder), &eachTest.policy, image.Pt(640, 480))
+				}
+				got := testEXIFTags(t, filtered)
+				if len(got) != len(eachTest.want) {
+					t.Fatalf("IFDs = %v, want %v", got, eachTest.want)
+				}
+				for eachIFD, eachTags := range eachTest.want {
+					sort.Ints(eachTags)
+					if !equalInts(got[eachIFD], eachTags) {
+						t.Errorf("%s tags = %x, want %x", eachIFD, got[eachIFD], eachTags)
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestFilterEXIFRewritesSourceTags(t *testing.T) {
+	for _, eachByteOrder := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		filtered := filterEXIF(testEXIF(eachByteOrder),
+			&MetadataPolicy{Mode: MetadataPreserve},
+			image.Pt(70000, 480))
+		orientation := testEXIFValue(filtered, 0, exifTagOrientation)
+		if len(orientation) != 2 || eachByteOrder.Uint16(orientation) != 1 {
+			t.Errorf("%s orientation = %v, want upright", eachByteOrder, orientation)
+		}
+		width := testEXIFValue(filtered, exifTagExifIFD, exifTagPixelXDimension)
+		if len(width) != 4 || eachByteOrder.Uint32(width) != 70000 {
+			t.Errorf("%s PixelXDimension = %v, want 70000", eachByteOrder, width)
+		}
+		height := testEXIFValue(filtered, exifTagExifIFD, exifTagPixelYDimension)
+		if len(height) != 4 || eachByteOrder.Uint32(height) != 480 {
+			t.Errorf("%s PixelYDimension = %v, want 480", eachByteOrder, height)
+		}
+	}
+}
+
+func testICCPChunk(t *testing.T, profile []byte) []byte {
+	t.Helper()
+	var compressed bytes.Buffer
+	compressed.WriteString("ICC Profile\x00\x00")
+	zlibWriter := zlib.NewWriter(&compressed)
+	if _, err := zlibWriter.Write(profile); err != nil {
+		t.Fatal(err)
+	}
+	if err := zlibWriter.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return compressed.Bytes()
+}
+
+func TestPNGICCProfileLimit(t *testing.T) {
+	profile := bytes.Repeat([]byte{0x42}, 3000)
+	if got := pngICCProfile(testICCPChunk(t, profile)); !bytes.Equal(got, profile) {
+		t.Errorf("profile = %d bytes, want %d", len(got), len(profile))
+	}
+	bomb := make([]byte, maxICCProfileSize+1)
+	if got := pngICCProfile(testICCPChunk(t, bomb)); got != nil {
+		t.Errorf("oversized profile = %d bytes, want nil", len(got))
+	}
+}
+
+func equalInts(lhs []int, rhs []int) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+	for index := range lhs {
+		if lhs[index] != rhs[index] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestDecodeReadsMetadataPerPolicy(t *testing.T) {
+	var encoded bytes.Buffer
+	if err := png.Encode(&encoded, image.NewRGBA(image.Rect(0, 0, 4, 4))); err != nil {
+		t.Fatal(err)
+	}
+	profile := bytes.Repeat([]byte{0x42}, 128)
+	data, err := InjectMetadata(encoded.Bytes(), FormatPNG, &Metadata{ICC: profile})
+	if err != nil {
+		t.Fatal(err)
+	}
+	logger := zerolog.Nop()
+	limits := DefaultDecodeLimits()
+	tests := []struct {
+		mode MetadataMode
+		want []byte
+	}{
+		{MetadataStrip, nil},
+		{MetadataPreserve, profile},
+	}
+	for _, eachTest := range tests {
+		source, err := DecodeWithLimits(context.Background(),
+			bytes.NewReader(data),
+			&limits,
+			&MetadataPolicy{Mode: eachTest.mode},
+			&logger)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []byte
+		if source.Metadata != nil {
+			got = source.Metadata.ICC
+		}
+		if !bytes.Equal(got, eachTest.want) {
+			t.Errorf("%s ICC = %d bytes, want %d", eachTest.mode, len(got), len(eachTest.want))
+		}
+	}
+}
@@ -0,0 +1,294 @@
+package transforms
+
+import (
+	"fmt"
+	"image"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Anchor is one of the nine positions a watermark can be pinned to
+type Anchor int
+
+const (
+	// AnchorTopLeft pins the watermark to the top left corner
+	AnchorTopLeft Anchor = iota
+	// AnchorTop pins the watermark to the middle of the top edge
+	AnchorTop
+	// AnchorTopRight pins the watermark to the top right corner
+	AnchorTopRight
+	// AnchorLeft pins the watermark to the middle of the left edge
+	AnchorLeft
+	// AnchorCenter pins the watermark to the center
+	AnchorCenter
+	// AnchorRight pins the watermark to the middle of the right edge
+	AnchorRight
+	// AnchorBottomLeft pins the watermark to the bottom left corner
+	AnchorBottomLeft
+	// AnchorBottom pins the watermark to the middle of the bottom edge
+	AnchorBottom
+	// AnchorBottomRight pins the watermark to the bottom right corner
+	AnchorBottomRight
+)
+
+var anchorNames = []string{
+	"top-left",
+	"top",
+	"top-right",
+	"left",
+	"center",
+	"right",
+	"bottom-left",
+	"bottom",
+	"bottom-right",
+}
+
+// String returns the hyphenated anchor name, eg "bottom-right"
+func (anchor Anchor) String() string {
+	if anchor < AnchorTopLeft || anchor > AnchorBottomRight {
+		return fmt.Sprintf("Anchor(%d)", int(anchor))
+	}
+	return anchorNames[anchor]
+}
+
+// ParseAnchor returns the Anchor for a hyphenated name such as "top-left"
+func ParseAnchor(name string) (Anchor, error) {
+	normalized := strings.ToLower(strings.TrimSpace(name))
+	for index, eachName := range anchorNames {
+		if eachName == normalized {
+			return Anchor(index), nil
+		}
+	}
+	return AnchorBottomRight, fmt.Errorf("unsupported anchor: %s", name)
+}
+
+// ParseAnchors parses a comma separated list of anchor names
+func ParseAnchors(value string) ([]Anchor, error) {
+	var anchors []Anchor
+	for _, eachName := range strings.Split(value, ",") {
+		if strings.TrimSpace(eachName) == "" {
+			continue
+		}
+		anchor, err := ParseAnchor(eachName)
+		if err != nil {
+			return nil, err
+		}
+		anchors = append(anchors, anchor)
+	}
+	return anchors, nil
+}
+
+// PlacementMode determines how the watermark is positioned
+type PlacementMode int
+
+const (
+	// PlacementAnchored draws a single watermark at the Anchor, inset by the
+	// Margin
+	PlacementAnchored PlacementMode = iota
+	// PlacementCentered draws a single watermark in the center of the image
+	PlacementCentered
+	// PlacementTiled repeats the watermark across the image in a diagonal
+	// pattern
+	PlacementTiled
+	// PlacementSmart draws a single watermark at whichever of the allowed
+	// anchors covers the least busy region of the image
+	PlacementSmart
+)
+
+var placementModeNames = []string{
+	"anchored",
+	"centered",
+	"tiled",
+	"smart",
+}
+
+// String returns the placement mode name accepted by ParsePlacementMode
+func (mode PlacementMode) String() string {
+	if mode < PlacementAnchored || mode > PlacementSmart {
+		return fmt.Sprintf("PlacementMode(%d)", int(mode))
+	}
+	return placementModeNames[mode]
+}
+
+// ParsePlacementMode returns the PlacementMode for one of "anchored",
+// "centered", "tiled" or "smart"
+func ParsePlacementMode(name string) (PlacementMode, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "", "anchored", "anchor":
+		return PlacementAnchored, nil
+	case "centered", "center":
+		return PlacementCentered, nil
+	case "tiled", "tile":
+		return PlacementTiled, nil
+	case "smart", "content-aware":
+		return PlacementSmart, nil
+	default:
+		return PlacementAnchored, fmt.Errorf("unsupported placement mode: %s", name)
+	}
+}
+
+// Margin is a distance expressed either in pixels or as a percentage of the
+// image dimension it applies to
+type Margin struct {
+	Value   float64
+	Percent bool
+}
+
+// Pixels resolves the margin against an image dimension
+func (margin Margin) Pixels(dimension int) int {
+	if margin.Percent {
+		return int(math.Round(margin.Value * float64(dimension) / 100))
+	}
+	return int(math.Round(margin.Value))
+}
+
+// String returns the margin in the format accepted by ParseMargin
+func (margin Margin) String() string {
+	if margin.Percent {
+		return strconv.FormatFloat(margin.Value, 'f', -1, 64) + "%"
+	}
+	return strconv.FormatFloat(margin.Value, 'f', -1, 64) + "px"
+}
+
+// ParseMargin parses a pixel ("16", "16px") or percentage ("2.5%") margin
+func ParseMargin(value string) (Margin, error) {
+	normalized := strings.ToLower(strings.TrimSpace(value))
+	margin := Margin{}
+	switch {
+	case normalized == "":
+		return margin, nil
+	case strings.HasSuffix(normalized, "%"):
+		margin.Percent = true
+		normalized = strings.TrimSuffix(normalized, "%")
+	case strings.HasSuffix(normalized, "px"):
+		normalized = strings.TrimSuffix(normalized, "px")
+	}
+	parsed, err := strconv.ParseFloat(normalized, 64)
+	if err != nil || parsed < 0 {
+		return margin, fmt.Errorf("invalid margin: %s", value)
+	}
+	margin.Value = parsed
+	return margin, nil
+}
+
+// StampOptions control how a watermark is placed on the target image
+type StampOptions struct {
+	Mode PlacementMode
+	// Anchor is the anchored position, and the preferred position for
+	// PlacementSmart
+	Anchor Anchor
+	// Anchors restricts the candidates considered by PlacementSmart. Empty
+	// considers all nine anchors.
+	Anchors []Anchor
+	// Margin insets an anchored watermark from the image edges
+	Margin Margin
+	// TileSpacing is the gap between repeated watermarks in PlacementTiled
+	// mode. Percentages are relative to the watermark size.
+	TileSpacing Margin
+	// Opacity of the watermark in [0, 1]
+	Opacity float64
+	// Blend is the blend mode used to composite the watermark
+	Blend BlendMode
+	// Contrast adapts the watermark to the background of each placement
+	Contrast ContrastMode
+}
+
+// DefaultStampOptions draws a single, fully opaque, watermark flush in the
+// bottom right corner
+func DefaultStampOptions() StampOptions {
+	return StampOptions{
+		Mode:    PlacementAnchored,
+		Anchor:  AnchorBottomRight,
+		Opacity: 1,
+		Blend:   BlendNormal,
+	}
+}
+
+// ParseOpacity parses an opacity expressed as a percentage ("60%" or "60")
+// in the range [0, 100] and returns it as a fraction in [0, 1]
+func ParseOpacity(value string) (float64, error) {
+	normalized := strings.TrimSuffix(strings.TrimSpace(value), "%")
+	parsed, err := strconv.ParseFloat(normalized, 64)
+	if err != nil || parsed < 0 || parsed > 100 {
+		return 0, fmt.Errorf("invalid opacity: %s", value)
+	}
+	return parsed / 100, nil
+}
+
+// anchorPoint returns the top left corner of a watermark of the given size
+// pinned to anchor within bounds
+func anchorPoint(bounds image.Rectangle,
+	size image.Point,
+	anchor Anchor,
+	marginX int,
+	marginY int) image.Point {
+	var x, y int
+	switch anchor % 3 {
+	case 0:
+		x = bounds.Min.X + marginX
+	case 1:
+		x = bounds.Min.X + (bounds.Dx()-size.X)/2
+	default:
+		x = bounds.Max.X - size.X - marginX
+	}
+	switch anchor / 3 {
+	case 0:
+		y = bounds.Min.Y + marginY
+	case 1:
+		y = bounds.Min.Y + (bounds.Dy()-size.Y)/2
+	default:
+		y = bounds.Max.Y - size.Y - marginY
+	}
+	return image.Pt(x, y)
+}
+
+// Placements returns the destination rectangles for a watermark of the
+// given size drawn into bounds. PlacementSmart needs the image pixels to
+// choose an anchor, so it's treated as PlacementAnchored here.
+func (options *StampOptions) Placements(bounds image.Rectangle, size image.Point) []image.Rectangle {
+	switch options.Mode {
+	case PlacementCentered:
+		origin := anchorPoint(bounds, size, AnchorCenter, 0, 0)
+		return []image.Rectangle{image.Rectangle{Min: origin, Max: origin.Add(size)}}
+	case PlacementTiled:
+		return tilePlacements(bounds, size, options.TileSpacing)
+	default:
+		origin := anchorPoint(bounds,
+			size,
+			options.Anchor,
+			options.Margin.Pixels(bounds.Dx()),
+			options.Margin.Pixels(bounds.Dy()))
+		return []image.Rectangle{image.Rectangle{Min: origin, Max: origin.Add(size)}}
+	}
+}
+
+// tileRowShift is the fraction of a step that each row of tiles is shifted
+// right of the row above. Anything other than a half step, which repeats
+// every other row as brickwork, lines the tiles up diagonally.
+const tileRowShift = 3
+
+// tilePlacements covers bounds with a lattice of watermarks where every row
+// is shifted a third of a step further than the row above, producing
+// diagonal lines of watermarks
+func tilePlacements(bounds image.Rectangle, size image.Point, spacing Margin) []image.Rectangle {
+	if size.X <= 0 || size.Y <= 0 {
+		return nil
+	}
+	stepX := size.X + spacing.Pixels(size.X)
+	stepY := size.Y + spacing.Pixels(size.Y)
+
+	var placements []image.Rectangle
+	for row, y := 0, bounds.Min.Y; y < bounds.Max.Y; row, y = row+1, y+stepY {
+		// Start one step to the left so the shifted rows still cover the
+		// left edge
+		offset := (row * stepX / tileRowShift) % stepX
+		for x := bounds.Min.X - stepX + offset; x < bounds.Max.X; x += stepX {
+			tile := image.Rect(x, y, x+size.X, y+size.Y)
+			if tile.Overlaps(bounds) {
+				placements = append(placements, tile)
+			}
+		}
+	}
+	return placements
+}
@@ -0,0 +1,123 @@
+package transforms
+
+import (
+	"image"
+	"testing"
+)
+
+func TestAnchoredPlacements(t *testing.T) {
+	bounds := image.Rect(10, 20, 110, 100)
+	size := image.Pt(20, 10)
+	tests := []struct {
+		anchor Anchor
+		want   image.Rectangle
+	}{
+		{AnchorTopLeft, image.Rect(15, 24, 35, 34)},
+		{AnchorTop, image.Rect(50, 24, 70, 34)},
+		{AnchorTopRight, image.Rect(85, 24, 105, 34)},
+		{AnchorLeft, image.Rect(15, 55, 35, 65)},
+		{AnchorCenter, image.Rect(50, 55, 70, 65)},
+		{AnchorRight, image.Rect(85, 55, 105, 65)},
+		{AnchorBottomLeft, image.Rect(15, 86, 35, 96)},
+		{AnchorBottom, image.Rect(50, 86, 70, 96)},
+		{AnchorBottomRight, image.Rect(85, 86, 105, 96)},
+	}
+	for _, eachTest := range tests {
+		t.Run(eachTest.anchor.String(), func(t *testing.T) {
+			options := StampOptions{
+				Mode:   PlacementAnchored,
+				Anchor: eachTest.anchor,
+				Margin: Margin{Value: 5, Percent: true},
+			}
+			placements := options.Placements(bounds, size)
+			if len(placements) != 1 || placements[0] != eachTest.want {
+				t.Errorf("Placements() = %v, want [%v]", placements, eachTest.want)
+			}
+		})
+	}
+}
+
+func TestCenteredPlacementIgnoresMargin(t *testing.T) {
+	options := StampOptions{
+		Mode:   PlacementCentered,
+		Anchor: AnchorTopLeft,
+		Margin: Margin{Value: 16},
+	}
+	placements := options.Placements(image.Rect(0, 0, 100, 50), image.Pt(20, 10))
+	want := image.Rect(40, 20, 60, 30)
+	if len(placements) != 1 || placements[0] != want {
+		t.Errorf("Placements() = %v, want [%v]", placements, want)
+	}
+}
+
+func TestTilePlacements(t *testing.T) {
+	tests := []struct {
+		name    string
+		bounds  image.Rectangle
+		size    image.Point
+		spacing Margin
+	}{
+		{"flush", image.Rect(0, 0, 100, 80), image.Pt(30, 20), Margin{}},
+		{"offset bounds", image.Rect(-7, 13, 93, 93), image.Pt(30, 20), Margin{}},
+		{"spaced", image.Rect(0, 0, 200, 150), image.Pt(30, 20), Margin{Value: 50, Percent: true}},
+		{"larger than bounds", image.Rect(0, 0, 20, 20), image.Pt(30, 30), Margin{}},
+	}
+	for _, eachTest := range tests {
+		t.Run(eachTest.name, func(t *testing.T) {
+			placements := tilePlacements(eachTest.bounds, eachTest.size, eachTest.spacing)
+			if len(placements) == 0 {
+				t.Fatal("no placements")
+			}
+			for _, eachPlacement := range placements {
+				if eachPlacement.Size() != eachTest.size {
+					t.Errorf("placement %v isn't %v", eachPlacement, eachTest.size)
+				}
+				if !eachPlacement.Overlaps(eachTest.bounds) {
+					t.Errorf("placement %v is outside %v", eachPlacement, eachTest.bounds)
+				}
+			}
+			if eachTest.spacing.Value != 0 {
+				return
+			}
+			// Without spacing, every pixel is covered
+			for y := eachTest.bounds.Min.Y; y < eachTest.bounds.Max.Y; y++ {
+				for x := eachTest.bounds.Min.X; x < eachTest.bounds.Max.X; x++ {
+					covered := false
+					for _, eachPlacement := range placements {
+						if image.Pt(x, y).In(eachPlacement) {
+							covered = true
+							break
+						}
+					}
+					if !covered {
+						t.Fatalf("pixel (%d, %d) isn't covered", x, y)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestTilePlacementsAreDiagonal(t *testing.T) {
+	size := image.Pt(30, 20)
+	placements := tilePlacements(image.Rect(0, 0, 300, 200), size, Margin{})
+	// Rows must keep shifting in the same direction rather than alternating
+	// between two offsets, which lines the tiles up as brickwork
+	rowOffsets := make(map[int]int)
+	for _, eachPlacement := range placements {
+		offset := ((eachPlacement.Min.X % size.X) + size.X) % size.X
+		rowOffsets[eachPlacement.Min.Y/size.Y] = offset
+	}
+	for row := 1; row < 3; row++ {
+		if rowOffsets[row] <= rowOffsets[row-1] {
+			t.Errorf("row %d offset %d doesn't advance on row %d offset %d",
+				row,
+				rowOffsets[row],
+				row-1,
+				rowOffsets[row-1])
+		}
+	}
+	if rowOffsets[2] == rowOffsets[0] {
+		t.Errorf("rows 0 and 2 share offset %d", rowOffsets[0])
+	}
+}
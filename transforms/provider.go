@@ -0,0 +1,314 @@
+package transforms
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"image"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/mweagle/SpartaImager/assets"
+	"github.com/rs/zerolog"
+)
+
+// DefaultWatermark is the name of the embedded 316x315 helmet
+const DefaultWatermark = "SpartaHelmet.png"
+
+// WatermarkProvider returns decoded watermark images by name
+type WatermarkProvider interface {
+	Watermark(ctx context.Context, name string, logger *zerolog.Logger) (image.Image, error)
+}
+
+// WatermarkDigester is implemented by providers that can identify the
+// content of the watermark they currently return for a name, s.t. outputs
+// can record which watermark they were stamped with
+type WatermarkDigester interface {
+	WatermarkDigest(ctx context.Context, name string, logger *zerolog.Logger) (string, error)
+}
+
+// PreloadWatermark loads and caches the named watermark, and resamples it to
+// each of the sizes, s.t. the first invocation doesn't pay for fetching,
+// decoding or scaling it
+func PreloadWatermark(ctx context.Context,
+	provider WatermarkProvider,
+	name string,
+	sizes []int,
+	logger *zerolog.Logger) error {
+	if provider == nil {
+		provider = defaultWatermarkProvider
+	}
+	if name == "" {
+		name = DefaultWatermark
+	}
+	master, err := provider.Watermark(ctx, name, logger)
+	if err != nil {
+		return err
+	}
+	for _, eachSize := range sizes {
+		if _, err := scaleWatermark(ctx, master, eachSize); err != nil {
+			return err
+		}
+	}
+	logger.Info().
+		Str("Watermark", name).
+		Ints("Sizes", sizes).
+		Msg("Preloaded watermark")
+	return nil
+}
+
+// errNotModified is returned by a watermarkSource when the cached version
+// is still current
+var errNotModified = errors.New("watermark not modified")
+
+// watermarkSource fetches encoded watermarks for a CachingProvider
+type watermarkSource interface {
+	// fetch returns the encoded watermark and an opaque version. If version
+	// is non-empty and still current it returns errNotModified.
+	fetch(ctx context.Context, name string, version string) ([]byte, string, error)
+	// String describes the source for logging
+	String() string
+}
+
+type cachedWatermark struct {
+	image   image.Image
+	version string
+	// digest is the hex SHA-256 of the encoded watermark
+	digest  string
+	checked time.Time
+}
+
+// CachingProvider is a WatermarkProvider that keeps decoded watermarks in
+// memory and revalidates them against the source once RefreshInterval has
+// elapsed. If revalidation fails the cached image continues to be used.
+type CachingProvider struct {
+	// RefreshInterval is the minimum time between revalidations. Zero
+	// revalidates on every request, a negative interval never revalidates.
+	RefreshInterval time.Duration
+	source          watermarkSource
+	mutex           sync.Mutex
+	entries         map[string]*cachedWatermark
+}
+
+func newCachingProvider(source watermarkSource, refreshInterval time.Duration) *CachingProvider {
+	return &CachingProvider{
+		RefreshInterval: refreshInterval,
+		source:          source,
+		entries:         make(map[string]*cachedWatermark),
+	}
+}
+
+// Watermark returns the decoded watermark with the given name
+func (provider *CachingProvider) Watermark(ctx context.Context,
+	name string,
+	logger *zerolog.Logger) (image.Image, error) {
+	provider.mutex.Lock()
+	defer provider.mutex.Unlock()
+
+	entry, exists := provider.entries[name]
+	if exists && (provider.RefreshInterval < 0 ||
+		time.Since(entry.checked) < provider.RefreshInterval) {
+		return entry.image, nil
+	}
+	version := ""
+	if exists {
+		version = entry.version
+	}
+	data, newVersion, err := provider.source.fetch(ctx, name, version)
+	if err == errNotModified {
+		entry.checked = time.Now()
+		return entry.image, nil
+	}
+	if err == nil {
+		var decoded image.Image
+		decoded, _, err = image.Decode(bytes.NewReader(data))
+		if err == nil {
+			// Premultiply once rather than on every composite
+			decoded, err = toRGBA(ctx, decoded)
+		}
+		if err == nil {
+			provider.entries[name] = &cachedWatermark{
+				image:   decoded,
+				version: newVersion,
+				digest:  fmt.Sprintf("%x", sha256.Sum256(data)),
+				checked: time.Now(),
+			}
+			logger.Info().
+				Str("Source", provider.source.String()).
+				Str("Name", name).
+				Str("Version", newVersion).
+				Interface("Bounds", decoded.Bounds()).
+				Msg("Loaded watermark")
+			return decoded, nil
+		}
+	}
+	if exists {
+		logger.Warn().
+			Err(err).
+			Str("Source", provider.source.String()).
+			Str("Name", name).
+			Msg("Failed to refresh watermark. Using cached version")
+		entry.checked = time.Now()
+		return entry.image, nil
+	}
+	logger.Error().
+		Err(err).
+		Str("Source", provider.source.String()).
+		Str("Name", name).
+		Msg("Failed to load watermark")
+	return nil, err
+}
+
+// WatermarkDigest returns the hex SHA-256 of the encoded watermark that
+// Watermark returns for the name, loading or revalidating it first
+func (provider *CachingProvider) WatermarkDigest(ctx context.Context,
+	name string,
+	logger *zerolog.Logger) (string, error) {
+	if name == "" {
+		name = DefaultWatermark
+	}
+	_, err := provider.Watermark(ctx, name, logger)
+	if err != nil {
+		return "", err
+	}
+	provider.mutex.Lock()
+	defer provider.mutex.Unlock()
+	return provider.entries[name].digest, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Embedded
+
+type embeddedSource struct{}
+
+func (source *embeddedSource) fetch(ctx context.Context, name string, version string) ([]byte, string, error) {
+	if version != "" {
+		return nil, "", errNotModified
+	}
+	data, err := assets.FSByte(false, path.Join("/resources", path.Clean("/"+name)))
+	if err != nil {
+		return nil, "", fmt.Errorf("unknown embedded watermark %s: %s", name, err)
+	}
+	return data, "embedded", nil
+}
+
+func (source *embeddedSource) String() string {
+	return "embedded"
+}
+
+// NewEmbeddedProvider returns a provider for the watermarks compiled into
+// the binary from the resources directory
+func NewEmbeddedProvider() *CachingProvider {
+	return newCachingProvider(&embeddedSource{}, -1)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// Local directory
+
+type directorySource struct {
+	root string
+}
+
+func (source *directorySource) fetch(ctx context.Context, name string, version string) ([]byte, string, error) {
+	filePath := filepath.Join(source.root, filepath.FromSlash(path.Clean("/"+name)))
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return nil, "", err
+	}
+	currentVersion := strconv.FormatInt(info.ModTime().UnixNano(), 10) +
+		"-" +
+		strconv.FormatInt(info.Size(), 10)
+	if currentVersion == version {
+		return nil, "", errNotModified
+	}
+	data, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return nil, "", err
+	}
+	return data, currentVersion, nil
+}
+
+func (source *directorySource) String() string {
+	return "file://" + source.root
+}
+
+// NewDirectoryProvider returns a provider for the watermarks in the local
+// root directory, eg a lambda layer mounted at /opt. Files are revalidated
+// by modification time and size.
+func NewDirectoryProvider(root string, refreshInterval time.Duration) *CachingProvider {
+	return newCachingProvider(&directorySource{root: root}, refreshInterval)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+// S3
+
+type s3Source struct {
+	bucket string
+	prefix string
+	client s3iface.S3API
+	once   sync.Once
+}
+
+func (source *s3Source) fetch(ctx context.Context, name string, version string) ([]byte, string, error) {
+	source.once.Do(func() {
+		if source.client == nil {
+			source.client = s3.New(session.Must(session.NewSession()))
+		}
+	})
+	input := &s3.GetObjectInput{
+		Bucket: aws.String(source.bucket),
+		Key:    aws.String(source.prefix + path.Clean("/" + name)[1:]),
+	}
+	if version != "" {
+		input.IfNoneMatch = aws.String(version)
+	}
+	result, err := source.client.GetObjectWithContext(ctx, input)
+	if err != nil {
+		if requestFailure, ok := err.(awserr.RequestFailure); ok &&
+			requestFailure.StatusCode() == http.StatusNotModified {
+			return nil, "", errNotModified
+		}
+		return nil, "", err
+	}
+	defer result.Body.Close()
+	data, err := ioutil.ReadAll(result.Body)
+	if err != nil {
+		return nil, "", err
+	}
+	return data, aws.StringValue(result.ETag), nil
+}
+
+func (source *s3Source) String() string {
+	return fmt.Sprintf("s3://%s/%s", source.bucket, source.prefix)
+}
+
+// NewS3Provider returns a provider for the watermarks stored under prefix
+// in bucket. Objects are revalidated with a conditional GET against their
+// ETag. A nil client uses the default AWS session.
+func NewS3Provider(bucket string,
+	prefix string,
+	client s3iface.S3API,
+	refreshInterval time.Duration) *CachingProvider {
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+	return newCachingProvider(&s3Source{
+		bucket: bucket,
+		prefix: prefix,
+		client: client,
+	}, refreshInterval)
+}
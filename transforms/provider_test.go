@@ -0,0 +1,80 @@
+package transforms
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/png"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// writeTestWatermark writes a solid PNG watermark and sets its modification
+// time, s.t. a rewrite within the filesystem's timestamp resolution is still
+// detected
+func writeTestWatermark(t *testing.T, path string, fill color.Color, modTime time.Time) {
+	img := image.NewNRGBA(image.Rect(0, 0, 8, 8))
+	for y := 0; y < 8; y++ {
+		for x := 0; x < 8; x++ {
+			img.Set(x, y, fill)
+		}
+	}
+	var encoded bytes.Buffer
+	if err := png.Encode(&encoded, img); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(path, encoded.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWatermarkDigestTracksContent(t *testing.T) {
+	logger := zerolog.Nop()
+	root := t.TempDir()
+	path := filepath.Join(root, "mark.png")
+	modTime := time.Now().Add(-time.Hour)
+	writeTestWatermark(t, path, color.White, modTime)
+
+	provider := NewDirectoryProvider(root, 0)
+	first, err := provider.WatermarkDigest(context.Background(), "mark.png", &logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(first) != 64 {
+		t.Fatalf("WatermarkDigest() = %q, want a hex SHA-256", first)
+	}
+	unchanged, err := provider.WatermarkDigest(context.Background(), "mark.png", &logger)
+	if err != nil || unchanged != first {
+		t.Errorf("WatermarkDigest() = %q, %v, want %q", unchanged, err, first)
+	}
+
+	// Replace the watermark in place
+	writeTestWatermark(t, path, color.Black, modTime.Add(time.Minute))
+	replaced, err := provider.WatermarkDigest(context.Background(), "mark.png", &logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if replaced == first {
+		t.Error("WatermarkDigest() didn't change with the watermark content")
+	}
+}
+
+func TestEmbeddedWatermarkDigest(t *testing.T) {
+	logger := zerolog.Nop()
+	digest, err := NewEmbeddedProvider().WatermarkDigest(context.Background(), "", &logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(digest) != 64 {
+		t.Errorf("WatermarkDigest() = %q, want a hex SHA-256", digest)
+	}
+}
@@ -0,0 +1,282 @@
+package transforms
+
+import (
+	"context"
+	"image"
+	"math"
+
+	"github.com/rs/zerolog"
+)
+
+// ResampleFilter is a separable convolution kernel used to resize images
+type ResampleFilter struct {
+	Name    string
+	Support float64
+	Kernel  func(x float64) float64
+}
+
+func sinc(x float64) float64 {
+	if x == 0 {
+		return 1
+	}
+	x *= math.Pi
+	return math.Sin(x) / x
+}
+
+// Lanczos3 is the sharpest of the filters and the default for Resize
+var Lanczos3 = &ResampleFilter{
+	Name:    "Lanczos3",
+	Support: 3,
+	Kernel: func(x float64) float64 {
+		x = math.Abs(x)
+		if x < 3 {
+			return sinc(x) * sinc(x/3)
+		}
+		return 0
+	},
+}
+
+// CatmullRom is a cubic filter that is slightly softer, and faster, than
+// Lanczos3
+var CatmullRom = &ResampleFilter{
+	Name:    "CatmullRom",
+	Support: 2,
+	Kernel: func(x float64) float64 {
+		x = math.Abs(x)
+		if x < 1 {
+			return (1.5*x-2.5)*x*x + 1
+		}
+		if x < 2 {
+			return ((-0.5*x+2.5)*x-4)*x + 2
+		}
+		return 0
+	},
+}
+
+// Linear is a bilinear (tent) filter
+var Linear = &ResampleFilter{
+	Name:    "Linear",
+	Support: 1,
+	Kernel: func(x float64) float64 {
+		x = math.Abs(x)
+		if x < 1 {
+			return 1 - x
+		}
+		return 0
+	},
+}
+
+// Resize is a Transform that scales the image to fit within Width x Height,
+// preserving the aspect ratio. A zero Width or Height leaves that dimension
+// unconstrained. Images are never upscaled.
+type Resize struct {
+	Width  int
+	Height int
+	// Filter defaults to Lanczos3
+	Filter *ResampleFilter
+}
+
+// Apply returns the resized image
+func (resize *Resize) Apply(ctx context.Context,
+	img image.Image,
+	logger *zerolog.Logger) (image.Image, error) {
+	width, height := FitDimensions(img.Bounds().Dx(),
+		img.Bounds().Dy(),
+		resize.Width,
+		resize.Height)
+	if width == img.Bounds().Dx() && height == img.Bounds().Dy() {
+		return img, nil
+	}
+	filter := resize.Filter
+	if filter == nil {
+		filter = Lanczos3
+	}
+	logger.Info().
+		Interface("SourceBounds", img.Bounds()).
+		Int("Width", width).
+		Int("Height", height).
+		Str("Filter", filter.Name).
+		Msg("Resizing")
+	return resample(ctx, img, width, height, filter)
+}
+
+// FitDimensions returns the largest size that fits within the maxWidth x
+// maxHeight box without upscaling. A zero bound is unconstrained.
+func FitDimensions(width int, height int, maxWidth int, maxHeight int) (int, int) {
+	scale := 1.0
+	if maxWidth > 0 && width > maxWidth {
+		scale = math.Min(scale, float64(maxWidth)/float64(width))
+	}
+	if maxHeight > 0 && height > maxHeight {
+		scale = math.Min(scale, float64(maxHeight)/float64(height))
+	}
+	if scale == 1.0 {
+		return width, height
+	}
+	return maxInt(1, int(math.Round(float64(width)*scale))),
+		maxInt(1, int(math.Round(float64(height)*scale)))
+}
+
+func maxInt(lhs int, rhs int) int {
+	if lhs > rhs {
+		return lhs
+	}
+	return rhs
+}
+
+func minInt(lhs int, rhs int) int {
+	if lhs < rhs {
+		return lhs
+	}
+	return rhs
+}
+
+func clampUint8(value float32) uint8 {
+	if value <= 0 {
+		return 0
+	}
+	if value >= 255 {
+		return 255
+	}
+	return uint8(value + 0.5)
+}
+
+// toRGBA returns img as an *image.RGBA with a zero origin, converting only
+// if necessary
+func toRGBA(ctx context.Context, img image.Image) (*image.RGBA, error) {
+	if rgba, ok := img.(*image.RGBA); ok && rgba.Bounds().Min == (image.Point{}) {
+		return rgba, nil
+	}
+	rgba, err := cloneRGBA(ctx, img)
+	if err != nil {
+		return nil, err
+	}
+	// The pixel layout doesn't depend on the origin
+	rgba.Rect = rgba.Rect.Sub(rgba.Rect.Min)
+	return rgba, nil
+}
+
+// resampleWeights is the set of source weights that contribute to a single
+// destination pixel
+type resampleWeights struct {
+	start   int
+	weights []float32
+}
+
+func computeWeights(srcSize int, dstSize int, filter *ResampleFilter) []resampleWeights {
+	scale := float64(srcSize) / float64(dstSize)
+	// Widen the kernel when downsampling so that every source pixel contributes
+	filterScale := math.Max(scale, 1)
+	support := filter.Support * filterScale
+
+	contributions := make([]resampleWeights, dstSize)
+	for i := 0; i < dstSize; i++ {
+		center := (float64(i) + 0.5) * scale
+		start := int(math.Max(0, math.Floor(center-support)))
+		end := int(math.Min(float64(srcSize), math.Ceil(center+support)))
+
+		weights := make([]float32, end-start)
+		sum := 0.0
+		for j := start; j < end; j++ {
+			weight := filter.Kernel((float64(j) + 0.5 - center) / filterScale)
+			weights[j-start] = float32(weight)
+			sum += weight
+		}
+		if sum != 0 {
+			for j := range weights {
+				weights[j] /= float32(sum)
+			}
+		}
+		contributions[i] = resampleWeights{
+			start:   start,
+			weights: weights,
+		}
+	}
+	return contributions
+}
+
+// ResizeImage resamples img to exactly width x height using the
+// separable filter. The result has a zero origin.
+func ResizeImage(img image.Image, width int, height int, filter *ResampleFilter) *image.RGBA {
+	// Resampling can only fail once the context is done
+	resized, _ := resample(context.Background(), img, width, height, filter)
+	return resized
+}
+
+// resample is ResizeImage, abandoned between row bands once ctx is done
+func resample(ctx context.Context,
+	img image.Image,
+	width int,
+	height int,
+	filter *ResampleFilter) (*image.RGBA, error) {
+	src, err := toRGBA(ctx, img)
+	if err != nil {
+		return nil, err
+	}
+	srcWidth := src.Bounds().Dx()
+	srcHeight := src.Bounds().Dy()
+
+	// Horizontal pass into a float buffer s.t. we only quantize once
+	horizontal := computeWeights(srcWidth, width, filter)
+	intermediate := make([]float32, width*srcHeight*4)
+	err = parallelRows(ctx, image.Rect(0, 0, width, srcHeight), func(minY int, maxY int) {
+		for y := minY; y < maxY; y++ {
+			row := src.Pix[y*src.Stride:]
+			for x, eachContribution := range horizontal {
+				var r, g, b, a float32
+				for i, weight := range eachContribution.weights {
+					offset := (eachContribution.start + i) * 4
+					r += float32(row[offset]) * weight
+					g += float32(row[offset+1]) * weight
+					b += float32(row[offset+2]) * weight
+					a += float32(row[offset+3]) * weight
+				}
+				offset := (y*width + x) * 4
+				intermediate[offset] = r
+				intermediate[offset+1] = g
+				intermediate[offset+2] = b
+				intermediate[offset+3] = a
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Vertical pass
+	vertical := computeWeights(srcHeight, height, filter)
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	err = parallelRows(ctx, dst.Bounds(), func(minY int, maxY int) {
+		for y := minY; y < maxY; y++ {
+			eachContribution := vertical[y]
+			for x := 0; x < width; x++ {
+				var r, g, b, a float32
+				for i, weight := range eachContribution.weights {
+					offset := ((eachContribution.start+i)*width + x) * 4
+					r += intermediate[offset] * weight
+					g += intermediate[offset+1] * weight
+					b += intermediate[offset+2] * weight
+					a += intermediate[offset+3] * weight
+				}
+				// Ringing can push premultiplied colors past alpha
+				alpha := clampUint8(a)
+				offset := y*dst.Stride + x*4
+				dst.Pix[offset] = minUint8(clampUint8(r), alpha)
+				dst.Pix[offset+1] = minUint8(clampUint8(g), alpha)
+				dst.Pix[offset+2] = minUint8(clampUint8(b), alpha)
+				dst.Pix[offset+3] = alpha
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dst, nil
+}
+
+func minUint8(lhs uint8, rhs uint8) uint8 {
+	if lhs < rhs {
+		return lhs
+	}
+	return rhs
+}
@@ -0,0 +1,235 @@
+package transforms
+
+import (
+	"context"
+	"image"
+	"image/color"
+	"math"
+	"sync"
+)
+
+const (
+	// saliencyGridSize is the longer edge of the luminance grid the edge
+	// density is computed on
+	saliencyGridSize = 256
+	// preferredAnchorBias discounts the score of the configured Anchor s.t.
+	// another anchor has to be noticeably less busy to be chosen
+	preferredAnchorBias = 0.85
+)
+
+// edgeDensity is a summed area table of the Sobel gradient magnitude of an
+// image, computed on a downsampled luminance grid
+type edgeDensity struct {
+	bounds image.Rectangle
+	// cell is the size of a grid cell in image pixels
+	cell   float64
+	width  int
+	height int
+	// sums has (width+1)*(height+1) entries
+	sums []float64
+}
+
+// pixelLuma returns a function that reads the luma of img's pixels.
+// Decoded RGBA and JPEG images are read from their pixel buffers.
+func pixelLuma(img image.Image) func(x int, y int) float64 {
+	switch src := img.(type) {
+	case *image.RGBA:
+		return func(x int, y int) float64 {
+			pix := src.Pix[src.PixOffset(x, y):]
+			return luma(float64(pix[0])/0xff, float64(pix[1])/0xff, float64(pix[2])/0xff)
+		}
+	case *image.YCbCr:
+		return func(x int, y int) float64 {
+			chromaOffset := src.COffset(x, y)
+			r, g, b := color.YCbCrToRGB(src.Y[src.YOffset(x, y)],
+				src.Cb[chromaOffset],
+				src.Cr[chromaOffset])
+			return luma(float64(r)/0xff, float64(g)/0xff, float64(b)/0xff)
+		}
+	default:
+		return func(x int, y int) float64 {
+			r, g, b, _ := img.At(x, y).RGBA()
+			return luma(float64(r)/0xffff, float64(g)/0xffff, float64(b)/0xffff)
+		}
+	}
+}
+
+// newEdgeDensity computes the edge density of img
+func newEdgeDensity(img image.Image) *edgeDensity {
+	bounds := img.Bounds()
+	cell := math.Max(1, float64(maxInt(bounds.Dx(), bounds.Dy()))/saliencyGridSize)
+	width := maxInt(1, int(math.Ceil(float64(bounds.Dx())/cell)))
+	height := maxInt(1, int(math.Ceil(float64(bounds.Dy())/cell)))
+
+	// Average the luminance of the pixels in every cell
+	luminance := make([]float64, width*height)
+	counts := make([]int, width*height)
+	lumaAt := pixelLuma(img)
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		gridY := minInt(height-1, int(float64(y-bounds.Min.Y)/cell))
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			gridX := minInt(width-1, int(float64(x-bounds.Min.X)/cell))
+			index := gridY*width + gridX
+			luminance[index] += lumaAt(x, y)
+			counts[index]++
+		}
+	}
+	for index, eachCount := range counts {
+		if eachCount != 0 {
+			luminance[index] /= float64(eachCount)
+		}
+	}
+	at := func(x int, y int) float64 {
+		x = maxInt(0, minInt(width-1, x))
+		y = maxInt(0, minInt(height-1, y))
+		return luminance[y*width+x]
+	}
+
+	density := &edgeDensity{
+		bounds: bounds,
+		cell:   cell,
+		width:  width,
+		height: height,
+		sums:   make([]float64, (width+1)*(height+1)),
+	}
+	for y := 0; y < height; y++ {
+		rowSum := 0.0
+		for x := 0; x < width; x++ {
+			gradientX := at(x+1, y-1) + 2*at(x+1, y) + at(x+1, y+1) -
+				at(x-1, y-1) - 2*at(x-1, y) - at(x-1, y+1)
+			gradientY := at(x-1, y+1) + 2*at(x, y+1) + at(x+1, y+1) -
+				at(x-1, y-1) - 2*at(x, y-1) - at(x+1, y-1)
+			rowSum += math.Hypot(gradientX, gradientY)
+			density.sums[(y+1)*(width+1)+x+1] = density.sums[y*(width+1)+x+1] + rowSum
+		}
+	}
+	return density
+}
+
+// Score returns the mean gradient magnitude within rect. Higher scores are
+// busier.
+func (density *edgeDensity) Score(rect image.Rectangle) float64 {
+	rect = rect.Intersect(density.bounds).Sub(density.bounds.Min)
+	if rect.Empty() {
+		return math.Inf(1)
+	}
+	toGrid := func(value int, limit int) int {
+		return maxInt(0, minInt(limit, int(math.Round(float64(value)/density.cell))))
+	}
+	x0, x1 := toGrid(rect.Min.X, density.width), toGrid(rect.Max.X, density.width)
+	y0, y1 := toGrid(rect.Min.Y, density.height), toGrid(rect.Max.Y, density.height)
+	if x1 <= x0 {
+		x1 = minInt(density.width, x0+1)
+		x0 = x1 - 1
+	}
+	if y1 <= y0 {
+		y1 = minInt(density.height, y0+1)
+		y0 = y1 - 1
+	}
+	stride := density.width + 1
+	sum := density.sums[y1*stride+x1] -
+		density.sums[y0*stride+x1] -
+		density.sums[y1*stride+x0] +
+		density.sums[y0*stride+x0]
+	return sum / float64((x1-x0)*(y1-y0))
+}
+
+// smartPlacement returns the least busy of the candidate anchored
+// placements for a watermark of the given size, along with its anchor and
+// edge density score. The configured Anchor is preferred unless another
+// candidate is noticeably less busy.
+func (options *StampOptions) smartPlacement(img image.Image, size image.Point) (image.Rectangle, Anchor, float64) {
+	bounds := img.Bounds()
+	candidates := []Anchor{options.Anchor}
+	anchors := options.Anchors
+	if len(anchors) == 0 {
+		for anchor := AnchorTopLeft; anchor <= AnchorBottomRight; anchor++ {
+			anchors = append(anchors, anchor)
+		}
+	}
+	for _, eachAnchor := range anchors {
+		if eachAnchor != options.Anchor {
+			candidates = append(candidates, eachAnchor)
+		}
+	}
+	if len(options.Anchors) != 0 && !containsAnchor(options.Anchors, options.Anchor) {
+		candidates = candidates[1:]
+	}
+
+	density := newEdgeDensity(img)
+	bestBiased := math.Inf(1)
+	bestScore := math.Inf(1)
+	var bestRect image.Rectangle
+	bestAnchor := options.Anchor
+	for index, eachAnchor := range candidates {
+		rect := options.anchoredRect(bounds, size, eachAnchor)
+		score := density.Score(rect)
+		biased := score
+		if eachAnchor == options.Anchor {
+			biased *= preferredAnchorBias
+		}
+		if index == 0 || biased < bestBiased {
+			bestBiased = biased
+			bestScore = score
+			bestRect = rect
+			bestAnchor = eachAnchor
+		}
+	}
+	return bestRect, bestAnchor, bestScore
+}
+
+// anchoredRect returns the placement of a watermark of the given size at
+// the anchor, inset by the Margin
+func (options *StampOptions) anchoredRect(bounds image.Rectangle, size image.Point, anchor Anchor) image.Rectangle {
+	origin := anchorPoint(bounds,
+		size,
+		anchor,
+		options.Margin.Pixels(bounds.Dx()),
+		options.Margin.Pixels(bounds.Dy()))
+	return image.Rectangle{Min: origin, Max: origin.Add(size)}
+}
+
+// smartAnchors pins the anchor smartPlacement chose for each StampOptions,
+// s.t. every frame of an animation is stamped in the same place rather than
+// the watermark jumping between frames
+type smartAnchors struct {
+	sync.Mutex
+	anchors map[*StampOptions]Anchor
+}
+
+// contextWithSmartAnchors returns a context in which the first smart
+// placement of each StampOptions is reused by the rest
+func contextWithSmartAnchors(ctx context.Context) context.Context {
+	return context.WithValue(ctx, smartAnchorsKey, &smartAnchors{
+		anchors: make(map[*StampOptions]Anchor),
+	})
+}
+
+// pinnedSmartPlacement is smartPlacement, unless an earlier frame already
+// chose the anchor, in which case that anchor is reused
+func (options *StampOptions) pinnedSmartPlacement(ctx context.Context,
+	img image.Image,
+	size image.Point) (rect image.Rectangle, anchor Anchor, score float64, reused bool) {
+	pinned, isPinned := ctx.Value(smartAnchorsKey).(*smartAnchors)
+	if !isPinned {
+		rect, anchor, score = options.smartPlacement(img, size)
+		return rect, anchor, score, false
+	}
+	pinned.Lock()
+	defer pinned.Unlock()
+	if anchor, exists := pinned.anchors[options]; exists {
+		return options.anchoredRect(img.Bounds(), size, anchor), anchor, 0, true
+	}
+	rect, anchor, score = options.smartPlacement(img, size)
+	pinned.anchors[options] = anchor
+	return rect, anchor, score, false
+}
+
+func containsAnchor(anchors []Anchor, anchor Anchor) bool {
+	for _, eachAnchor := range anchors {
+		if eachAnchor == anchor {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,109 @@
+package transforms
+
+import (
+	"context"
+	"image"
+	"image/gif"
+	"testing"
+
+	"github.com/rs/zerolog"
+)
+
+// flatCornerFrame is a busy checkerboard except for a flat quadrant at the
+// corner anchor
+func flatCornerFrame(size int, corner Anchor) *image.Paletted {
+	frame := image.NewPaletted(image.Rect(0, 0, size, size), testAnimationPalette)
+	half := size / 2
+	flat := map[Anchor]image.Rectangle{
+		AnchorTopLeft:     image.Rect(0, 0, half, half),
+		AnchorTopRight:    image.Rect(half, 0, size, half),
+		AnchorBottomLeft:  image.Rect(0, half, half, size),
+		AnchorBottomRight: image.Rect(half, half, size, size),
+	}[corner]
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			index := uint8(1)
+			if image.Pt(x, y).In(flat) {
+				index = 2
+			} else if (x/4+y/4)%2 == 0 {
+				index = 4
+			}
+			frame.SetColorIndex(x, y, index)
+		}
+	}
+	return frame
+}
+
+// smartTestOptions prefers the busy center of flatCornerFrame
+func smartTestOptions() StampOptions {
+	return StampOptions{
+		Mode:    PlacementSmart,
+		Anchor:  AnchorCenter,
+		Margin:  Margin{Value: 4},
+		Opacity: 1,
+	}
+}
+
+var flatCorners = []Anchor{
+	AnchorTopLeft,
+	AnchorTopRight,
+	AnchorBottomLeft,
+	AnchorBottomRight,
+}
+
+func TestSmartPlacementChoosesLeastBusyAnchor(t *testing.T) {
+	options := smartTestOptions()
+	size := image.Pt(40, 30)
+	for _, eachCorner := range flatCorners {
+		t.Run(eachCorner.String(), func(t *testing.T) {
+			frame := flatCornerFrame(200, eachCorner)
+			rect, anchor, _ := options.smartPlacement(frame, size)
+			if anchor != eachCorner {
+				t.Fatalf("smartPlacement() chose %s, want %s", anchor, eachCorner)
+			}
+			if want := options.anchoredRect(frame.Bounds(), size, eachCorner); rect != want {
+				t.Errorf("smartPlacement() = %v, want %v", rect, want)
+			}
+		})
+	}
+}
+
+func TestSmartPlacementIsPinnedAcrossFrames(t *testing.T) {
+	logger := zerolog.Nop()
+	animation := &gif.GIF{Config: image.Config{Width: 200, Height: 200}}
+	for _, eachCorner := range flatCorners {
+		animation.Image = append(animation.Image, flatCornerFrame(200, eachCorner))
+		animation.Delay = append(animation.Delay, 10)
+		animation.Disposal = append(animation.Disposal, gif.DisposalNone)
+	}
+	options := smartTestOptions()
+	var anchors []string
+	pipeline := NewPipeline(&Stamp{Options: &options},
+		TransformFunc(func(ctx context.Context, img image.Image, logger *zerolog.Logger) (image.Image, error) {
+			anchors = append(anchors, ctx.Value(annotationsKey).(Annotations)["watermark-anchor"])
+			return img, nil
+		}))
+	ctx := context.WithValue(context.Background(), annotationsKey, Annotations{})
+	if _, err := pipeline.applyAnimation(ctx, animation, &logger); err != nil {
+		t.Fatal(err)
+	}
+	if len(anchors) != len(flatCorners) {
+		t.Fatalf("stamped %d frames, want %d", len(anchors), len(flatCorners))
+	}
+	for index, eachAnchor := range anchors {
+		if eachAnchor != flatCorners[0].String() {
+			t.Errorf("frame %d stamped at %s, want %s", index, eachAnchor, flatCorners[0])
+		}
+	}
+
+	// Still images choose their own placement
+	for _, eachCorner := range flatCorners[1:] {
+		anchors = nil
+		if _, err := pipeline.Apply(ctx, flatCornerFrame(200, eachCorner), &logger); err != nil {
+			t.Fatal(err)
+		}
+		if anchors[0] != eachCorner.String() {
+			t.Errorf("still image stamped at %s, want %s", anchors[0], eachCorner)
+		}
+	}
+}
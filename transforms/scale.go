@@ -0,0 +1,196 @@
+package transforms
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"math"
+	"math/bits"
+	"strings"
+	"sync"
+)
+
+// ScaleEdge selects the target image edge a WatermarkScale is relative to
+type ScaleEdge int
+
+const (
+	// EdgeShorter sizes the watermark relative to the shorter image edge
+	EdgeShorter ScaleEdge = iota
+	// EdgeLonger sizes the watermark relative to the longer image edge
+	EdgeLonger
+)
+
+// String returns the edge name accepted by ParseScaleEdge
+func (edge ScaleEdge) String() string {
+	switch edge {
+	case EdgeShorter:
+		return "shorter"
+	case EdgeLonger:
+		return "longer"
+	default:
+		return fmt.Sprintf("ScaleEdge(%d)", int(edge))
+	}
+}
+
+// ParseScaleEdge returns the ScaleEdge for "shorter" or "longer"
+func ParseScaleEdge(name string) (ScaleEdge, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "", "shorter", "short":
+		return EdgeShorter, nil
+	case "longer", "long":
+		return EdgeLonger, nil
+	default:
+		return EdgeShorter, fmt.Errorf("unsupported scale edge: %s", name)
+	}
+}
+
+// WatermarkScale determines the size of the watermark's longer side
+type WatermarkScale struct {
+	// Size is either a fixed pixel size or a percentage of the Edge
+	Size Margin
+	Edge ScaleEdge
+	// MinSize and MaxSize bound the resolved size in pixels. Zero disables
+	// the bound.
+	MinSize int
+	MaxSize int
+}
+
+// embeddedWatermarkEdge is the longer side of the embedded helmet
+const embeddedWatermarkEdge = 316
+
+// DefaultWatermarkScale sizes the watermark to 20% of the shorter image
+// edge, between 32 pixels and the embedded helmet's native size
+func DefaultWatermarkScale() WatermarkScale {
+	return WatermarkScale{
+		Size:    Margin{Value: 20, Percent: true},
+		Edge:    EdgeShorter,
+		MinSize: 32,
+		MaxSize: embeddedWatermarkEdge,
+	}
+}
+
+// Pixels returns the size of the watermark's longer side for an image with
+// the given bounds. The result is never larger than the image's shorter
+// edge and at least 1.
+func (scale *WatermarkScale) Pixels(bounds image.Rectangle) int {
+	shorterEdge := bounds.Dx()
+	longerEdge := bounds.Dy()
+	if longerEdge < shorterEdge {
+		shorterEdge, longerEdge = longerEdge, shorterEdge
+	}
+	edge := shorterEdge
+	if scale.Edge == EdgeLonger {
+		edge = longerEdge
+	}
+	size := scale.Size.Pixels(edge)
+	if scale.MinSize > 0 && size < scale.MinSize {
+		size = scale.MinSize
+	}
+	if scale.MaxSize > 0 && size > scale.MaxSize {
+		size = scale.MaxSize
+	}
+	if size > shorterEdge {
+		size = shorterEdge
+	}
+	return maxInt(1, size)
+}
+
+// commonAspectRatios are the longer to shorter edge ratios of common photos
+var commonAspectRatios = []image.Point{{1, 1}, {4, 3}, {3, 2}, {16, 9}}
+
+// CommonSizes returns the watermark sizes for images at the common photo
+// aspect ratios whose longer edge is maxEdge, eg a resized derivative. A
+// zero maxEdge returns the MaxSize that large originals are capped at.
+func (scale *WatermarkScale) CommonSizes(maxEdge int) []int {
+	if maxEdge <= 0 {
+		if scale.MaxSize > 0 {
+			return []int{scale.MaxSize}
+		}
+		return nil
+	}
+	var sizes []int
+	seen := make(map[int]bool)
+	for _, eachRatio := range commonAspectRatios {
+		bounds := image.Rect(0, 0, maxEdge, maxInt(1, maxEdge*eachRatio.Y/eachRatio.X))
+		size := bucketWatermarkSize(scale.Pixels(bounds))
+		if !seen[size] {
+			seen[size] = true
+			sizes = append(sizes, size)
+		}
+	}
+	return sizes
+}
+
+// maxScaledWatermarks bounds the number of resampled watermarks kept in
+// memory. The cache is reset when it fills, eg after a watermark refresh.
+const maxScaledWatermarks = 64
+
+// scaledWatermarkKey identifies a resampled watermark. Masters are compared
+// by identity, so a refreshed master never matches a stale entry.
+type scaledWatermarkKey struct {
+	master image.Image
+	size   int
+}
+
+var scaledWatermarks = struct {
+	sync.RWMutex
+	images map[scaledWatermarkKey]*image.RGBA
+}{images: make(map[scaledWatermarkKey]*image.RGBA)}
+
+// watermarkSizeBits is the number of significant bits kept by
+// bucketWatermarkSize. Five bits gives 16 sizes per doubling, each within
+// about 6% of the next.
+const watermarkSizeBits = 5
+
+// bucketWatermarkSize rounds size down to one of a small set of sizes, s.t.
+// images with similar dimensions share a cached, resampled watermark.
+// Rounding down keeps the watermark within the bound Pixels applied.
+func bucketWatermarkSize(size int) int {
+	shift := bits.Len(uint(size)) - watermarkSizeBits
+	if shift <= 0 {
+		return size
+	}
+	return size >> uint(shift) << uint(shift)
+}
+
+// scaleWatermark returns the premultiplied master watermark resampled s.t.
+// its longer side is size pixels, rounded by bucketWatermarkSize, preserving
+// the aspect ratio. The master is never upscaled, as that only blurs it, so
+// size is capped at the master's longer side. Results are cached across
+// invocations. Resampling stops with ctx.Err() once ctx is done.
+func scaleWatermark(ctx context.Context, master image.Image, size int) (*image.RGBA, error) {
+	masterBounds := master.Bounds()
+	masterEdge := maxInt(masterBounds.Dx(), masterBounds.Dy())
+	size = bucketWatermarkSize(size)
+	if size > masterEdge {
+		size = masterEdge
+	}
+	key := scaledWatermarkKey{master: master, size: size}
+	scaledWatermarks.RLock()
+	scaled, exists := scaledWatermarks.images[key]
+	scaledWatermarks.RUnlock()
+	if exists {
+		return scaled, nil
+	}
+
+	var err error
+	if masterEdge == size || masterEdge == 0 {
+		scaled, err = toRGBA(ctx, master)
+	} else {
+		ratio := float64(size) / float64(masterEdge)
+		width := maxInt(1, int(math.Round(float64(masterBounds.Dx())*ratio)))
+		height := maxInt(1, int(math.Round(float64(masterBounds.Dy())*ratio)))
+		scaled, err = resample(ctx, master, width, height, Lanczos3)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	scaledWatermarks.Lock()
+	if len(scaledWatermarks.images) >= maxScaledWatermarks {
+		scaledWatermarks.images = make(map[scaledWatermarkKey]*image.RGBA)
+	}
+	scaledWatermarks.images[key] = scaled
+	scaledWatermarks.Unlock()
+	return scaled, nil
+}
@@ -0,0 +1,194 @@
+package transforms
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"testing"
+
+	"github.com/rs/zerolog"
+)
+
+func TestScaleWatermarkNeverUpscales(t *testing.T) {
+	master := image.NewRGBA(image.Rect(0, 0, 80, 40))
+	tests := []struct {
+		size int
+		want image.Point
+	}{
+		{20, image.Pt(20, 10)},
+		{80, image.Pt(80, 40)},
+		{512, image.Pt(80, 40)},
+	}
+	for _, eachTest := range tests {
+		scaled, err := scaleWatermark(context.Background(), master, eachTest.size)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if scaled.Bounds().Size() != eachTest.want {
+			t.Errorf("scaleWatermark(%d) is %v, want %v",
+				eachTest.size,
+				scaled.Bounds().Size(),
+				eachTest.want)
+		}
+	}
+}
+
+func TestDefaultWatermarkScaleFitsEmbeddedMaster(t *testing.T) {
+	logger := zerolog.Nop()
+	master, err := NewEmbeddedProvider().Watermark(context.Background(), DefaultWatermark, &logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	masterEdge := maxInt(master.Bounds().Dx(), master.Bounds().Dy())
+	if scale := DefaultWatermarkScale(); scale.MaxSize != masterEdge {
+		t.Errorf("DefaultWatermarkScale().MaxSize = %d, want the embedded master's %d", scale.MaxSize, masterEdge)
+	}
+}
+
+func TestBucketWatermarkSize(t *testing.T) {
+	buckets := make(map[int]bool)
+	for size := 1; size <= 512; size++ {
+		bucket := bucketWatermarkSize(size)
+		if bucket > size {
+			t.Fatalf("bucketWatermarkSize(%d) = %d rounds up", size, bucket)
+		}
+		if float64(size-bucket) > 0.07*float64(size) {
+			t.Fatalf("bucketWatermarkSize(%d) = %d is too coarse", size, bucket)
+		}
+		buckets[bucket] = true
+	}
+	// 1-31 are exact, then 16 buckets per doubling up to 512
+	if len(buckets) != 31+4*16+1 {
+		t.Errorf("sizes 1-512 use %d buckets", len(buckets))
+	}
+}
+
+func TestCommonSizes(t *testing.T) {
+	scale := DefaultWatermarkScale()
+	// 20% of the shorter edge of 1280x1280, 1280x960, 1280x853 and 1280x720,
+	// rounded down to their buckets
+	want := []int{256, 192, 168, 144}
+	if got := scale.CommonSizes(1280); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("CommonSizes(1280) = %v, want %v", got, want)
+	}
+	if got := scale.CommonSizes(0); fmt.Sprint(got) != fmt.Sprint([]int{scale.MaxSize}) {
+		t.Errorf("CommonSizes(0) = %v, want the MaxSize", got)
+	}
+}
+
+func TestPreloadWatermarkScalesSizes(t *testing.T) {
+	logger := zerolog.Nop()
+	provider := NewEmbeddedProvider()
+	scale := DefaultWatermarkScale()
+	sizes := scale.CommonSizes(640)
+	if err := PreloadWatermark(context.Background(), provider, "", sizes, &logger); err != nil {
+		t.Fatal(err)
+	}
+	master, err := provider.Watermark(context.Background(), DefaultWatermark, &logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	scaledWatermarks.RLock()
+	defer scaledWatermarks.RUnlock()
+	for _, eachSize := range sizes {
+		if _, exists := scaledWatermarks.images[scaledWatermarkKey{master: master, size: eachSize}]; !exists {
+			t.Errorf("%dpx watermark wasn't preloaded", eachSize)
+		}
+	}
+}
+
+// benchmarkWatermark returns the embedded master watermark
+func benchmarkWatermark(b *testing.B) image.Image {
+	logger := zerolog.Nop()
+	master, err := defaultWatermarkProvider.Watermark(context.Background(),
+		DefaultWatermark,
+		&logger)
+	if err != nil {
+		b.Fatal(err)
+	}
+	return master
+}
+
+func BenchmarkScaleWatermark(b *testing.B) {
+	master := benchmarkWatermark(b)
+	for _, eachSize := range []int{64, 128, 256} {
+		b.Run(fmt.Sprintf("%dpx", eachSize), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				// Measure resampling rather than the cache
+				scaledWatermarks.Lock()
+				scaledWatermarks.images = make(map[scaledWatermarkKey]*image.RGBA)
+				scaledWatermarks.Unlock()
+				scaleWatermark(context.Background(), master, eachSize)
+			}
+		})
+	}
+}
+
+// benchmarkWatermarkSize is the watermark size for a 1280x960 derivative
+const benchmarkWatermarkSize = 192
+
+// BenchmarkWatermarkUncached decodes, premultiplies and resamples the
+// watermark for every stamp, as each invocation did without the caches
+func BenchmarkWatermarkUncached(b *testing.B) {
+	logger := zerolog.Nop()
+	for i := 0; i < b.N; i++ {
+		scaledWatermarks.Lock()
+		scaledWatermarks.images = make(map[scaledWatermarkKey]*image.RGBA)
+		scaledWatermarks.Unlock()
+		master, err := NewEmbeddedProvider().Watermark(context.Background(), DefaultWatermark, &logger)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if _, err := scaleWatermark(context.Background(), master, benchmarkWatermarkSize); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkWatermarkCached is BenchmarkWatermarkUncached on a warm container
+// whose caches were preloaded at cold start
+func BenchmarkWatermarkCached(b *testing.B) {
+	logger := zerolog.Nop()
+	provider := NewEmbeddedProvider()
+	err := PreloadWatermark(context.Background(), provider, "", []int{benchmarkWatermarkSize}, &logger)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		master, err := provider.Watermark(context.Background(), DefaultWatermark, &logger)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if _, err := scaleWatermark(context.Background(), master, benchmarkWatermarkSize); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkComposite(b *testing.B) {
+	watermark, err := scaleWatermark(context.Background(), benchmarkWatermark(b), 256)
+	if err != nil {
+		b.Fatal(err)
+	}
+	target := image.NewRGBA(image.Rect(0, 0, 2048, 1536))
+	for i := range target.Pix {
+		target.Pix[i] = 0x80
+	}
+	rect := watermark.Bounds().Add(image.Pt(1024, 768))
+	for _, eachMode := range []BlendMode{BlendNormal, BlendMultiply, BlendSoftLight} {
+		b.Run(eachMode.String(), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				Composite(context.Background(), target, rect, watermark, image.Point{}, eachMode, 0.6)
+			}
+		})
+	}
+	b.Run("tiled", func(b *testing.B) {
+		options := StampOptions{Mode: PlacementTiled, Opacity: 0.6}
+		for i := 0; i < b.N; i++ {
+			for _, eachPlacement := range options.Placements(target.Bounds(), watermark.Bounds().Size()) {
+				Composite(context.Background(), target, eachPlacement, watermark, image.Point{}, BlendNormal, options.Opacity)
+			}
+		}
+	})
+}
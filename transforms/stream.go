@@ -0,0 +1,123 @@
+package transforms
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"sync"
+)
+
+// encodeBufferSize is the size of the pooled buffers encoders write through.
+// Encoders issue many small writes, which are coalesced before they reach
+// the destination, eg an io.Pipe feeding an upload.
+const encodeBufferSize = 64 * 1024
+
+var bufferedWriters = sync.Pool{
+	New: func() interface{} {
+		return bufio.NewWriterSize(nil, encodeBufferSize)
+	},
+}
+
+func getBufferedWriter(writer io.Writer) *bufio.Writer {
+	buffered := bufferedWriters.Get().(*bufio.Writer)
+	buffered.Reset(writer)
+	return buffered
+}
+
+func putBufferedWriter(buffered *bufio.Writer) {
+	buffered.Reset(nil)
+	bufferedWriters.Put(buffered)
+}
+
+// countingWriter counts the bytes written through it
+type countingWriter struct {
+	writer io.Writer
+	count  int64
+}
+
+func (counter *countingWriter) Write(data []byte) (int, error) {
+	written, err := counter.writer.Write(data)
+	counter.count += int64(written)
+	return written, err
+}
+
+// metadataWriter inserts metadata blocks into the JPEG or PNG stream written
+// through it. Only the leading signature and header are held back, the rest
+// of the stream is passed straight through. Other formats are passed through
+// as is.
+type metadataWriter struct {
+	writer   io.Writer
+	metadata *Metadata
+	prefix   []byte
+	injected bool
+}
+
+func (injector *metadataWriter) Write(data []byte) (int, error) {
+	if injector.injected {
+		return injector.writer.Write(data)
+	}
+	injector.prefix = append(injector.prefix, data...)
+	format, length := metadataInjectionPoint(injector.prefix)
+	if length < 0 {
+		return len(data), nil
+	}
+	err := injector.inject(format, length)
+	if err != nil {
+		return 0, err
+	}
+	return len(data), nil
+}
+
+// Close flushes a stream that ended before the injection point was found
+func (injector *metadataWriter) Close() error {
+	if injector.injected {
+		return nil
+	}
+	return injector.inject(FormatAuto, 0)
+}
+
+func (injector *metadataWriter) inject(format Format, length int) error {
+	injector.injected = true
+	header, err := InjectMetadata(injector.prefix[:length], format, injector.metadata)
+	if err != nil {
+		return err
+	}
+	_, err = injector.writer.Write(header)
+	if err == nil {
+		_, err = injector.writer.Write(injector.prefix[length:])
+	}
+	injector.prefix = nil
+	return err
+}
+
+// metadataInjectionPoint returns the format of the encoded prefix and the
+// length of the header the metadata is inserted after, or -1 when more of
+// the stream is needed
+func metadataInjectionPoint(prefix []byte) (Format, int) {
+	if len(prefix) < 2 {
+		return FormatAuto, -1
+	}
+	if prefix[0] == 0xff && prefix[1] == markerSOI {
+		return FormatJPEG, 2
+	}
+	if len(prefix) < len(pngHeader) {
+		if bytes.HasPrefix(pngHeader, prefix) {
+			return FormatAuto, -1
+		}
+		return FormatAuto, 0
+	}
+	if !bytes.HasPrefix(prefix, pngHeader) {
+		return FormatAuto, 0
+	}
+	// The IHDR chunk immediately follows the signature
+	if len(prefix) < len(pngHeader)+8 {
+		return FormatAuto, -1
+	}
+	length := int64(binary.BigEndian.Uint32(prefix[len(pngHeader):]))
+	end := int64(len(pngHeader)) + 12 + length
+	if int64(len(prefix)) < end {
+		return FormatAuto, -1
+	}
+	return FormatPNG, int(end)
+}
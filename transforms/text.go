@@ -0,0 +1,349 @@
+package transforms
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/mweagle/SpartaImager/assets"
+	"github.com/rs/zerolog"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+)
+
+// DefaultFont is the embedded font used when TextOptions.Font is empty
+const DefaultFont = "Go-Bold"
+
+func fontName(name string) string {
+	return fmt.Sprintf("/resources/fonts/%s.ttf", name)
+}
+
+var fontCache = struct {
+	sync.Mutex
+	fonts map[string]*opentype.Font
+}{fonts: make(map[string]*opentype.Font)}
+
+// LoadFont returns the parsed embedded TrueType/OpenType font with the
+// given name, eg "Go-Regular"
+func LoadFont(name string) (*opentype.Font, error) {
+	fontCache.Lock()
+	defer fontCache.Unlock()
+
+	if parsed, exists := fontCache.fonts[name]; exists {
+		return parsed, nil
+	}
+	byteSource, err := assets.FSByte(false, fontName(name))
+	if err != nil {
+		return nil, fmt.Errorf("unknown font %s: %s", name, err)
+	}
+	parsed, err := opentype.Parse(byteSource)
+	if err != nil {
+		return nil, err
+	}
+	fontCache.fonts[name] = parsed
+	return parsed, nil
+}
+
+// ParseColor parses a #RGB, #RRGGBB or #RRGGBBAA hex color
+func ParseColor(value string) (color.Color, error) {
+	normalized := strings.TrimPrefix(strings.TrimSpace(value), "#")
+	if len(normalized) == 3 {
+		normalized = fmt.Sprintf("%c%c%c%c%c%c",
+			normalized[0], normalized[0],
+			normalized[1], normalized[1],
+			normalized[2], normalized[2])
+	}
+	if len(normalized) == 6 {
+		normalized += "ff"
+	}
+	decoded, err := hex.DecodeString(normalized)
+	if err != nil || len(decoded) != 4 {
+		return nil, fmt.Errorf("invalid color: %s", value)
+	}
+	return color.NRGBA{R: decoded[0], G: decoded[1], B: decoded[2], A: decoded[3]}, nil
+}
+
+// TextData is the set of values available to a TextOptions template
+type TextData struct {
+	// Date is the time the object was uploaded
+	Date     time.Time
+	Uploader string
+	Bucket   string
+	Key      string
+}
+
+type contextKey int
+
+const (
+	textDataKey contextKey = iota
+	annotationsKey
+	ownedImageKey
+	smartAnchorsKey
+)
+
+// ContextWithTextData returns a context that carries the per upload values
+// used to execute text watermark templates
+func ContextWithTextData(ctx context.Context, data *TextData) context.Context {
+	return context.WithValue(ctx, textDataKey, data)
+}
+
+func textDataFromContext(ctx context.Context) *TextData {
+	data, ok := ctx.Value(textDataKey).(*TextData)
+	if !ok || data == nil {
+		return &TextData{Date: time.Now()}
+	}
+	return data
+}
+
+// TextOptions describe how a text watermark is rendered
+type TextOptions struct {
+	// Text is a text/template executed against a TextData value, eg:
+	// "© Acme {{.Date.Year}}"
+	Text string
+	// Template is Text parsed by ParseTemplate. RenderText parses Text if
+	// it's nil.
+	Template *template.Template `json:"-"`
+	// Font is the embedded font name. Defaults to DefaultFont.
+	Font string
+	// Size is the font height, either in pixels or as a percentage of the
+	// shorter image edge
+	Size  Margin
+	Color color.Color
+	// ShadowColor enables a drop shadow drawn at ShadowOffset
+	ShadowColor  color.Color
+	ShadowOffset image.Point
+	// OutlineColor enables an outline OutlineWidth pixels wide
+	OutlineColor color.Color
+	OutlineWidth int
+	// Rotation in degrees, counter-clockwise
+	Rotation float64
+}
+
+// DefaultTextOptions renders semi-transparent white text with a dark
+// shadow at 4% of the shorter image edge
+func DefaultTextOptions() TextOptions {
+	return TextOptions{
+		Font:         DefaultFont,
+		Size:         Margin{Value: 4, Percent: true},
+		Color:        color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xcc},
+		ShadowColor:  color.NRGBA{A: 0x80},
+		ShadowOffset: image.Pt(2, 2),
+	}
+}
+
+// ParseTemplate parses Text into Template, s.t. an invalid template is
+// rejected once rather than by every render
+func (options *TextOptions) ParseTemplate() error {
+	parsed, err := template.New("watermark").Parse(options.Text)
+	if err != nil {
+		return err
+	}
+	options.Template = parsed
+	return nil
+}
+
+func absInt(value int) int {
+	if value < 0 {
+		return -value
+	}
+	return value
+}
+
+// RenderText executes the options template against data and renders the
+// result onto a transparent image. Size is the font height in pixels.
+func RenderText(options *TextOptions, data interface{}, size float64) (*image.RGBA, error) {
+	textTemplate := options.Template
+	if textTemplate == nil {
+		parsed, err := template.New("watermark").Parse(options.Text)
+		if err != nil {
+			return nil, err
+		}
+		textTemplate = parsed
+	}
+	var textBuffer bytes.Buffer
+	err := textTemplate.Execute(&textBuffer, data)
+	if err != nil {
+		return nil, err
+	}
+	lines := strings.Split(textBuffer.String(), "\n")
+
+	fontFaceName := options.Font
+	if fontFaceName == "" {
+		fontFaceName = DefaultFont
+	}
+	parsedFont, err := LoadFont(fontFaceName)
+	if err != nil {
+		return nil, err
+	}
+	face, err := opentype.NewFace(parsedFont, &opentype.FaceOptions{
+		Size:    math.Max(1, size),
+		DPI:     72,
+		Hinting: font.HintingFull,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer face.Close()
+
+	metrics := face.Metrics()
+	lineHeight := metrics.Height.Ceil()
+	textWidth := 0
+	for _, eachLine := range lines {
+		textWidth = maxInt(textWidth, font.MeasureString(face, eachLine).Ceil())
+	}
+	textHeight := lineHeight * len(lines)
+
+	// Leave room for the outline and shadow
+	padding := 0
+	if options.OutlineColor != nil {
+		padding = maxInt(0, options.OutlineWidth)
+	}
+	if options.ShadowColor != nil {
+		padding = maxInt(padding, maxInt(absInt(options.ShadowOffset.X), absInt(options.ShadowOffset.Y)))
+	}
+	rendered := image.NewRGBA(image.Rect(0, 0, textWidth+2*padding, textHeight+2*padding))
+
+	drawLines := func(textColor color.Color, offset image.Point) {
+		drawer := &font.Drawer{
+			Dst:  rendered,
+			Src:  image.NewUniform(textColor),
+			Face: face,
+		}
+		for index, eachLine := range lines {
+			drawer.Dot = fixed.P(padding+offset.X,
+				padding+offset.Y+index*lineHeight+metrics.Ascent.Ceil())
+			drawer.DrawString(eachLine)
+		}
+	}
+	if options.ShadowColor != nil {
+		drawLines(options.ShadowColor, options.ShadowOffset)
+	}
+	if options.OutlineColor != nil && options.OutlineWidth > 0 {
+		width := options.OutlineWidth
+		for dy := -width; dy <= width; dy++ {
+			for dx := -width; dx <= width; dx++ {
+				if dx*dx+dy*dy <= width*width {
+					drawLines(options.OutlineColor, image.Pt(dx, dy))
+				}
+			}
+		}
+	}
+	textColor := options.Color
+	if textColor == nil {
+		textColor = color.White
+	}
+	drawLines(textColor, image.Point{})
+
+	if options.Rotation != 0 {
+		return rotate(rendered, options.Rotation), nil
+	}
+	return rendered, nil
+}
+
+// rotate returns src rotated counter-clockwise by degrees about its center,
+// bilinearly sampled onto a canvas large enough to hold the result
+func rotate(src *image.RGBA, degrees float64) *image.RGBA {
+	radians := degrees * math.Pi / 180
+	sin, cos := math.Sincos(radians)
+	srcWidth := float64(src.Bounds().Dx())
+	srcHeight := float64(src.Bounds().Dy())
+	// Sincos isn't exact at right angles, so ignore the rounding error
+	// rather than growing the canvas by a pixel
+	const epsilon = 1e-9
+	dstWidth := int(math.Ceil(math.Abs(srcWidth*cos) + math.Abs(srcHeight*sin) - epsilon))
+	dstHeight := int(math.Ceil(math.Abs(srcWidth*sin) + math.Abs(srcHeight*cos) - epsilon))
+	dst := image.NewRGBA(image.Rect(0, 0, dstWidth, dstHeight))
+
+	srcCenterX, srcCenterY := srcWidth/2, srcHeight/2
+	dstCenterX, dstCenterY := float64(dstWidth)/2, float64(dstHeight)/2
+	for y := 0; y < dstHeight; y++ {
+		for x := 0; x < dstWidth; x++ {
+			// Inverse map the pixel center. Image Y grows downwards, so a
+			// counter-clockwise rotation uses the transposed matrix.
+			dx := float64(x) + 0.5 - dstCenterX
+			dy := float64(y) + 0.5 - dstCenterY
+			sx := dx*cos - dy*sin + srcCenterX - 0.5
+			sy := dx*sin + dy*cos + srcCenterY - 0.5
+			sampleBilinear(src, sx, sy, dst.Pix[dst.PixOffset(x, y):])
+		}
+	}
+	return dst
+}
+
+// sampleBilinear writes the bilinearly interpolated premultiplied color at
+// (x, y) to out. Samples outside src are transparent.
+func sampleBilinear(src *image.RGBA, x float64, y float64, out []uint8) {
+	x0 := int(math.Floor(x))
+	y0 := int(math.Floor(y))
+	fx := x - float64(x0)
+	fy := y - float64(y0)
+	bounds := src.Bounds()
+	var channels [4]float64
+	for _, eachSample := range [4]struct {
+		x, y   int
+		weight float64
+	}{
+		{x0, y0, (1 - fx) * (1 - fy)},
+		{x0 + 1, y0, fx * (1 - fy)},
+		{x0, y0 + 1, (1 - fx) * fy},
+		{x0 + 1, y0 + 1, fx * fy},
+	} {
+		point := image.Pt(bounds.Min.X+eachSample.x, bounds.Min.Y+eachSample.y)
+		if eachSample.weight == 0 || !point.In(bounds) {
+			continue
+		}
+		offset := src.PixOffset(point.X, point.Y)
+		for channel := 0; channel < 4; channel++ {
+			channels[channel] += float64(src.Pix[offset+channel]) * eachSample.weight
+		}
+	}
+	for channel := 0; channel < 4; channel++ {
+		out[channel] = clampUint8(float32(channels[channel]))
+	}
+}
+
+// TextStamp is a Transform that composites a rendered text watermark into
+// the image. The template data is read from the context, see
+// ContextWithTextData.
+type TextStamp struct {
+	Text *TextOptions
+	// Options defaults to DefaultStampOptions
+	Options *StampOptions
+}
+
+// Apply stamps the target image with the rendered text
+func (stamp *TextStamp) Apply(ctx context.Context,
+	target image.Image,
+	logger *zerolog.Logger) (image.Image, error) {
+	shorterEdge := target.Bounds().Dx()
+	if target.Bounds().Dy() < shorterEdge {
+		shorterEdge = target.Bounds().Dy()
+	}
+	size := float64(stamp.Text.Size.Pixels(shorterEdge))
+	watermark, err := RenderText(stamp.Text, textDataFromContext(ctx), size)
+	if err != nil {
+		logger.Error().
+			Err(err).
+			Str("Text", stamp.Text.Text).
+			Msg("Failed to render text watermark")
+		return nil, err
+	}
+	logger.Info().
+		Float64("Size", size).
+		Interface("TextBounds", watermark.Bounds()).
+		Msg("Rendered text watermark")
+	stamped, err := compositeWatermark(ctx, target, watermark, stamp.Options, logger)
+	if err != nil {
+		return nil, err
+	}
+	return stamped, nil
+}
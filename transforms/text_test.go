@@ -0,0 +1,142 @@
+package transforms
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"testing"
+	"time"
+)
+
+// plainTextOptions renders opaque white text without a shadow
+func plainTextOptions(text string) TextOptions {
+	options := DefaultTextOptions()
+	options.Text = text
+	options.Color = color.White
+	options.ShadowColor = nil
+	return options
+}
+
+func TestRenderTextExpandsTemplate(t *testing.T) {
+	data := &TextData{
+		Date:     time.Date(2026, time.October, 17, 12, 0, 0, 0, time.UTC),
+		Uploader: "ben",
+		Bucket:   "uploads",
+		Key:      "photos/cat.jpg",
+	}
+	templated := plainTextOptions("© {{.Uploader}} {{.Date.Year}}\n{{.Key}}")
+	if err := templated.ParseTemplate(); err != nil {
+		t.Fatal(err)
+	}
+	got, err := RenderText(&templated, data, 24)
+	if err != nil {
+		t.Fatal(err)
+	}
+	literal := plainTextOptions("© ben 2026\nphotos/cat.jpg")
+	want, err := RenderText(&literal, nil, 24)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Bounds() != want.Bounds() || string(got.Pix) != string(want.Pix) {
+		t.Errorf("rendered template %v differs from the expanded text %v", got.Bounds(), want.Bounds())
+	}
+
+	// Templates parsed up front aren't parsed again
+	unparsed := plainTextOptions("{{.Uploader}}")
+	parsed := unparsed
+	if err := parsed.ParseTemplate(); err != nil {
+		t.Fatal(err)
+	}
+	parsed.Text = "ignored"
+	fromText, _ := RenderText(&unparsed, data, 24)
+	fromTemplate, _ := RenderText(&parsed, data, 24)
+	if string(fromText.Pix) != string(fromTemplate.Pix) {
+		t.Error("RenderText didn't use the parsed Template")
+	}
+}
+
+func TestTextTemplateErrors(t *testing.T) {
+	for _, eachText := range []string{"{{.Date", "{{end}}", "{{.Uploader | missing}}"} {
+		options := plainTextOptions(eachText)
+		if err := options.ParseTemplate(); err == nil {
+			t.Errorf("ParseTemplate(%q) succeeded", eachText)
+		}
+	}
+	// Fields that don't exist fail when the template is executed
+	options := plainTextOptions("{{.Missing}}")
+	if err := options.ParseTemplate(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := RenderText(&options, &TextData{}, 24); err == nil {
+		t.Error("RenderText() succeeded with an unknown field")
+	}
+}
+
+func TestRenderTextOutline(t *testing.T) {
+	plain := plainTextOptions("Sparta")
+	plainRendered, err := RenderText(&plain, nil, 24)
+	if err != nil {
+		t.Fatal(err)
+	}
+	outlined := plainTextOptions("Sparta")
+	outlined.OutlineColor = color.RGBA{R: 0xff, A: 0xff}
+	outlined.OutlineWidth = 3
+	outlinedRendered, err := RenderText(&outlined, nil, 24)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The outline is padded on every side
+	wantSize := plainRendered.Bounds().Size().Add(image.Pt(6, 6))
+	if outlinedRendered.Bounds().Size() != wantSize {
+		t.Fatalf("outlined text is %v, want %v", outlinedRendered.Bounds().Size(), wantSize)
+	}
+	// The glyphs are drawn over the outline, which only shows around them
+	var glyph, outline int
+	for y := 0; y < outlinedRendered.Bounds().Dy(); y++ {
+		for x := 0; x < outlinedRendered.Bounds().Dx(); x++ {
+			pixel := outlinedRendered.RGBAAt(x, y)
+			switch {
+			case pixel == color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}:
+				glyph++
+			case pixel == color.RGBA{R: 0xff, A: 0xff}:
+				outline++
+			}
+		}
+	}
+	if glyph == 0 || outline == 0 {
+		t.Errorf("outlined text has %d glyph and %d outline pixels", glyph, outline)
+	}
+	for _, eachCorner := range []image.Point{{0, 0}, {wantSize.X - 1, wantSize.Y - 1}} {
+		if pixel := outlinedRendered.RGBAAt(eachCorner.X, eachCorner.Y); pixel.A != 0 {
+			t.Errorf("corner %v is %v, want transparent", eachCorner, pixel)
+		}
+	}
+}
+
+func TestRenderTextRotatedBounds(t *testing.T) {
+	upright := plainTextOptions("Sparta")
+	uprightRendered, err := RenderText(&upright, nil, 24)
+	if err != nil {
+		t.Fatal(err)
+	}
+	width := float64(uprightRendered.Bounds().Dx())
+	height := float64(uprightRendered.Bounds().Dy())
+	for _, eachRotation := range []float64{30, 45, 90, -90, 180, 270} {
+		rotated := plainTextOptions("Sparta")
+		rotated.Rotation = eachRotation
+		rendered, err := RenderText(&rotated, nil, 24)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sin, cos := math.Sincos(eachRotation * math.Pi / 180)
+		want := image.Pt(int(math.Round(math.Abs(width*cos)+math.Abs(height*sin))),
+			int(math.Round(math.Abs(width*sin)+math.Abs(height*cos))))
+		got := rendered.Bounds().Size()
+		if got.X < want.X || got.X > want.X+1 || got.Y < want.Y || got.Y > want.Y+1 {
+			t.Errorf("text rotated %g° is %v, want %v", eachRotation, got, want)
+		}
+		if math.Mod(eachRotation, 90) == 0 && got != want {
+			t.Errorf("text rotated %g° is %v, want exactly %v", eachRotation, got, want)
+		}
+	}
+}
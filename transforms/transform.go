@@ -0,0 +1,389 @@
+package transforms
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"image"
+	"image/gif"
+	"io"
+
+	"github.com/rs/zerolog"
+)
+
+// Transform is a single image operation that can be chained in a Pipeline.
+// Images may have any bounds, eg a SubImage, and all geometry is relative to
+// Bounds().Min.
+type Transform interface {
+	Apply(ctx context.Context, img image.Image, logger *zerolog.Logger) (image.Image, error)
+}
+
+// TransformFunc adapts an ordinary function to the Transform interface
+type TransformFunc func(ctx context.Context, img image.Image, logger *zerolog.Logger) (image.Image, error)
+
+// Apply calls transformFunc(ctx, img, logger)
+func (transformFunc TransformFunc) Apply(ctx context.Context,
+	img image.Image,
+	logger *zerolog.Logger) (image.Image, error) {
+	return transformFunc(ctx, img, logger)
+}
+
+// Source is a decoded input image
+type Source struct {
+	// Image is upright, with any EXIF orientation already applied
+	Image image.Image
+	// Format is the format name reported by the registered decoder
+	Format string
+	// Orientation is the EXIF orientation of the original upload
+	Orientation int
+	// Metadata are the EXIF, XMP and ICC blocks of the original upload
+	Metadata *Metadata
+	// Animation holds every frame of an animated GIF upload. Image is the
+	// first frame.
+	Animation *gif.GIF
+	// Digest is the hex encoded SHA-256 of the original upload
+	Digest string
+}
+
+// Output is the encoded result of a Pipeline
+type Output struct {
+	Body   io.ReadSeeker
+	Format Format
+	// Annotations were recorded by the transforms that produced the output
+	Annotations Annotations
+}
+
+// Annotations are key/value pairs that transforms record about how an
+// output was produced, eg the position of the watermark. Keys are
+// lowercase and hyphenated s.t. they can be stored as S3 user metadata.
+type Annotations map[string]string
+
+// Annotate records a value on the Output being produced with ctx. It's a
+// no-op outside of Pipeline.Process.
+func Annotate(ctx context.Context, key string, value string) {
+	if annotations, ok := ctx.Value(annotationsKey).(Annotations); ok {
+		annotations[key] = value
+	}
+}
+
+// ContentType returns the MIME type of the encoded output
+func (output *Output) ContentType() string {
+	return output.Format.ContentType()
+}
+
+// Pipeline decodes an image, applies an ordered set of Transforms and
+// encodes the result
+type Pipeline struct {
+	Transforms []Transform
+	Encoder    Encoder
+	// Metadata selects the source metadata carried into the output. A nil
+	// policy strips all metadata.
+	Metadata *MetadataPolicy
+}
+
+// NewPipeline returns a Pipeline that applies the transforms in order and
+// encodes the result in the source format
+func NewPipeline(transforms ...Transform) *Pipeline {
+	return &Pipeline{
+		Transforms: transforms,
+		Encoder:    &FormatEncoder{},
+	}
+}
+
+// Then appends a Transform to the pipeline and returns the pipeline s.t.
+// calls can be chained
+func (pipeline *Pipeline) Then(transform Transform) *Pipeline {
+	pipeline.Transforms = append(pipeline.Transforms, transform)
+	return pipeline
+}
+
+// Apply runs every Transform in order against the already decoded image.
+// It stops with ctx.Err() once ctx is done.
+func (pipeline *Pipeline) Apply(ctx context.Context,
+	img image.Image,
+	logger *zerolog.Logger) (image.Image, error) {
+	var err error
+	input := img
+	for _, eachTransform := range pipeline.Transforms {
+		if err = ctx.Err(); err != nil {
+			return nil, err
+		}
+		// An image produced by an earlier transform belongs to this pipeline,
+		// so later transforms may draw into it rather than copying it
+		if rgba, isRGBA := img.(*image.RGBA); isRGBA && img != input {
+			ctx = context.WithValue(ctx, ownedImageKey, rgba)
+		}
+		img, err = eachTransform.Apply(ctx, img, logger)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return img, nil
+}
+
+// Decode reads the image and all of its metadata in reader subject to the
+// DefaultDecodeLimits. JPEG images are rotated and flipped according to
+// their EXIF orientation s.t. every subsequent transform operates on upright
+// pixels. Any EXIF carried into the output has its orientation reset, see
+// Metadata.Filter.
+func Decode(ctx context.Context, reader io.Reader, logger *zerolog.Logger) (*Source, error) {
+	limits := DefaultDecodeLimits()
+	return DecodeWithLimits(ctx, reader, &limits, &MetadataPolicy{Mode: MetadataPreserve}, logger)
+}
+
+// DecodeWithLimits reads the image in reader. The declared dimensions are
+// checked against the limits before any pixels are allocated, so oversized
+// uploads fail with ErrImageTooLarge and unknown formats fail with
+// ErrUnsupportedFormat. Source metadata is only read if the policy keeps
+// some of it. Decoding stops with ctx.Err() once ctx is done.
+func DecodeWithLimits(ctx context.Context,
+	reader io.Reader,
+	limits *DecodeLimits,
+	policy *MetadataPolicy,
+	logger *zerolog.Logger) (*Source, error) {
+	data, err := readLimited(reader, limits.MaxBytes)
+	if err == nil {
+		err = ctx.Err()
+	}
+	if err != nil {
+		logger.Error().
+			Err(err).
+			Msg("Failed to read image")
+		return nil, err
+	}
+	config, _, err := limits.Check(data)
+	if err != nil {
+		logger.Error().
+			Err(err).
+			Int("Width", config.Width).
+			Int("Height", config.Height).
+			Int("Size", len(data)).
+			Msg("Rejected image")
+		return nil, err
+	}
+	img, imageType, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		logger.Error().
+			Err(err).
+			Msg("Failed to decode image")
+		return nil, err
+	}
+	if err = ctx.Err(); err != nil {
+		return nil, err
+	}
+	orientation := 1
+	var animation *gif.GIF
+	switch imageType {
+	case "jpeg":
+		orientation = exifOrientation(jpegExif(data))
+		img, err = Orient(ctx, img, orientation)
+		if err != nil {
+			return nil, err
+		}
+	case "gif":
+		animation, err = gif.DecodeAll(bytes.NewReader(data))
+		if err != nil {
+			logger.Error().
+				Err(err).
+				Msg("Failed to decode GIF frames")
+			return nil, err
+		}
+		if len(animation.Image) <= 1 {
+			animation = nil
+		}
+	}
+	frameCount := 1
+	if animation != nil {
+		frameCount = len(animation.Image)
+	}
+	logger.Info().
+		Str("ImageType", imageType).
+		Int("Orientation", orientation).
+		Int("FrameCount", frameCount).
+		Interface("Bounds", img.Bounds()).
+		Msg("Decoded image")
+	source := &Source{
+		Image:       img,
+		Format:      imageType,
+		Orientation: orientation,
+		Animation:   animation,
+		Digest:      fmt.Sprintf("%x", sha256.Sum256(data)),
+	}
+	if policy != nil && policy.Mode != MetadataStrip {
+		source.Metadata = ReadMetadata(data)
+	}
+	return source, nil
+}
+
+// Run decodes the image in reader, applies the pipeline transforms and
+// returns the encoded output
+func (pipeline *Pipeline) Run(ctx context.Context,
+	reader io.Reader,
+	logger *zerolog.Logger) (*Output, error) {
+	limits := DefaultDecodeLimits()
+	source, err := DecodeWithLimits(ctx, reader, &limits, pipeline.Metadata, logger)
+	if err != nil {
+		return nil, err
+	}
+	return pipeline.Process(ctx, source, logger)
+}
+
+// Process applies the pipeline transforms to an already decoded image and
+// returns the encoded output. The source image is not modified, so a single
+// decoded image can be shared by several pipelines.
+func (pipeline *Pipeline) Process(ctx context.Context,
+	source *Source,
+	logger *zerolog.Logger) (*Output, error) {
+	rendering, err := pipeline.Render(ctx, source, logger)
+	if err == nil {
+		err = ctx.Err()
+	}
+	if err != nil {
+		return nil, err
+	}
+	buf := new(bytes.Buffer)
+	_, err = rendering.WriteTo(buf)
+	if err != nil {
+		return nil, err
+	}
+	return &Output{
+		Body:        bytes.NewReader(buf.Bytes()),
+		Format:      rendering.Format,
+		Annotations: rendering.Annotations,
+	}, nil
+}
+
+// Rendering is a transformed image that has yet to be encoded. Its Format
+// and Annotations are known up front, so an upload can be started before
+// WriteTo streams the encoded bytes into it.
+type Rendering struct {
+	// Format is the format WriteTo writes. It's FormatAuto until written for
+	// Encoders that don't report an OutputFormat.
+	Format Format
+	// Annotations were recorded by the transforms that produced the image
+	Annotations Annotations
+
+	image        image.Image
+	animation    *gif.GIF
+	sourceFormat string
+	encoder      Encoder
+	metadata     *Metadata
+	logger       *zerolog.Logger
+}
+
+// Render applies the pipeline transforms to an already decoded image without
+// encoding the result. Like Process, the source image is not modified.
+func (pipeline *Pipeline) Render(ctx context.Context,
+	source *Source,
+	logger *zerolog.Logger) (*Rendering, error) {
+	logger.Info().
+		Int("TransformCount", len(pipeline.Transforms)).
+		Msg("Running pipeline")
+	annotations := make(Annotations)
+	ctx = context.WithValue(ctx, annotationsKey, annotations)
+
+	encoder := pipeline.Encoder
+	if encoder == nil {
+		encoder = &FormatEncoder{}
+	}
+	rendering := &Rendering{
+		Annotations:  annotations,
+		sourceFormat: source.Format,
+		encoder:      encoder,
+		logger:       logger,
+	}
+	if formatEncoder, isFormatEncoder := encoder.(interface {
+		OutputFormat(sourceFormat string) Format
+	}); isFormatEncoder {
+		rendering.Format = formatEncoder.OutputFormat(source.Format)
+	}
+
+	animationEncoder, isAnimationEncoder := encoder.(AnimationEncoder)
+	if source.Animation != nil &&
+		isAnimationEncoder &&
+		animationEncoder.OutputFormat(source.Format) == FormatGIF {
+		animation, err := pipeline.applyAnimation(ctx, source.Animation, logger)
+		if err != nil {
+			return nil, err
+		}
+		rendering.animation = animation
+		return rendering, nil
+	}
+
+	transformed, err := pipeline.Apply(ctx, source.Image, logger)
+	if err != nil {
+		return nil, err
+	}
+	rendering.image = transformed
+	if pipeline.Metadata != nil && !source.Metadata.Empty() {
+		rendering.metadata = source.Metadata.Filter(pipeline.Metadata, transformed.Bounds().Size())
+	}
+	return rendering, nil
+}
+
+// WriteTo encodes the rendered image to writer, injecting any retained
+// metadata as the encoded bytes stream past. It returns the number of bytes
+// written.
+func (rendering *Rendering) WriteTo(writer io.Writer) (int64, error) {
+	counter := &countingWriter{writer: writer}
+	var target io.Writer = counter
+	var injector *metadataWriter
+	if rendering.metadata != nil && !rendering.metadata.Empty() {
+		injector = &metadataWriter{writer: counter, metadata: rendering.metadata}
+		target = injector
+	}
+	buffered := getBufferedWriter(target)
+	defer putBufferedWriter(buffered)
+
+	var err error
+	if rendering.animation != nil {
+		err = rendering.encoder.(AnimationEncoder).EncodeAnimation(buffered, rendering.animation)
+		if err == nil {
+			err = buffered.Flush()
+		}
+		if err != nil {
+			rendering.logger.Error().
+				Err(err).
+				Msg("Failed to encode animation")
+			return counter.count, err
+		}
+		rendering.logger.Info().
+			Int("FrameCount", len(rendering.animation.Image)).
+			Int64("Size", counter.count).
+			Msg("Encoded animation")
+		return counter.count, nil
+	}
+
+	format, err := rendering.encoder.Encode(buffered, rendering.image, rendering.sourceFormat)
+	if err == nil {
+		err = buffered.Flush()
+	}
+	if err != nil {
+		rendering.logger.Error().
+			Err(err).
+			Msg("Failed to encode image")
+		return counter.count, err
+	}
+	rendering.Format = format
+	if injector != nil {
+		err = injector.Close()
+		if err != nil {
+			rendering.logger.Error().
+				Err(err).
+				Msg("Failed to inject metadata")
+			return counter.count, err
+		}
+		rendering.logger.Info().
+			Int("EXIFSize", len(rendering.metadata.EXIF)).
+			Int("XMPSize", len(rendering.metadata.XMP)).
+			Int("ICCSize", len(rendering.metadata.ICC)).
+			Msg("Injected metadata")
+	}
+	rendering.logger.Info().
+		Str("SourceFormat", rendering.sourceFormat).
+		Str("OutputFormat", string(format)).
+		Int64("Size", counter.count).
+		Msg("Encoded image")
+	return counter.count, nil
+}
@@ -0,0 +1,224 @@
+package transforms
+
+import (
+	"context"
+	"errors"
+	"image"
+	"math/rand"
+	"reflect"
+	"testing"
+	"testing/quick"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// boundsCase is a generated source image: a zero origin image and the same
+// pixels at an offset, either as an image with offset bounds or as a
+// SubImage of a larger image
+type boundsCase struct {
+	Zero   *image.RGBA
+	Offset *image.RGBA
+}
+
+// Generate implements quick.Generator. Sizes include 1x1 images and images
+// smaller than the watermark.
+func (boundsCase) Generate(random *rand.Rand, size int) reflect.Value {
+	width := 1 + random.Intn(96)
+	height := 1 + random.Intn(96)
+	if random.Intn(4) == 0 {
+		width, height = 1, 1+random.Intn(3)
+	}
+	zero := image.NewRGBA(image.Rect(0, 0, width, height))
+	for index := 0; index < len(zero.Pix); index += 4 {
+		alpha := uint8(random.Intn(256))
+		if random.Intn(2) == 0 {
+			alpha = 0xff
+		}
+		// Keep the pixels premultiplied
+		zero.Pix[index] = uint8(random.Intn(int(alpha) + 1))
+		zero.Pix[index+1] = uint8(random.Intn(int(alpha) + 1))
+		zero.Pix[index+2] = uint8(random.Intn(int(alpha) + 1))
+		zero.Pix[index+3] = alpha
+	}
+
+	origin := image.Pt(random.Intn(201)-100, random.Intn(201)-100)
+	var offset *image.RGBA
+	if random.Intn(2) == 0 {
+		offset = image.NewRGBA(zero.Bounds().Add(origin))
+	} else {
+		parent := image.NewRGBA(zero.Bounds().Add(origin).Inset(-1 - random.Intn(8)))
+		offset = parent.SubImage(zero.Bounds().Add(origin)).(*image.RGBA)
+	}
+	for y := 0; y < height; y++ {
+		copy(offset.Pix[offset.PixOffset(origin.X, origin.Y+y):offset.PixOffset(origin.X+width, origin.Y+y)],
+			zero.Pix[zero.PixOffset(0, y):zero.PixOffset(width, y)])
+	}
+	return reflect.ValueOf(boundsCase{Zero: zero, Offset: offset})
+}
+
+// propertyTransforms are the transforms checked by the bounds properties
+func propertyTransforms() map[string]Transform {
+	text := DefaultTextOptions()
+	text.Text = "Sparta"
+	return map[string]Transform{
+		"resize":    &Resize{Width: 40, Height: 40},
+		"upscale":   &Resize{Width: 200, Height: 200},
+		"crop":      &Crop{Rect: image.Rect(3, 2, 30, 20)},
+		"grayscale": &Grayscale{},
+		"stamp":     &Stamp{},
+		"stamp-tiled": &Stamp{Options: &StampOptions{
+			Mode:        PlacementTiled,
+			TileSpacing: Margin{Value: 25, Percent: true},
+			Opacity:     0.5,
+			Blend:       BlendMultiply,
+		}},
+		"stamp-smart": &Stamp{Options: &StampOptions{
+			Mode:     PlacementSmart,
+			Anchor:   AnchorTopLeft,
+			Margin:   Margin{Value: 5, Percent: true},
+			Opacity:  1,
+			Contrast: ContrastAuto,
+		}},
+		"text": &TextStamp{Text: &text},
+	}
+}
+
+// sameRelativePixels returns true if the images have the same size and the
+// same pixels relative to their origins
+func sameRelativePixels(lhs image.Image, rhs image.Image) bool {
+	if lhs.Bounds().Size() != rhs.Bounds().Size() {
+		return false
+	}
+	for y := 0; y < lhs.Bounds().Dy(); y++ {
+		for x := 0; x < lhs.Bounds().Dx(); x++ {
+			lhsR, lhsG, lhsB, lhsA := lhs.At(lhs.Bounds().Min.X+x, lhs.Bounds().Min.Y+y).RGBA()
+			rhsR, rhsG, rhsB, rhsA := rhs.At(rhs.Bounds().Min.X+x, rhs.Bounds().Min.Y+y).RGBA()
+			if lhsR != rhsR || lhsG != rhsG || lhsB != rhsB || lhsA != rhsA {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func TestTransformsAreBoundsRelative(t *testing.T) {
+	logger := zerolog.Nop()
+	for eachName, eachTransform := range propertyTransforms() {
+		transform := eachTransform
+		t.Run(eachName, func(t *testing.T) {
+			property := func(source boundsCase) bool {
+				zeroOutput, zeroErr := transform.Apply(context.Background(), source.Zero, &logger)
+				offsetOutput, offsetErr := transform.Apply(context.Background(), source.Offset, &logger)
+				if zeroErr != nil || offsetErr != nil {
+					t.Logf("%v: %v, %v", source.Offset.Bounds(), zeroErr, offsetErr)
+					return false
+				}
+				return sameRelativePixels(zeroOutput, offsetOutput)
+			}
+			if err := quick.Check(property, &quick.Config{MaxCount: 40}); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestTransformsKeepSourceAndSize(t *testing.T) {
+	logger := zerolog.Nop()
+	for eachName, eachTransform := range propertyTransforms() {
+		transform := eachTransform
+		t.Run(eachName, func(t *testing.T) {
+			property := func(source boundsCase) bool {
+				original := image.NewRGBA(source.Offset.Bounds())
+				copy(original.Pix, source.Zero.Pix)
+				output, err := transform.Apply(context.Background(), source.Offset, &logger)
+				if err != nil {
+					return false
+				}
+				// Transforms never draw into an image they don't own
+				if !sameRelativePixels(source.Offset, original) {
+					return false
+				}
+				bounds := source.Offset.Bounds()
+				switch typed := transform.(type) {
+				case *Resize:
+					width, height := FitDimensions(bounds.Dx(), bounds.Dy(), typed.Width, typed.Height)
+					return output.Bounds().Size() == image.Pt(width, height)
+				case *Crop:
+					// Crops outside a small image are empty
+					return output.Bounds().Size() == typed.Rect.Intersect(bounds.Sub(bounds.Min)).Size()
+				default:
+					return output.Bounds().Size() == bounds.Size()
+				}
+			}
+			if err := quick.Check(property, &quick.Config{MaxCount: 40}); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+// cancellingProvider serves its master and then cancels the invocation, s.t.
+// the rest of the stamp runs with a done context
+type cancellingProvider struct {
+	master image.Image
+	cancel context.CancelFunc
+}
+
+func (provider *cancellingProvider) Watermark(ctx context.Context,
+	name string,
+	logger *zerolog.Logger) (image.Image, error) {
+	provider.cancel()
+	return provider.master, nil
+}
+
+func TestRenderStopsOnceCancelled(t *testing.T) {
+	logger := zerolog.Nop()
+	tests := []struct {
+		name       string
+		transforms []Transform
+		stamp      *StampOptions
+	}{
+		{"stamp source", nil, nil},
+		{"stamp resized", []Transform{&Resize{Width: 300, Height: 300}}, nil},
+		{"tiled", nil, &StampOptions{Mode: PlacementTiled, Opacity: 0.5, Blend: BlendMultiply}},
+		{"halo", nil, &StampOptions{Mode: PlacementAnchored, Opacity: 1, Contrast: ContrastHalo}},
+	}
+	for _, eachTest := range tests {
+		t.Run(eachTest.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			// A new master is never in the scaled watermark cache
+			provider := &cancellingProvider{master: gradientWatermark(200, 150), cancel: cancel}
+			pipeline := NewPipeline(eachTest.transforms...)
+			pipeline.Then(&Stamp{Options: eachTest.stamp, Provider: provider})
+			source := &Source{Image: testYCbCr(640, 480), Format: "jpeg"}
+			rendering, err := pipeline.Render(ctx, source, &logger)
+			if !errors.Is(err, context.Canceled) || rendering != nil {
+				t.Errorf("Render() = %v, %v, want context.Canceled", rendering, err)
+			}
+		})
+	}
+}
+
+func TestRenderStopsAtDeadline(t *testing.T) {
+	logger := zerolog.Nop()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	pipeline := NewPipeline(&Resize{Width: 2000, Height: 2000}, &Grayscale{}, &Stamp{})
+	source := &Source{Image: testYCbCr(3000, 3000), Format: "jpeg"}
+	rendering, err := pipeline.Render(ctx, source, &logger)
+	if !errors.Is(err, context.DeadlineExceeded) || rendering != nil {
+		t.Errorf("Render() = %v, %v, want context.DeadlineExceeded", rendering, err)
+	}
+}
+
+func TestOrientStopsOnceCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for orientation := 2; orientation <= 8; orientation++ {
+		if _, err := Orient(ctx, testYCbCr(64, 48), orientation); !errors.Is(err, context.Canceled) {
+			t.Errorf("Orient(%d) = %v, want context.Canceled", orientation, err)
+		}
+	}
+}